@@ -0,0 +1,103 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/term"
+
+	"storj.io/private/process"
+	"storj.io/storj/multinode/accounts"
+	"storj.io/storj/multinode/multinodedb"
+)
+
+// AddAccountConfig defines the configuration of the add-account command.
+type AddAccountConfig struct {
+	Database string `help:"multinode database connection string" default:"sqlite3://file:$CONFDIR/master.db"`
+	Role     string `help:"role of the account, admin or viewer" default:"admin"`
+
+	Accounts accounts.Config
+}
+
+var (
+	addAccountCmd = &cobra.Command{
+		Use:   "add-account <username>",
+		Short: "Create a dashboard account, e.g. the first admin",
+		Long: "Create a dashboard account. The password is read from the terminal, " +
+			"or from the first line of the standard input when it's not a terminal.",
+		Args: cobra.ExactArgs(1),
+		RunE: cmdAddAccount,
+	}
+
+	addAccountCfg AddAccountConfig
+)
+
+func cmdAddAccount(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	password, err := readPassword()
+	if err != nil {
+		return err
+	}
+
+	db, err := multinodedb.Open(ctx, log.Named("db"), addAccountCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on multinode: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+	if err := db.MigrateToLatest(ctx); err != nil {
+		return err
+	}
+
+	service := accounts.NewService(log.Named("accounts:service"), db.Accounts(), addAccountCfg.Accounts)
+	account, err := service.Create(ctx, args[0], password, accounts.Role(addAccountCfg.Role))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s account %q created\n", account.Role, account.Username)
+	return nil
+}
+
+// readPassword reads the password twice from the terminal, or once from
+// the standard input when it's not a terminal.
+func readPassword() (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return "", errs.New("unable to read password from stdin: %w", err)
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	fmt.Print("Password: ")
+	first, err := term.ReadPassword(fd)
+	fmt.Println()
+	if err != nil {
+		return "", errs.New("unable to read password: %w", err)
+	}
+
+	fmt.Print("Again: ")
+	second, err := term.ReadPassword(fd)
+	fmt.Println()
+	if err != nil {
+		return "", errs.New("unable to read password: %w", err)
+	}
+
+	if string(first) != string(second) {
+		return "", errs.New("passwords did not match")
+	}
+	return string(first), nil
+}
@@ -19,6 +19,9 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/private/process"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/invoiceonly"
+	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/satellitedb"
 )
@@ -53,7 +56,7 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripecoinpayments.Servic
 			db.StripeCoinPayments().Customers(),
 			db.Console().Users(),
 		)
-	case "stripecoinpayments":
+	case paymentsconfig.ProviderStripeCoinPayments:
 		stripeClient = stripecoinpayments.NewStripeClient(log, pc.StripeCoinPayments)
 	}
 
@@ -64,6 +67,7 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripecoinpayments.Servic
 		db.StripeCoinPayments(),
 		db.Console().Projects(),
 		db.ProjectAccounting(),
+		db.PricePlans(),
 		pc.StorageTBPrice,
 		pc.EgressTBPrice,
 		pc.ObjectPrice,
@@ -74,6 +78,39 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripecoinpayments.Servic
 		pc.MinCoinPayment)
 }
 
+func runInvoiceOnlyCmd(ctx context.Context, cmdFunc func(context.Context, *invoiceonly.Service) error) error {
+	pc := runCfg.Payments
+	if pc.Provider != paymentsconfig.ProviderInvoiceOnly {
+		return errs.New("payments provider is %q, these commands require %q", pc.Provider, paymentsconfig.ProviderInvoiceOnly)
+	}
+
+	priceModel, err := payments.NewProjectUsagePriceModel(pc.StorageTBPrice, pc.EgressTBPrice, pc.ObjectPrice)
+	if err != nil {
+		return err
+	}
+
+	logger := zap.L()
+	db, err := satellitedb.Open(ctx, logger.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-billing"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	service := invoiceonly.NewService(
+		logger.Named("payments.invoiceonly:service"),
+		db.InvoiceOnly(),
+		db.Console().Projects(),
+		db.Console().Users(),
+		db.ProjectAccounting(),
+		db.PricePlans(),
+		priceModel,
+		pc.InvoiceOnly)
+
+	return cmdFunc(ctx, service)
+}
+
 // parseBillingPeriodFromString parses provided date string and returns corresponding time.Time.
 func parseBillingPeriod(s string) (time.Time, error) {
 	values := strings.Split(s, "/")
@@ -9,6 +9,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"sync/atomic"
 	"text/tabwriter"
 	"time"
@@ -40,6 +41,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/payments/invoiceonly"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/satellitedb"
 )
@@ -237,6 +239,20 @@ var (
 		Long:  "Ensures that all customers with a credit card are in the paid tier.",
 		RunE:  cmdCheckPaidTier,
 	}
+	createInvoiceOnlyInvoicesCmd = &cobra.Command{
+		Use:   "create-invoiceonly-invoices [period]",
+		Short: "Creates invoices of the invoice only payment provider",
+		Long:  "Creates the invoices of all project owners for the billing period, when the invoice only payment provider is used. Invoices that already exist are skipped.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdCreateInvoiceOnlyInvoices,
+	}
+	recordInvoiceOnlyPaymentCmd = &cobra.Command{
+		Use:   "record-invoiceonly-payment [invoice-id] [amount-in-cents] [reference]",
+		Short: "Records a payment of an invoice",
+		Long:  "Records a payment of an invoice of the invoice only payment provider, which was received outside of the satellite.",
+		Args:  cobra.ExactArgs(3),
+		RunE:  cmdRecordInvoiceOnlyPayment,
+	}
 	consistencyCmd = &cobra.Command{
 		Use:   "consistency",
 		Short: "Readdress DB consistency issues",
@@ -248,6 +264,26 @@ var (
 		Long:  "Cleanup Graceful Exit data which is lingering in the transfer queue DB table on nodes which has finished the exit.",
 		RunE:  cmdConsistencyGECleanup,
 	}
+	metabaseCmd = &cobra.Command{
+		Use:   "metabase",
+		Short: "Metabase commands",
+	}
+	metabaseExportCmd = &cobra.Command{
+		Use:   "export [dir]",
+		Short: "Export metabase objects and segments",
+		Long: "Export objects, segments and node aliases of the whole metabase, a project or a bucket into a directory. " +
+			"An interrupted export continues when it's run again with the same directory.",
+		Args: cobra.ExactArgs(1),
+		RunE: cmdMetabaseExport,
+	}
+	metabaseImportCmd = &cobra.Command{
+		Use:   "import [dir]",
+		Short: "Import metabase objects and segments",
+		Long: "Import a metabase export and verify the number of objects and segments afterwards. " +
+			"An interrupted import continues when it's run again with the same directory.",
+		Args: cobra.ExactArgs(1),
+		RunE: cmdMetabaseImport,
+	}
 	restoreTrashCmd = &cobra.Command{
 		Use:   "restore-trash [node-id-1 node-id-2 node-id-3 ...]",
 		Short: "Restore trash",
@@ -294,6 +330,17 @@ var (
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 		Before   string `help:"select only exited nodes before this UTC date formatted like YYYY-MM. Date cannot be newer than the current time (required)"`
 	}
+	metabaseExportCfg struct {
+		MetabaseDB         string        `help:"metabase database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		ProjectID          string        `help:"export only objects of the project" default:""`
+		Bucket             string        `help:"export only objects of the bucket, requires project-id" default:""`
+		ChunkSize          int           `help:"number of objects in a single chunk" default:"100000"`
+		AsOfSystemInterval time.Duration `help:"as of system interval for reading from CockroachDB" releaseDefault:"-5m" devDefault:"-1us"`
+	}
+	metabaseImportCfg struct {
+		MetabaseDB string `help:"metabase database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		SkipVerify bool   `help:"skip comparing object and segment counts with the export, needed when the destination already had objects in the same scope" default:"false"`
+	}
 
 	confDir     string
 	identityDir string
@@ -317,6 +364,7 @@ func init() {
 	rootCmd.AddCommand(compensationCmd)
 	rootCmd.AddCommand(billingCmd)
 	rootCmd.AddCommand(consistencyCmd)
+	rootCmd.AddCommand(metabaseCmd)
 	rootCmd.AddCommand(restoreTrashCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
 	reportsCmd.AddCommand(partnerAttributionCmd)
@@ -333,7 +381,11 @@ func init() {
 	billingCmd.AddCommand(finalizeCustomerInvoicesCmd)
 	billingCmd.AddCommand(stripeCustomerCmd)
 	billingCmd.AddCommand(checkPaidTierCmd)
+	billingCmd.AddCommand(createInvoiceOnlyInvoicesCmd)
+	billingCmd.AddCommand(recordInvoiceOnlyPaymentCmd)
 	consistencyCmd.AddCommand(consistencyGECleanupCmd)
+	metabaseCmd.AddCommand(metabaseExportCmd)
+	metabaseCmd.AddCommand(metabaseImportCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runMigrationCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runAPICmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -358,7 +410,11 @@ func init() {
 	process.Bind(finalizeCustomerInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(stripeCustomerCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(checkPaidTierCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(createInvoiceOnlyInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(recordInvoiceOnlyPaymentCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(consistencyGECleanupCmd, &consistencyGECleanupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(metabaseExportCmd, &metabaseExportCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(metabaseImportCmd, &metabaseImportCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 
 	if err := consistencyGECleanupCmd.MarkFlagRequired("before"); err != nil {
 		panic(err)
@@ -753,6 +809,37 @@ func cmdFinalizeCustomerInvoices(cmd *cobra.Command, args []string) (err error)
 	})
 }
 
+func cmdCreateInvoiceOnlyInvoices(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	period, err := parseBillingPeriod(args[0])
+	if err != nil {
+		return errs.New("invalid period specified: %v", err)
+	}
+
+	return runInvoiceOnlyCmd(ctx, func(ctx context.Context, service *invoiceonly.Service) error {
+		return service.CreateInvoices(ctx, period)
+	})
+}
+
+func cmdRecordInvoiceOnlyPayment(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	invoiceID, err := uuid.FromString(args[0])
+	if err != nil {
+		return errs.New("invalid invoice id specified: %v", err)
+	}
+	amount, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return errs.New("invalid amount specified: %v", err)
+	}
+
+	return runInvoiceOnlyCmd(ctx, func(ctx context.Context, service *invoiceonly.Service) error {
+		_, err := service.RecordPayment(ctx, invoiceID, amount, args[2], time.Now())
+		return err
+	})
+}
+
 func cmdStripeCustomer(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 
@@ -0,0 +1,83 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/private/process"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/dump"
+)
+
+func cmdMetabaseExport(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	var scope dump.Scope
+	if metabaseExportCfg.ProjectID != "" {
+		scope.ProjectID, err = uuid.FromString(metabaseExportCfg.ProjectID)
+		if err != nil {
+			return errs.New("invalid project id: %+v", err)
+		}
+	}
+	scope.BucketName = metabaseExportCfg.Bucket
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), metabaseExportCfg.MetabaseDB)
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	manifest, err := dump.Export(ctx, log, metabaseDB, args[0], dump.ExportOptions{
+		Scope:              scope,
+		ChunkSize:          metabaseExportCfg.ChunkSize,
+		AsOfSystemInterval: metabaseExportCfg.AsOfSystemInterval,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("export finished",
+		zap.Int("chunks", len(manifest.Chunks)),
+		zap.Int64("objects", manifest.Objects),
+		zap.Int64("segments", manifest.Segments))
+	return nil
+}
+
+func cmdMetabaseImport(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), metabaseImportCfg.MetabaseDB)
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	err = metabaseDB.CheckVersion(ctx)
+	if err != nil {
+		return errs.New("failed metabase version check: %+v", err)
+	}
+
+	progress, err := dump.Import(ctx, log, metabaseDB, args[0], dump.ImportOptions{
+		SkipVerify: metabaseImportCfg.SkipVerify,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("import finished",
+		zap.Int64("objects", progress.Objects),
+		zap.Int64("segments", progress.Segments),
+		zap.Int64("skipped", progress.Skipped))
+	return nil
+}
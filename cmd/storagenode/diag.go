@@ -0,0 +1,179 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/rpc"
+	"storj.io/private/version"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/diagnostics"
+	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/preflight"
+	"storj.io/storj/storagenode/storagenodedb"
+	"storj.io/storj/storagenode/trust"
+)
+
+// runSelfCheck runs the self-checks of the node, prints the report and writes the redacted report bundle.
+func runSelfCheck(ctx context.Context, diagDir string, db *storagenodedb.DB) (err error) {
+	log := zap.L()
+
+	identity, err := diagCfg.Identity.Load()
+	if err != nil {
+		return errs.New("Failed to load identity: %+v", err)
+	}
+
+	// the revocation database is locked by the running node, so it isn't used for dialing the satellites.
+	tlsConfig := diagCfg.Server.Config
+	tlsConfig.Extensions.Revocation = false
+	tlsOptions, err := tlsopts.NewOptions(identity, tlsConfig, nil)
+	if err != nil {
+		return err
+	}
+	dialer := rpc.NewDefaultDialer(tlsOptions)
+
+	pool, err := trust.NewPool(log.Named("trust"), trust.Dialer(dialer), diagCfg.Storage2.Trust, db.Satellites())
+	if err != nil {
+		return err
+	}
+	if err := pool.Refresh(ctx); err != nil {
+		// the checks, which don't need the satellites, are still useful.
+		log.Warn("Failed to refresh the trusted satellites.", zap.Error(err))
+	}
+
+	usageCache := pieces.NewBlobsUsageCache(log.Named("blobscache"), db.Pieces())
+	store := pieces.NewStore(log.Named("pieces"),
+		usageCache,
+		db.V0PieceInfo(),
+		db.PieceExpirationDB(),
+		db.PieceSpaceUsedDB(),
+		diagCfg.Pieces,
+	)
+	// the used space is the one of the last used space walk of the node.
+	cacheService := pieces.NewService(log.Named("piecestore:cache"), usageCache, store, diagCfg.Storage2.CacheSyncInterval)
+	if err := cacheService.Init(ctx); err != nil {
+		return err
+	}
+
+	externalAddress := diagCfg.Contact.ExternalAddress
+	if externalAddress == "" {
+		externalAddress = diagCfg.Server.Address
+	}
+	pbVersion, err := version.Build.Proto()
+	if err != nil {
+		return err
+	}
+	contactService := contact.NewService(log.Named("contact:service"), dialer, contact.NodeInfo{
+		ID:      identity.ID,
+		Address: externalAddress,
+		Operator: pb.NodeOperator{
+			Email:          diagCfg.Operator.Email,
+			Wallet:         diagCfg.Operator.Wallet,
+			WalletFeatures: diagCfg.Operator.WalletFeatures,
+		},
+		Version: *pbVersion,
+	}, pool, nil)
+
+	monitorService := monitor.NewService(log.Named("piecestore:monitor"),
+		store,
+		contactService,
+		pool,
+		db.Bandwidth(),
+		nil,
+		nil,
+		db.Allocation(),
+		diagCfg.Storage.AllocatedDiskSpace.Int64(),
+		diagCfg.Storage.KBucketRefreshInterval,
+		nil,
+		diagCfg.Storage2.Monitor,
+	)
+	localTime := preflight.NewLocalTime(log.Named("preflight:localtime"), diagCfg.Preflight, pool, dialer)
+
+	// the last contact is checked with the runtime state of the running node.
+	var inspector diagnostics.Inspector
+	conn, err := rpc.NewDefaultDialer(nil).DialAddressUnencrypted(ctx, diagCfg.Server.PrivateAddress)
+	if err != nil {
+		log.Warn("Failed to connect to the running node.", zap.Error(err))
+	} else {
+		defer func() { err = errs.Combine(err, conn.Close()) }()
+		inspector = internalpb.NewDRPCPieceStoreInspectorClient(conn)
+	}
+
+	service := diagnostics.NewService(log.Named("diagnostics"),
+		diagCfg.Diagnostics,
+		version.Build,
+		identity,
+		diagnostics.Addresses{
+			Server:   diagCfg.Server.Address,
+			External: externalAddress,
+		},
+		db,
+		store,
+		monitorService,
+		localTime,
+		dialer,
+		inspector,
+		pool,
+	)
+
+	report, err := service.Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	placeholders := map[string]string{
+		diagCfg.Operator.Email:  "<email>",
+		diagCfg.Operator.Wallet: "<wallet>",
+		diagCfg.Storage.Path:    "<storage-dir>",
+		confDir:                 "<config-dir>",
+		diagDir:                 "<config-dir>",
+		identityDir:             "<identity-dir>",
+	}
+	if host, _, err := net.SplitHostPort(externalAddress); err == nil {
+		placeholders[host] = "<external-host>"
+	}
+	report.Redact(diagnostics.NewRedactor(placeholders))
+
+	fmt.Println()
+	if err := report.WriteText(os.Stdout); err != nil {
+		return err
+	}
+
+	bundlePath := diagReportPath
+	if bundlePath == "" {
+		bundlePath = filepath.Join(diagDir, "diag-"+report.CreatedAt.Format("20060102-150405")+".zip")
+	}
+	if err := writeReportBundle(bundlePath, report); err != nil {
+		return errs.New("Failed to write the report bundle: %v", err)
+	}
+	fmt.Printf("\nThe redacted report for support tickets was written to %s\n", bundlePath)
+
+	if report.Status() == diagnostics.StatusFailed {
+		return errs.New("Self-check failed")
+	}
+	return nil
+}
+
+// writeReportBundle writes the report bundle to the file.
+func writeReportBundle(path string, report diagnostics.Report) (err error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	return report.WriteBundle(file)
+}
@@ -60,8 +60,15 @@ var (
 		Annotations: map[string]string{"type": "setup"},
 	}
 	diagCmd = &cobra.Command{
-		Use:         "diag",
-		Short:       "Diagnostic Tool support",
+		Use:   "diag",
+		Short: "Diagnostic Tool support",
+		Long: "Print the bandwidth usage of the node.\n" +
+			"With --self-check the self-checks of the node are run as well. They verify the identity, the storage directory, " +
+			"the databases, the disk space, the clock, the reachability of the external address and a sample of the pieces. " +
+			"The reachability is reported from the satellites pinging the node back on its last check-ins, and the last contact with the satellites, " +
+			"so both are only reported while the node is running. The external address is also dialed from the network of the node as supplementary information, " +
+			"while the node isn't running the dial is answered on the address of the node itself. " +
+			"The redacted report is written as a bundle, which can be attached to support tickets.",
 		RunE:        cmdDiag,
 		Annotations: map[string]string{"type": "helper"},
 	}
@@ -91,6 +98,12 @@ var (
 		Short: "Issue apikey for mnd",
 		RunE:  cmdIssue,
 	}
+	testNotificationCmd = &cobra.Command{
+		Use:         "test-notification",
+		Short:       "Send a test notification to the configured notification sinks",
+		RunE:        cmdTestNotification,
+		Annotations: map[string]string{"type": "helper"},
+	}
 
 	runCfg       StorageNodeFlags
 	setupCfg     StorageNodeFlags
@@ -102,6 +115,9 @@ var (
 	confDir        string
 	identityDir    string
 	useColor       bool
+	issueAdmin     bool
+	diagSelfCheck  bool
+	diagReportPath string
 )
 
 const (
@@ -126,6 +142,10 @@ func init() {
 	rootCmd.AddCommand(gracefulExitInitCmd)
 	rootCmd.AddCommand(gracefulExitStatusCmd)
 	rootCmd.AddCommand(issueAPITokenCmd)
+	rootCmd.AddCommand(testNotificationCmd)
+	diagCmd.Flags().BoolVar(&diagSelfCheck, "self-check", false, "run the self-checks of the node and write the redacted report bundle")
+	diagCmd.Flags().StringVar(&diagReportPath, "report", "", "path of the redacted report bundle of the self-checks (default diag-<time>.zip in the config directory)")
+	issueAPITokenCmd.Flags().BoolVar(&issueAdmin, "admin", false, "issue an admin apikey, which also allows to change node's configuration and to control its services")
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(configCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
@@ -134,6 +154,7 @@ func init() {
 	process.Bind(gracefulExitInitCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 	process.Bind(gracefulExitStatusCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 	process.Bind(issueAPITokenCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(testNotificationCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -314,7 +335,12 @@ func cmdIssue(cmd *cobra.Command, args []string) (err error) {
 
 	service := apikeys.NewService(db.APIKeys())
 
-	apiKey, err := service.Issue(ctx)
+	issue := service.Issue
+	if issueAdmin {
+		issue = service.IssueAdmin
+	}
+
+	apiKey, err := issue(ctx)
 	if err != nil {
 		return errs.New("Error while trying to issue new api key: %v", err)
 	}
@@ -360,7 +386,6 @@ func cmdDiag(cmd *cobra.Command, args []string) (err error) {
 	sort.Sort(satellites)
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.AlignRight|tabwriter.Debug)
-	defer func() { err = errs.Combine(err, w.Flush()) }()
 
 	fmt.Fprint(w, "Satellite\tTotal\tPut\tGet\tDelete\tAudit Get\tRepair Get\tRepair Put\n")
 
@@ -377,8 +402,14 @@ func cmdDiag(cmd *cobra.Command, args []string) (err error) {
 			memory.Size(summary.PutRepair),
 		)
 	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
 
-	return nil
+	if !diagSelfCheck {
+		return nil
+	}
+	return runSelfCheck(ctx, diagDir, db)
 }
 
 func main() {
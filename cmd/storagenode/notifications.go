@@ -0,0 +1,46 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/private/process"
+	"storj.io/storj/storagenode/notifications"
+)
+
+func cmdTestNotification(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	identity, err := diagCfg.Identity.Load()
+	if err != nil {
+		return errs.New("Failed to load identity: %+v", err)
+	}
+
+	routes, err := notifications.NewRoutes(identity.ID, diagCfg.Notifications)
+	if err != nil {
+		return err
+	}
+	if len(routes) == 0 {
+		return errs.New("No notification sinks are configured")
+	}
+
+	var failed int
+	for _, route := range routes {
+		if err := route.Test(ctx); err != nil {
+			failed++
+			fmt.Printf("%s: failed: %v\n", route.Name(), err)
+			continue
+		}
+		fmt.Printf("%s: sent\n", route.Name())
+	}
+
+	if failed > 0 {
+		return errs.New("Failed to send the test notification to %d of %d sinks", failed, len(routes))
+	}
+	return nil
+}
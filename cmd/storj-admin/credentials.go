@@ -0,0 +1,213 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/satellite/admin/credentials"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+var (
+	credentialsCmd = &cobra.Command{
+		Use:   "credentials",
+		Short: "Manage the named credentials of the satellite admin API",
+	}
+	credentialsCreateCmd = &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a credential and print its secret",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdCredentialsCreate,
+	}
+	credentialsListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the credentials",
+		Args:  cobra.NoArgs,
+		RunE:  cmdCredentialsList,
+	}
+	credentialsRotateCmd = &cobra.Command{
+		Use:   "rotate <name>",
+		Short: "Replace the secret of a credential and print the new secret",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdCredentialsRotate,
+	}
+	credentialsScopesCmd = &cobra.Command{
+		Use:   "set-scopes <name>",
+		Short: "Replace the scopes of a credential",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdCredentialsSetScopes,
+	}
+	credentialsRevokeCmd = &cobra.Command{
+		Use:   "revoke <name>",
+		Short: "Delete a credential",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdCredentialsRevoke,
+	}
+
+	credentialsCfg CredentialsConf
+)
+
+// CredentialsConf defines the configuration of the credentials commands.
+type CredentialsConf struct {
+	Database string `help:"satellite database connection string" default:""`
+	Scopes   string `help:"comma separated list of the credential scopes: read, users, limits, billing and destructive" default:"read"`
+}
+
+func init() {
+	rootCmd.AddCommand(credentialsCmd)
+	for _, cmd := range []*cobra.Command{
+		credentialsCreateCmd,
+		credentialsListCmd,
+		credentialsRotateCmd,
+		credentialsScopesCmd,
+		credentialsRevokeCmd,
+	} {
+		credentialsCmd.AddCommand(cmd)
+		process.Bind(cmd, &credentialsCfg, cfgstruct.ConfDir(confDir))
+	}
+}
+
+// withCredentialsDB runs fn with the credentials database of the satellite.
+func withCredentialsDB(cmd *cobra.Command, fn func(ctx context.Context, db credentials.DB) error) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	if credentialsCfg.Database == "" {
+		return errs.New("satellite database connection string is required")
+	}
+
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), credentialsCfg.Database, satellitedb.Options{
+		ApplicationName: "storj-admin",
+	})
+	if err != nil {
+		return errs.New("failed to connect to satellite database: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	return fn(ctx, db.AdminCredentials())
+}
+
+func cmdCredentialsCreate(cmd *cobra.Command, args []string) error {
+	scopes, err := credentials.ParseScopes(credentialsCfg.Scopes)
+	if err != nil {
+		return err
+	}
+
+	return withCredentialsDB(cmd, func(ctx context.Context, db credentials.DB) error {
+		id, err := uuid.New()
+		if err != nil {
+			return err
+		}
+		secret, hash, err := credentials.NewSecret()
+		if err != nil {
+			return err
+		}
+
+		err = db.Insert(ctx, credentials.Credential{
+			ID:         id,
+			Name:       args[0],
+			Scopes:     scopes,
+			SecretHash: hash,
+			CreatedAt:  time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		printSecret(args[0], secret)
+		return nil
+	})
+}
+
+func cmdCredentialsList(cmd *cobra.Command, args []string) error {
+	return withCredentialsDB(cmd, func(ctx context.Context, db credentials.DB) error {
+		all, err := db.List(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tSCOPES\tCREATED\tROTATED")
+		for _, credential := range all {
+			rotated := "-"
+			if credential.RotatedAt != nil {
+				rotated = credential.RotatedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", credential.Name, credentials.FormatScopes(credential.Scopes),
+				credential.CreatedAt.Format(time.RFC3339), rotated)
+		}
+		return w.Flush()
+	})
+}
+
+func cmdCredentialsRotate(cmd *cobra.Command, args []string) error {
+	return withCredentialsDB(cmd, func(ctx context.Context, db credentials.DB) error {
+		credential, err := db.GetByName(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		secret, hash, err := credentials.NewSecret()
+		if err != nil {
+			return err
+		}
+		if err := db.Rotate(ctx, credential.ID, hash, time.Now()); err != nil {
+			return err
+		}
+
+		printSecret(credential.Name, secret)
+		return nil
+	})
+}
+
+func cmdCredentialsSetScopes(cmd *cobra.Command, args []string) error {
+	scopes, err := credentials.ParseScopes(credentialsCfg.Scopes)
+	if err != nil {
+		return err
+	}
+
+	return withCredentialsDB(cmd, func(ctx context.Context, db credentials.DB) error {
+		credential, err := db.GetByName(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		if err := db.UpdateScopes(ctx, credential.ID, scopes); err != nil {
+			return err
+		}
+
+		fmt.Printf("credential %q has the scopes %s\n", credential.Name, credentials.FormatScopes(scopes))
+		return nil
+	})
+}
+
+func cmdCredentialsRevoke(cmd *cobra.Command, args []string) error {
+	return withCredentialsDB(cmd, func(ctx context.Context, db credentials.DB) error {
+		credential, err := db.GetByName(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		if err := db.Delete(ctx, credential.ID); err != nil {
+			return err
+		}
+
+		fmt.Printf("credential %q revoked\n", credential.Name)
+		return nil
+	})
+}
+
+// printSecret prints the secret, which can't be retrieved later.
+func printSecret(name, secret string) {
+	fmt.Printf("credential %q secret, it's shown only once:\n%s\n", name, secret)
+}
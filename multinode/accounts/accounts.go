@@ -0,0 +1,103 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounts
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// DB exposes needed by MND accounts and sessions functionality.
+//
+// architecture: Database
+type DB interface {
+	// Create inserts a new account, it returns ErrUsernameTaken when the username is used.
+	Create(ctx context.Context, account Account) error
+	// Get returns the account with the given id.
+	Get(ctx context.Context, id uuid.UUID) (Account, error)
+	// GetByUsername returns the account with the given username.
+	GetByUsername(ctx context.Context, username string) (Account, error)
+	// List returns all accounts ordered by username.
+	List(ctx context.Context) ([]Account, error)
+	// Update updates the password hash, role and TOTP settings of the account.
+	Update(ctx context.Context, account Account) error
+	// Delete deletes the account and its sessions.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// CreateSession inserts a new session.
+	CreateSession(ctx context.Context, session Session) error
+	// GetSession returns the session with the given id.
+	GetSession(ctx context.Context, id []byte) (Session, error)
+	// DeleteSession deletes the session with the given id.
+	DeleteSession(ctx context.Context, id []byte) error
+	// DeleteAccountSessions deletes all sessions of the account.
+	DeleteAccountSessions(ctx context.Context, accountID uuid.UUID) error
+}
+
+var (
+	// ErrNoAccount is a special error type that indicates about absence of account in AccountsDB.
+	ErrNoAccount = errs.Class("no such account")
+	// ErrNoSession is a special error type that indicates about absence of session in AccountsDB.
+	ErrNoSession = errs.Class("no such session")
+	// ErrUsernameTaken indicates that an account with the same username already exists.
+	ErrUsernameTaken = errs.Class("username is already taken")
+)
+
+// Role defines what an account is allowed to do.
+type Role string
+
+const (
+	// RoleViewer allows reading the nodes data.
+	RoleViewer Role = "viewer"
+	// RoleAdmin additionally allows managing nodes and accounts.
+	RoleAdmin Role = "admin"
+)
+
+// Valid returns whether the role is known.
+func (role Role) Valid() bool {
+	return role == RoleViewer || role == RoleAdmin
+}
+
+// Account is an operator account of the Multinode Dashboard.
+type Account struct {
+	ID           uuid.UUID `json:"id"`
+	Username     string    `json:"username"`
+	PasswordHash []byte    `json:"-"`
+	Role         Role      `json:"role"`
+	// TOTPSecret is the secret of the time based one time passwords, it's set
+	// before TOTP is enabled, so that the first code can be verified.
+	TOTPSecret  string    `json:"-"`
+	TOTPEnabled bool      `json:"totpEnabled"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// IsAdmin returns whether the account has the admin role.
+func (account Account) IsAdmin() bool {
+	return account.Role == RoleAdmin
+}
+
+// Session is a login session of an account.
+type Session struct {
+	// ID is the hash of the session token, the token itself is not stored.
+	ID        []byte
+	AccountID uuid.UUID
+	ExpiresAt time.Time
+}
+
+type accountKey struct{}
+
+// WithAccount returns a context with the authenticated account.
+func WithAccount(ctx context.Context, account Account) context.Context {
+	return context.WithValue(ctx, accountKey{}, account)
+}
+
+// GetAccount returns the authenticated account from the context.
+func GetAccount(ctx context.Context) (Account, bool) {
+	account, ok := ctx.Value(accountKey{}).(Account)
+	return account, ok
+}
@@ -0,0 +1,413 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounts
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is an error class for accounts service error.
+	Error = errs.Class("accounts")
+	// ErrUnauthorized indicates that the credentials or the session are invalid.
+	ErrUnauthorized = errs.Class("unauthorized")
+	// ErrTOTPRequired indicates that the account requires a TOTP code to log in.
+	ErrTOTPRequired = errs.Class("totp code required")
+	// ErrValidation indicates that the request arguments are invalid.
+	ErrValidation = errs.Class("validation")
+)
+
+// minPasswordLength is the minimal length of the account passwords.
+const minPasswordLength = 8
+
+// totpIssuer is the issuer shown in the authenticator apps.
+const totpIssuer = "Storj Multinode Dashboard"
+
+// totpOpts are the options of the generated TOTP codes.
+var totpOpts = totp.ValidateOpts{
+	Period:    30,
+	Skew:      1,
+	Digits:    otp.DigitsSix,
+	Algorithm: otp.AlgorithmSHA1,
+}
+
+// Config contains configuration for the accounts service.
+type Config struct {
+	SessionDuration time.Duration `help:"duration of the login sessions" default:"24h"`
+	PasswordCost    int           `help:"bcrypt cost of the password hashes, 0 uses the default cost" default:"0" testDefault:"4"`
+}
+
+// Service exposes all accounts and sessions related logic.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	// dummyHash is compared with the password of unknown usernames, so that
+	// the duration of the login doesn't reveal whether the username exists.
+	dummyHash []byte
+
+	mu sync.Mutex
+	// totpSteps contains the time step of the last accepted TOTP code of
+	// the accounts, so that a code can't be used twice.
+	totpSteps map[uuid.UUID]uint64
+
+	nowFn func() time.Time
+}
+
+// NewService creates new instance of Service.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	if config.PasswordCost == 0 {
+		config.PasswordCost = bcrypt.DefaultCost
+	}
+
+	dummyHash, err := bcrypt.GenerateFromPassword([]byte("dummy password"), config.PasswordCost)
+	if err != nil {
+		log.Error("failed to generate dummy password hash", zap.Error(err))
+	}
+
+	return &Service{
+		log:       log,
+		db:        db,
+		config:    config,
+		dummyHash: dummyHash,
+		totpSteps: make(map[uuid.UUID]uint64),
+		nowFn:     time.Now,
+	}
+}
+
+// Create creates a new account.
+func (service *Service) Create(ctx context.Context, username, password string, role Role) (_ Account, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return Account{}, ErrValidation.New("username is required")
+	}
+	if !role.Valid() {
+		return Account{}, ErrValidation.New("unknown role %q", role)
+	}
+
+	hash, err := service.hashPassword(password)
+	if err != nil {
+		return Account{}, err
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return Account{}, Error.Wrap(err)
+	}
+
+	account := Account{
+		ID:           id,
+		Username:     username,
+		PasswordHash: hash,
+		Role:         role,
+		CreatedAt:    service.nowFn().UTC(),
+	}
+	if err := service.db.Create(ctx, account); err != nil {
+		if ErrUsernameTaken.Has(err) {
+			return Account{}, err
+		}
+		return Account{}, Error.Wrap(err)
+	}
+
+	return account, nil
+}
+
+// List returns all accounts.
+func (service *Service) List(ctx context.Context) (_ []Account, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	accounts, err := service.db.List(ctx)
+	return accounts, Error.Wrap(err)
+}
+
+// Delete deletes the account and its sessions. The last admin can't be deleted.
+func (service *Service) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	account, err := service.db.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if account.IsAdmin() {
+		all, err := service.db.List(ctx)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		admins := 0
+		for _, other := range all {
+			if other.IsAdmin() {
+				admins++
+			}
+		}
+		if admins <= 1 {
+			return ErrValidation.New("the last admin account can't be deleted")
+		}
+	}
+
+	if err := service.db.Delete(ctx, id); err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.mu.Lock()
+	delete(service.totpSteps, id)
+	service.mu.Unlock()
+
+	return nil
+}
+
+// ChangePassword replaces the password of the account and ends its sessions.
+func (service *Service) ChangePassword(ctx context.Context, id uuid.UUID, password, newPassword string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	account, err := service.db.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if bcrypt.CompareHashAndPassword(account.PasswordHash, []byte(password)) != nil {
+		return ErrUnauthorized.New("invalid password")
+	}
+
+	account.PasswordHash, err = service.hashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+	if err := service.db.Update(ctx, account); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(service.db.DeleteAccountSessions(ctx, id))
+}
+
+// Login checks the credentials and creates a new session. It returns the
+// session token and the time when it expires.
+func (service *Service) Login(ctx context.Context, username, password, totpCode string) (token string, expiresAt time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	account, err := service.db.GetByUsername(ctx, username)
+	if err != nil {
+		if ErrNoAccount.Has(err) {
+			_ = bcrypt.CompareHashAndPassword(service.dummyHash, []byte(password))
+			return "", time.Time{}, ErrUnauthorized.New("invalid username or password")
+		}
+		return "", time.Time{}, Error.Wrap(err)
+	}
+	if bcrypt.CompareHashAndPassword(account.PasswordHash, []byte(password)) != nil {
+		return "", time.Time{}, ErrUnauthorized.New("invalid username or password")
+	}
+
+	if account.TOTPEnabled {
+		if totpCode == "" {
+			return "", time.Time{}, ErrTOTPRequired.New("")
+		}
+		if !service.validateTOTP(account.ID, account.TOTPSecret, totpCode) {
+			return "", time.Time{}, ErrUnauthorized.New("invalid totp code")
+		}
+	}
+
+	var data [32]byte
+	if _, err := rand.Read(data[:]); err != nil {
+		return "", time.Time{}, Error.Wrap(err)
+	}
+	token = base64.RawURLEncoding.EncodeToString(data[:])
+	expiresAt = service.nowFn().Add(service.config.SessionDuration).UTC()
+
+	err = service.db.CreateSession(ctx, Session{
+		ID:        hashToken(token),
+		AccountID: account.ID,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return "", time.Time{}, Error.Wrap(err)
+	}
+
+	return token, expiresAt, nil
+}
+
+// Authorize returns the account of the session token.
+func (service *Service) Authorize(ctx context.Context, token string) (_ Account, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if token == "" {
+		return Account{}, ErrUnauthorized.New("session token is missing")
+	}
+
+	session, err := service.db.GetSession(ctx, hashToken(token))
+	if err != nil {
+		if ErrNoSession.Has(err) {
+			return Account{}, ErrUnauthorized.New("invalid session")
+		}
+		return Account{}, Error.Wrap(err)
+	}
+
+	if !service.nowFn().Before(session.ExpiresAt) {
+		if err := service.db.DeleteSession(ctx, session.ID); err != nil {
+			service.log.Warn("failed to delete expired session", zap.Error(err))
+		}
+		return Account{}, ErrUnauthorized.New("session expired")
+	}
+
+	account, err := service.db.Get(ctx, session.AccountID)
+	if err != nil {
+		if ErrNoAccount.Has(err) {
+			return Account{}, ErrUnauthorized.New("invalid session")
+		}
+		return Account{}, Error.Wrap(err)
+	}
+
+	return account, nil
+}
+
+// Logout ends the session of the token.
+func (service *Service) Logout(ctx context.Context, token string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(service.db.DeleteSession(ctx, hashToken(token)))
+}
+
+// GenerateTOTP generates a new TOTP secret for the account, which is enabled
+// by EnableTOTP with a valid code. It returns the key URL for the authenticator apps.
+func (service *Service) GenerateTOTP(ctx context.Context, id uuid.UUID) (url string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	account, err := service.db.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if account.TOTPEnabled {
+		return "", ErrValidation.New("totp is already enabled")
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      totpIssuer,
+		AccountName: account.Username,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	account.TOTPSecret = key.Secret()
+	if err := service.db.Update(ctx, account); err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	return key.URL(), nil
+}
+
+// EnableTOTP enables TOTP for the account, when the code matches the generated secret.
+func (service *Service) EnableTOTP(ctx context.Context, id uuid.UUID, code string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	account, err := service.db.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if account.TOTPEnabled {
+		return ErrValidation.New("totp is already enabled")
+	}
+	if account.TOTPSecret == "" {
+		return ErrValidation.New("totp secret is not generated")
+	}
+	if !service.validateTOTP(account.ID, account.TOTPSecret, code) {
+		return ErrUnauthorized.New("invalid totp code")
+	}
+
+	account.TOTPEnabled = true
+	return Error.Wrap(service.db.Update(ctx, account))
+}
+
+// DisableTOTP disables TOTP for the account, when the code is valid.
+func (service *Service) DisableTOTP(ctx context.Context, id uuid.UUID, code string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	account, err := service.db.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !account.TOTPEnabled {
+		return ErrValidation.New("totp is not enabled")
+	}
+	if !service.validateTOTP(account.ID, account.TOTPSecret, code) {
+		return ErrUnauthorized.New("invalid totp code")
+	}
+
+	account.TOTPEnabled = false
+	account.TOTPSecret = ""
+	return Error.Wrap(service.db.Update(ctx, account))
+}
+
+// validateTOTP returns whether the code is valid for the secret at the current
+// time. A code is accepted only once for the account.
+func (service *Service) validateTOTP(accountID uuid.UUID, secret, code string) bool {
+	now := service.nowFn()
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	for skew := -int(totpOpts.Skew); skew <= int(totpOpts.Skew); skew++ {
+		at := now.Add(time.Duration(skew*int(totpOpts.Period)) * time.Second)
+		expected, err := totp.GenerateCodeCustom(secret, at, totpOpts)
+		if err != nil {
+			return false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) != 1 {
+			continue
+		}
+
+		step := uint64(at.Unix()) / uint64(totpOpts.Period)
+		if step <= service.totpSteps[accountID] {
+			return false
+		}
+		service.totpSteps[accountID] = step
+		return true
+	}
+
+	return false
+}
+
+// hashPassword validates and hashes the password.
+func (service *Service) hashPassword(password string) ([]byte, error) {
+	if len(password) < minPasswordLength {
+		return nil, ErrValidation.New("password must contain at least %d characters", minPasswordLength)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), service.config.PasswordCost)
+	return hash, Error.Wrap(err)
+}
+
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// hashToken returns the session id of the token.
+func hashToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
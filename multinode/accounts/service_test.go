@@ -0,0 +1,154 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/crypto/bcrypt"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/accounts"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+)
+
+func TestService(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		service := accounts.NewService(zaptest.NewLogger(t), db.Accounts(), accounts.Config{
+			SessionDuration: time.Hour,
+			PasswordCost:    bcrypt.MinCost,
+		})
+
+		admin, err := service.Create(ctx, "admin", "admin-password", accounts.RoleAdmin)
+		require.NoError(t, err)
+		require.True(t, admin.IsAdmin())
+
+		_, err = service.Create(ctx, "admin", "other-password", accounts.RoleViewer)
+		require.True(t, accounts.ErrUsernameTaken.Has(err))
+		_, err = service.Create(ctx, "viewer", "short", accounts.RoleViewer)
+		require.True(t, accounts.ErrValidation.Has(err))
+		_, err = service.Create(ctx, "viewer", "viewer-password", accounts.Role("owner"))
+		require.True(t, accounts.ErrValidation.Has(err))
+
+		viewer, err := service.Create(ctx, "viewer", "viewer-password", accounts.RoleViewer)
+		require.NoError(t, err)
+		require.False(t, viewer.IsAdmin())
+
+		list, err := service.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, "admin", list[0].Username)
+
+		t.Run("login", func(t *testing.T) {
+			_, _, err := service.Login(ctx, "viewer", "wrong-password", "")
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+			_, _, err = service.Login(ctx, "nobody", "viewer-password", "")
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+
+			token, expiresAt, err := service.Login(ctx, "viewer", "viewer-password", "")
+			require.NoError(t, err)
+			require.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+			account, err := service.Authorize(ctx, token)
+			require.NoError(t, err)
+			require.Equal(t, viewer.ID, account.ID)
+			require.Equal(t, accounts.RoleViewer, account.Role)
+
+			_, err = service.Authorize(ctx, "invalid")
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+
+			service.SetNow(func() time.Time { return time.Now().Add(2 * time.Hour) })
+			_, err = service.Authorize(ctx, token)
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+			service.SetNow(time.Now)
+
+			token, _, err = service.Login(ctx, "viewer", "viewer-password", "")
+			require.NoError(t, err)
+			require.NoError(t, service.Logout(ctx, token))
+			_, err = service.Authorize(ctx, token)
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+		})
+
+		t.Run("password", func(t *testing.T) {
+			token, _, err := service.Login(ctx, "viewer", "viewer-password", "")
+			require.NoError(t, err)
+
+			err = service.ChangePassword(ctx, viewer.ID, "wrong-password", "new-password")
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+			require.NoError(t, service.ChangePassword(ctx, viewer.ID, "viewer-password", "new-password"))
+
+			// the existing sessions are ended.
+			_, err = service.Authorize(ctx, token)
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+
+			_, _, err = service.Login(ctx, "viewer", "viewer-password", "")
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+			_, _, err = service.Login(ctx, "viewer", "new-password", "")
+			require.NoError(t, err)
+		})
+
+		t.Run("totp", func(t *testing.T) {
+			url, err := service.GenerateTOTP(ctx, admin.ID)
+			require.NoError(t, err)
+			key, err := otp.NewKeyFromURL(url)
+			require.NoError(t, err)
+
+			// TOTP is not required before it's enabled.
+			_, _, err = service.Login(ctx, "admin", "admin-password", "")
+			require.NoError(t, err)
+
+			require.True(t, accounts.ErrUnauthorized.Has(service.EnableTOTP(ctx, admin.ID, "000000")))
+			code, err := totp.GenerateCode(key.Secret(), time.Now())
+			require.NoError(t, err)
+			require.NoError(t, service.EnableTOTP(ctx, admin.ID, code))
+
+			_, _, err = service.Login(ctx, "admin", "admin-password", "")
+			require.True(t, accounts.ErrTOTPRequired.Has(err))
+			_, _, err = service.Login(ctx, "admin", "admin-password", "000000")
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+			// the code used to enable TOTP can't be used again.
+			_, _, err = service.Login(ctx, "admin", "admin-password", code)
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+
+			now := time.Now().Add(30 * time.Second)
+			service.SetNow(func() time.Time { return now })
+			defer service.SetNow(time.Now)
+
+			code, err = totp.GenerateCode(key.Secret(), now)
+			require.NoError(t, err)
+			_, _, err = service.Login(ctx, "admin", "admin-password", code)
+			require.NoError(t, err)
+			_, _, err = service.Login(ctx, "admin", "admin-password", code)
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+
+			now = now.Add(30 * time.Second)
+			code, err = totp.GenerateCode(key.Secret(), now)
+			require.NoError(t, err)
+			require.NoError(t, service.DisableTOTP(ctx, admin.ID, code))
+			_, _, err = service.Login(ctx, "admin", "admin-password", "")
+			require.NoError(t, err)
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			err := service.Delete(ctx, admin.ID)
+			require.True(t, accounts.ErrValidation.Has(err), "the last admin can't be deleted")
+
+			token, _, err := service.Login(ctx, "viewer", "new-password", "")
+			require.NoError(t, err)
+
+			require.NoError(t, service.Delete(ctx, viewer.ID))
+			_, err = service.Authorize(ctx, token)
+			require.True(t, accounts.ErrUnauthorized.Has(err))
+
+			err = service.Delete(ctx, viewer.ID)
+			require.True(t, accounts.ErrNoAccount.Has(err))
+		})
+	})
+}
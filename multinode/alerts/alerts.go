@@ -0,0 +1,100 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/notify"
+)
+
+// DB exposes needed by MND AlertsDB functionality.
+//
+// architecture: Database
+type DB interface {
+	// Create inserts a new alert.
+	Create(ctx context.Context, alert Alert) error
+	// Active returns all alerts which aren't resolved.
+	Active(ctx context.Context) ([]Alert, error)
+	// List returns the most recently triggered alerts, newest first.
+	List(ctx context.Context, limit int) ([]Alert, error)
+	// UpdateNotified updates the message and the last notification time of the alert.
+	UpdateNotified(ctx context.Context, id uuid.UUID, message string, notifiedAt time.Time) error
+	// Resolve marks the alert as resolved.
+	Resolve(ctx context.Context, id uuid.UUID, resolvedAt time.Time) error
+}
+
+var (
+	// Error is the default error class for alerts.
+	Error = errs.Class("alerts")
+	// ErrNoAlert is a special error type that indicates about absence of alert in AlertsDB.
+	ErrNoAlert = errs.Class("no such alert")
+)
+
+// Rule identifies an alerting rule.
+type Rule string
+
+const (
+	// RuleOffline fires when the node is offline for too long.
+	RuleOffline Rule = "offline"
+	// RuleAuditScore fires when the audit score is below the threshold on any satellite.
+	RuleAuditScore Rule = "audit_score"
+	// RuleSuspensionScore fires when the suspension score is below the threshold on any satellite.
+	RuleSuspensionScore Rule = "suspension_score"
+	// RuleDiskFree fires when the node is running out of disk space.
+	RuleDiskFree Rule = "disk_free"
+	// RuleVersion fires when the node runs a version older than the minimum.
+	RuleVersion Rule = "version"
+	// RulePayoutDrop fires when the payout estimation dropped compared to the past.
+	RulePayoutDrop Rule = "payout_drop"
+)
+
+// Alert is a rule triggered by a node.
+type Alert struct {
+	ID          uuid.UUID    `json:"id"`
+	NodeID      storj.NodeID `json:"nodeId"`
+	Rule        Rule         `json:"rule"`
+	Message     string       `json:"message"`
+	TriggeredAt time.Time    `json:"triggeredAt"`
+	NotifiedAt  time.Time    `json:"notifiedAt"`
+	ResolvedAt  *time.Time   `json:"resolvedAt"`
+}
+
+// Active returns whether the alert isn't resolved yet.
+func (alert Alert) Active() bool {
+	return alert.ResolvedAt == nil
+}
+
+// Event describes why a notification is sent.
+type Event string
+
+const (
+	// EventFiring is sent when an alert is triggered and repeatedly while it's active.
+	EventFiring Event = "firing"
+	// EventResolved is sent when an alert is resolved.
+	EventResolved Event = "resolved"
+)
+
+// Notification is sent to the notifiers when the state of an alert changes.
+type Notification struct {
+	Event    Event  `json:"event"`
+	NodeName string `json:"nodeName"`
+	Alert    Alert  `json:"alert"`
+}
+
+// Config contains configurable values for alerts.
+type Config struct {
+	Interval         time.Duration `help:"how frequently the alert rules are evaluated" default:"5m0s"`
+	RenotifyInterval time.Duration `help:"how frequently notifications for an active alert are repeated" default:"24h0m0s"`
+
+	Rules   RulesConfig
+	SMTP    notify.SMTPConfig
+	Webhook notify.WebhookConfig
+	Command notify.CommandConfig
+}
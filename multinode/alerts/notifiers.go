@@ -0,0 +1,91 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"storj.io/storj/private/notify"
+)
+
+// Notifier delivers alert notifications.
+type Notifier interface {
+	// Name returns the name of the notifier used in the logs.
+	Name() string
+	// Notify delivers the notification.
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// NewNotifiers creates the notifiers enabled in the config.
+func NewNotifiers(config Config) ([]Notifier, error) {
+	enabled, err := notify.NewNotifiers(notify.Config{
+		SMTP:    config.SMTP,
+		Webhook: config.Webhook,
+		Command: config.Command,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifiers := make([]Notifier, 0, len(enabled))
+	for _, notifier := range enabled {
+		notifiers = append(notifiers, NewNotifier(notifier))
+	}
+	return notifiers, nil
+}
+
+// NewNotifier creates a notifier delivering the alert notifications with the generic notifier.
+//
+// The notification is passed to commands as json on the standard input and
+// its main fields as ALERT_* environment variables.
+func NewNotifier(notifier notify.Notifier) Notifier {
+	return &messageNotifier{notifier: notifier}
+}
+
+// messageNotifier delivers alert notifications as notify messages.
+type messageNotifier struct {
+	notifier notify.Notifier
+}
+
+// Name implements Notifier.
+func (notifier *messageNotifier) Name() string { return notifier.notifier.Name() }
+
+// Notify implements Notifier.
+func (notifier *messageNotifier) Notify(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return notifier.notifier.Notify(ctx, notificationMessage(notification))
+}
+
+// notificationMessage converts the notification to a notify message.
+func notificationMessage(notification Notification) notify.Message {
+	fields := []notify.Field{
+		{Label: "Node", Value: nodeLabel(notification)},
+		{Env: "ALERT_NODE_ID", Value: notification.Alert.NodeID.String()},
+		{Env: "ALERT_NODE_NAME", Value: notification.NodeName},
+		{Label: "Rule", Env: "ALERT_RULE", Value: string(notification.Alert.Rule)},
+		{Label: "Status", Env: "ALERT_EVENT", Value: string(notification.Event)},
+		{Label: "Message", Env: "ALERT_MESSAGE", Value: notification.Alert.Message},
+		{Label: "Triggered at", Value: notification.Alert.TriggeredAt.Format(time.RFC3339)},
+	}
+	if notification.Alert.ResolvedAt != nil {
+		fields = append(fields, notify.Field{Label: "Resolved at", Value: notification.Alert.ResolvedAt.Format(time.RFC3339)})
+	}
+
+	return notify.Message{
+		Subject: fmt.Sprintf("[%s] %s: %s", notification.Event, nodeLabel(notification), notification.Alert.Rule),
+		Fields:  fields,
+		Payload: notification,
+	}
+}
+
+// nodeLabel returns the name of the node followed by its id.
+func nodeLabel(notification Notification) string {
+	if notification.NodeName == "" {
+		return notification.Alert.NodeID.String()
+	}
+	return fmt.Sprintf("%s (%s)", notification.NodeName, notification.Alert.NodeID)
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode/alerts"
+	"storj.io/storj/private/notify"
+)
+
+func testNotification() alerts.Notification {
+	return alerts.Notification{
+		Event:    alerts.EventFiring,
+		NodeName: "node",
+		Alert: alerts.Alert{
+			ID:          testrand.UUID(),
+			NodeID:      testrand.NodeID(),
+			Rule:        alerts.RuleDiskFree,
+			Message:     "available disk space 1.00 GB is below 10.00 GB",
+			TriggeredAt: time.Date(2021, 8, 1, 10, 0, 0, 0, time.UTC),
+			NotifiedAt:  time.Date(2021, 8, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	received := make(chan alerts.Notification, 1)
+	status := int32(http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var notification alerts.Notification
+		if err := json.NewDecoder(r.Body).Decode(&notification); err == nil {
+			received <- notification
+		}
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer server.Close()
+
+	notifier := alerts.NewNotifier(notify.NewWebhookNotifier(notify.WebhookConfig{URL: server.URL, Timeout: time.Second}))
+
+	notification := testNotification()
+	require.NoError(t, notifier.Notify(ctx, notification))
+	require.Equal(t, notification, <-received)
+
+	atomic.StoreInt32(&status, http.StatusInternalServerError)
+	require.Error(t, notifier.Notify(ctx, notification))
+}
+
+func TestCommandNotifier(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a shell")
+	}
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	output := ctx.File("output")
+	script := filepath.Join(ctx.Dir("bin"), "notify.sh")
+	require.NoError(t, ioutil.WriteFile(script, []byte("#!/bin/sh\necho \"$ALERT_EVENT $ALERT_RULE $ALERT_NODE_NAME $ALERT_NODE_ID\" > "+output+"\ncat >> "+output+"\n"), 0700))
+
+	notifier := alerts.NewNotifier(notify.NewCommandNotifier(notify.CommandConfig{Path: script, Timeout: 10 * time.Second}))
+
+	notification := testNotification()
+	require.NoError(t, notifier.Notify(ctx, notification))
+
+	data, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+
+	expected, err := json.Marshal(notification)
+	require.NoError(t, err)
+	require.Equal(t, "firing disk_free node "+notification.Alert.NodeID.String()+"\n"+string(expected), string(data))
+
+	failing := alerts.NewNotifier(notify.NewCommandNotifier(notify.CommandConfig{Path: filepath.Join(ctx.Dir("bin"), "missing")}))
+	require.Error(t, failing.Notify(ctx, notification))
+}
@@ -0,0 +1,166 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"fmt"
+	"time"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/private/version"
+	"storj.io/storj/multinode/nodes"
+)
+
+// RulesConfig contains the thresholds of the alert rules, a zero value disables the rule.
+type RulesConfig struct {
+	OfflineAfter       time.Duration `help:"alert when a node is offline or unreachable for longer than this" default:"4h0m0s"`
+	MinAuditScore      float64       `help:"alert when the audit score on any satellite is below this" default:"0.98"`
+	MinSuspensionScore float64       `help:"alert when the suspension score on any satellite is below this" default:"0.9"`
+	MinDiskFree        memory.Size   `help:"alert when the available disk space is below this" default:"10GB"`
+	MinVersion         string        `help:"alert when a node runs a version older than this" default:""`
+	PayoutDrop         float64       `help:"alert when the payout estimation falls below this fraction of the estimation in the past" default:"0.5"`
+	PayoutDropWindow   time.Duration `help:"how far in the past the payout estimation is compared" default:"168h0m0s"`
+}
+
+// NodeState is the state of a node the rules are evaluated against.
+// Metrics which couldn't be fetched are nil, the rules depending on them
+// are then neither fired nor resolved.
+type NodeState struct {
+	ID   storj.NodeID
+	Name string
+
+	Status nodes.Status
+	// OfflineSince is when the node was last seen online, it's zero for online nodes.
+	OfflineSince time.Time
+	Version      string
+
+	DiskSpaceAvailable *int64
+	// AuditScore and SuspensionScore are the lowest across the satellites.
+	AuditScore      *float64
+	SuspensionScore *float64
+
+	EstimatedPayout *int64
+	// PastEstimatedPayout is the oldest payout estimation in the older half of the payout drop window.
+	PastEstimatedPayout *int64
+}
+
+// result is the outcome of evaluating a rule against a node.
+type result int
+
+const (
+	resultUnknown result = iota
+	resultOK
+	resultFiring
+)
+
+// evaluateFunc evaluates a node state and returns the alert message when it's firing.
+type evaluateFunc func(config RulesConfig, state NodeState, now time.Time) (result, string)
+
+// rules contains all the supported rules in the order they are evaluated.
+var rules = []struct {
+	rule     Rule
+	evaluate evaluateFunc
+}{
+	{RuleOffline, evaluateOffline},
+	{RuleAuditScore, evaluateAuditScore},
+	{RuleSuspensionScore, evaluateSuspensionScore},
+	{RuleDiskFree, evaluateDiskFree},
+	{RuleVersion, evaluateVersion},
+	{RulePayoutDrop, evaluatePayoutDrop},
+}
+
+func evaluateOffline(config RulesConfig, state NodeState, now time.Time) (result, string) {
+	if config.OfflineAfter <= 0 {
+		return resultOK, ""
+	}
+	if state.OfflineSince.IsZero() {
+		return resultOK, ""
+	}
+
+	offline := now.Sub(state.OfflineSince)
+	if offline < config.OfflineAfter {
+		return resultOK, ""
+	}
+	return resultFiring, fmt.Sprintf("node is %s for %s", state.Status, offline.Truncate(time.Minute))
+}
+
+func evaluateAuditScore(config RulesConfig, state NodeState, now time.Time) (result, string) {
+	if config.MinAuditScore <= 0 {
+		return resultOK, ""
+	}
+	if state.AuditScore == nil {
+		return resultUnknown, ""
+	}
+	if *state.AuditScore >= config.MinAuditScore {
+		return resultOK, ""
+	}
+	return resultFiring, fmt.Sprintf("audit score %.4f is below %.4f", *state.AuditScore, config.MinAuditScore)
+}
+
+func evaluateSuspensionScore(config RulesConfig, state NodeState, now time.Time) (result, string) {
+	if config.MinSuspensionScore <= 0 {
+		return resultOK, ""
+	}
+	if state.SuspensionScore == nil {
+		return resultUnknown, ""
+	}
+	if *state.SuspensionScore >= config.MinSuspensionScore {
+		return resultOK, ""
+	}
+	return resultFiring, fmt.Sprintf("suspension score %.4f is below %.4f", *state.SuspensionScore, config.MinSuspensionScore)
+}
+
+func evaluateDiskFree(config RulesConfig, state NodeState, now time.Time) (result, string) {
+	if config.MinDiskFree <= 0 {
+		return resultOK, ""
+	}
+	if state.DiskSpaceAvailable == nil {
+		return resultUnknown, ""
+	}
+	if memory.Size(*state.DiskSpaceAvailable) >= config.MinDiskFree {
+		return resultOK, ""
+	}
+	return resultFiring, fmt.Sprintf("available disk space %s is below %s",
+		memory.Size(*state.DiskSpaceAvailable).Base10String(), config.MinDiskFree.Base10String())
+}
+
+func evaluateVersion(config RulesConfig, state NodeState, now time.Time) (result, string) {
+	if config.MinVersion == "" {
+		return resultOK, ""
+	}
+	if state.Version == "" {
+		return resultUnknown, ""
+	}
+
+	minimum, err := version.NewSemVer(config.MinVersion)
+	if err != nil {
+		return resultUnknown, ""
+	}
+	current, err := version.NewSemVer(state.Version)
+	if err != nil {
+		return resultUnknown, ""
+	}
+	if current.Compare(minimum) >= 0 {
+		return resultOK, ""
+	}
+	return resultFiring, fmt.Sprintf("version %s is older than the minimum %s", state.Version, config.MinVersion)
+}
+
+func evaluatePayoutDrop(config RulesConfig, state NodeState, now time.Time) (result, string) {
+	if config.PayoutDrop <= 0 {
+		return resultOK, ""
+	}
+	if state.EstimatedPayout == nil || state.PastEstimatedPayout == nil {
+		return resultUnknown, ""
+	}
+	if *state.PastEstimatedPayout <= 0 {
+		return resultOK, ""
+	}
+	if float64(*state.EstimatedPayout) >= config.PayoutDrop*float64(*state.PastEstimatedPayout) {
+		return resultOK, ""
+	}
+	return resultFiring, fmt.Sprintf("payout estimation dropped from %d to %d cents",
+		*state.PastEstimatedPayout, *state.EstimatedPayout)
+}
@@ -0,0 +1,218 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/private/version"
+)
+
+var mon = monkit.Package()
+
+// Service periodically evaluates the alert rules against all nodes and
+// notifies about triggered and resolved alerts.
+//
+// An alert stays active while its rule keeps firing, so every alert is
+// notified once and then only every renotify interval until it's resolved.
+//
+// architecture: Chore
+type Service struct {
+	log       *zap.Logger
+	db        DB
+	source    Source
+	notifiers []Notifier
+	config    Config
+
+	nowFn func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewService creates a new alerts service.
+func NewService(log *zap.Logger, db DB, source Source, notifiers []Notifier, config Config) (*Service, error) {
+	if config.Rules.MinVersion != "" {
+		if _, err := version.NewSemVer(config.Rules.MinVersion); err != nil {
+			return nil, Error.New("invalid minimum version %q: %v", config.Rules.MinVersion, err)
+		}
+	}
+
+	return &Service{
+		log:       log,
+		db:        db,
+		source:    source,
+		notifiers: notifiers,
+		config:    config,
+		nowFn:     time.Now,
+		Loop:      sync2.NewCycle(config.Interval),
+	}, nil
+}
+
+// Run runs the alerts service.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.Evaluate(ctx); err != nil {
+			service.log.Error("error during evaluating alert rules", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the alerts service.
+func (service *Service) Close() (err error) {
+	service.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// alertKey identifies the active alert of a rule on a node.
+type alertKey struct {
+	nodeID storj.NodeID
+	rule   Rule
+}
+
+// Evaluate evaluates all rules against all nodes once, it creates, renotifies and resolves the alerts.
+func (service *Service) Evaluate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := service.nowFn().UTC()
+
+	states, err := service.source.States(ctx, now)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	activeAlerts, err := service.db.Active(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	active := make(map[alertKey]Alert, len(activeAlerts))
+	for _, alert := range activeAlerts {
+		active[alertKey{nodeID: alert.NodeID, rule: alert.Rule}] = alert
+	}
+
+	var group errs.Group
+	for _, state := range states {
+		for _, rule := range rules {
+			key := alertKey{nodeID: state.ID, rule: rule.rule}
+			alert, isActive := active[key]
+			delete(active, key)
+
+			result, message := rule.evaluate(service.config.Rules, state, now)
+			switch {
+			case result == resultFiring && !isActive:
+				group.Add(service.trigger(ctx, state, rule.rule, message, now))
+			case result == resultFiring && service.shouldRenotify(alert, now):
+				group.Add(service.renotify(ctx, state, alert, message, now))
+			case result == resultOK && isActive:
+				group.Add(service.resolve(ctx, state.Name, alert, now))
+			}
+		}
+	}
+
+	// the remaining active alerts belong to removed nodes.
+	for _, alert := range active {
+		group.Add(service.resolve(ctx, "", alert, now))
+	}
+
+	return Error.Wrap(group.Err())
+}
+
+// List returns the most recently triggered alerts, newest first.
+func (service *Service) List(ctx context.Context, limit int) (_ []Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	alerts, err := service.db.List(ctx, limit)
+	return alerts, Error.Wrap(err)
+}
+
+// Active returns all alerts which aren't resolved.
+func (service *Service) Active(ctx context.Context) (_ []Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	alerts, err := service.db.Active(ctx)
+	return alerts, Error.Wrap(err)
+}
+
+// shouldRenotify returns whether the notification of the active alert should be repeated.
+func (service *Service) shouldRenotify(alert Alert, now time.Time) bool {
+	return service.config.RenotifyInterval > 0 && now.Sub(alert.NotifiedAt) >= service.config.RenotifyInterval
+}
+
+// trigger creates a new alert and notifies about it.
+func (service *Service) trigger(ctx context.Context, state NodeState, rule Rule, message string, now time.Time) error {
+	id, err := uuid.New()
+	if err != nil {
+		return err
+	}
+
+	alert := Alert{
+		ID:          id,
+		NodeID:      state.ID,
+		Rule:        rule,
+		Message:     message,
+		TriggeredAt: now,
+		NotifiedAt:  now,
+	}
+	if err := service.db.Create(ctx, alert); err != nil {
+		return err
+	}
+
+	service.log.Info("alert triggered", zap.Stringer("Node ID", state.ID), zap.String("rule", string(rule)), zap.String("message", message))
+	service.notify(ctx, Notification{Event: EventFiring, NodeName: state.Name, Alert: alert})
+	return nil
+}
+
+// renotify repeats the notification of an active alert.
+func (service *Service) renotify(ctx context.Context, state NodeState, alert Alert, message string, now time.Time) error {
+	alert.Message = message
+	alert.NotifiedAt = now
+	if err := service.db.UpdateNotified(ctx, alert.ID, message, now); err != nil {
+		return err
+	}
+
+	service.notify(ctx, Notification{Event: EventFiring, NodeName: state.Name, Alert: alert})
+	return nil
+}
+
+// resolve resolves an active alert and notifies about it.
+func (service *Service) resolve(ctx context.Context, nodeName string, alert Alert, now time.Time) error {
+	alert.ResolvedAt = &now
+	if err := service.db.Resolve(ctx, alert.ID, now); err != nil {
+		return err
+	}
+
+	service.log.Info("alert resolved", zap.Stringer("Node ID", alert.NodeID), zap.String("rule", string(alert.Rule)))
+	service.notify(ctx, Notification{Event: EventResolved, NodeName: nodeName, Alert: alert})
+	return nil
+}
+
+// notify sends the notification with all notifiers, failures are only logged
+// so a broken notifier doesn't block the others.
+func (service *Service) notify(ctx context.Context, notification Notification) {
+	for _, notifier := range service.notifiers {
+		if err := notifier.Notify(ctx, notification); err != nil {
+			service.log.Error("failed to send alert notification",
+				zap.String("notifier", notifier.Name()),
+				zap.Stringer("Node ID", notification.Alert.NodeID),
+				zap.String("rule", string(notification.Alert.Rule)),
+				zap.Error(err))
+		}
+	}
+}
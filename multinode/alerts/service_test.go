@@ -0,0 +1,192 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/alerts"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/nodes"
+)
+
+type staticSource struct {
+	states []alerts.NodeState
+}
+
+func (source *staticSource) States(ctx context.Context, now time.Time) ([]alerts.NodeState, error) {
+	return source.states, nil
+}
+
+type recordingNotifier struct {
+	notifications []alerts.Notification
+}
+
+func (notifier *recordingNotifier) Name() string { return "recording" }
+
+func (notifier *recordingNotifier) Notify(ctx context.Context, notification alerts.Notification) error {
+	notifier.notifications = append(notifier.notifications, notification)
+	return nil
+}
+
+func (notifier *recordingNotifier) take() []alerts.Notification {
+	notifications := notifier.notifications
+	notifier.notifications = nil
+	return notifications
+}
+
+func int64Ptr(v int64) *int64       { return &v }
+func float64Ptr(v float64) *float64 { return &v }
+
+func TestService(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		source := &staticSource{}
+		notifier := &recordingNotifier{}
+
+		service, err := alerts.NewService(zaptest.NewLogger(t), db.Alerts(), source, []alerts.Notifier{notifier}, alerts.Config{
+			Interval:         time.Minute,
+			RenotifyInterval: 24 * time.Hour,
+			Rules: alerts.RulesConfig{
+				OfflineAfter:       4 * time.Hour,
+				MinAuditScore:      0.98,
+				MinSuspensionScore: 0.9,
+				MinDiskFree:        10 * memory.GB,
+				MinVersion:         "v1.30.0",
+				PayoutDrop:         0.5,
+			},
+		})
+		require.NoError(t, err)
+
+		now := time.Date(2021, 8, 1, 10, 0, 0, 0, time.UTC)
+		service.SetNow(func() time.Time { return now })
+
+		healthy := alerts.NodeState{
+			ID:                  testrand.NodeID(),
+			Name:                "healthy",
+			Status:              nodes.StatusOnline,
+			Version:             "v1.31.2",
+			DiskSpaceAvailable:  int64Ptr(100 * memory.GB.Int64()),
+			AuditScore:          float64Ptr(1),
+			SuspensionScore:     float64Ptr(1),
+			EstimatedPayout:     int64Ptr(1000),
+			PastEstimatedPayout: int64Ptr(1100),
+		}
+
+		failing := alerts.NodeState{
+			ID:                  testrand.NodeID(),
+			Name:                "failing",
+			Status:              nodes.StatusOffline,
+			OfflineSince:        now.Add(-5 * time.Hour),
+			Version:             "v1.29.0",
+			DiskSpaceAvailable:  int64Ptr(memory.GB.Int64()),
+			AuditScore:          float64Ptr(0.97),
+			SuspensionScore:     float64Ptr(0.85),
+			EstimatedPayout:     int64Ptr(400),
+			PastEstimatedPayout: int64Ptr(1000),
+		}
+
+		source.states = []alerts.NodeState{healthy, failing}
+		require.NoError(t, service.Evaluate(ctx))
+
+		notifications := notifier.take()
+		require.Len(t, notifications, 6)
+		rules := map[alerts.Rule]bool{}
+		for _, notification := range notifications {
+			require.Equal(t, alerts.EventFiring, notification.Event)
+			require.Equal(t, failing.ID, notification.Alert.NodeID)
+			require.Equal(t, "failing", notification.NodeName)
+			require.NotEmpty(t, notification.Alert.Message)
+			rules[notification.Alert.Rule] = true
+		}
+		require.Equal(t, map[alerts.Rule]bool{
+			alerts.RuleOffline:         true,
+			alerts.RuleAuditScore:      true,
+			alerts.RuleSuspensionScore: true,
+			alerts.RuleDiskFree:        true,
+			alerts.RuleVersion:         true,
+			alerts.RulePayoutDrop:      true,
+		}, rules)
+
+		active, err := service.Active(ctx)
+		require.NoError(t, err)
+		require.Len(t, active, 6)
+
+		// active alerts aren't notified again before the renotify interval.
+		now = now.Add(time.Hour)
+		require.NoError(t, service.Evaluate(ctx))
+		require.Empty(t, notifier.take())
+
+		now = now.Add(24 * time.Hour)
+		require.NoError(t, service.Evaluate(ctx))
+		require.Len(t, notifier.take(), 6)
+
+		// unknown metrics neither fire nor resolve the alerts.
+		failing.Status = nodes.StatusNotReachable
+		failing.DiskSpaceAvailable = nil
+		failing.AuditScore = nil
+		failing.SuspensionScore = nil
+		failing.Version = ""
+		failing.EstimatedPayout = nil
+		source.states = []alerts.NodeState{healthy, failing}
+
+		now = now.Add(time.Hour)
+		require.NoError(t, service.Evaluate(ctx))
+		require.Empty(t, notifier.take())
+
+		// the node recovers.
+		failing.Status = nodes.StatusOnline
+		failing.OfflineSince = time.Time{}
+		failing.DiskSpaceAvailable = int64Ptr(50 * memory.GB.Int64())
+		source.states = []alerts.NodeState{healthy, failing}
+
+		now = now.Add(time.Hour)
+		require.NoError(t, service.Evaluate(ctx))
+		notifications = notifier.take()
+		require.Len(t, notifications, 2)
+		for _, notification := range notifications {
+			require.Equal(t, alerts.EventResolved, notification.Event)
+			require.NotNil(t, notification.Alert.ResolvedAt)
+		}
+
+		active, err = service.Active(ctx)
+		require.NoError(t, err)
+		require.Len(t, active, 4)
+
+		// the alerts of removed nodes are resolved.
+		source.states = []alerts.NodeState{healthy}
+		require.NoError(t, service.Evaluate(ctx))
+		require.Len(t, notifier.take(), 4)
+
+		active, err = service.Active(ctx)
+		require.NoError(t, err)
+		require.Empty(t, active)
+
+		history, err := service.List(ctx, 100)
+		require.NoError(t, err)
+		require.Len(t, history, 6)
+		for _, alert := range history {
+			require.False(t, alert.Active())
+		}
+
+		history, err = service.List(ctx, 2)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+	})
+}
+
+func TestServiceInvalidMinVersion(t *testing.T) {
+	_, err := alerts.NewService(zaptest.NewLogger(t), nil, &staticSource{}, nil, alerts.Config{
+		Rules: alerts.RulesConfig{MinVersion: "latest"},
+	})
+	require.Error(t, err)
+}
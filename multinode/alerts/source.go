@@ -0,0 +1,180 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/history"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/multinode/payouts"
+	"storj.io/storj/multinode/reputation"
+)
+
+// Source provides the states of all nodes the rules are evaluated against.
+type Source interface {
+	// States returns the current state of every node.
+	States(ctx context.Context, now time.Time) ([]NodeState, error)
+}
+
+// ServiceSource gathers the node states with the dashboard services.
+type ServiceSource struct {
+	log        *zap.Logger
+	nodes      *nodes.Service
+	reputation *reputation.Service
+	payouts    *payouts.Service
+	history    *history.Service
+	rules      RulesConfig
+
+	// offlineSince remembers since when the unreachable nodes are unreachable.
+	offlineSince map[storj.NodeID]time.Time
+}
+
+// NewServiceSource creates a new node state source.
+func NewServiceSource(log *zap.Logger, nodes *nodes.Service, reputation *reputation.Service, payouts *payouts.Service, history *history.Service, rules RulesConfig) *ServiceSource {
+	return &ServiceSource{
+		log:          log,
+		nodes:        nodes,
+		reputation:   reputation,
+		payouts:      payouts,
+		history:      history,
+		rules:        rules,
+		offlineSince: map[storj.NodeID]time.Time{},
+	}
+}
+
+// States implements Source.
+func (source *ServiceSource) States(ctx context.Context, now time.Time) (_ []NodeState, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	infos, err := source.nodes.ListInfos(ctx, nodes.Filter{})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	auditScores, suspensionScores := source.scores(ctx)
+
+	seen := map[storj.NodeID]struct{}{}
+	states := make([]NodeState, 0, len(infos))
+	for _, info := range infos {
+		seen[info.ID] = struct{}{}
+
+		state := NodeState{
+			ID:     info.ID,
+			Name:   info.Name,
+			Status: info.Status,
+		}
+
+		switch info.Status {
+		case nodes.StatusOnline:
+			delete(source.offlineSince, info.ID)
+		case nodes.StatusOffline:
+			state.OfflineSince = info.LastContact
+			source.offlineSince[info.ID] = info.LastContact
+		default:
+			since, ok := source.offlineSince[info.ID]
+			if !ok {
+				since = now
+				source.offlineSince[info.ID] = since
+			}
+			state.OfflineSince = since
+		}
+
+		if reachable(info.Status) {
+			diskSpaceAvailable := info.DiskSpaceLeft
+			state.Version = info.Version
+			state.DiskSpaceAvailable = &diskSpaceAvailable
+
+			if source.rules.PayoutDrop > 0 {
+				state.EstimatedPayout, state.PastEstimatedPayout = source.payoutEstimations(ctx, info.ID, now)
+			}
+		}
+
+		if score, ok := auditScores[info.ID]; ok {
+			state.AuditScore = &score
+		}
+		if score, ok := suspensionScores[info.ID]; ok {
+			state.SuspensionScore = &score
+		}
+
+		states = append(states, state)
+	}
+
+	for id := range source.offlineSince {
+		if _, ok := seen[id]; !ok {
+			delete(source.offlineSince, id)
+		}
+	}
+
+	return states, nil
+}
+
+// scores returns the lowest audit and suspension scores of every node across
+// the trusted satellites. When any of the satellites couldn't be queried no
+// scores are returned, because the lowest score isn't known.
+func (source *ServiceSource) scores(ctx context.Context) (audit, suspension map[storj.NodeID]float64) {
+	satellites, err := source.nodes.TrustedSatellites(ctx, nodes.Filter{})
+	if err != nil {
+		source.log.Warn("failed to get trusted satellites", zap.Error(err))
+		return nil, nil
+	}
+
+	audit = map[storj.NodeID]float64{}
+	suspension = map[storj.NodeID]float64{}
+	for _, satellite := range satellites {
+		stats, err := source.reputation.Stats(ctx, satellite.ID, nodes.Filter{})
+		if err != nil {
+			source.log.Warn("failed to get reputation stats", zap.Stringer("Satellite ID", satellite.ID), zap.Error(err))
+			return nil, nil
+		}
+
+		for _, stat := range stats {
+			if score, ok := audit[stat.NodeID]; !ok || stat.Audit.Score < score {
+				audit[stat.NodeID] = stat.Audit.Score
+			}
+			if score, ok := suspension[stat.NodeID]; !ok || stat.Audit.SuspensionScore < score {
+				suspension[stat.NodeID] = stat.Audit.SuspensionScore
+			}
+		}
+	}
+
+	return audit, suspension
+}
+
+// payoutEstimations returns the current payout estimation of the node and the
+// oldest estimation collected in the older half of the payout drop window.
+func (source *ServiceSource) payoutEstimations(ctx context.Context, nodeID storj.NodeID, now time.Time) (current, past *int64) {
+	expectations, err := source.payouts.NodeExpectations(ctx, nodeID)
+	if err != nil {
+		source.log.Warn("failed to get payout expectations", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return nil, nil
+	}
+	current = &expectations.CurrentMonthEstimation
+
+	window := source.rules.PayoutDropWindow
+	if window <= 0 {
+		return current, nil
+	}
+	samples, err := source.history.ListNode(ctx, nodeID, now.Add(-window), now.Add(-window/2))
+	if err != nil {
+		source.log.Warn("failed to get node history", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return current, nil
+	}
+	for _, sample := range samples {
+		if sample.EstimatedPayout != nil {
+			return current, sample.EstimatedPayout
+		}
+	}
+
+	return current, nil
+}
+
+// reachable returns whether the node responded to the dashboard.
+func reachable(status nodes.Status) bool {
+	return status == nodes.StatusOnline || status == nodes.StatusOffline
+}
@@ -42,15 +42,22 @@ func NewService(log *zap.Logger, dialer rpc.Dialer, nodes *nodes.Service) *Servi
 }
 
 // Monthly returns monthly bandwidth summary.
-func (service *Service) Monthly(ctx context.Context) (_ Monthly, err error) {
+func (service *Service) Monthly(ctx context.Context, filter nodes.Filter) (_ Monthly, err error) {
 	defer mon.Task()(&ctx)(&err)
-	var totalMonthly Monthly
 
-	listNodes, err := service.nodes.List(ctx)
+	listNodes, err := service.nodes.List(ctx, filter)
 	if err != nil {
 		return Monthly{}, Error.Wrap(err)
 	}
 
+	return service.NodesMonthly(ctx, listNodes)
+}
+
+// NodesMonthly returns monthly bandwidth summary of the nodes, unreachable nodes are skipped.
+func (service *Service) NodesMonthly(ctx context.Context, listNodes []nodes.Node) (_ Monthly, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var totalMonthly Monthly
+
 	cache := make(UsageRollupDailyCache)
 
 	for _, node := range listNodes {
@@ -93,11 +100,11 @@ func (service *Service) MonthlyNode(ctx context.Context, nodeID storj.NodeID) (_
 }
 
 // MonthlySatellite returns monthly bandwidth summary for specific satellite.
-func (service *Service) MonthlySatellite(ctx context.Context, satelliteID storj.NodeID) (_ Monthly, err error) {
+func (service *Service) MonthlySatellite(ctx context.Context, satelliteID storj.NodeID, filter nodes.Filter) (_ Monthly, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var totalMonthly Monthly
 
-	listNodes, err := service.nodes.List(ctx)
+	listNodes, err := service.nodes.List(ctx, filter)
 	if err != nil {
 		return Monthly{}, Error.Wrap(err)
 	}
@@ -0,0 +1,324 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/multinode/accounts"
+)
+
+var (
+	// ErrAccounts is an internal error type for accounts web api controller.
+	ErrAccounts = errs.Class("accounts web api controller")
+)
+
+// SessionCookieName is the name of the cookie with the session token.
+const SessionCookieName = "_multinode_session"
+
+// Accounts is a web api controller.
+type Accounts struct {
+	log     *zap.Logger
+	service *accounts.Service
+}
+
+// NewAccounts is a constructor for Accounts.
+func NewAccounts(log *zap.Logger, service *accounts.Service) *Accounts {
+	return &Accounts{
+		log:     log,
+		service: service,
+	}
+}
+
+// Login handles account login, it sets the session cookie.
+func (controller *Accounts) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	var payload struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		TOTPCode string `json:"totpCode"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAccounts.Wrap(err))
+		return
+	}
+
+	token, expiresAt, err := controller.service.Login(ctx, payload.Username, payload.Password, payload.TOTPCode)
+	if err != nil {
+		controller.log.Info("login failed", zap.String("username", payload.Username), zap.String("remoteAddr", r.RemoteAddr), zap.Error(err))
+		controller.serveServiceError(w, "could not log in", err)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    token,
+		Path:     "/",
+		Expires:  expiresAt,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// Logout handles account logout, it ends the session and removes the session cookie.
+func (controller *Accounts) Logout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	if cookie, cookieErr := r.Cookie(SessionCookieName); cookieErr == nil {
+		if err = controller.service.Logout(ctx, cookie.Value); err != nil {
+			controller.log.Error("could not log out", zap.Error(err))
+			controller.serveError(w, http.StatusInternalServerError, ErrAccounts.Wrap(err))
+			return
+		}
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    "",
+		Path:     "/",
+		Expires:  time.Unix(0, 0),
+		MaxAge:   -1,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// Account returns the logged in account.
+func (controller *Accounts) Account(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	account, ok := accounts.GetAccount(ctx)
+	if !ok {
+		controller.serveError(w, http.StatusUnauthorized, ErrAccounts.New("not logged in"))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(account); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// ChangePassword changes the password of the logged in account.
+func (controller *Accounts) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	account, ok := accounts.GetAccount(ctx)
+	if !ok {
+		controller.serveError(w, http.StatusUnauthorized, ErrAccounts.New("not logged in"))
+		return
+	}
+
+	var payload struct {
+		Password    string `json:"password"`
+		NewPassword string `json:"newPassword"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAccounts.Wrap(err))
+		return
+	}
+
+	if err = controller.service.ChangePassword(ctx, account.ID, payload.Password, payload.NewPassword); err != nil {
+		controller.serveServiceError(w, "could not change password", err)
+		return
+	}
+}
+
+// GenerateTOTP generates a new TOTP secret for the logged in account.
+func (controller *Accounts) GenerateTOTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	account, ok := accounts.GetAccount(ctx)
+	if !ok {
+		controller.serveError(w, http.StatusUnauthorized, ErrAccounts.New("not logged in"))
+		return
+	}
+
+	url, err := controller.service.GenerateTOTP(ctx, account.ID)
+	if err != nil {
+		controller.serveServiceError(w, "could not generate totp secret", err)
+		return
+	}
+
+	var response struct {
+		URL string `json:"url"`
+	}
+	response.URL = url
+
+	if err = json.NewEncoder(w).Encode(response); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// EnableTOTP enables TOTP for the logged in account.
+func (controller *Accounts) EnableTOTP(w http.ResponseWriter, r *http.Request) {
+	controller.updateTOTP(w, r, "could not enable totp", (*accounts.Service).EnableTOTP)
+}
+
+// DisableTOTP disables TOTP for the logged in account.
+func (controller *Accounts) DisableTOTP(w http.ResponseWriter, r *http.Request) {
+	controller.updateTOTP(w, r, "could not disable totp", (*accounts.Service).DisableTOTP)
+}
+
+// updateTOTP handles enabling or disabling TOTP with the code from the request.
+func (controller *Accounts) updateTOTP(w http.ResponseWriter, r *http.Request, failure string,
+	update func(*accounts.Service, context.Context, uuid.UUID, string) error) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	account, ok := accounts.GetAccount(ctx)
+	if !ok {
+		controller.serveError(w, http.StatusUnauthorized, ErrAccounts.New("not logged in"))
+		return
+	}
+
+	var payload struct {
+		Code string `json:"code"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAccounts.Wrap(err))
+		return
+	}
+
+	if err = update(controller.service, ctx, account.ID, payload.Code); err != nil {
+		controller.serveServiceError(w, failure, err)
+		return
+	}
+}
+
+// List handles retrieval of all accounts.
+func (controller *Accounts) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	list, err := controller.service.List(ctx)
+	if err != nil {
+		controller.serveServiceError(w, "could not list accounts", err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(list); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// Create handles account creation.
+func (controller *Accounts) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	var payload struct {
+		Username string        `json:"username"`
+		Password string        `json:"password"`
+		Role     accounts.Role `json:"role"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAccounts.Wrap(err))
+		return
+	}
+
+	account, err := controller.service.Create(ctx, payload.Username, payload.Password, payload.Role)
+	if err != nil {
+		controller.serveServiceError(w, "could not create account", err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(account); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// Delete handles account deletion.
+func (controller *Accounts) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAccounts.Wrap(err))
+		return
+	}
+
+	if err = controller.service.Delete(ctx, id); err != nil {
+		controller.serveServiceError(w, "could not delete account", err)
+		return
+	}
+}
+
+// serveServiceError sends the json error with the http status of the accounts service error.
+func (controller *Accounts) serveServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case accounts.ErrValidation.Has(err):
+		controller.serveError(w, http.StatusBadRequest, ErrAccounts.Wrap(err))
+	case accounts.ErrUnauthorized.Has(err), accounts.ErrTOTPRequired.Has(err):
+		controller.serveError(w, http.StatusUnauthorized, ErrAccounts.Wrap(err))
+	case accounts.ErrNoAccount.Has(err):
+		controller.serveError(w, http.StatusNotFound, ErrAccounts.Wrap(err))
+	case accounts.ErrUsernameTaken.Has(err):
+		controller.serveError(w, http.StatusConflict, ErrAccounts.Wrap(err))
+	default:
+		controller.log.Error(message, zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrAccounts.Wrap(err))
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Accounts) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/multinode/alerts"
+)
+
+var (
+	// ErrAlerts is an error type for alerts web api controller.
+	ErrAlerts = errs.Class("alerts web api controller")
+)
+
+const (
+	// defaultAlertsLimit is the number of alerts returned when limit isn't specified.
+	defaultAlertsLimit = 100
+	// maxAlertsLimit is the maximal number of alerts returned at once.
+	maxAlertsLimit = 1000
+)
+
+// Alerts is an alerts web api controller.
+type Alerts struct {
+	log     *zap.Logger
+	service *alerts.Service
+}
+
+// NewAlerts is a constructor of alerts controller.
+func NewAlerts(log *zap.Logger, service *alerts.Service) *Alerts {
+	return &Alerts{
+		log:     log,
+		service: service,
+	}
+}
+
+// List handles retrieval of the alert history, newest first.
+func (controller *Alerts) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	limit := defaultAlertsLimit
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		limit, err = strconv.Atoi(limitParam)
+		if err != nil {
+			controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+			return
+		}
+		if limit <= 0 || limit > maxAlertsLimit {
+			controller.serveError(w, http.StatusBadRequest, ErrAlerts.New("limit must be between 1 and %d", maxAlertsLimit))
+			return
+		}
+	}
+
+	list, err := controller.service.List(ctx, limit)
+	controller.serveAlerts(w, list, err)
+}
+
+// Active handles retrieval of the alerts which aren't resolved.
+func (controller *Alerts) Active(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	list, err := controller.service.Active(ctx)
+	controller.serveAlerts(w, list, err)
+}
+
+// serveAlerts sends the alerts or the error returned by the service.
+func (controller *Alerts) serveAlerts(w http.ResponseWriter, list []alerts.Alert, err error) {
+	if err != nil {
+		controller.log.Error("alerts internal error", zap.Error(ErrAlerts.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrAlerts.Wrap(err))
+		return
+	}
+
+	if list == nil {
+		list = make([]alerts.Alert, 0)
+	}
+	if err = json.NewEncoder(w).Encode(list); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrAlerts.Wrap(err)))
+		return
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Alerts) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
@@ -42,7 +42,13 @@ func (controller *Bandwidth) Monthly(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Add("Content-Type", "application/json")
 
-	monthly, err := controller.service.Monthly(ctx)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrBandwidth.Wrap(err))
+		return
+	}
+
+	monthly, err := controller.service.Monthly(ctx, filter)
 	if err != nil {
 		controller.log.Error("get bandwidth monthly error", zap.Error(err))
 		controller.serveError(w, http.StatusInternalServerError, ErrBandwidth.Wrap(err))
@@ -110,7 +116,13 @@ func (controller *Bandwidth) MonthlySatellite(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	monthly, err := controller.service.MonthlySatellite(ctx, satelliteID)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrBandwidth.Wrap(err))
+		return
+	}
+
+	monthly, err := controller.service.MonthlySatellite(ctx, satelliteID, filter)
 	if err != nil {
 		controller.log.Error("get bandwidth monthly for specific satellite error", zap.Error(err))
 		controller.serveError(w, http.StatusInternalServerError, ErrBandwidth.Wrap(err))
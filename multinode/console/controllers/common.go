@@ -9,6 +9,8 @@ import (
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"go.uber.org/zap"
+
+	"storj.io/storj/multinode/nodes"
 )
 
 var (
@@ -43,3 +45,16 @@ func (handler *NotFound) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		handler.log.Error("failed to write json error response", zap.Error(err))
 	}
 }
+
+// parseFilter parses the nodes filter from the tag query parameters in the key:value form,
+// e.g. ?tag=site:berlin&tag=disk:hdd selects the nodes which have both tags.
+func parseFilter(r *http.Request) (filter nodes.Filter, err error) {
+	for _, param := range r.URL.Query()["tag"] {
+		tag, err := nodes.ParseTag(param)
+		if err != nil {
+			return nodes.Filter{}, err
+		}
+		filter.Tags = append(filter.Tags, tag)
+	}
+	return filter, nil
+}
@@ -0,0 +1,284 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/control"
+	"storj.io/storj/multinode/nodes"
+)
+
+var (
+	// ErrControl is an error type for control web api controller.
+	ErrControl = errs.Class("control web api controller")
+)
+
+// Control is a web api controller, which allows to change configuration of the nodes and to control their services.
+type Control struct {
+	log     *zap.Logger
+	service *control.Service
+}
+
+// NewControl is a constructor of control controller.
+func NewControl(log *zap.Logger, service *control.Service) *Control {
+	return &Control{
+		log:     log,
+		service: service,
+	}
+}
+
+// SetAllocatedDiskSpace handles change of the disk space allocated for the node.
+func (controller *Control) SetAllocatedDiskSpace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := controller.nodeID(r, "nodeID")
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+		return
+	}
+
+	var payload struct {
+		Allocated int64 `json:"allocated"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+		return
+	}
+
+	allocated, err := controller.service.SetAllocatedDiskSpace(ctx, nodeID, payload.Allocated)
+	if err != nil {
+		controller.serveServiceError(w, err)
+		return
+	}
+
+	controller.serveJSON(w, struct {
+		Allocated int64 `json:"allocated"`
+	}{allocated})
+}
+
+// InitiateGracefulExit handles start of graceful exit of the node from the satellite.
+func (controller *Control) InitiateGracefulExit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := controller.nodeID(r, "nodeID")
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+		return
+	}
+	satelliteID, err := controller.nodeID(r, "satelliteID")
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+		return
+	}
+
+	exit, err := controller.service.InitiateGracefulExit(ctx, nodeID, satelliteID)
+	if err != nil {
+		controller.serveServiceError(w, err)
+		return
+	}
+
+	controller.serveJSON(w, exit)
+}
+
+// Trash handles retrieval of the disk space used by the trash of the node.
+func (controller *Control) Trash(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := controller.nodeID(r, "nodeID")
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+		return
+	}
+
+	used, err := controller.service.Trash(ctx, nodeID)
+	if err != nil {
+		controller.serveServiceError(w, err)
+		return
+	}
+
+	controller.serveJSON(w, struct {
+		Used int64 `json:"used"`
+	}{used})
+}
+
+// EmptyTrash handles deletion of all pieces in the trash of the node.
+func (controller *Control) EmptyTrash(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := controller.nodeID(r, "nodeID")
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+		return
+	}
+
+	freed, err := controller.service.EmptyTrash(ctx, nodeID)
+	if err != nil {
+		controller.serveServiceError(w, err)
+		return
+	}
+
+	controller.serveJSON(w, struct {
+		Freed int64 `json:"freed"`
+	}{freed})
+}
+
+// SetUploadsPaused handles pausing and resuming of new uploads to the node.
+// The change lasts until the node restarts.
+func (controller *Control) SetUploadsPaused(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := controller.nodeID(r, "nodeID")
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+		return
+	}
+
+	var payload struct {
+		Paused bool `json:"paused"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+		return
+	}
+
+	paused, err := controller.service.SetUploadsPaused(ctx, nodeID, payload.Paused)
+	if err != nil {
+		controller.serveServiceError(w, err)
+		return
+	}
+
+	controller.serveJSON(w, struct {
+		Paused bool `json:"paused"`
+	}{paused})
+}
+
+// RestartRetain handles restart of garbage collection of the node for the satellite.
+func (controller *Control) RestartRetain(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := controller.nodeID(r, "nodeID")
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+		return
+	}
+	satelliteID, err := controller.nodeID(r, "satelliteID")
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+		return
+	}
+
+	queued, err := controller.service.RestartRetain(ctx, nodeID, satelliteID)
+	if err != nil {
+		controller.serveServiceError(w, err)
+		return
+	}
+
+	controller.serveJSON(w, struct {
+		Queued bool `json:"queued"`
+	}{queued})
+}
+
+// RestartUsedSpaceWalker handles restart of recalculation of the disk space used by the node.
+func (controller *Control) RestartUsedSpaceWalker(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := controller.nodeID(r, "nodeID")
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+		return
+	}
+
+	triggered, err := controller.service.RestartUsedSpaceWalker(ctx, nodeID)
+	if err != nil {
+		controller.serveServiceError(w, err)
+		return
+	}
+
+	controller.serveJSON(w, struct {
+		Triggered bool `json:"triggered"`
+	}{triggered})
+}
+
+// nodeID parses node id from the path segment.
+func (controller *Control) nodeID(r *http.Request, segment string) (storj.NodeID, error) {
+	idEnc, ok := mux.Vars(r)[segment]
+	if !ok {
+		return storj.NodeID{}, errs.New("could not receive %s segment", segment)
+	}
+	return storj.NodeIDFromString(idEnc)
+}
+
+// serveJSON sends the response.
+func (controller *Control) serveJSON(w http.ResponseWriter, response interface{}) {
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrControl.Wrap(err)))
+	}
+}
+
+// serveServiceError maps the error returned by the service to http status.
+func (controller *Control) serveServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case nodes.ErrNoNode.Has(err):
+		controller.serveError(w, http.StatusNotFound, ErrControl.Wrap(err))
+	case control.ErrNotAdminKey.Has(err):
+		controller.serveError(w, http.StatusForbidden, ErrControl.Wrap(err))
+	case control.ErrRejected.Has(err):
+		controller.serveError(w, http.StatusBadRequest, ErrControl.Wrap(err))
+	case nodes.ErrNodeNotReachable.Has(err), nodes.ErrNodeAPIKeyInvalid.Has(err):
+		controller.serveError(w, http.StatusBadGateway, ErrControl.Wrap(err))
+	default:
+		controller.log.Error("control internal error", zap.Error(ErrControl.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrControl.Wrap(err))
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Control) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/multinode/groups"
+)
+
+var (
+	// ErrGroups is an error type for groups web api controller.
+	ErrGroups = errs.Class("groups web api controller")
+)
+
+// Groups is a web api controller, which exposes the summaries of the nodes grouped by their tags.
+type Groups struct {
+	log     *zap.Logger
+	service *groups.Service
+}
+
+// NewGroups is a constructor of groups controller.
+func NewGroups(log *zap.Logger, service *groups.Service) *Groups {
+	return &Groups{
+		log:     log,
+		service: service,
+	}
+}
+
+// Summaries handles retrieval of the summaries of the groups of the nodes with the same value of the tag.
+func (controller *Groups) Summaries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	key, ok := mux.Vars(r)["key"]
+	if !ok {
+		controller.serveError(w, http.StatusBadRequest, ErrGroups.New("could not receive key segment"))
+		return
+	}
+
+	summaries, err := controller.service.Summaries(ctx, key)
+	if err != nil {
+		controller.log.Error("group summaries internal error", zap.Error(ErrGroups.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrGroups.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(summaries); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrGroups.Wrap(err)))
+		return
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Groups) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/history"
+)
+
+var (
+	// ErrHistory is an error type for node history web api controller.
+	ErrHistory = errs.Class("history web api controller")
+)
+
+// defaultHistoryRange is the range of the history returned when from isn't specified.
+const defaultHistoryRange = 7 * 24 * time.Hour
+
+// History is a node history web api controller.
+type History struct {
+	log     *zap.Logger
+	service *history.Service
+}
+
+// NewHistory is a constructor of node history controller.
+func NewHistory(log *zap.Logger, service *history.Service) *History {
+	return &History{
+		log:     log,
+		service: service,
+	}
+}
+
+// List handles retrieval of the metrics history of all nodes.
+// The range is set with the optional from and to RFC3339 query parameters,
+// by default the last seven days are returned.
+func (controller *History) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	from, to, err := parseHistoryRange(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrHistory.Wrap(err))
+		return
+	}
+
+	samples, err := controller.service.List(ctx, from, to)
+	controller.serveSamples(w, samples, err)
+}
+
+// ListNode handles retrieval of the metrics history of a single node.
+func (controller *History) ListNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+	segments := mux.Vars(r)
+
+	nodeIDEnc, ok := segments["nodeID"]
+	if !ok {
+		controller.serveError(w, http.StatusBadRequest, ErrHistory.New("could not retrieve node id segment"))
+		return
+	}
+	nodeID, err := storj.NodeIDFromString(nodeIDEnc)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrHistory.Wrap(err))
+		return
+	}
+
+	from, to, err := parseHistoryRange(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrHistory.Wrap(err))
+		return
+	}
+
+	samples, err := controller.service.ListNode(ctx, nodeID, from, to)
+	controller.serveSamples(w, samples, err)
+}
+
+// Latest handles retrieval of the most recent metrics of every node.
+func (controller *History) Latest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	samples, err := controller.service.Latest(ctx)
+	controller.serveSamples(w, samples, err)
+}
+
+// serveSamples sends the samples or the error returned by the service.
+func (controller *History) serveSamples(w http.ResponseWriter, samples []history.Sample, err error) {
+	if err != nil {
+		if history.ErrInvalidRange.Has(err) {
+			controller.serveError(w, http.StatusBadRequest, ErrHistory.Wrap(err))
+			return
+		}
+
+		controller.log.Error("node history internal error", zap.Error(ErrHistory.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrHistory.Wrap(err))
+		return
+	}
+
+	if samples == nil {
+		samples = make([]history.Sample, 0)
+	}
+	if err = json.NewEncoder(w).Encode(samples); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrHistory.Wrap(err)))
+		return
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *History) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
+
+// parseHistoryRange parses the from and to query parameters.
+func parseHistoryRange(r *http.Request) (from, to time.Time, err error) {
+	to = time.Now()
+	if value := r.URL.Query().Get("to"); value != "" {
+		to, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	from = to.Add(-defaultHistoryRange)
+	if value := r.URL.Query().Get("from"); value != "" {
+		from, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	return from, to, nil
+}
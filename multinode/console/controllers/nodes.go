@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
+	"storj.io/storj/multinode/history"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/private/multinodeauth"
 )
@@ -25,13 +26,15 @@ var (
 type Nodes struct {
 	log     *zap.Logger
 	service *nodes.Service
+	history *history.Service
 }
 
 // NewNodes is a constructor for Nodes.
-func NewNodes(log *zap.Logger, service *nodes.Service) *Nodes {
+func NewNodes(log *zap.Logger, service *nodes.Service, history *history.Service) *Nodes {
 	return &Nodes{
 		log:     log,
 		service: service,
+		history: history,
 	}
 }
 
@@ -184,6 +187,72 @@ func (controller *Nodes) Delete(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// SetTag handles change of the value of the node's tag.
+func (controller *Nodes) SetTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	vars := mux.Vars(r)
+
+	id, err := storj.NodeIDFromString(vars["id"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	var payload struct {
+		Value string `json:"value"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	err = controller.service.SetTag(ctx, id, nodes.Tag{Key: vars["key"], Value: payload.Value})
+	if err != nil {
+		switch {
+		case nodes.ErrInvalidTag.Has(err):
+			controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		case nodes.ErrNoNode.Has(err):
+			controller.serveError(w, http.StatusNotFound, ErrNodes.Wrap(err))
+		default:
+			controller.log.Error("set node tag internal error", zap.Error(err))
+			controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
+		}
+		return
+	}
+}
+
+// DeleteTag handles removal of the node's tag.
+func (controller *Nodes) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	vars := mux.Vars(r)
+
+	id, err := storj.NodeIDFromString(vars["id"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	if err = controller.service.DeleteTag(ctx, id, vars["key"]); err != nil {
+		if nodes.ErrNoTag.Has(err) {
+			controller.serveError(w, http.StatusNotFound, ErrNodes.Wrap(err))
+			return
+		}
+		controller.log.Error("delete node tag internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
+		return
+	}
+}
+
 // ListInfos handles node basic info list retrieval.
 func (controller *Nodes) ListInfos(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -192,13 +261,24 @@ func (controller *Nodes) ListInfos(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Add("Content-Type", "application/json")
 
-	infos, err := controller.service.ListInfos(ctx)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	infos, err := controller.service.ListInfos(ctx, filter)
 	if err != nil {
 		controller.log.Error("list node infos internal error", zap.Error(err))
 		controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
 		return
 	}
 
+	// nodes which couldn't be queried are shown with their last known metrics.
+	if err := controller.history.FillInfos(ctx, infos); err != nil {
+		controller.log.Warn("failed to fill node infos from history", zap.Error(err))
+	}
+
 	if err = json.NewEncoder(w).Encode(infos); err != nil {
 		controller.log.Error("failed to write json response", zap.Error(err))
 		return
@@ -221,7 +301,13 @@ func (controller *Nodes) ListInfosSatellite(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	infos, err := controller.service.ListInfosSatellite(ctx, satelliteID)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	infos, err := controller.service.ListInfosSatellite(ctx, satelliteID, filter)
 	if err != nil {
 		controller.log.Error("list node satellite infos internal error", zap.Error(err))
 		controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
@@ -240,7 +326,13 @@ func (controller *Nodes) TrustedSatellites(w http.ResponseWriter, r *http.Reques
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
-	nodeURLs, err := controller.service.TrustedSatellites(ctx)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	nodeURLs, err := controller.service.TrustedSatellites(ctx, filter)
 	if err != nil {
 		controller.log.Error("list node trusted satellites internal error", zap.Error(err))
 		controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
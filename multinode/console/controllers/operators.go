@@ -64,9 +64,16 @@ func (controller *Operators) ListPaginated(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrOperators.Wrap(err))
+		return
+	}
+
 	cursor := operators.Cursor{
-		Limit: limit,
-		Page:  pageNumber,
+		Limit:  limit,
+		Page:   pageNumber,
+		Filter: filter,
 	}
 	page, err := controller.service.ListPaginated(ctx, cursor)
 	if err != nil {
@@ -41,7 +41,13 @@ func (controller *Payouts) Earned(w http.ResponseWriter, r *http.Request) {
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
-	earned, err := controller.service.Earned(ctx)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrPayouts.Wrap(err))
+		return
+	}
+
+	earned, err := controller.service.Earned(ctx, filter)
 	if err != nil {
 		controller.log.Error("all node total earned internal error", zap.Error(err))
 		controller.serveError(w, http.StatusInternalServerError, ErrPayouts.Wrap(err))
@@ -95,7 +101,13 @@ func (controller *Payouts) Expectations(w http.ResponseWriter, r *http.Request)
 
 	w.Header().Add("Content-Type", "application/json")
 
-	expectations, err := controller.service.Expectations(ctx)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrPayouts.Wrap(err))
+		return
+	}
+
+	expectations, err := controller.service.Expectations(ctx, filter)
 	if err != nil {
 		controller.serveError(w, http.StatusInternalServerError, ErrPayouts.Wrap(err))
 		return
@@ -122,7 +134,13 @@ func (controller *Payouts) SummaryPeriod(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	summary, err := controller.service.SummaryPeriod(ctx, period)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrPayouts.Wrap(err))
+		return
+	}
+
+	summary, err := controller.service.SummaryPeriod(ctx, period, filter)
 	if err != nil {
 		controller.serveError(w, http.StatusInternalServerError, ErrPayouts.Wrap(err))
 		return
@@ -142,7 +160,13 @@ func (controller *Payouts) Summary(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Add("Content-Type", "application/json")
 
-	summary, err := controller.service.Summary(ctx)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrPayouts.Wrap(err))
+		return
+	}
+
+	summary, err := controller.service.Summary(ctx, filter)
 	if err != nil {
 		controller.serveError(w, http.StatusInternalServerError, ErrPayouts.Wrap(err))
 		return
@@ -181,7 +205,13 @@ func (controller *Payouts) SummarySatellitePeriod(w http.ResponseWriter, r *http
 		return
 	}
 
-	summary, err := controller.service.SummarySatellitePeriod(ctx, satelliteID, period)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrPayouts.Wrap(err))
+		return
+	}
+
+	summary, err := controller.service.SummarySatellitePeriod(ctx, satelliteID, period, filter)
 	if err != nil {
 		controller.serveError(w, http.StatusInternalServerError, ErrPayouts.Wrap(err))
 		return
@@ -214,7 +244,13 @@ func (controller *Payouts) SummarySatellite(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	summary, err := controller.service.SummarySatellite(ctx, satelliteID)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrPayouts.Wrap(err))
+		return
+	}
+
+	summary, err := controller.service.SummarySatellite(ctx, satelliteID, filter)
 	if err != nil {
 		controller.serveError(w, http.StatusInternalServerError, ErrPayouts.Wrap(err))
 		return
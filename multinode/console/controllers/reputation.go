@@ -55,7 +55,13 @@ func (controller *Reputation) Stats(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	stats, err := controller.service.Stats(ctx, satelliteID)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrReputation.Wrap(err))
+		return
+	}
+
+	stats, err := controller.service.Stats(ctx, satelliteID, filter)
 	if err != nil {
 		if nodes.ErrNoNode.Has(err) {
 			controller.serveError(w, http.StatusNotFound, ErrReputation.Wrap(err))
@@ -182,7 +182,13 @@ func (controller *Storage) TotalUsage(w http.ResponseWriter, r *http.Request) {
 		to = period.EndDateExclusive()
 	}
 
-	usage, err := controller.service.TotalUsage(ctx, from, to)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrStorage.Wrap(err))
+		return
+	}
+
+	usage, err := controller.service.TotalUsage(ctx, from, to, filter)
 	if err != nil {
 		if nodes.ErrNoNode.Has(err) {
 			controller.serveError(w, http.StatusNotFound, ErrStorage.Wrap(err))
@@ -238,7 +244,13 @@ func (controller *Storage) TotalUsageSatellite(w http.ResponseWriter, r *http.Re
 		to = period.EndDateExclusive()
 	}
 
-	usage, err := controller.service.TotalUsageSatellite(ctx, satelliteID, from, to)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrStorage.Wrap(err))
+		return
+	}
+
+	usage, err := controller.service.TotalUsageSatellite(ctx, satelliteID, from, to, filter)
 	if err != nil {
 		if nodes.ErrNoNode.Has(err) {
 			controller.serveError(w, http.StatusNotFound, ErrStorage.Wrap(err))
@@ -268,7 +280,13 @@ func (controller *Storage) TotalDiskSpace(w http.ResponseWriter, r *http.Request
 
 	w.Header().Add("Content-Type", "application/json")
 
-	totalDiskSpace, err := controller.service.TotalDiskSpace(ctx)
+	filter, err := parseFilter(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrStorage.Wrap(err))
+		return
+	}
+
+	totalDiskSpace, err := controller.service.TotalDiskSpace(ctx, filter)
 	if err != nil {
 		controller.log.Error("could not get total disk space", zap.Error(err))
 		controller.serveError(w, http.StatusInternalServerError, ErrStorage.Wrap(err))
@@ -5,6 +5,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"html/template"
 	"io/ioutil"
 	"net"
@@ -15,13 +16,19 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"storj.io/storj/multinode/accounts"
+	"storj.io/storj/multinode/alerts"
 	"storj.io/storj/multinode/bandwidth"
 	"storj.io/storj/multinode/console/controllers"
+	"storj.io/storj/multinode/control"
+	"storj.io/storj/multinode/groups"
+	"storj.io/storj/multinode/history"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/multinode/operators"
 	"storj.io/storj/multinode/payouts"
 	"storj.io/storj/multinode/reputation"
 	"storj.io/storj/multinode/storage"
+	"storj.io/storj/private/web"
 )
 
 var (
@@ -33,16 +40,23 @@ var (
 type Config struct {
 	Address   string `json:"address" help:"server address of the api gateway and frontend app" default:"127.0.0.1:15002"`
 	StaticDir string `help:"path to static resources" default:""`
+
+	RateLimit web.IPRateLimiterConfig
 }
 
 // Services contains services utilized by multinode dashboard.
 type Services struct {
+	Accounts   *accounts.Service
 	Nodes      *nodes.Service
 	Payouts    *payouts.Service
 	Operators  *operators.Service
 	Storage    *storage.Service
 	Bandwidth  *bandwidth.Service
 	Reputation *reputation.Service
+	History    *history.Service
+	Alerts     *alerts.Service
+	Control    *control.Service
+	Groups     *groups.Service
 }
 
 // Server represents Multinode Dashboard http server.
@@ -54,28 +68,41 @@ type Server struct {
 	http     http.Server
 	assets   http.FileSystem
 
+	rateLimiter *web.IPRateLimiter
+
+	accounts   *accounts.Service
 	nodes      *nodes.Service
 	payouts    *payouts.Service
 	operators  *operators.Service
 	bandwidth  *bandwidth.Service
 	storage    *storage.Service
 	reputation *reputation.Service
+	history    *history.Service
+	alerts     *alerts.Service
+	control    *control.Service
+	groups     *groups.Service
 
 	index *template.Template
 }
 
 // NewServer returns new instance of Multinode Dashboard http server.
-func NewServer(log *zap.Logger, listener net.Listener, assets http.FileSystem, services Services) (*Server, error) {
+func NewServer(log *zap.Logger, config Config, listener net.Listener, assets http.FileSystem, services Services) (*Server, error) {
 	server := Server{
-		log:        log,
-		listener:   listener,
-		assets:     assets,
-		nodes:      services.Nodes,
-		operators:  services.Operators,
-		payouts:    services.Payouts,
-		storage:    services.Storage,
-		bandwidth:  services.Bandwidth,
-		reputation: services.Reputation,
+		log:         log,
+		listener:    listener,
+		assets:      assets,
+		rateLimiter: web.NewIPRateLimiter(config.RateLimit),
+		accounts:    services.Accounts,
+		nodes:       services.Nodes,
+		operators:   services.Operators,
+		payouts:     services.Payouts,
+		storage:     services.Storage,
+		bandwidth:   services.Bandwidth,
+		reputation:  services.Reputation,
+		history:     services.History,
+		alerts:      services.Alerts,
+		control:     services.Control,
+		groups:      services.Groups,
 	}
 
 	router := mux.NewRouter()
@@ -83,29 +110,52 @@ func NewServer(log *zap.Logger, listener net.Listener, assets http.FileSystem, s
 	apiRouter := router.PathPrefix("/api/v0").Subrouter()
 	apiRouter.NotFoundHandler = controllers.NewNotFound(server.log)
 
-	nodesController := controllers.NewNodes(server.log, server.nodes)
-	nodesRouter := apiRouter.PathPrefix("/nodes").Subrouter()
-	nodesRouter.HandleFunc("", nodesController.Add).Methods(http.MethodPost)
+	accountsController := controllers.NewAccounts(server.log, server.accounts)
+	apiRouter.Handle("/auth/login", server.rateLimiter.Limit(http.HandlerFunc(accountsController.Login))).Methods(http.MethodPost)
+	apiRouter.HandleFunc("/auth/logout", accountsController.Logout).Methods(http.MethodPost)
+
+	// every other endpoint requires a logged in account.
+	protectedRouter := apiRouter.NewRoute().Subrouter()
+	protectedRouter.Use(server.withAuth)
+
+	authRouter := protectedRouter.PathPrefix("/auth").Subrouter()
+	authRouter.HandleFunc("/account", accountsController.Account).Methods(http.MethodGet)
+	authRouter.HandleFunc("/password", accountsController.ChangePassword).Methods(http.MethodPost)
+	authRouter.HandleFunc("/totp", accountsController.GenerateTOTP).Methods(http.MethodPost)
+	authRouter.HandleFunc("/totp/enable", accountsController.EnableTOTP).Methods(http.MethodPost)
+	authRouter.HandleFunc("/totp/disable", accountsController.DisableTOTP).Methods(http.MethodPost)
+
+	accountsRouter := protectedRouter.PathPrefix("/accounts").Subrouter()
+	accountsRouter.Use(server.withAdmin)
+	accountsRouter.HandleFunc("", accountsController.List).Methods(http.MethodGet)
+	accountsRouter.HandleFunc("", accountsController.Create).Methods(http.MethodPost)
+	accountsRouter.HandleFunc("/{id}", accountsController.Delete).Methods(http.MethodDelete)
+
+	nodesController := controllers.NewNodes(server.log, server.nodes, server.history)
+	nodesRouter := protectedRouter.PathPrefix("/nodes").Subrouter()
+	nodesRouter.Handle("", server.withAdmin(http.HandlerFunc(nodesController.Add))).Methods(http.MethodPost)
 	nodesRouter.HandleFunc("/infos", nodesController.ListInfos).Methods(http.MethodGet)
 	nodesRouter.HandleFunc("/infos/{satelliteID}", nodesController.ListInfosSatellite).Methods(http.MethodGet)
 	nodesRouter.HandleFunc("/trusted-satellites", nodesController.TrustedSatellites).Methods(http.MethodGet)
 	nodesRouter.HandleFunc("/{id}", nodesController.Get).Methods(http.MethodGet)
-	nodesRouter.HandleFunc("/{id}", nodesController.UpdateName).Methods(http.MethodPatch)
-	nodesRouter.HandleFunc("/{id}", nodesController.Delete).Methods(http.MethodDelete)
+	nodesRouter.Handle("/{id}", server.withAdmin(http.HandlerFunc(nodesController.UpdateName))).Methods(http.MethodPatch)
+	nodesRouter.Handle("/{id}", server.withAdmin(http.HandlerFunc(nodesController.Delete))).Methods(http.MethodDelete)
+	nodesRouter.Handle("/{id}/tags/{key}", server.withAdmin(http.HandlerFunc(nodesController.SetTag))).Methods(http.MethodPut)
+	nodesRouter.Handle("/{id}/tags/{key}", server.withAdmin(http.HandlerFunc(nodesController.DeleteTag))).Methods(http.MethodDelete)
 
 	operatorsController := controllers.NewOperators(server.log, server.operators)
-	operatorsRouter := apiRouter.PathPrefix("/operators").Subrouter()
+	operatorsRouter := protectedRouter.PathPrefix("/operators").Subrouter()
 	operatorsRouter.HandleFunc("", operatorsController.ListPaginated).Methods(http.MethodGet)
 
 	bandwidthController := controllers.NewBandwidth(server.log, server.bandwidth)
-	bandwidthRouter := apiRouter.PathPrefix("/bandwidth").Subrouter()
+	bandwidthRouter := protectedRouter.PathPrefix("/bandwidth").Subrouter()
 	bandwidthRouter.HandleFunc("/", bandwidthController.Monthly).Methods(http.MethodGet)
 	bandwidthRouter.HandleFunc("/{nodeID}", bandwidthController.MonthlyNode).Methods(http.MethodGet)
 	bandwidthRouter.HandleFunc("/satellites/{id}", bandwidthController.MonthlySatellite).Methods(http.MethodGet)
 	bandwidthRouter.HandleFunc("/satellites/{id}/{nodeID}", bandwidthController.MonthlySatelliteNode).Methods(http.MethodGet)
 
 	payoutsController := controllers.NewPayouts(server.log, server.payouts)
-	payoutsRouter := apiRouter.PathPrefix("/payouts").Subrouter()
+	payoutsRouter := protectedRouter.PathPrefix("/payouts").Subrouter()
 	payoutsRouter.HandleFunc("/summaries", payoutsController.Summary).Methods(http.MethodGet)
 	payoutsRouter.HandleFunc("/summaries/{period}", payoutsController.SummaryPeriod).Methods(http.MethodGet)
 	payoutsRouter.HandleFunc("/expectations", payoutsController.Expectations).Methods(http.MethodGet)
@@ -120,7 +170,7 @@ func NewServer(log *zap.Logger, listener net.Listener, assets http.FileSystem, s
 	payoutsRouter.HandleFunc("/satellites/{id}/paystubs/{period}/{nodeID}", payoutsController.PaystubSatellitePeriod).Methods(http.MethodGet)
 
 	storageController := controllers.NewStorage(server.log, server.storage)
-	storageRouter := apiRouter.PathPrefix("/storage").Subrouter()
+	storageRouter := protectedRouter.PathPrefix("/storage").Subrouter()
 	storageRouter.HandleFunc("/usage", storageController.TotalUsage).Methods(http.MethodGet)
 	storageRouter.HandleFunc("/usage/{nodeID}", storageController.Usage).Methods(http.MethodGet)
 	storageRouter.HandleFunc("/satellites/{satelliteID}/usage", storageController.TotalUsageSatellite).Methods(http.MethodGet)
@@ -129,9 +179,35 @@ func NewServer(log *zap.Logger, listener net.Listener, assets http.FileSystem, s
 	storageRouter.HandleFunc("/disk-space/{nodeID}", storageController.DiskSpace).Methods(http.MethodGet)
 
 	reputationController := controllers.NewReputation(server.log, server.reputation)
-	reputationRouter := apiRouter.PathPrefix("/reputation").Subrouter()
+	reputationRouter := protectedRouter.PathPrefix("/reputation").Subrouter()
 	reputationRouter.HandleFunc("/satellites/{satelliteID}", reputationController.Stats)
 
+	historyController := controllers.NewHistory(server.log, server.history)
+	historyRouter := protectedRouter.PathPrefix("/history").Subrouter()
+	historyRouter.HandleFunc("", historyController.List).Methods(http.MethodGet)
+	historyRouter.HandleFunc("/latest", historyController.Latest).Methods(http.MethodGet)
+	historyRouter.HandleFunc("/{nodeID}", historyController.ListNode).Methods(http.MethodGet)
+
+	alertsController := controllers.NewAlerts(server.log, server.alerts)
+	alertsRouter := protectedRouter.PathPrefix("/alerts").Subrouter()
+	alertsRouter.HandleFunc("", alertsController.List).Methods(http.MethodGet)
+	alertsRouter.HandleFunc("/active", alertsController.Active).Methods(http.MethodGet)
+
+	controlController := controllers.NewControl(server.log, server.control)
+	controlRouter := protectedRouter.PathPrefix("/control/{nodeID}").Subrouter()
+	controlRouter.Use(server.withAdmin)
+	controlRouter.HandleFunc("/allocated-disk-space", controlController.SetAllocatedDiskSpace).Methods(http.MethodPut)
+	controlRouter.HandleFunc("/graceful-exit/{satelliteID}", controlController.InitiateGracefulExit).Methods(http.MethodPost)
+	controlRouter.HandleFunc("/trash", controlController.Trash).Methods(http.MethodGet)
+	controlRouter.HandleFunc("/trash", controlController.EmptyTrash).Methods(http.MethodDelete)
+	controlRouter.HandleFunc("/uploads-paused", controlController.SetUploadsPaused).Methods(http.MethodPut)
+	controlRouter.HandleFunc("/retain/{satelliteID}", controlController.RestartRetain).Methods(http.MethodPost)
+	controlRouter.HandleFunc("/used-space-walker", controlController.RestartUsedSpaceWalker).Methods(http.MethodPost)
+
+	groupsController := controllers.NewGroups(server.log, server.groups)
+	groupsRouter := protectedRouter.PathPrefix("/groups").Subrouter()
+	groupsRouter.HandleFunc("/{key}", groupsController.Summaries).Methods(http.MethodGet)
+
 	if server.assets != nil {
 		fs := http.FileServer(server.assets)
 		router.PathPrefix("/static/").Handler(http.StripPrefix("/static", fs))
@@ -145,6 +221,58 @@ func NewServer(log *zap.Logger, listener net.Listener, assets http.FileSystem, s
 	return &server, nil
 }
 
+// withAuth authenticates the request with the session cookie and adds the
+// logged in account to the request context.
+func (server *Server) withAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var token string
+		if cookie, err := r.Cookie(controllers.SessionCookieName); err == nil {
+			token = cookie.Value
+		}
+
+		account, err := server.accounts.Authorize(r.Context(), token)
+		if err != nil {
+			if !accounts.ErrUnauthorized.Has(err) {
+				server.log.Error("could not authorize request", zap.Error(err))
+				server.serveError(w, http.StatusInternalServerError, "could not authorize request")
+				return
+			}
+			server.serveError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(accounts.WithAccount(r.Context(), account)))
+	})
+}
+
+// withAdmin allows the request only for the accounts with the admin role.
+func (server *Server) withAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		account, ok := accounts.GetAccount(r.Context())
+		if !ok || !account.IsAdmin() {
+			server.serveError(w, http.StatusForbidden, "admin role required")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// serveError sends the json error response.
+func (server *Server) serveError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = message
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		server.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
+
 // appHandler is web app http handler function.
 func (server *Server) appHandler(w http.ResponseWriter, r *http.Request) {
 	header := w.Header()
@@ -178,6 +306,10 @@ func (server *Server) Run(ctx context.Context) (err error) {
 		<-ctx.Done()
 		return Error.Wrap(server.http.Shutdown(context.Background()))
 	})
+	group.Go(func() error {
+		server.rateLimiter.Run(ctx)
+		return nil
+	})
 	group.Go(func() error {
 		defer cancel()
 		return Error.Wrap(server.http.Serve(server.listener))
@@ -0,0 +1,233 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/crypto/bcrypt"
+
+	"storj.io/common/rpc"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/accounts"
+	"storj.io/storj/multinode/console/server"
+	"storj.io/storj/multinode/control"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/private/web"
+)
+
+// runServer starts the dashboard server with only the accounts and control services. It returns
+// the address of the api and the function, which stops the server.
+func runServer(ctx *testcontext.Context, t *testing.T, db multinode.DB, rateLimit web.IPRateLimiterConfig) (string, *accounts.Service, func()) {
+	log := zaptest.NewLogger(t)
+
+	service := accounts.NewService(log.Named("accounts"), db.Accounts(), accounts.Config{
+		SessionDuration: time.Hour,
+		PasswordCost:    bcrypt.MinCost,
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	endpoint, err := server.NewServer(log.Named("server"), server.Config{RateLimit: rateLimit},
+		listener, http.Dir(ctx.Dir("static")), server.Services{
+			Accounts: service,
+			Control:  control.NewService(log.Named("control"), rpc.Dialer{}, db.Nodes()),
+		})
+	require.NoError(t, err)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error {
+		err := endpoint.Run(runCtx)
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	})
+
+	return "http://" + listener.Addr().String() + "/api/v0", service, cancel
+}
+
+// do sends the request and returns the http status.
+func do(ctx *testcontext.Context, t *testing.T, client *http.Client, method, url string, body interface{}) int {
+	var data []byte
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	return resp.StatusCode
+}
+
+func TestAuthorization(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		api, service, stop := runServer(ctx, t, db, web.IPRateLimiterConfig{Duration: time.Minute, Burst: 100, NumLimits: 10})
+		defer stop()
+
+		_, err := service.Create(ctx, "admin", "admin-password", accounts.RoleAdmin)
+		require.NoError(t, err)
+		_, err = service.Create(ctx, "viewer", "viewer-password", accounts.RoleViewer)
+		require.NoError(t, err)
+
+		t.Run("protected routes", func(t *testing.T) {
+			for _, route := range []struct{ method, path string }{
+				{http.MethodGet, "/auth/account"},
+				{http.MethodPost, "/auth/password"},
+				{http.MethodPost, "/auth/totp"},
+				{http.MethodPost, "/auth/totp/enable"},
+				{http.MethodPost, "/auth/totp/disable"},
+				{http.MethodGet, "/accounts"},
+				{http.MethodPost, "/accounts"},
+				{http.MethodDelete, "/accounts/id"},
+				{http.MethodPost, "/nodes"},
+				{http.MethodGet, "/nodes/infos"},
+				{http.MethodGet, "/nodes/infos/satellite"},
+				{http.MethodGet, "/nodes/trusted-satellites"},
+				{http.MethodGet, "/nodes/id"},
+				{http.MethodPatch, "/nodes/id"},
+				{http.MethodDelete, "/nodes/id"},
+				{http.MethodGet, "/operators"},
+				{http.MethodGet, "/bandwidth/"},
+				{http.MethodGet, "/bandwidth/node"},
+				{http.MethodGet, "/payouts/summaries"},
+				{http.MethodGet, "/payouts/expectations"},
+				{http.MethodGet, "/payouts/total-earned"},
+				{http.MethodGet, "/storage/usage"},
+				{http.MethodGet, "/storage/disk-space"},
+				{http.MethodGet, "/reputation/satellites/satellite"},
+			} {
+				status := do(ctx, t, http.DefaultClient, route.method, api+route.path, nil)
+				require.Equal(t, http.StatusUnauthorized, status, "%s %s", route.method, route.path)
+			}
+		})
+
+		login := func(t *testing.T, username, password string) *http.Client {
+			jar, err := cookiejar.New(nil)
+			require.NoError(t, err)
+			client := &http.Client{Jar: jar}
+
+			status := do(ctx, t, client, http.MethodPost, api+"/auth/login", map[string]string{
+				"username": username,
+				"password": password,
+			})
+			require.Equal(t, http.StatusOK, status)
+			return client
+		}
+
+		t.Run("login", func(t *testing.T) {
+			for _, credentials := range [][2]string{
+				{"viewer", "wrong-password"},
+				{"nobody", "viewer-password"},
+			} {
+				status := do(ctx, t, http.DefaultClient, http.MethodPost, api+"/auth/login", map[string]string{
+					"username": credentials[0],
+					"password": credentials[1],
+				})
+				require.Equal(t, http.StatusUnauthorized, status)
+			}
+
+			client := login(t, "viewer", "viewer-password")
+			require.Equal(t, http.StatusOK, do(ctx, t, client, http.MethodGet, api+"/auth/account", nil))
+
+			require.Equal(t, http.StatusOK, do(ctx, t, client, http.MethodPost, api+"/auth/logout", nil))
+			require.Equal(t, http.StatusUnauthorized, do(ctx, t, client, http.MethodGet, api+"/auth/account", nil))
+		})
+
+		t.Run("admin routes", func(t *testing.T) {
+			viewer := login(t, "viewer", "viewer-password")
+			require.Equal(t, http.StatusForbidden, do(ctx, t, viewer, http.MethodGet, api+"/accounts", nil))
+			require.Equal(t, http.StatusForbidden, do(ctx, t, viewer, http.MethodPost, api+"/nodes", nil))
+			require.Equal(t, http.StatusForbidden, do(ctx, t, viewer, http.MethodDelete, api+"/nodes/id", nil))
+
+			admin := login(t, "admin", "admin-password")
+			require.Equal(t, http.StatusOK, do(ctx, t, admin, http.MethodGet, api+"/accounts", nil))
+		})
+	})
+}
+
+func TestControlAuthorization(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		api, service, stop := runServer(ctx, t, db, web.IPRateLimiterConfig{Duration: time.Minute, Burst: 100, NumLimits: 10})
+		defer stop()
+
+		_, err := service.Create(ctx, "admin", "admin-password", accounts.RoleAdmin)
+		require.NoError(t, err)
+		_, err = service.Create(ctx, "viewer", "viewer-password", accounts.RoleViewer)
+		require.NoError(t, err)
+
+		login := func(t *testing.T, username, password string) *http.Client {
+			jar, err := cookiejar.New(nil)
+			require.NoError(t, err)
+			client := &http.Client{Jar: jar}
+
+			status := do(ctx, t, client, http.MethodPost, api+"/auth/login", map[string]string{
+				"username": username,
+				"password": password,
+			})
+			require.Equal(t, http.StatusOK, status)
+			return client
+		}
+
+		// the node isn't added to the dashboard, so the requests which pass the role check fail with not found.
+		controlAPI := api + "/control/" + testrand.NodeID().String()
+		satellite := testrand.NodeID().String()
+		routes := []struct {
+			method, path string
+			body         interface{}
+		}{
+			{http.MethodPut, "/allocated-disk-space", map[string]int64{"allocated": 1}},
+			{http.MethodPost, "/graceful-exit/" + satellite, nil},
+			{http.MethodGet, "/trash", nil},
+			{http.MethodDelete, "/trash", nil},
+			{http.MethodPut, "/uploads-paused", map[string]bool{"paused": true}},
+			{http.MethodPost, "/retain/" + satellite, nil},
+			{http.MethodPost, "/used-space-walker", nil},
+		}
+
+		viewer := login(t, "viewer", "viewer-password")
+		admin := login(t, "admin", "admin-password")
+		for _, route := range routes {
+			status := do(ctx, t, http.DefaultClient, route.method, controlAPI+route.path, route.body)
+			require.Equal(t, http.StatusUnauthorized, status, "%s %s", route.method, route.path)
+
+			status = do(ctx, t, viewer, route.method, controlAPI+route.path, route.body)
+			require.Equal(t, http.StatusForbidden, status, "%s %s", route.method, route.path)
+
+			status = do(ctx, t, admin, route.method, controlAPI+route.path, route.body)
+			require.Equal(t, http.StatusNotFound, status, "%s %s", route.method, route.path)
+		}
+	})
+}
+
+func TestLoginRateLimit(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		api, _, stop := runServer(ctx, t, db, web.IPRateLimiterConfig{Duration: time.Hour, Burst: 2, NumLimits: 10})
+		defer stop()
+
+		credentials := map[string]string{"username": "nobody", "password": "password"}
+		require.Equal(t, http.StatusUnauthorized, do(ctx, t, http.DefaultClient, http.MethodPost, api+"/auth/login", credentials))
+		require.Equal(t, http.StatusUnauthorized, do(ctx, t, http.DefaultClient, http.MethodPost, api+"/auth/login", credentials))
+		require.Equal(t, http.StatusTooManyRequests, do(ctx, t, http.DefaultClient, http.MethodPost, api+"/auth/login", credentials))
+	})
+}
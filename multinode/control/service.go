@@ -0,0 +1,219 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package control
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodepb"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is an error class for control service error.
+	Error = errs.Class("control")
+	// ErrNotAdminKey indicates that the node's api key isn't allowed to control the node.
+	ErrNotAdminKey = errs.Class("node api key is not an admin api key")
+	// ErrRejected indicates that the node rejected the request as invalid in its current state.
+	ErrRejected = errs.Class("request rejected by node")
+)
+
+// GracefulExit contains information about initiated graceful exit.
+type GracefulExit struct {
+	SatelliteAddress string `json:"satelliteAddress"`
+	BytesToTransfer  int64  `json:"bytesToTransfer"`
+}
+
+// Service allows to change configuration of the nodes and to control their services.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	dialer rpc.Dialer
+	nodes  nodes.DB
+}
+
+// NewService creates new instance of Service.
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB) *Service {
+	return &Service{
+		log:    log,
+		dialer: dialer,
+		nodes:  nodes,
+	}
+}
+
+// SetAllocatedDiskSpace changes the disk space allocated for the node.
+// The node keeps the change when it restarts, until its configured allocation is changed.
+func (service *Service) SetAllocatedDiskSpace(ctx context.Context, nodeID storj.NodeID, allocated int64) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var response *multinodepb.SetAllocatedDiskSpaceResponse
+	err = service.dial(ctx, nodeID, func(client multinodepb.DRPCControlClient, header *multinodepb.RequestHeader) (err error) {
+		response, err = client.SetAllocatedDiskSpace(ctx, &multinodepb.SetAllocatedDiskSpaceRequest{
+			Header:    header,
+			Allocated: allocated,
+		})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Allocated, nil
+}
+
+// InitiateGracefulExit starts graceful exit of the node from the satellite.
+func (service *Service) InitiateGracefulExit(ctx context.Context, nodeID, satelliteID storj.NodeID) (_ GracefulExit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var response *multinodepb.ControlInitiateGracefulExitResponse
+	err = service.dial(ctx, nodeID, func(client multinodepb.DRPCControlClient, header *multinodepb.RequestHeader) (err error) {
+		response, err = client.InitiateGracefulExit(ctx, &multinodepb.ControlInitiateGracefulExitRequest{
+			Header:      header,
+			SatelliteId: satelliteID,
+		})
+		return err
+	})
+	if err != nil {
+		return GracefulExit{}, err
+	}
+
+	return GracefulExit{
+		SatelliteAddress: response.SatelliteAddress,
+		BytesToTransfer:  response.BytesToTransfer,
+	}, nil
+}
+
+// Trash returns the disk space used by the trash of the node.
+func (service *Service) Trash(ctx context.Context, nodeID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var response *multinodepb.TrashResponse
+	err = service.dial(ctx, nodeID, func(client multinodepb.DRPCControlClient, header *multinodepb.RequestHeader) (err error) {
+		response, err = client.Trash(ctx, &multinodepb.TrashRequest{Header: header})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Used, nil
+}
+
+// EmptyTrash deletes all pieces in the trash of the node and returns the freed disk space.
+func (service *Service) EmptyTrash(ctx context.Context, nodeID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var response *multinodepb.EmptyTrashResponse
+	err = service.dial(ctx, nodeID, func(client multinodepb.DRPCControlClient, header *multinodepb.RequestHeader) (err error) {
+		response, err = client.EmptyTrash(ctx, &multinodepb.EmptyTrashRequest{Header: header})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Freed, nil
+}
+
+// SetUploadsPaused pauses or resumes new uploads to the node.
+// The change is temporary, the node accepts uploads again after a restart.
+func (service *Service) SetUploadsPaused(ctx context.Context, nodeID storj.NodeID, paused bool) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var response *multinodepb.SetUploadsPausedResponse
+	err = service.dial(ctx, nodeID, func(client multinodepb.DRPCControlClient, header *multinodepb.RequestHeader) (err error) {
+		response, err = client.SetUploadsPaused(ctx, &multinodepb.SetUploadsPausedRequest{
+			Header: header,
+			Paused: paused,
+		})
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return response.Paused, nil
+}
+
+// RestartRetain restarts garbage collection of the node for the satellite.
+// false is returned if the node has no bloom filter from the satellite.
+func (service *Service) RestartRetain(ctx context.Context, nodeID, satelliteID storj.NodeID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var response *multinodepb.RestartRetainResponse
+	err = service.dial(ctx, nodeID, func(client multinodepb.DRPCControlClient, header *multinodepb.RequestHeader) (err error) {
+		response, err = client.RestartRetain(ctx, &multinodepb.RestartRetainRequest{
+			Header:      header,
+			SatelliteId: satelliteID,
+		})
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return response.Queued, nil
+}
+
+// RestartUsedSpaceWalker restarts recalculation of the disk space used by the node.
+// false is returned if a recalculation is already pending.
+func (service *Service) RestartUsedSpaceWalker(ctx context.Context, nodeID storj.NodeID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var response *multinodepb.RestartUsedSpaceWalkerResponse
+	err = service.dial(ctx, nodeID, func(client multinodepb.DRPCControlClient, header *multinodepb.RequestHeader) (err error) {
+		response, err = client.RestartUsedSpaceWalker(ctx, &multinodepb.RestartUsedSpaceWalkerRequest{Header: header})
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return response.Triggered, nil
+}
+
+// dial dials the node and calls fn with the control client and the node's api key.
+func (service *Service) dial(ctx context.Context, nodeID storj.NodeID, fn func(client multinodepb.DRPCControlClient, header *multinodepb.RequestHeader) error) (err error) {
+	node, err := service.nodes.Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return Error.Wrap(nodes.ErrNodeNotReachable.Wrap(err))
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	err = fn(multinodepb.NewDRPCControlClient(conn), &multinodepb.RequestHeader{
+		ApiKey: node.APISecret,
+	})
+	switch rpcstatus.Code(err) {
+	case rpcstatus.OK:
+		return nil
+	case rpcstatus.Unauthenticated:
+		return Error.Wrap(nodes.ErrNodeAPIKeyInvalid.Wrap(err))
+	case rpcstatus.PermissionDenied:
+		return Error.Wrap(ErrNotAdminKey.Wrap(err))
+	case rpcstatus.InvalidArgument, rpcstatus.FailedPrecondition, rpcstatus.NotFound:
+		return Error.Wrap(ErrRejected.Wrap(err))
+	default:
+		return Error.Wrap(err)
+	}
+}
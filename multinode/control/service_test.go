@@ -0,0 +1,108 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package control_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/control"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode/apikeys"
+)
+
+func TestService(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+			service := control.NewService(zaptest.NewLogger(t), planet.Satellites[0].Dialer, db.Nodes())
+
+			node := planet.StorageNodes[0]
+			adminKey, err := apikeys.NewService(node.DB.APIKeys()).IssueAdmin(ctx)
+			require.NoError(t, err)
+			require.NoError(t, db.Nodes().Add(ctx, node.ID(), adminKey.Secret[:], node.Addr()))
+
+			readOnlyNode := planet.StorageNodes[1]
+			readOnlyKey, err := apikeys.NewService(readOnlyNode.DB.APIKeys()).Issue(ctx)
+			require.NoError(t, err)
+			require.NoError(t, db.Nodes().Add(ctx, readOnlyNode.ID(), readOnlyKey.Secret[:], readOnlyNode.Addr()))
+
+			t.Run("allocated disk space", func(t *testing.T) {
+				allocated, err := service.SetAllocatedDiskSpace(ctx, node.ID(), (500 * memory.MB).Int64())
+				require.NoError(t, err)
+				require.Equal(t, (500 * memory.MB).Int64(), allocated)
+				require.Equal(t, allocated, node.Storage2.Monitor.AllocatedDiskSpace())
+
+				// the change is kept when the node restarts.
+				allocation, err := node.DB.Allocation().Get(ctx)
+				require.NoError(t, err)
+				require.NotNil(t, allocation)
+				require.Equal(t, allocated, allocation.Allocated)
+
+				_, err = service.SetAllocatedDiskSpace(ctx, node.ID(), memory.MB.Int64())
+				require.True(t, control.ErrRejected.Has(err), err)
+				require.Equal(t, allocated, node.Storage2.Monitor.AllocatedDiskSpace())
+			})
+
+			t.Run("uploads paused", func(t *testing.T) {
+				paused, err := service.SetUploadsPaused(ctx, node.ID(), true)
+				require.NoError(t, err)
+				require.True(t, paused)
+				require.True(t, node.Storage2.Monitor.UploadsPaused())
+
+				paused, err = service.SetUploadsPaused(ctx, node.ID(), false)
+				require.NoError(t, err)
+				require.False(t, paused)
+				require.False(t, node.Storage2.Monitor.UploadsPaused())
+			})
+
+			t.Run("trash", func(t *testing.T) {
+				used, err := service.Trash(ctx, node.ID())
+				require.NoError(t, err)
+				require.Zero(t, used)
+
+				freed, err := service.EmptyTrash(ctx, node.ID())
+				require.NoError(t, err)
+				require.Zero(t, freed)
+			})
+
+			t.Run("restart retain", func(t *testing.T) {
+				// the node hasn't received a bloom filter from the satellite.
+				queued, err := service.RestartRetain(ctx, node.ID(), planet.Satellites[0].ID())
+				require.NoError(t, err)
+				require.False(t, queued)
+			})
+
+			t.Run("restart used space walker", func(t *testing.T) {
+				_, err := service.RestartUsedSpaceWalker(ctx, node.ID())
+				require.NoError(t, err)
+			})
+
+			t.Run("graceful exit of unknown satellite", func(t *testing.T) {
+				_, err := service.InitiateGracefulExit(ctx, node.ID(), testrand.NodeID())
+				require.True(t, control.ErrRejected.Has(err), err)
+			})
+
+			t.Run("read only api key", func(t *testing.T) {
+				_, err := service.SetUploadsPaused(ctx, readOnlyNode.ID(), true)
+				require.True(t, control.ErrNotAdminKey.Has(err), err)
+				require.False(t, readOnlyNode.Storage2.Monitor.UploadsPaused())
+			})
+
+			t.Run("unknown node", func(t *testing.T) {
+				_, err := service.SetUploadsPaused(ctx, testrand.NodeID(), true)
+				require.True(t, nodes.ErrNoNode.Has(err), err)
+			})
+		})
+	})
+}
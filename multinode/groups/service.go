@@ -0,0 +1,115 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package groups
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/bandwidth"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/multinode/payouts"
+	"storj.io/storj/multinode/storage"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is an error class for groups service error.
+	Error = errs.Class("groups")
+)
+
+// Summary contains bandwidth, disk space and payout summaries of the nodes of the group.
+type Summary struct {
+	Group     nodes.Group       `json:"group"`
+	Bandwidth bandwidth.Monthly `json:"bandwidth"`
+	DiskSpace storage.DiskSpace `json:"diskSpace"`
+	Payouts   payouts.Summary   `json:"payouts"`
+}
+
+// Service aggregates the summaries of the nodes grouped by their tags.
+//
+// architecture: Service
+type Service struct {
+	log       *zap.Logger
+	nodes     *nodes.Service
+	bandwidth *bandwidth.Service
+	storage   *storage.Service
+	payouts   *payouts.Service
+}
+
+// NewService creates new instance of Service.
+func NewService(log *zap.Logger, nodes *nodes.Service, bandwidth *bandwidth.Service, storage *storage.Service, payouts *payouts.Service) *Service {
+	return &Service{
+		log:       log,
+		nodes:     nodes,
+		bandwidth: bandwidth,
+		storage:   storage,
+		payouts:   payouts,
+	}
+}
+
+// Summaries returns the summaries of the groups of the nodes with the same value of the tag with the key.
+// A node has a single value of the tag, so it belongs to one group at most and is polled only once.
+func (service *Service) Summaries(ctx context.Context, key string) (_ []Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	listNodes, err := service.nodes.List(ctx, nodes.Filter{})
+	if err != nil {
+		if nodes.ErrNoNode.Has(err) {
+			return []Summary{}, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	byID := make(map[storj.NodeID]nodes.Node, len(listNodes))
+	for _, node := range listNodes {
+		byID[node.ID] = node
+	}
+
+	groups := nodes.GroupBy(listNodes, key)
+	summaries := make([]Summary, 0, len(groups))
+	for _, group := range groups {
+		groupNodes := make([]nodes.Node, 0, len(group.Nodes))
+		for _, id := range group.Nodes {
+			groupNodes = append(groupNodes, byID[id])
+		}
+
+		summary, err := service.summary(ctx, group, groupNodes)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, nil
+}
+
+// summary aggregates the summaries of the nodes of the group.
+func (service *Service) summary(ctx context.Context, group nodes.Group, groupNodes []nodes.Node) (_ Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	summary := Summary{Group: group}
+
+	summary.Bandwidth, err = service.bandwidth.NodesMonthly(ctx, groupNodes)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	summary.DiskSpace, err = service.storage.NodesDiskSpace(ctx, groupNodes)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	summary.Payouts, err = service.payouts.NodesSummary(ctx, groupNodes)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package groups_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/bandwidth"
+	"storj.io/storj/multinode/groups"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/multinode/payouts"
+	"storj.io/storj/multinode/storage"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode/apikeys"
+)
+
+func TestSummaries(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+			log := zaptest.NewLogger(t)
+			dialer := planet.Satellites[0].Dialer
+
+			nodesService := nodes.NewService(log, dialer, db.Nodes())
+			storageService := storage.NewService(log, dialer, db.Nodes())
+			service := groups.NewService(log,
+				nodesService,
+				bandwidth.NewService(log, dialer, nodesService),
+				storageService,
+				payouts.NewService(log, dialer, db.Nodes()),
+			)
+
+			// the last node has no site tag and isn't part of any group.
+			sites := []string{"berlin", "paris", "berlin", ""}
+			expected := make(map[string][]storj.NodeID)
+			for i, node := range planet.StorageNodes {
+				apiKey, err := apikeys.NewService(node.DB.APIKeys()).Issue(ctx)
+				require.NoError(t, err)
+
+				require.NoError(t, nodesService.Add(ctx, node.ID(), apiKey.Secret[:], node.Addr()))
+				if sites[i] == "" {
+					continue
+				}
+				require.NoError(t, nodesService.SetTag(ctx, node.ID(), nodes.Tag{Key: "site", Value: sites[i]}))
+				expected[sites[i]] = append(expected[sites[i]], node.ID())
+			}
+
+			summaries, err := service.Summaries(ctx, "site")
+			require.NoError(t, err)
+			require.Len(t, summaries, 2)
+
+			for _, summary := range summaries {
+				groupNodes := expected[summary.Group.Tag.Value]
+				require.Equal(t, "site", summary.Group.Tag.Key)
+				require.ElementsMatch(t, groupNodes, summary.Group.Nodes)
+
+				var diskSpace storage.DiskSpace
+				for _, id := range groupNodes {
+					nodeDiskSpace, err := storageService.DiskSpace(ctx, id)
+					require.NoError(t, err)
+					diskSpace.Add(nodeDiskSpace)
+				}
+				require.Equal(t, diskSpace.Allocated, summary.DiskSpace.Allocated)
+
+				var payoutNodes []storj.NodeID
+				for _, nodeSummary := range summary.Payouts.NodeSummary {
+					payoutNodes = append(payoutNodes, nodeSummary.NodeID)
+				}
+				require.ElementsMatch(t, groupNodes, payoutNodes)
+			}
+
+			summaries, err = service.Summaries(ctx, "disk")
+			require.NoError(t, err)
+			require.Empty(t, summaries)
+		})
+	})
+}
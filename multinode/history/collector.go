@@ -0,0 +1,249 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodepb"
+)
+
+var mon = monkit.Package()
+
+// Collector periodically polls all nodes and stores their metrics in the history.
+//
+// architecture: Chore
+type Collector struct {
+	log     *zap.Logger
+	dialer  rpc.Dialer
+	nodes   nodes.DB
+	history DB
+	config  Config
+
+	nowFn func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewCollector creates a new node history collector.
+func NewCollector(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB, history DB, config Config) *Collector {
+	return &Collector{
+		log:     log,
+		dialer:  dialer,
+		nodes:   nodes,
+		history: history,
+		config:  config,
+		nowFn:   time.Now,
+		Loop:    sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the collector.
+func (collector *Collector) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return collector.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := collector.Collect(ctx); err != nil {
+			collector.log.Error("error during collecting node metrics", zap.Error(err))
+		}
+		if err := collector.DeleteExpired(ctx); err != nil {
+			collector.log.Error("error during deleting expired node metrics", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the collector.
+func (collector *Collector) Close() (err error) {
+	collector.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the collector act as if the current time is whatever they want.
+func (collector *Collector) SetNow(nowFn func() time.Time) {
+	collector.nowFn = nowFn
+}
+
+// Collect queries every node once and stores a sample for each of them.
+// At most Config.Concurrency nodes are queried at the same time.
+func (collector *Collector) Collect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := collector.nodes.List(ctx)
+	if err != nil {
+		if nodes.ErrNoNode.Has(err) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	collectedAt := collector.nowFn().UTC().Truncate(time.Second)
+
+	concurrency := collector.config.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	limiter := sync2.NewLimiter(concurrency)
+
+	samples := make([]Sample, len(list))
+	for i, node := range list {
+		i, node := i, node
+		limiter.Go(ctx, func() {
+			samples[i] = collector.sample(ctx, node)
+		})
+	}
+	limiter.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var group errs.Group
+	for _, sample := range samples {
+		sample.CollectedAt = collectedAt
+		group.Add(collector.history.Insert(ctx, sample))
+	}
+
+	return Error.Wrap(group.Err())
+}
+
+// DeleteExpired deletes the samples which are older than the retention period.
+func (collector *Collector) DeleteExpired(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if collector.config.Retention <= 0 {
+		return nil
+	}
+
+	deleted, err := collector.history.DeleteBefore(ctx, collector.nowFn().Add(-collector.config.Retention))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if deleted > 0 {
+		collector.log.Debug("deleted expired node metrics", zap.Int64("count", deleted))
+	}
+	return nil
+}
+
+// sample queries the metrics of a single node, the metrics are left empty when
+// the node couldn't be queried.
+func (collector *Collector) sample(ctx context.Context, node nodes.Node) (sample Sample) {
+	sample.NodeID = node.ID
+
+	if collector.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, collector.config.Timeout)
+		defer cancel()
+	}
+
+	conn, err := collector.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		sample.Status = nodes.StatusNotReachable
+		return sample
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			collector.log.Debug("failed to close connection", zap.Stringer("Node ID", node.ID), zap.Error(err))
+		}
+	}()
+
+	nodeClient := multinodepb.NewDRPCNodeClient(conn)
+	storageClient := multinodepb.NewDRPCStorageClient(conn)
+	bandwidthClient := multinodepb.NewDRPCBandwidthClient(conn)
+	payoutClient := multinodepb.NewDRPCPayoutClient(conn)
+
+	header := &multinodepb.RequestHeader{
+		ApiKey: node.APISecret,
+	}
+
+	lastContact, err := nodeClient.LastContact(ctx, &multinodepb.LastContactRequest{Header: header})
+	if err != nil {
+		if rpcstatus.Code(err) == rpcstatus.Unauthenticated {
+			sample.Status = nodes.StatusUnauthorized
+			return sample
+		}
+		sample.Status = nodes.StatusStorageNodeInternalError
+		return sample
+	}
+
+	metrics, err := func() (metrics Sample, err error) {
+		diskSpace, err := storageClient.DiskSpace(ctx, &multinodepb.DiskSpaceRequest{Header: header})
+		if err != nil {
+			return Sample{}, err
+		}
+
+		bandwidth, err := bandwidthClient.MonthSummary(ctx, &multinodepb.BandwidthMonthSummaryRequest{Header: header})
+		if err != nil {
+			return Sample{}, err
+		}
+
+		estimated, err := payoutClient.EstimatedPayoutTotal(ctx, &multinodepb.EstimatedPayoutTotalRequest{Header: header})
+		if err != nil {
+			return Sample{}, err
+		}
+
+		trusted, err := nodeClient.TrustedSatellites(ctx, &multinodepb.TrustedSatellitesRequest{Header: header})
+		if err != nil {
+			return Sample{}, err
+		}
+
+		for _, satellite := range trusted.TrustedSatellites {
+			reputation, err := nodeClient.Reputation(ctx, &multinodepb.ReputationRequest{
+				Header:      header,
+				SatelliteId: satellite.NodeId,
+			})
+			if err != nil {
+				if rpcstatus.Code(err) == rpcstatus.NotFound {
+					continue
+				}
+				return Sample{}, err
+			}
+
+			metrics.AuditScore = minScore(metrics.AuditScore, reputation.GetAudit().GetScore())
+			metrics.SuspensionScore = minScore(metrics.SuspensionScore, reputation.GetAudit().GetSuspensionScore())
+			metrics.OnlineScore = minScore(metrics.OnlineScore, reputation.GetOnline().GetScore())
+		}
+
+		diskSpaceUsed := diskSpace.GetUsedPieces() + diskSpace.GetUsedTrash()
+		diskSpaceAvailable := diskSpace.GetAvailable()
+		bandwidthUsed := bandwidth.GetUsed()
+		estimatedPayout := estimated.GetEstimatedEarnings()
+
+		metrics.DiskSpaceUsed = &diskSpaceUsed
+		metrics.DiskSpaceAvailable = &diskSpaceAvailable
+		metrics.BandwidthUsed = &bandwidthUsed
+		metrics.EstimatedPayout = &estimatedPayout
+		return metrics, nil
+	}()
+	if err != nil {
+		collector.log.Debug("failed to query node metrics", zap.Stringer("Node ID", node.ID), zap.Error(err))
+		sample.Status = nodes.StatusStorageNodeInternalError
+		return sample
+	}
+
+	metrics.NodeID = sample.NodeID
+	metrics.Status = nodes.StatusFromLastContact(lastContact.LastContact)
+	return metrics
+}
+
+// minScore returns the lower of the current and the new score.
+func minScore(current *float64, score float64) *float64 {
+	if current != nil && *current <= score {
+		return current
+	}
+	return &score
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/nodes"
+)
+
+// DB exposes needed by MND node history functionality.
+//
+// architecture: Database
+type DB interface {
+	// Insert stores a sample, samples for the same node and time are ignored.
+	Insert(ctx context.Context, sample Sample) error
+	// List returns samples collected in [from, to) ordered by collection time.
+	// When nodeID is nil samples of all nodes are returned. When step is positive
+	// the range is split into step long buckets and only the first sample of
+	// every node in each bucket is returned.
+	List(ctx context.Context, nodeID *storj.NodeID, from, to time.Time, step time.Duration) ([]Sample, error)
+	// Latest returns the most recent sample of every node.
+	Latest(ctx context.Context) ([]Sample, error)
+	// LatestMetrics returns the most recent sample with metrics of every node.
+	LatestMetrics(ctx context.Context) ([]Sample, error)
+	// DeleteBefore deletes samples collected before the given time and returns their count.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Error is the default error class for node history.
+var Error = errs.Class("history")
+
+// Sample is the state of a node at a point in time.
+// The metrics are nil when the node couldn't be queried.
+type Sample struct {
+	NodeID      storj.NodeID `json:"nodeId"`
+	CollectedAt time.Time    `json:"collectedAt"`
+	Status      nodes.Status `json:"status"`
+
+	DiskSpaceUsed      *int64 `json:"diskSpaceUsed"`
+	DiskSpaceAvailable *int64 `json:"diskSpaceAvailable"`
+	// BandwidthUsed is the bandwidth used in the current month.
+	BandwidthUsed *int64 `json:"bandwidthUsed"`
+	// EstimatedPayout is the current month payout estimation in cents.
+	EstimatedPayout *int64 `json:"estimatedPayout"`
+
+	// Reputation scores are the lowest across the trusted satellites.
+	AuditScore      *float64 `json:"auditScore"`
+	SuspensionScore *float64 `json:"suspensionScore"`
+	OnlineScore     *float64 `json:"onlineScore"`
+}
+
+// Config contains configurable values for node history.
+type Config struct {
+	Interval    time.Duration `help:"how frequently the node metrics are collected" default:"15m0s"`
+	Timeout     time.Duration `help:"how long to wait for a single node to respond" default:"30s"`
+	Concurrency int           `help:"how many nodes are queried at the same time" default:"10"`
+	Retention   time.Duration `help:"how long the collected metrics are kept" default:"2160h0m0s"`
+	MaxRange    time.Duration `help:"the longest time range which can be listed at once" default:"2160h0m0s"`
+	MaxSamples  int           `help:"the maximum number of samples listed per node, longer ranges are downsampled" default:"500"`
+}
@@ -0,0 +1,167 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package history_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/rpc"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/history"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/nodes"
+)
+
+func TestHistoryDB(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		historyDB := db.History()
+
+		nodeA, nodeB := testrand.NodeID(), testrand.NodeID()
+		start := time.Date(2021, 8, 1, 10, 0, 0, 0, time.UTC)
+		used, score := int64(1000), 0.95
+
+		for i := 0; i < 3; i++ {
+			collectedAt := start.Add(time.Duration(i) * time.Hour)
+			require.NoError(t, historyDB.Insert(ctx, history.Sample{
+				NodeID:        nodeA,
+				CollectedAt:   collectedAt,
+				Status:        nodes.StatusOnline,
+				DiskSpaceUsed: &used,
+				AuditScore:    &score,
+			}))
+			require.NoError(t, historyDB.Insert(ctx, history.Sample{
+				NodeID:      nodeB,
+				CollectedAt: collectedAt,
+				Status:      nodes.StatusNotReachable,
+			}))
+		}
+
+		// inserting the same sample twice is ignored.
+		require.NoError(t, historyDB.Insert(ctx, history.Sample{
+			NodeID:      nodeB,
+			CollectedAt: start,
+			Status:      nodes.StatusOnline,
+		}))
+
+		samples, err := historyDB.List(ctx, nil, start, start.Add(2*time.Hour), 0)
+		require.NoError(t, err)
+		require.Len(t, samples, 4)
+		for _, sample := range samples {
+			require.True(t, sample.CollectedAt.Before(start.Add(2*time.Hour)))
+		}
+
+		samples, err = historyDB.List(ctx, &nodeA, start, start.Add(24*time.Hour), 0)
+		require.NoError(t, err)
+		require.Len(t, samples, 3)
+		require.Equal(t, start, samples[0].CollectedAt)
+		require.Equal(t, nodes.StatusOnline, samples[0].Status)
+		require.Equal(t, used, *samples[0].DiskSpaceUsed)
+		require.Equal(t, score, *samples[0].AuditScore)
+		require.Nil(t, samples[0].BandwidthUsed)
+
+		samples, err = historyDB.List(ctx, &nodeB, start, start.Add(24*time.Hour), 0)
+		require.NoError(t, err)
+		require.Len(t, samples, 3)
+		require.Equal(t, nodes.StatusNotReachable, samples[0].Status)
+		require.Nil(t, samples[0].DiskSpaceUsed)
+
+		latest, err := historyDB.Latest(ctx)
+		require.NoError(t, err)
+		require.Len(t, latest, 2)
+		for _, sample := range latest {
+			require.Equal(t, start.Add(2*time.Hour), sample.CollectedAt)
+		}
+
+		// nodeB was never queried successfully.
+		latest, err = historyDB.LatestMetrics(ctx)
+		require.NoError(t, err)
+		require.Len(t, latest, 1)
+		require.Equal(t, nodeA, latest[0].NodeID)
+		require.Equal(t, start.Add(2*time.Hour), latest[0].CollectedAt)
+
+		// downsampling keeps the first sample of every node in each bucket.
+		samples, err = historyDB.List(ctx, nil, start, start.Add(24*time.Hour), 2*time.Hour)
+		require.NoError(t, err)
+		require.Len(t, samples, 4)
+		for _, sample := range samples {
+			require.NotEqual(t, start.Add(time.Hour), sample.CollectedAt)
+		}
+
+		deleted, err := historyDB.DeleteBefore(ctx, start.Add(time.Hour))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, deleted)
+
+		samples, err = historyDB.List(ctx, nil, start, start.Add(24*time.Hour), 0)
+		require.NoError(t, err)
+		require.Len(t, samples, 4)
+	})
+}
+
+func TestCollector(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		config := history.Config{
+			Interval:    time.Hour,
+			Timeout:     time.Second,
+			Concurrency: 2,
+			Retention:   24 * time.Hour,
+			MaxRange:    48 * time.Hour,
+			MaxSamples:  10,
+		}
+		collector := history.NewCollector(zaptest.NewLogger(t), rpc.Dialer{}, db.Nodes(), db.History(), config)
+		service := history.NewService(zaptest.NewLogger(t), db.History(), config)
+
+		// no nodes, nothing to collect.
+		require.NoError(t, collector.Collect(ctx))
+
+		nodeID := testrand.NodeID()
+		require.NoError(t, db.Nodes().Add(ctx, nodeID, testrand.BytesInt(32), "127.0.0.1:0"))
+
+		now := time.Date(2021, 8, 1, 10, 0, 0, 500, time.UTC)
+		collector.SetNow(func() time.Time { return now })
+		require.NoError(t, collector.Collect(ctx))
+
+		samples, err := service.ListNode(ctx, nodeID, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, samples, 1)
+		require.Equal(t, nodes.StatusNotReachable, samples[0].Status)
+		require.Equal(t, now.Truncate(time.Second), samples[0].CollectedAt)
+		require.Nil(t, samples[0].DiskSpaceUsed)
+		require.Nil(t, samples[0].OnlineScore)
+
+		_, err = service.List(ctx, now, now)
+		require.True(t, history.ErrInvalidRange.Has(err))
+
+		_, err = service.List(ctx, now.Add(-72*time.Hour), now)
+		require.True(t, history.ErrInvalidRange.Has(err))
+
+		// the unreachable node is filled with its last known metrics.
+		used := int64(1000)
+		require.NoError(t, db.History().Insert(ctx, history.Sample{
+			NodeID:        nodeID,
+			CollectedAt:   now.Add(-time.Minute).Truncate(time.Second),
+			Status:        nodes.StatusOnline,
+			DiskSpaceUsed: &used,
+		}))
+		infos := []nodes.NodeInfo{{ID: nodeID, Status: nodes.StatusNotReachable}}
+		require.NoError(t, service.FillInfos(ctx, infos))
+		require.NotNil(t, infos[0].CollectedAt)
+		require.Equal(t, now.Add(-time.Minute).Truncate(time.Second), *infos[0].CollectedAt)
+		require.Equal(t, used, infos[0].DiskSpaceUsed)
+		require.Equal(t, nodes.StatusNotReachable, infos[0].Status)
+
+		// the sample expires after the retention period.
+		collector.SetNow(func() time.Time { return now.Add(25 * time.Hour) })
+		require.NoError(t, collector.DeleteExpired(ctx))
+
+		latest, err := service.Latest(ctx)
+		require.NoError(t, err)
+		require.Len(t, latest, 0)
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/nodes"
+)
+
+// ErrInvalidRange indicates that the requested time range is invalid.
+var ErrInvalidRange = errs.Class("invalid range")
+
+// Service exposes the collected node metrics.
+//
+// architecture: Service
+type Service struct {
+	log     *zap.Logger
+	history DB
+	config  Config
+}
+
+// NewService creates a new node history service.
+func NewService(log *zap.Logger, history DB, config Config) *Service {
+	return &Service{
+		log:     log,
+		history: history,
+		config:  config,
+	}
+}
+
+// List returns the samples of all nodes collected in [from, to).
+// Ranges with more than Config.MaxSamples samples per node are downsampled.
+func (service *Service) List(ctx context.Context, from, to time.Time) (_ []Sample, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	step, err := service.step(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	samples, err := service.history.List(ctx, nil, from, to, step)
+	return samples, Error.Wrap(err)
+}
+
+// ListNode returns the samples of a single node collected in [from, to).
+// Ranges with more than Config.MaxSamples samples are downsampled.
+func (service *Service) ListNode(ctx context.Context, nodeID storj.NodeID, from, to time.Time) (_ []Sample, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	step, err := service.step(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	samples, err := service.history.List(ctx, &nodeID, from, to, step)
+	return samples, Error.Wrap(err)
+}
+
+// Latest returns the most recent sample of every node.
+func (service *Service) Latest(ctx context.Context) (_ []Sample, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	samples, err := service.history.Latest(ctx)
+	return samples, Error.Wrap(err)
+}
+
+// FillInfos fills the metrics of the nodes which couldn't be queried with
+// their most recent sample, so the dashboard doesn't go blank when a node is offline.
+func (service *Service) FillInfos(ctx context.Context, infos []nodes.NodeInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var unavailable bool
+	for _, info := range infos {
+		if !info.Available() {
+			unavailable = true
+			break
+		}
+	}
+	if !unavailable {
+		return nil
+	}
+
+	latest, err := service.history.LatestMetrics(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	byNode := make(map[storj.NodeID]Sample, len(latest))
+	for _, sample := range latest {
+		byNode[sample.NodeID] = sample
+	}
+
+	for i := range infos {
+		if infos[i].Available() {
+			continue
+		}
+
+		sample, ok := byNode[infos[i].ID]
+		if !ok {
+			continue
+		}
+
+		collectedAt := sample.CollectedAt
+		infos[i].CollectedAt = &collectedAt
+		infos[i].DiskSpaceUsed = valueOrZero(sample.DiskSpaceUsed)
+		infos[i].DiskSpaceLeft = valueOrZero(sample.DiskSpaceAvailable)
+		infos[i].BandwidthUsed = valueOrZero(sample.BandwidthUsed)
+	}
+
+	return nil
+}
+
+// step returns the downsampling step for the range, it fails when the range is
+// invalid or longer than allowed.
+func (service *Service) step(from, to time.Time) (time.Duration, error) {
+	if !from.Before(to) {
+		return 0, ErrInvalidRange.New("from %s is not before to %s", from, to)
+	}
+
+	length := to.Sub(from)
+	if service.config.MaxRange > 0 && length > service.config.MaxRange {
+		return 0, ErrInvalidRange.New("range %s is longer than %s", length, service.config.MaxRange)
+	}
+
+	if service.config.MaxSamples <= 0 {
+		return 0, nil
+	}
+
+	maxSamples := time.Duration(service.config.MaxSamples)
+	return (length + maxSamples - 1) / maxSamples, nil
+}
+
+// valueOrZero returns the value or zero when it's missing.
+func valueOrZero(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
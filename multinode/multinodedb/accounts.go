@@ -0,0 +1,209 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinodedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/multinode/accounts"
+)
+
+// ErrAccountsDB indicates about internal AccountsDB error.
+var ErrAccountsDB = errs.Class("AccountsDB")
+
+// ensures that accountsdb implements accounts.DB.
+var _ accounts.DB = (*accountsdb)(nil)
+
+// accountsdb exposes needed by MND AccountsDB functionality.
+//
+// architecture: Database
+type accountsdb struct {
+	db *DB
+}
+
+// Create inserts a new account, it returns ErrUsernameTaken when the username is used.
+func (a *accountsdb) Create(ctx context.Context, account accounts.Account) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := a.db.ExecContext(ctx, a.db.Rebind(`
+		INSERT INTO accounts (id, username, password_hash, role, totp_secret, totp_enabled, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (username) DO NOTHING
+	`), account.ID, account.Username, account.PasswordHash, string(account.Role),
+		nullString(account.TOTPSecret), account.TOTPEnabled, account.CreatedAt)
+	if err != nil {
+		return ErrAccountsDB.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return ErrAccountsDB.Wrap(err)
+	}
+	if affected == 0 {
+		return accounts.ErrUsernameTaken.New("%s", account.Username)
+	}
+	return nil
+}
+
+// Get returns the account with the given id.
+func (a *accountsdb) Get(ctx context.Context, id uuid.UUID) (_ accounts.Account, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := a.db.QueryRowContext(ctx, a.db.Rebind(`
+		SELECT id, username, password_hash, role, totp_secret, totp_enabled, created_at
+		FROM accounts WHERE id = ?
+	`), id)
+	return scanAccount(row)
+}
+
+// GetByUsername returns the account with the given username.
+func (a *accountsdb) GetByUsername(ctx context.Context, username string) (_ accounts.Account, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := a.db.QueryRowContext(ctx, a.db.Rebind(`
+		SELECT id, username, password_hash, role, totp_secret, totp_enabled, created_at
+		FROM accounts WHERE username = ?
+	`), username)
+	return scanAccount(row)
+}
+
+// List returns all accounts ordered by username.
+func (a *accountsdb) List(ctx context.Context) (_ []accounts.Account, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT id, username, password_hash, role, totp_secret, totp_enabled, created_at
+		FROM accounts ORDER BY username
+	`)
+	if err != nil {
+		return nil, ErrAccountsDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	list := []accounts.Account{}
+	for rows.Next() {
+		account, err := scanAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, account)
+	}
+
+	return list, ErrAccountsDB.Wrap(rows.Err())
+}
+
+// Update updates the password hash, role and TOTP settings of the account.
+func (a *accountsdb) Update(ctx context.Context, account accounts.Account) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := a.db.ExecContext(ctx, a.db.Rebind(`
+		UPDATE accounts SET password_hash = ?, role = ?, totp_secret = ?, totp_enabled = ?
+		WHERE id = ?
+	`), account.PasswordHash, string(account.Role), nullString(account.TOTPSecret), account.TOTPEnabled, account.ID)
+	if err != nil {
+		return ErrAccountsDB.Wrap(err)
+	}
+	return requireAffected(result, &accounts.ErrNoAccount)
+}
+
+// Delete deletes the account and its sessions.
+func (a *accountsdb) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// sqlite3 doesn't enforce the foreign keys by default, so the sessions
+	// are deleted explicitly.
+	if err := a.DeleteAccountSessions(ctx, id); err != nil {
+		return err
+	}
+
+	result, err := a.db.ExecContext(ctx, a.db.Rebind(`DELETE FROM accounts WHERE id = ?`), id)
+	if err != nil {
+		return ErrAccountsDB.Wrap(err)
+	}
+	return requireAffected(result, &accounts.ErrNoAccount)
+}
+
+// CreateSession inserts a new session.
+func (a *accountsdb) CreateSession(ctx context.Context, session accounts.Session) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = a.db.ExecContext(ctx, a.db.Rebind(`
+		INSERT INTO sessions (id, account_id, expires_at) VALUES (?, ?, ?)
+	`), session.ID, session.AccountID, session.ExpiresAt)
+	return ErrAccountsDB.Wrap(err)
+}
+
+// GetSession returns the session with the given id.
+func (a *accountsdb) GetSession(ctx context.Context, id []byte) (_ accounts.Session, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var session accounts.Session
+	err = a.db.QueryRowContext(ctx, a.db.Rebind(`
+		SELECT id, account_id, expires_at FROM sessions WHERE id = ?
+	`), id).Scan(&session.ID, &session.AccountID, &session.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return accounts.Session{}, accounts.ErrNoSession.Wrap(err)
+	}
+	if err != nil {
+		return accounts.Session{}, ErrAccountsDB.Wrap(err)
+	}
+	return session, nil
+}
+
+// DeleteSession deletes the session with the given id.
+func (a *accountsdb) DeleteSession(ctx context.Context, id []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = a.db.ExecContext(ctx, a.db.Rebind(`DELETE FROM sessions WHERE id = ?`), id)
+	return ErrAccountsDB.Wrap(err)
+}
+
+// DeleteAccountSessions deletes all sessions of the account.
+func (a *accountsdb) DeleteAccountSessions(ctx context.Context, accountID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = a.db.ExecContext(ctx, a.db.Rebind(`DELETE FROM sessions WHERE account_id = ?`), accountID)
+	return ErrAccountsDB.Wrap(err)
+}
+
+// scanAccount scans an account from the row.
+func scanAccount(row interface{ Scan(...interface{}) error }) (accounts.Account, error) {
+	var account accounts.Account
+	var role string
+	var totpSecret sql.NullString
+	err := row.Scan(&account.ID, &account.Username, &account.PasswordHash, &role,
+		&totpSecret, &account.TOTPEnabled, &account.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return accounts.Account{}, accounts.ErrNoAccount.Wrap(err)
+	}
+	if err != nil {
+		return accounts.Account{}, ErrAccountsDB.Wrap(err)
+	}
+
+	account.Role = accounts.Role(role)
+	account.TOTPSecret = totpSecret.String
+	return account, nil
+}
+
+// requireAffected returns the notFound error when the statement didn't affect any row.
+func requireAffected(result sql.Result, notFound *errs.Class) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return ErrAccountsDB.Wrap(err)
+	}
+	if affected == 0 {
+		return notFound.New("")
+	}
+	return nil
+}
+
+// nullString returns a NULL value for the empty string.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinodedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/multinode/alerts"
+)
+
+// ErrAlertsDB indicates about internal AlertsDB error.
+var ErrAlertsDB = errs.Class("AlertsDB")
+
+// ensures that alertsdb implements alerts.DB.
+var _ alerts.DB = (*alertsdb)(nil)
+
+// alertsdb exposes needed by MND AlertsDB functionality.
+//
+// architecture: Database
+type alertsdb struct {
+	db *DB
+}
+
+// Create inserts a new alert.
+func (a *alertsdb) Create(ctx context.Context, alert alerts.Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = a.db.ExecContext(ctx, a.db.Rebind(`
+		INSERT INTO alerts (id, node_id, rule, message, triggered_at, notified_at, resolved_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`), alert.ID, alert.NodeID, string(alert.Rule), alert.Message,
+		alert.TriggeredAt.UTC(), alert.NotifiedAt.UTC(), alert.ResolvedAt)
+	return ErrAlertsDB.Wrap(err)
+}
+
+// Active returns all alerts which aren't resolved.
+func (a *alertsdb) Active(ctx context.Context) (_ []alerts.Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT id, node_id, rule, message, triggered_at, notified_at, resolved_at
+		FROM alerts WHERE resolved_at IS NULL
+		ORDER BY triggered_at
+	`)
+	if err != nil {
+		return nil, ErrAlertsDB.Wrap(err)
+	}
+	return scanAlerts(rows)
+}
+
+// List returns the most recently triggered alerts, newest first.
+func (a *alertsdb) List(ctx context.Context, limit int) (_ []alerts.Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := a.db.QueryContext(ctx, a.db.Rebind(`
+		SELECT id, node_id, rule, message, triggered_at, notified_at, resolved_at
+		FROM alerts
+		ORDER BY triggered_at DESC
+		LIMIT ?
+	`), limit)
+	if err != nil {
+		return nil, ErrAlertsDB.Wrap(err)
+	}
+	return scanAlerts(rows)
+}
+
+// UpdateNotified updates the message and the last notification time of the alert.
+func (a *alertsdb) UpdateNotified(ctx context.Context, id uuid.UUID, message string, notifiedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := a.db.ExecContext(ctx, a.db.Rebind(`
+		UPDATE alerts SET message = ?, notified_at = ? WHERE id = ?
+	`), message, notifiedAt.UTC(), id)
+	if err != nil {
+		return ErrAlertsDB.Wrap(err)
+	}
+	return requireAffected(result, &alerts.ErrNoAlert)
+}
+
+// Resolve marks the alert as resolved.
+func (a *alertsdb) Resolve(ctx context.Context, id uuid.UUID, resolvedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := a.db.ExecContext(ctx, a.db.Rebind(`
+		UPDATE alerts SET resolved_at = ? WHERE id = ? AND resolved_at IS NULL
+	`), resolvedAt.UTC(), id)
+	if err != nil {
+		return ErrAlertsDB.Wrap(err)
+	}
+	return requireAffected(result, &alerts.ErrNoAlert)
+}
+
+// scanAlerts scans all alerts from the rows and closes them.
+func scanAlerts(rows tagsql.Rows) (_ []alerts.Alert, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	list := []alerts.Alert{}
+	for rows.Next() {
+		var alert alerts.Alert
+		var rule string
+		var resolvedAt sql.NullTime
+
+		err := rows.Scan(&alert.ID, &alert.NodeID, &rule, &alert.Message,
+			&alert.TriggeredAt, &alert.NotifiedAt, &resolvedAt)
+		if err != nil {
+			return nil, ErrAlertsDB.Wrap(err)
+		}
+
+		alert.Rule = alerts.Rule(rule)
+		alert.TriggeredAt = alert.TriggeredAt.UTC()
+		alert.NotifiedAt = alert.NotifiedAt.UTC()
+		if resolvedAt.Valid {
+			resolved := resolvedAt.Time.UTC()
+			alert.ResolvedAt = &resolved
+		}
+
+		list = append(list, alert)
+	}
+
+	return list, ErrAlertsDB.Wrap(rows.Err())
+}
@@ -15,6 +15,9 @@ import (
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
 	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/accounts"
+	"storj.io/storj/multinode/alerts"
+	"storj.io/storj/multinode/history"
 	"storj.io/storj/multinode/multinodedb/dbx"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/private/migrate"
@@ -91,6 +94,28 @@ func Open(ctx context.Context, log *zap.Logger, databaseURL string) (*DB, error)
 func (db *DB) Nodes() nodes.DB {
 	return &nodesdb{
 		methods: db,
+		db:      db,
+	}
+}
+
+// Accounts returns accounts database.
+func (db *DB) Accounts() accounts.DB {
+	return &accountsdb{
+		db: db,
+	}
+}
+
+// Alerts returns alerts database.
+func (db *DB) Alerts() alerts.DB {
+	return &alertsdb{
+		db: db,
+	}
+}
+
+// History returns node history database.
+func (db *DB) History() history.DB {
+	return &historydb{
+		db: db,
 	}
 }
 
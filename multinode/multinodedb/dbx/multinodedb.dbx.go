@@ -275,13 +275,61 @@ func newpgx(db *DB) *pgxDB {
 }
 
 func (obj *pgxDB) Schema() string {
-	return `CREATE TABLE nodes (
+	return `CREATE TABLE accounts (
+	id bytea NOT NULL,
+	username text NOT NULL,
+	password_hash bytea NOT NULL,
+	role text NOT NULL,
+	totp_secret text,
+	totp_enabled boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( username )
+);
+CREATE TABLE alerts (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	rule text NOT NULL,
+	message text NOT NULL,
+	triggered_at timestamp with time zone NOT NULL,
+	notified_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_history (
+	node_id bytea NOT NULL,
+	collected_at timestamp with time zone NOT NULL,
+	status text NOT NULL,
+	disk_space_used bigint,
+	disk_space_available bigint,
+	bandwidth_used bigint,
+	estimated_payout bigint,
+	audit_score double precision,
+	suspension_score double precision,
+	online_score double precision,
+	PRIMARY KEY ( node_id, collected_at )
+);
+CREATE TABLE nodes (
 	id bytea NOT NULL,
 	name text NOT NULL,
 	public_address text NOT NULL,
 	api_secret bytea NOT NULL,
 	PRIMARY KEY ( id )
-);`
+);
+CREATE TABLE node_tags (
+	node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	key text NOT NULL,
+	value text NOT NULL,
+	PRIMARY KEY ( node_id, key )
+);
+CREATE TABLE sessions (
+	id bytea NOT NULL,
+	account_id bytea NOT NULL REFERENCES accounts( id ) ON DELETE CASCADE,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX alerts_triggered_at_index ON alerts ( triggered_at ) ;
+CREATE INDEX node_history_collected_at_index ON node_history ( collected_at ) ;`
 }
 
 func (obj *pgxDB) wrapTx(tx tagsql.Tx) txMethods {
@@ -307,201 +355,1020 @@ func pgxLogStmt(stmt string, args ...interface{}) {
 	}
 }
 
-type sqlite3Impl struct {
-	db      *DB
-	dialect __sqlbundle_sqlite3
-	driver  driver
+type sqlite3Impl struct {
+	db      *DB
+	dialect __sqlbundle_sqlite3
+	driver  driver
+}
+
+func (obj *sqlite3Impl) Rebind(s string) string {
+	return obj.dialect.Rebind(s)
+}
+
+func (obj *sqlite3Impl) logStmt(stmt string, args ...interface{}) {
+	sqlite3LogStmt(stmt, args...)
+}
+
+func (obj *sqlite3Impl) makeErr(err error) error {
+	constraint, ok := obj.isConstraintError(err)
+	if ok {
+		return constraintViolation(err, constraint)
+	}
+	return makeErr(err)
+}
+
+type sqlite3DB struct {
+	db *DB
+	*sqlite3Impl
+}
+
+func newsqlite3(db *DB) *sqlite3DB {
+	return &sqlite3DB{
+		db: db,
+		sqlite3Impl: &sqlite3Impl{
+			db:     db,
+			driver: db.DB,
+		},
+	}
+}
+
+func (obj *sqlite3DB) Schema() string {
+	return `CREATE TABLE accounts (
+	id BLOB NOT NULL,
+	username TEXT NOT NULL,
+	password_hash BLOB NOT NULL,
+	role TEXT NOT NULL,
+	totp_secret TEXT,
+	totp_enabled INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( username )
+);
+CREATE TABLE alerts (
+	id BLOB NOT NULL,
+	node_id BLOB NOT NULL,
+	rule TEXT NOT NULL,
+	message TEXT NOT NULL,
+	triggered_at TIMESTAMP NOT NULL,
+	notified_at TIMESTAMP NOT NULL,
+	resolved_at TIMESTAMP,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_history (
+	node_id BLOB NOT NULL,
+	collected_at TIMESTAMP NOT NULL,
+	status TEXT NOT NULL,
+	disk_space_used INTEGER,
+	disk_space_available INTEGER,
+	bandwidth_used INTEGER,
+	estimated_payout INTEGER,
+	audit_score REAL,
+	suspension_score REAL,
+	online_score REAL,
+	PRIMARY KEY ( node_id, collected_at )
+);
+CREATE TABLE nodes (
+	id BLOB NOT NULL,
+	name TEXT NOT NULL,
+	public_address TEXT NOT NULL,
+	api_secret BLOB NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_tags (
+	node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	key TEXT NOT NULL,
+	value TEXT NOT NULL,
+	PRIMARY KEY ( node_id, key )
+);
+CREATE TABLE sessions (
+	id BLOB NOT NULL,
+	account_id BLOB NOT NULL REFERENCES accounts( id ) ON DELETE CASCADE,
+	expires_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX alerts_triggered_at_index ON alerts ( triggered_at ) ;
+CREATE INDEX node_history_collected_at_index ON node_history ( collected_at ) ;`
+}
+
+func (obj *sqlite3DB) wrapTx(tx tagsql.Tx) txMethods {
+	return &sqlite3Tx{
+		dialectTx: dialectTx{tx: tx},
+		sqlite3Impl: &sqlite3Impl{
+			db:     obj.db,
+			driver: tx,
+		},
+	}
+}
+
+type sqlite3Tx struct {
+	dialectTx
+	*sqlite3Impl
+}
+
+func sqlite3LogStmt(stmt string, args ...interface{}) {
+	// TODO: render placeholders
+	if Logger != nil {
+		out := fmt.Sprintf("stmt: %s\nargs: %v\n", stmt, pretty(args))
+		Logger(out)
+	}
+}
+
+type pretty []interface{}
+
+func (p pretty) Format(f fmt.State, c rune) {
+	fmt.Fprint(f, "[")
+nextval:
+	for i, val := range p {
+		if i > 0 {
+			fmt.Fprint(f, ", ")
+		}
+		rv := reflect.ValueOf(val)
+		if rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				fmt.Fprint(f, "NULL")
+				continue
+			}
+			val = rv.Elem().Interface()
+		}
+		switch v := val.(type) {
+		case string:
+			fmt.Fprintf(f, "%q", v)
+		case time.Time:
+			fmt.Fprintf(f, "%s", v.Format(time.RFC3339Nano))
+		case []byte:
+			for _, b := range v {
+				if !unicode.IsPrint(rune(b)) {
+					fmt.Fprintf(f, "%#x", v)
+					continue nextval
+				}
+			}
+			fmt.Fprintf(f, "%q", v)
+		default:
+			fmt.Fprintf(f, "%v", v)
+		}
+	}
+	fmt.Fprint(f, "]")
+}
+
+type Account struct {
+	Id           []byte
+	Username     string
+	PasswordHash []byte
+	Role         string
+	TotpSecret   *string
+	TotpEnabled  bool
+	CreatedAt    time.Time
+}
+
+func (Account) _Table() string { return "accounts" }
+
+type Account_Update_Fields struct {
+	PasswordHash Account_PasswordHash_Field
+	Role         Account_Role_Field
+	TotpSecret   Account_TotpSecret_Field
+	TotpEnabled  Account_TotpEnabled_Field
+}
+
+type Account_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Account_Id(v []byte) Account_Id_Field {
+	return Account_Id_Field{_set: true, _value: v}
+}
+
+func (f Account_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Account_Id_Field) _Column() string { return "id" }
+
+type Account_Username_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Account_Username(v string) Account_Username_Field {
+	return Account_Username_Field{_set: true, _value: v}
+}
+
+func (f Account_Username_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Account_Username_Field) _Column() string { return "username" }
+
+type Account_PasswordHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Account_PasswordHash(v []byte) Account_PasswordHash_Field {
+	return Account_PasswordHash_Field{_set: true, _value: v}
+}
+
+func (f Account_PasswordHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Account_PasswordHash_Field) _Column() string { return "password_hash" }
+
+type Account_Role_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Account_Role(v string) Account_Role_Field {
+	return Account_Role_Field{_set: true, _value: v}
+}
+
+func (f Account_Role_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Account_Role_Field) _Column() string { return "role" }
+
+type Account_TotpSecret_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Account_TotpSecret(v string) Account_TotpSecret_Field {
+	return Account_TotpSecret_Field{_set: true, _value: &v}
+}
+
+func Account_TotpSecret_Raw(v *string) Account_TotpSecret_Field {
+	if v == nil {
+		return Account_TotpSecret_Null()
+	}
+	return Account_TotpSecret(*v)
+}
+
+func Account_TotpSecret_Null() Account_TotpSecret_Field {
+	return Account_TotpSecret_Field{_set: true, _null: true}
+}
+
+func (f Account_TotpSecret_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Account_TotpSecret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Account_TotpSecret_Field) _Column() string { return "totp_secret" }
+
+type Account_TotpEnabled_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Account_TotpEnabled(v bool) Account_TotpEnabled_Field {
+	return Account_TotpEnabled_Field{_set: true, _value: v}
+}
+
+func (f Account_TotpEnabled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Account_TotpEnabled_Field) _Column() string { return "totp_enabled" }
+
+type Account_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Account_CreatedAt(v time.Time) Account_CreatedAt_Field {
+	return Account_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Account_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Account_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Alert struct {
+	Id          []byte
+	NodeId      []byte
+	Rule        string
+	Message     string
+	TriggeredAt time.Time
+	NotifiedAt  time.Time
+	ResolvedAt  *time.Time
+}
+
+func (Alert) _Table() string { return "alerts" }
+
+type Alert_Update_Fields struct {
+	Message    Alert_Message_Field
+	NotifiedAt Alert_NotifiedAt_Field
+	ResolvedAt Alert_ResolvedAt_Field
+}
+
+type Alert_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Alert_Id(v []byte) Alert_Id_Field {
+	return Alert_Id_Field{_set: true, _value: v}
+}
+
+func (f Alert_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_Id_Field) _Column() string { return "id" }
+
+type Alert_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Alert_NodeId(v []byte) Alert_NodeId_Field {
+	return Alert_NodeId_Field{_set: true, _value: v}
+}
+
+func (f Alert_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_NodeId_Field) _Column() string { return "node_id" }
+
+type Alert_Rule_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Alert_Rule(v string) Alert_Rule_Field {
+	return Alert_Rule_Field{_set: true, _value: v}
+}
+
+func (f Alert_Rule_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_Rule_Field) _Column() string { return "rule" }
+
+type Alert_Message_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Alert_Message(v string) Alert_Message_Field {
+	return Alert_Message_Field{_set: true, _value: v}
+}
+
+func (f Alert_Message_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_Message_Field) _Column() string { return "message" }
+
+type Alert_TriggeredAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Alert_TriggeredAt(v time.Time) Alert_TriggeredAt_Field {
+	return Alert_TriggeredAt_Field{_set: true, _value: v}
+}
+
+func (f Alert_TriggeredAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_TriggeredAt_Field) _Column() string { return "triggered_at" }
+
+type Alert_NotifiedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Alert_NotifiedAt(v time.Time) Alert_NotifiedAt_Field {
+	return Alert_NotifiedAt_Field{_set: true, _value: v}
+}
+
+func (f Alert_NotifiedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_NotifiedAt_Field) _Column() string { return "notified_at" }
+
+type Alert_ResolvedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Alert_ResolvedAt(v time.Time) Alert_ResolvedAt_Field {
+	return Alert_ResolvedAt_Field{_set: true, _value: &v}
+}
+
+func Alert_ResolvedAt_Raw(v *time.Time) Alert_ResolvedAt_Field {
+	if v == nil {
+		return Alert_ResolvedAt_Null()
+	}
+	return Alert_ResolvedAt(*v)
+}
+
+func Alert_ResolvedAt_Null() Alert_ResolvedAt_Field {
+	return Alert_ResolvedAt_Field{_set: true, _null: true}
+}
+
+func (f Alert_ResolvedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Alert_ResolvedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_ResolvedAt_Field) _Column() string { return "resolved_at" }
+
+type Node struct {
+	Id            []byte
+	Name          string
+	PublicAddress string
+	ApiSecret     []byte
+}
+
+func (Node) _Table() string { return "nodes" }
+
+type Node_Update_Fields struct {
+	Name Node_Name_Field
+}
+
+type Node_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
+}
+
+func (f Node_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Id_Field) _Column() string { return "id" }
+
+type Node_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Name(v string) Node_Name_Field {
+	return Node_Name_Field{_set: true, _value: v}
+}
+
+func (f Node_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Name_Field) _Column() string { return "name" }
+
+type Node_PublicAddress_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_PublicAddress(v string) Node_PublicAddress_Field {
+	return Node_PublicAddress_Field{_set: true, _value: v}
+}
+
+func (f Node_PublicAddress_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_PublicAddress_Field) _Column() string { return "public_address" }
+
+type Node_ApiSecret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Node_ApiSecret(v []byte) Node_ApiSecret_Field {
+	return Node_ApiSecret_Field{_set: true, _value: v}
+}
+
+func (f Node_ApiSecret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ApiSecret_Field) _Column() string { return "api_secret" }
+
+type NodeHistory struct {
+	NodeId             []byte
+	CollectedAt        time.Time
+	Status             string
+	DiskSpaceUsed      *int64
+	DiskSpaceAvailable *int64
+	BandwidthUsed      *int64
+	EstimatedPayout    *int64
+	AuditScore         *float64
+	SuspensionScore    *float64
+	OnlineScore        *float64
+}
+
+func (NodeHistory) _Table() string { return "node_history" }
+
+type NodeHistory_Update_Fields struct {
+}
+
+type NodeHistory_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeHistory_NodeId(v []byte) NodeHistory_NodeId_Field {
+	return NodeHistory_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeHistory_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeHistory_CollectedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeHistory_CollectedAt(v time.Time) NodeHistory_CollectedAt_Field {
+	return NodeHistory_CollectedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_CollectedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeHistory_CollectedAt_Field) _Column() string { return "collected_at" }
+
+type NodeHistory_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeHistory_Status(v string) NodeHistory_Status_Field {
+	return NodeHistory_Status_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeHistory_Status_Field) _Column() string { return "status" }
+
+type NodeHistory_DiskSpaceUsed_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func NodeHistory_DiskSpaceUsed(v int64) NodeHistory_DiskSpaceUsed_Field {
+	return NodeHistory_DiskSpaceUsed_Field{_set: true, _value: &v}
+}
+
+func NodeHistory_DiskSpaceUsed_Raw(v *int64) NodeHistory_DiskSpaceUsed_Field {
+	if v == nil {
+		return NodeHistory_DiskSpaceUsed_Null()
+	}
+	return NodeHistory_DiskSpaceUsed(*v)
+}
+
+func NodeHistory_DiskSpaceUsed_Null() NodeHistory_DiskSpaceUsed_Field {
+	return NodeHistory_DiskSpaceUsed_Field{_set: true, _null: true}
+}
+
+func (f NodeHistory_DiskSpaceUsed_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeHistory_DiskSpaceUsed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeHistory_DiskSpaceUsed_Field) _Column() string { return "disk_space_used" }
+
+type NodeHistory_DiskSpaceAvailable_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func NodeHistory_DiskSpaceAvailable(v int64) NodeHistory_DiskSpaceAvailable_Field {
+	return NodeHistory_DiskSpaceAvailable_Field{_set: true, _value: &v}
+}
+
+func NodeHistory_DiskSpaceAvailable_Raw(v *int64) NodeHistory_DiskSpaceAvailable_Field {
+	if v == nil {
+		return NodeHistory_DiskSpaceAvailable_Null()
+	}
+	return NodeHistory_DiskSpaceAvailable(*v)
+}
+
+func NodeHistory_DiskSpaceAvailable_Null() NodeHistory_DiskSpaceAvailable_Field {
+	return NodeHistory_DiskSpaceAvailable_Field{_set: true, _null: true}
+}
+
+func (f NodeHistory_DiskSpaceAvailable_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f NodeHistory_DiskSpaceAvailable_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeHistory_DiskSpaceAvailable_Field) _Column() string { return "disk_space_available" }
+
+type NodeHistory_BandwidthUsed_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func NodeHistory_BandwidthUsed(v int64) NodeHistory_BandwidthUsed_Field {
+	return NodeHistory_BandwidthUsed_Field{_set: true, _value: &v}
+}
+
+func NodeHistory_BandwidthUsed_Raw(v *int64) NodeHistory_BandwidthUsed_Field {
+	if v == nil {
+		return NodeHistory_BandwidthUsed_Null()
+	}
+	return NodeHistory_BandwidthUsed(*v)
+}
+
+func NodeHistory_BandwidthUsed_Null() NodeHistory_BandwidthUsed_Field {
+	return NodeHistory_BandwidthUsed_Field{_set: true, _null: true}
+}
+
+func (f NodeHistory_BandwidthUsed_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeHistory_BandwidthUsed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeHistory_BandwidthUsed_Field) _Column() string { return "bandwidth_used" }
+
+type NodeHistory_EstimatedPayout_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func NodeHistory_EstimatedPayout(v int64) NodeHistory_EstimatedPayout_Field {
+	return NodeHistory_EstimatedPayout_Field{_set: true, _value: &v}
+}
+
+func NodeHistory_EstimatedPayout_Raw(v *int64) NodeHistory_EstimatedPayout_Field {
+	if v == nil {
+		return NodeHistory_EstimatedPayout_Null()
+	}
+	return NodeHistory_EstimatedPayout(*v)
+}
+
+func NodeHistory_EstimatedPayout_Null() NodeHistory_EstimatedPayout_Field {
+	return NodeHistory_EstimatedPayout_Field{_set: true, _null: true}
+}
+
+func (f NodeHistory_EstimatedPayout_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f NodeHistory_EstimatedPayout_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeHistory_EstimatedPayout_Field) _Column() string { return "estimated_payout" }
+
+type NodeHistory_AuditScore_Field struct {
+	_set   bool
+	_null  bool
+	_value *float64
+}
+
+func NodeHistory_AuditScore(v float64) NodeHistory_AuditScore_Field {
+	return NodeHistory_AuditScore_Field{_set: true, _value: &v}
 }
 
-func (obj *sqlite3Impl) Rebind(s string) string {
-	return obj.dialect.Rebind(s)
+func NodeHistory_AuditScore_Raw(v *float64) NodeHistory_AuditScore_Field {
+	if v == nil {
+		return NodeHistory_AuditScore_Null()
+	}
+	return NodeHistory_AuditScore(*v)
 }
 
-func (obj *sqlite3Impl) logStmt(stmt string, args ...interface{}) {
-	sqlite3LogStmt(stmt, args...)
+func NodeHistory_AuditScore_Null() NodeHistory_AuditScore_Field {
+	return NodeHistory_AuditScore_Field{_set: true, _null: true}
 }
 
-func (obj *sqlite3Impl) makeErr(err error) error {
-	constraint, ok := obj.isConstraintError(err)
-	if ok {
-		return constraintViolation(err, constraint)
+func (f NodeHistory_AuditScore_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeHistory_AuditScore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return makeErr(err)
+	return f._value
 }
 
-type sqlite3DB struct {
-	db *DB
-	*sqlite3Impl
+func (NodeHistory_AuditScore_Field) _Column() string { return "audit_score" }
+
+type NodeHistory_SuspensionScore_Field struct {
+	_set   bool
+	_null  bool
+	_value *float64
 }
 
-func newsqlite3(db *DB) *sqlite3DB {
-	return &sqlite3DB{
-		db: db,
-		sqlite3Impl: &sqlite3Impl{
-			db:     db,
-			driver: db.DB,
-		},
+func NodeHistory_SuspensionScore(v float64) NodeHistory_SuspensionScore_Field {
+	return NodeHistory_SuspensionScore_Field{_set: true, _value: &v}
+}
+
+func NodeHistory_SuspensionScore_Raw(v *float64) NodeHistory_SuspensionScore_Field {
+	if v == nil {
+		return NodeHistory_SuspensionScore_Null()
 	}
+	return NodeHistory_SuspensionScore(*v)
 }
 
-func (obj *sqlite3DB) Schema() string {
-	return `CREATE TABLE nodes (
-	id BLOB NOT NULL,
-	name TEXT NOT NULL,
-	public_address TEXT NOT NULL,
-	api_secret BLOB NOT NULL,
-	PRIMARY KEY ( id )
-);`
+func NodeHistory_SuspensionScore_Null() NodeHistory_SuspensionScore_Field {
+	return NodeHistory_SuspensionScore_Field{_set: true, _null: true}
 }
 
-func (obj *sqlite3DB) wrapTx(tx tagsql.Tx) txMethods {
-	return &sqlite3Tx{
-		dialectTx: dialectTx{tx: tx},
-		sqlite3Impl: &sqlite3Impl{
-			db:     obj.db,
-			driver: tx,
-		},
+func (f NodeHistory_SuspensionScore_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f NodeHistory_SuspensionScore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
 }
 
-type sqlite3Tx struct {
-	dialectTx
-	*sqlite3Impl
+func (NodeHistory_SuspensionScore_Field) _Column() string { return "suspension_score" }
+
+type NodeHistory_OnlineScore_Field struct {
+	_set   bool
+	_null  bool
+	_value *float64
 }
 
-func sqlite3LogStmt(stmt string, args ...interface{}) {
-	// TODO: render placeholders
-	if Logger != nil {
-		out := fmt.Sprintf("stmt: %s\nargs: %v\n", stmt, pretty(args))
-		Logger(out)
+func NodeHistory_OnlineScore(v float64) NodeHistory_OnlineScore_Field {
+	return NodeHistory_OnlineScore_Field{_set: true, _value: &v}
+}
+
+func NodeHistory_OnlineScore_Raw(v *float64) NodeHistory_OnlineScore_Field {
+	if v == nil {
+		return NodeHistory_OnlineScore_Null()
 	}
+	return NodeHistory_OnlineScore(*v)
 }
 
-type pretty []interface{}
+func NodeHistory_OnlineScore_Null() NodeHistory_OnlineScore_Field {
+	return NodeHistory_OnlineScore_Field{_set: true, _null: true}
+}
 
-func (p pretty) Format(f fmt.State, c rune) {
-	fmt.Fprint(f, "[")
-nextval:
-	for i, val := range p {
-		if i > 0 {
-			fmt.Fprint(f, ", ")
-		}
-		rv := reflect.ValueOf(val)
-		if rv.Kind() == reflect.Ptr {
-			if rv.IsNil() {
-				fmt.Fprint(f, "NULL")
-				continue
-			}
-			val = rv.Elem().Interface()
-		}
-		switch v := val.(type) {
-		case string:
-			fmt.Fprintf(f, "%q", v)
-		case time.Time:
-			fmt.Fprintf(f, "%s", v.Format(time.RFC3339Nano))
-		case []byte:
-			for _, b := range v {
-				if !unicode.IsPrint(rune(b)) {
-					fmt.Fprintf(f, "%#x", v)
-					continue nextval
-				}
-			}
-			fmt.Fprintf(f, "%q", v)
-		default:
-			fmt.Fprintf(f, "%v", v)
-		}
+func (f NodeHistory_OnlineScore_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeHistory_OnlineScore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	fmt.Fprint(f, "]")
+	return f._value
 }
 
-type Node struct {
-	Id            []byte
-	Name          string
-	PublicAddress string
-	ApiSecret     []byte
+func (NodeHistory_OnlineScore_Field) _Column() string { return "online_score" }
+
+type NodeTag struct {
+	NodeId []byte
+	Key    string
+	Value  string
 }
 
-func (Node) _Table() string { return "nodes" }
+func (NodeTag) _Table() string { return "node_tags" }
 
-type Node_Update_Fields struct {
-	Name Node_Name_Field
+type NodeTag_Update_Fields struct {
+	Value NodeTag_Value_Field
 }
 
-type Node_Id_Field struct {
+type NodeTag_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+func NodeTag_NodeId(v []byte) NodeTag_NodeId_Field {
+	return NodeTag_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f NodeTag_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (NodeTag_NodeId_Field) _Column() string { return "node_id" }
 
-type Node_Name_Field struct {
+type NodeTag_Key_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_Name(v string) Node_Name_Field {
-	return Node_Name_Field{_set: true, _value: v}
+func NodeTag_Key(v string) NodeTag_Key_Field {
+	return NodeTag_Key_Field{_set: true, _value: v}
 }
 
-func (f Node_Name_Field) value() interface{} {
+func (f NodeTag_Key_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Name_Field) _Column() string { return "name" }
+func (NodeTag_Key_Field) _Column() string { return "key" }
 
-type Node_PublicAddress_Field struct {
+type NodeTag_Value_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_PublicAddress(v string) Node_PublicAddress_Field {
-	return Node_PublicAddress_Field{_set: true, _value: v}
+func NodeTag_Value(v string) NodeTag_Value_Field {
+	return NodeTag_Value_Field{_set: true, _value: v}
 }
 
-func (f Node_PublicAddress_Field) value() interface{} {
+func (f NodeTag_Value_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_PublicAddress_Field) _Column() string { return "public_address" }
+func (NodeTag_Value_Field) _Column() string { return "value" }
 
-type Node_ApiSecret_Field struct {
+type Session struct {
+	Id        []byte
+	AccountId []byte
+	ExpiresAt time.Time
+}
+
+func (Session) _Table() string { return "sessions" }
+
+type Session_Update_Fields struct {
+}
+
+type Session_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Node_ApiSecret(v []byte) Node_ApiSecret_Field {
-	return Node_ApiSecret_Field{_set: true, _value: v}
+func Session_Id(v []byte) Session_Id_Field {
+	return Session_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_ApiSecret_Field) value() interface{} {
+func (f Session_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ApiSecret_Field) _Column() string { return "api_secret" }
+func (Session_Id_Field) _Column() string { return "id" }
+
+type Session_AccountId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Session_AccountId(v []byte) Session_AccountId_Field {
+	return Session_AccountId_Field{_set: true, _value: v}
+}
+
+func (f Session_AccountId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Session_AccountId_Field) _Column() string { return "account_id" }
+
+type Session_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Session_ExpiresAt(v time.Time) Session_ExpiresAt_Field {
+	return Session_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f Session_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Session_ExpiresAt_Field) _Column() string { return "expires_at" }
 
 func toUTC(t time.Time) time.Time {
 	return t.UTC()
@@ -1180,11 +2047,61 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM sessions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_history;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -1489,11 +2406,61 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 	defer mon.Task()(&ctx)(&err)
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM sessions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_history;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -1,5 +1,39 @@
 -- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
+CREATE TABLE accounts (
+	id bytea NOT NULL,
+	username text NOT NULL,
+	password_hash bytea NOT NULL,
+	role text NOT NULL,
+	totp_secret text,
+	totp_enabled boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( username )
+);
+CREATE TABLE alerts (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	rule text NOT NULL,
+	message text NOT NULL,
+	triggered_at timestamp with time zone NOT NULL,
+	notified_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_history (
+	node_id bytea NOT NULL,
+	collected_at timestamp with time zone NOT NULL,
+	status text NOT NULL,
+	disk_space_used bigint,
+	disk_space_available bigint,
+	bandwidth_used bigint,
+	estimated_payout bigint,
+	audit_score double precision,
+	suspension_score double precision,
+	online_score double precision,
+	PRIMARY KEY ( node_id, collected_at )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -7,3 +41,17 @@ CREATE TABLE nodes (
 	api_secret bytea NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_tags (
+	node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	key text NOT NULL,
+	value text NOT NULL,
+	PRIMARY KEY ( node_id, key )
+);
+CREATE TABLE sessions (
+	id bytea NOT NULL,
+	account_id bytea NOT NULL REFERENCES accounts( id ) ON DELETE CASCADE,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX alerts_triggered_at_index ON alerts ( triggered_at ) ;
+CREATE INDEX node_history_collected_at_index ON node_history ( collected_at ) ;
@@ -1,5 +1,39 @@
 -- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
+CREATE TABLE accounts (
+	id BLOB NOT NULL,
+	username TEXT NOT NULL,
+	password_hash BLOB NOT NULL,
+	role TEXT NOT NULL,
+	totp_secret TEXT,
+	totp_enabled INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( username )
+);
+CREATE TABLE alerts (
+	id BLOB NOT NULL,
+	node_id BLOB NOT NULL,
+	rule TEXT NOT NULL,
+	message TEXT NOT NULL,
+	triggered_at TIMESTAMP NOT NULL,
+	notified_at TIMESTAMP NOT NULL,
+	resolved_at TIMESTAMP,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_history (
+	node_id BLOB NOT NULL,
+	collected_at TIMESTAMP NOT NULL,
+	status TEXT NOT NULL,
+	disk_space_used INTEGER,
+	disk_space_available INTEGER,
+	bandwidth_used INTEGER,
+	estimated_payout INTEGER,
+	audit_score REAL,
+	suspension_score REAL,
+	online_score REAL,
+	PRIMARY KEY ( node_id, collected_at )
+);
 CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
@@ -7,3 +41,17 @@ CREATE TABLE nodes (
 	api_secret BLOB NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_tags (
+	node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	key TEXT NOT NULL,
+	value TEXT NOT NULL,
+	PRIMARY KEY ( node_id, key )
+);
+CREATE TABLE sessions (
+	id BLOB NOT NULL,
+	account_id BLOB NOT NULL REFERENCES accounts( id ) ON DELETE CASCADE,
+	expires_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX alerts_triggered_at_index ON alerts ( triggered_at ) ;
+CREATE INDEX node_history_collected_at_index ON node_history ( collected_at ) ;
@@ -0,0 +1,190 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinodedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/private/tagsql"
+	"storj.io/storj/multinode/history"
+	"storj.io/storj/multinode/nodes"
+)
+
+// ErrHistoryDB indicates about internal HistoryDB error.
+var ErrHistoryDB = errs.Class("HistoryDB")
+
+// ensures that historydb implements history.DB.
+var _ history.DB = (*historydb)(nil)
+
+// historydb exposes needed by MND HistoryDB functionality.
+//
+// architecture: Database
+type historydb struct {
+	db *DB
+}
+
+const historyColumns = `node_id, collected_at, status, disk_space_used, disk_space_available,
+	bandwidth_used, estimated_payout, audit_score, suspension_score, online_score`
+
+// Insert stores a sample, samples for the same node and time are ignored.
+func (h *historydb) Insert(ctx context.Context, sample history.Sample) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = h.db.ExecContext(ctx, h.db.Rebind(`
+		INSERT INTO node_history (`+historyColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (node_id, collected_at) DO NOTHING
+	`), sample.NodeID, sample.CollectedAt.UTC(), string(sample.Status),
+		sample.DiskSpaceUsed, sample.DiskSpaceAvailable, sample.BandwidthUsed, sample.EstimatedPayout,
+		sample.AuditScore, sample.SuspensionScore, sample.OnlineScore)
+	return ErrHistoryDB.Wrap(err)
+}
+
+// List returns samples collected in [from, to) ordered by collection time.
+// When step is positive only the first sample of every node in each step long bucket is returned.
+func (h *historydb) List(ctx context.Context, nodeID *storj.NodeID, from, to time.Time, step time.Duration) (_ []history.Sample, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var rows tagsql.Rows
+	if nodeID == nil {
+		rows, err = h.db.QueryContext(ctx, h.db.Rebind(`
+			SELECT `+historyColumns+` FROM node_history
+			WHERE collected_at >= ? AND collected_at < ?
+			ORDER BY collected_at, node_id
+		`), from.UTC(), to.UTC())
+	} else {
+		rows, err = h.db.QueryContext(ctx, h.db.Rebind(`
+			SELECT `+historyColumns+` FROM node_history
+			WHERE node_id = ? AND collected_at >= ? AND collected_at < ?
+			ORDER BY collected_at
+		`), *nodeID, from.UTC(), to.UTC())
+	}
+	if err != nil {
+		return nil, ErrHistoryDB.Wrap(err)
+	}
+
+	if step <= 0 {
+		return scanSamples(rows, nil)
+	}
+
+	// buckets contains the last bucket of every node a sample was kept for.
+	buckets := map[storj.NodeID]int64{}
+	return scanSamples(rows, func(sample history.Sample) bool {
+		bucket := int64(sample.CollectedAt.Sub(from) / step)
+		if last, ok := buckets[sample.NodeID]; ok && last == bucket {
+			return false
+		}
+		buckets[sample.NodeID] = bucket
+		return true
+	})
+}
+
+// Latest returns the most recent sample of every node.
+func (h *historydb) Latest(ctx context.Context) (_ []history.Sample, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := h.db.QueryContext(ctx, `
+		SELECT `+historyColumns+` FROM node_history latest
+		WHERE collected_at = (
+			SELECT MAX(collected_at) FROM node_history WHERE node_id = latest.node_id
+		)
+		ORDER BY node_id
+	`)
+	if err != nil {
+		return nil, ErrHistoryDB.Wrap(err)
+	}
+	return scanSamples(rows, nil)
+}
+
+// LatestMetrics returns the most recent sample with metrics of every node.
+func (h *historydb) LatestMetrics(ctx context.Context) (_ []history.Sample, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := h.db.QueryContext(ctx, `
+		SELECT `+historyColumns+` FROM node_history latest
+		WHERE collected_at = (
+			SELECT MAX(collected_at) FROM node_history
+			WHERE node_id = latest.node_id AND disk_space_used IS NOT NULL
+		)
+		ORDER BY node_id
+	`)
+	if err != nil {
+		return nil, ErrHistoryDB.Wrap(err)
+	}
+	return scanSamples(rows, nil)
+}
+
+// DeleteBefore deletes samples collected before the given time and returns their count.
+func (h *historydb) DeleteBefore(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := h.db.ExecContext(ctx, h.db.Rebind(`
+		DELETE FROM node_history WHERE collected_at < ?
+	`), before.UTC())
+	if err != nil {
+		return 0, ErrHistoryDB.Wrap(err)
+	}
+
+	deleted, err := result.RowsAffected()
+	return deleted, ErrHistoryDB.Wrap(err)
+}
+
+// scanSamples scans the samples from the rows and closes them.
+// When keep is not nil only the samples it returns true for are returned.
+func scanSamples(rows tagsql.Rows, keep func(history.Sample) bool) (_ []history.Sample, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	samples := []history.Sample{}
+	for rows.Next() {
+		var sample history.Sample
+		var status string
+		var diskSpaceUsed, diskSpaceAvailable, bandwidthUsed, estimatedPayout sql.NullInt64
+		var auditScore, suspensionScore, onlineScore sql.NullFloat64
+
+		err := rows.Scan(&sample.NodeID, &sample.CollectedAt, &status,
+			&diskSpaceUsed, &diskSpaceAvailable, &bandwidthUsed, &estimatedPayout,
+			&auditScore, &suspensionScore, &onlineScore)
+		if err != nil {
+			return nil, ErrHistoryDB.Wrap(err)
+		}
+
+		sample.CollectedAt = sample.CollectedAt.UTC()
+		sample.Status = nodes.Status(status)
+		sample.DiskSpaceUsed = nullInt64Ptr(diskSpaceUsed)
+		sample.DiskSpaceAvailable = nullInt64Ptr(diskSpaceAvailable)
+		sample.BandwidthUsed = nullInt64Ptr(bandwidthUsed)
+		sample.EstimatedPayout = nullInt64Ptr(estimatedPayout)
+		sample.AuditScore = nullFloat64Ptr(auditScore)
+		sample.SuspensionScore = nullFloat64Ptr(suspensionScore)
+		sample.OnlineScore = nullFloat64Ptr(onlineScore)
+
+		if keep != nil && !keep(sample) {
+			continue
+		}
+		samples = append(samples, sample)
+	}
+
+	return samples, ErrHistoryDB.Wrap(rows.Err())
+}
+
+// nullInt64Ptr returns nil for a NULL value.
+func nullInt64Ptr(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
+
+// nullFloat64Ptr returns nil for a NULL value.
+func nullFloat64Ptr(v sql.NullFloat64) *float64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Float64
+}
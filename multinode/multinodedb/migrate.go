@@ -26,6 +26,82 @@ func (db *DB) SQLite3Migration() *migrate.Migration {
 					); `,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add accounts and sessions",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE accounts (
+						id BLOB NOT NULL,
+						username TEXT NOT NULL,
+						password_hash BLOB NOT NULL,
+						role TEXT NOT NULL,
+						totp_secret TEXT,
+						totp_enabled INTEGER NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( username )
+					);`,
+					`CREATE TABLE sessions (
+						id BLOB NOT NULL,
+						account_id BLOB NOT NULL REFERENCES accounts( id ) ON DELETE CASCADE,
+						expires_at TIMESTAMP NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node history",
+				Version:     2,
+				Action: migrate.SQL{
+					`CREATE TABLE node_history (
+						node_id BLOB NOT NULL,
+						collected_at TIMESTAMP NOT NULL,
+						status TEXT NOT NULL,
+						disk_space_used INTEGER,
+						disk_space_available INTEGER,
+						bandwidth_used INTEGER,
+						estimated_payout INTEGER,
+						audit_score REAL,
+						suspension_score REAL,
+						online_score REAL,
+						PRIMARY KEY ( node_id, collected_at )
+					);`,
+					`CREATE INDEX node_history_collected_at_index ON node_history ( collected_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add alerts",
+				Version:     3,
+				Action: migrate.SQL{
+					`CREATE TABLE alerts (
+						id BLOB NOT NULL,
+						node_id BLOB NOT NULL,
+						rule TEXT NOT NULL,
+						message TEXT NOT NULL,
+						triggered_at TIMESTAMP NOT NULL,
+						notified_at TIMESTAMP NOT NULL,
+						resolved_at TIMESTAMP,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX alerts_triggered_at_index ON alerts ( triggered_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node tags",
+				Version:     4,
+				Action: migrate.SQL{
+					`CREATE TABLE node_tags (
+						node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+						key TEXT NOT NULL,
+						value TEXT NOT NULL,
+						PRIMARY KEY ( node_id, key )
+					);`,
+				},
+			},
 		},
 	}
 }
@@ -49,6 +125,82 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add accounts and sessions",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE accounts (
+						id bytea NOT NULL,
+						username text NOT NULL,
+						password_hash bytea NOT NULL,
+						role text NOT NULL,
+						totp_secret text,
+						totp_enabled boolean NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( username )
+					);`,
+					`CREATE TABLE sessions (
+						id bytea NOT NULL,
+						account_id bytea NOT NULL REFERENCES accounts( id ) ON DELETE CASCADE,
+						expires_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node history",
+				Version:     2,
+				Action: migrate.SQL{
+					`CREATE TABLE node_history (
+						node_id bytea NOT NULL,
+						collected_at timestamp with time zone NOT NULL,
+						status text NOT NULL,
+						disk_space_used bigint,
+						disk_space_available bigint,
+						bandwidth_used bigint,
+						estimated_payout bigint,
+						audit_score double precision,
+						suspension_score double precision,
+						online_score double precision,
+						PRIMARY KEY ( node_id, collected_at )
+					);`,
+					`CREATE INDEX node_history_collected_at_index ON node_history ( collected_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add alerts",
+				Version:     3,
+				Action: migrate.SQL{
+					`CREATE TABLE alerts (
+						id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						rule text NOT NULL,
+						message text NOT NULL,
+						triggered_at timestamp with time zone NOT NULL,
+						notified_at timestamp with time zone NOT NULL,
+						resolved_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX alerts_triggered_at_index ON alerts ( triggered_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node tags",
+				Version:     4,
+				Action: migrate.SQL{
+					`CREATE TABLE node_tags (
+						node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+						key text NOT NULL,
+						value text NOT NULL,
+						PRIMARY KEY ( node_id, key )
+					);`,
+				},
+			},
 		},
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/private/tagsql"
 	"storj.io/storj/multinode/multinodedb/dbx"
 	"storj.io/storj/multinode/nodes"
 )
@@ -27,6 +28,7 @@ var _ nodes.DB = (*nodesdb)(nil)
 // architecture: Database
 type nodesdb struct {
 	methods dbx.Methods
+	db      *DB
 }
 
 // List returns all connected nodes.
@@ -41,11 +43,17 @@ func (n *nodesdb) List(ctx context.Context) (allNodes []nodes.Node, err error) {
 		return []nodes.Node{}, nodes.ErrNoNode.New("no nodes")
 	}
 
+	tags, err := n.listTags(ctx)
+	if err != nil {
+		return []nodes.Node{}, err
+	}
+
 	for _, dbxNode := range dbxNodes {
 		node, err := fromDBXNode(ctx, dbxNode)
 		if err != nil {
 			return []nodes.Node{}, ErrNodesDB.Wrap(err)
 		}
+		node.Tags = tags[node.ID]
 
 		allNodes = append(allNodes, node)
 	}
@@ -53,6 +61,28 @@ func (n *nodesdb) List(ctx context.Context) (allNodes []nodes.Node, err error) {
 	return allNodes, ErrNodesDB.Wrap(err)
 }
 
+// ListFiltered returns connected nodes, which match the filter.
+func (n *nodesdb) ListFiltered(ctx context.Context, filter nodes.Filter) (_ []nodes.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	allNodes, err := n.List(ctx)
+	if err != nil || filter.IsEmpty() {
+		return allNodes, err
+	}
+
+	var filtered []nodes.Node
+	for _, node := range allNodes {
+		if filter.Match(node) {
+			filtered = append(filtered, node)
+		}
+	}
+	if len(filtered) == 0 {
+		return []nodes.Node{}, nodes.ErrNoNode.New("no nodes match the filter")
+	}
+
+	return filtered, nil
+}
+
 // ListPaged returns paginated nodes list.
 func (n *nodesdb) ListPaged(ctx context.Context, cursor nodes.Cursor) (page nodes.Page, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -61,6 +91,9 @@ func (n *nodesdb) ListPaged(ctx context.Context, cursor nodes.Cursor) (page node
 		Limit:       cursor.Limit,
 		Offset:      (cursor.Page - 1) * cursor.Limit,
 	}
+	if !cursor.Filter.IsEmpty() {
+		return n.listPagedFiltered(ctx, cursor.Filter, page)
+	}
 	totalCount, err := n.methods.Count_Node(ctx)
 	if err != nil {
 		return nodes.Page{}, ErrNodesDB.Wrap(err)
@@ -74,16 +107,46 @@ func (n *nodesdb) ListPaged(ctx context.Context, cursor nodes.Cursor) (page node
 	if err != nil {
 		return nodes.Page{}, ErrNodesDB.Wrap(err)
 	}
+	tags, err := n.listTags(ctx)
+	if err != nil {
+		return nodes.Page{}, err
+	}
 	for _, dbxNode := range dbxNodes {
 		node, err := fromDBXNode(ctx, dbxNode)
 		if err != nil {
 			return nodes.Page{}, ErrNodesDB.Wrap(err)
 		}
+		node.Tags = tags[node.ID]
 		page.Nodes = append(page.Nodes, node)
 	}
 	return page, nil
 }
 
+// listPagedFiltered fills the page with the nodes, which match the filter.
+// The tags are stored in a separate table, so the nodes are filtered and paged in memory.
+func (n *nodesdb) listPagedFiltered(ctx context.Context, filter nodes.Filter, page nodes.Page) (_ nodes.Page, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	filtered, err := n.ListFiltered(ctx, filter)
+	if err != nil && !nodes.ErrNoNode.Has(err) {
+		return nodes.Page{}, err
+	}
+
+	page.TotalCount = int64(len(filtered))
+	page.PageCount = page.TotalCount / page.Limit
+	if page.TotalCount%page.Limit != 0 {
+		page.PageCount++
+	}
+	if page.Offset < page.TotalCount {
+		end := page.Offset + page.Limit
+		if end > page.TotalCount {
+			end = page.TotalCount
+		}
+		page.Nodes = filtered[page.Offset:end]
+	}
+	return page, nil
+}
+
 // Get return node from NodesDB by its id.
 func (n *nodesdb) Get(ctx context.Context, id storj.NodeID) (_ nodes.Node, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -97,8 +160,23 @@ func (n *nodesdb) Get(ctx context.Context, id storj.NodeID) (_ nodes.Node, err e
 	}
 
 	node, err := fromDBXNode(ctx, dbxNode)
+	if err != nil {
+		return nodes.Node{}, ErrNodesDB.Wrap(err)
+	}
+
+	rows, err := n.db.QueryContext(ctx, n.db.Rebind(`
+		SELECT node_id, key, value FROM node_tags WHERE node_id = ? ORDER BY key
+	`), id.Bytes())
+	if err != nil {
+		return nodes.Node{}, ErrNodesDB.Wrap(err)
+	}
+	tags, err := scanTags(rows)
+	if err != nil {
+		return nodes.Node{}, err
+	}
+	node.Tags = tags[node.ID]
 
-	return node, ErrNodesDB.Wrap(err)
+	return node, nil
 }
 
 // Add creates new node in NodesDB.
@@ -120,6 +198,13 @@ func (n *nodesdb) Add(ctx context.Context, id storj.NodeID, apiSecret []byte, pu
 func (n *nodesdb) Remove(ctx context.Context, id storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	// sqlite3 doesn't enforce the foreign keys by default, so the tags
+	// are deleted explicitly.
+	_, err = n.db.ExecContext(ctx, n.db.Rebind(`DELETE FROM node_tags WHERE node_id = ?`), id.Bytes())
+	if err != nil {
+		return ErrNodesDB.Wrap(err)
+	}
+
 	_, err = n.methods.Delete_Node_By_Id(ctx, dbx.Node_Id(id.Bytes()))
 
 	return ErrNodesDB.Wrap(err)
@@ -136,6 +221,70 @@ func (n *nodesdb) UpdateName(ctx context.Context, id storj.NodeID, name string)
 	return ErrNodesDB.Wrap(err)
 }
 
+// SetTag sets the value of the node's tag, the tag is added when the node doesn't have it.
+func (n *nodesdb) SetTag(ctx context.Context, id storj.NodeID, tag nodes.Tag) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = n.methods.Get_Node_By_Id(ctx, dbx.Node_Id(id.Bytes()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nodes.ErrNoNode.Wrap(err)
+		}
+		return ErrNodesDB.Wrap(err)
+	}
+
+	_, err = n.db.ExecContext(ctx, n.db.Rebind(`
+		INSERT INTO node_tags (node_id, key, value) VALUES (?, ?, ?)
+		ON CONFLICT (node_id, key) DO UPDATE SET value = EXCLUDED.value
+	`), id.Bytes(), tag.Key, tag.Value)
+	return ErrNodesDB.Wrap(err)
+}
+
+// DeleteTag deletes the node's tag with the key.
+func (n *nodesdb) DeleteTag(ctx context.Context, id storj.NodeID, key string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := n.db.ExecContext(ctx, n.db.Rebind(`
+		DELETE FROM node_tags WHERE node_id = ? AND key = ?
+	`), id.Bytes(), key)
+	if err != nil {
+		return ErrNodesDB.Wrap(err)
+	}
+	return requireAffected(result, &nodes.ErrNoTag)
+}
+
+// listTags returns the tags of all the nodes.
+func (n *nodesdb) listTags(ctx context.Context) (_ map[storj.NodeID]nodes.Tags, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := n.db.QueryContext(ctx, `SELECT node_id, key, value FROM node_tags ORDER BY node_id, key`)
+	if err != nil {
+		return nil, ErrNodesDB.Wrap(err)
+	}
+	return scanTags(rows)
+}
+
+// scanTags reads the tags from the rows and groups them by the node.
+func scanTags(rows tagsql.Rows) (_ map[storj.NodeID]nodes.Tags, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	tags := make(map[storj.NodeID]nodes.Tags)
+	for rows.Next() {
+		var nodeID []byte
+		var tag nodes.Tag
+		if err := rows.Scan(&nodeID, &tag.Key, &tag.Value); err != nil {
+			return nil, ErrNodesDB.Wrap(err)
+		}
+
+		id, err := storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, ErrNodesDB.Wrap(err)
+		}
+		tags[id] = append(tags[id], tag)
+	}
+	return tags, ErrNodesDB.Wrap(rows.Err())
+}
+
 // fromDBXNode converts dbx.Node to console.Node.
 func fromDBXNode(ctx context.Context, node *dbx.Node) (_ nodes.Node, err error) {
 	defer mon.Task()(&ctx)(&err)
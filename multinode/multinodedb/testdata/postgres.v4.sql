@@ -0,0 +1,72 @@
+-- AUTOGENERATED BY storj.io/dbx
+-- DO NOT EDIT
+CREATE TABLE accounts (
+	id bytea NOT NULL,
+	username text NOT NULL,
+	password_hash bytea NOT NULL,
+	role text NOT NULL,
+	totp_secret text,
+	totp_enabled boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( username )
+);
+CREATE TABLE alerts (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	rule text NOT NULL,
+	message text NOT NULL,
+	triggered_at timestamp with time zone NOT NULL,
+	notified_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_history (
+	node_id bytea NOT NULL,
+	collected_at timestamp with time zone NOT NULL,
+	status text NOT NULL,
+	disk_space_used bigint,
+	disk_space_available bigint,
+	bandwidth_used bigint,
+	estimated_payout bigint,
+	audit_score double precision,
+	suspension_score double precision,
+	online_score double precision,
+	PRIMARY KEY ( node_id, collected_at )
+);
+CREATE TABLE nodes (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	public_address text NOT NULL,
+	api_secret bytea NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_tags (
+	node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	key text NOT NULL,
+	value text NOT NULL,
+	PRIMARY KEY ( node_id, key )
+);
+CREATE TABLE sessions (
+	id bytea NOT NULL,
+	account_id bytea NOT NULL REFERENCES accounts( id ) ON DELETE CASCADE,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX alerts_triggered_at_index ON alerts ( triggered_at ) ;
+CREATE INDEX node_history_collected_at_index ON node_history ( collected_at ) ;
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'node_name', '127.0.0.1:13000', E'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\001');
+INSERT INTO accounts (id, username, password_hash, role, totp_secret, totp_enabled, created_at) VALUES (E'\\363\\311\\033w\\222\\303Ci\\265\\317u\\333\\177\\227\\300\\304', 'admin', E'\\044\\062\\141\\044\\061\\060', 'admin', NULL, false, '2021-08-01 10:00:00+00');
+INSERT INTO sessions (id, account_id, expires_at) VALUES (E'\\001\\002\\003\\004', E'\\363\\311\\033w\\222\\303Ci\\265\\317u\\333\\177\\227\\300\\304', '2021-08-02 10:00:00+00');
+INSERT INTO node_history (node_id, collected_at, status, disk_space_used, disk_space_available, bandwidth_used, estimated_payout, audit_score, suspension_score, online_score) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', '2021-08-01 10:00:00+00', 'online', 1000000, 2000000, 300000, 1250, 1, 1, 0.98);
+INSERT INTO node_history (node_id, collected_at, status, disk_space_used, disk_space_available, bandwidth_used, estimated_payout, audit_score, suspension_score, online_score) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', '2021-08-01 10:15:00+00', 'not reachable', NULL, NULL, NULL, NULL, NULL, NULL, NULL);
+INSERT INTO alerts (id, node_id, rule, message, triggered_at, notified_at, resolved_at) VALUES (E'\\363\\311\\033w\\222\\303Ci\\265\\317u\\333\\177\\227\\300\\305', E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'offline', 'node is offline', '2021-08-01 10:00:00+00', '2021-08-01 10:00:00+00', '2021-08-01 12:00:00+00');
+INSERT INTO alerts (id, node_id, rule, message, triggered_at, notified_at, resolved_at) VALUES (E'\\363\\311\\033w\\222\\303Ci\\265\\317u\\333\\177\\227\\300\\306', E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'disk_free', 'node is running out of disk space', '2021-08-01 11:00:00+00', '2021-08-01 11:00:00+00', NULL);
+
+-- NEW DATA --
+
+INSERT INTO node_tags (node_id, key, value) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'site', 'berlin');
+INSERT INTO node_tags (node_id, key, value) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'disk', 'hdd');
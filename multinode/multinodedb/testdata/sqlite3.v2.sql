@@ -0,0 +1,51 @@
+-- AUTOGENERATED BY storj.io/dbx
+-- DO NOT EDIT
+CREATE TABLE accounts (
+	id BLOB NOT NULL,
+	username TEXT NOT NULL,
+	password_hash BLOB NOT NULL,
+	role TEXT NOT NULL,
+	totp_secret TEXT,
+	totp_enabled INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( username )
+);
+CREATE TABLE node_history (
+	node_id BLOB NOT NULL,
+	collected_at TIMESTAMP NOT NULL,
+	status TEXT NOT NULL,
+	disk_space_used INTEGER,
+	disk_space_available INTEGER,
+	bandwidth_used INTEGER,
+	estimated_payout INTEGER,
+	audit_score REAL,
+	suspension_score REAL,
+	online_score REAL,
+	PRIMARY KEY ( node_id, collected_at )
+);
+CREATE TABLE nodes (
+	id BLOB NOT NULL,
+	name TEXT NOT NULL,
+	public_address TEXT NOT NULL,
+	api_secret BLOB NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE sessions (
+	id BLOB NOT NULL,
+	account_id BLOB NOT NULL REFERENCES accounts( id ) ON DELETE CASCADE,
+	expires_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX node_history_collected_at_index ON node_history ( collected_at ) ;
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'node_name', '127.0.0.1:13000', X'62180593328b8ff3c9f97565fdfd305d');
+INSERT INTO accounts (id, username, password_hash, role, totp_secret, totp_enabled, created_at) VALUES (X'f3c91b7792c34369b5cf75db7f97c0c4', 'admin', X'243261243130', 'admin', NULL, 0, '2021-08-01 10:00:00+00:00');
+INSERT INTO sessions (id, account_id, expires_at) VALUES (X'01020304', X'f3c91b7792c34369b5cf75db7f97c0c4', '2021-08-02 10:00:00+00:00');
+
+-- NEW DATA --
+
+INSERT INTO node_history (node_id, collected_at, status, disk_space_used, disk_space_available, bandwidth_used, estimated_payout, audit_score, suspension_score, online_score) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', '2021-08-01 10:00:00+00:00', 'online', 1000000, 2000000, 300000, 1250, 1, 1, 0.98);
+INSERT INTO node_history (node_id, collected_at, status, disk_space_used, disk_space_available, bandwidth_used, estimated_payout, audit_score, suspension_score, online_score) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', '2021-08-01 10:15:00+00:00', 'not reachable', NULL, NULL, NULL, NULL, NULL, NULL, NULL);
@@ -20,6 +20,8 @@ type DB interface {
 	Get(ctx context.Context, id storj.NodeID) (Node, error)
 	// List returns all connected nodes.
 	List(ctx context.Context) ([]Node, error)
+	// ListFiltered returns connected nodes, which match the filter.
+	ListFiltered(ctx context.Context, filter Filter) ([]Node, error)
 	// ListPaged returns paginated nodes list.
 	// TODO: rename to ListPaginated, because pagination is to divide up copy into pages,
 	// because paging doesn't necessarily mean pagination in computing.
@@ -31,6 +33,10 @@ type DB interface {
 	Remove(ctx context.Context, id storj.NodeID) error
 	// UpdateName will update name of the specified node in database.
 	UpdateName(ctx context.Context, id storj.NodeID, name string) error
+	// SetTag sets the value of the node's tag, the tag is added when the node doesn't have it.
+	SetTag(ctx context.Context, id storj.NodeID, tag Tag) error
+	// DeleteTag deletes the node's tag with the key.
+	DeleteTag(ctx context.Context, id storj.NodeID, key string) error
 }
 
 var (
@@ -45,6 +51,7 @@ type Node struct {
 	APISecret     []byte `json:"apiSecret"`
 	PublicAddress string `json:"publicAddress"`
 	Name          string `json:"name"`
+	Tags          Tags   `json:"tags"`
 }
 
 // Status represents node online status.
@@ -67,6 +74,7 @@ const (
 type NodeInfo struct {
 	ID            storj.NodeID `json:"id"`
 	Name          string       `json:"name"`
+	Tags          Tags         `json:"tags"`
 	Version       string       `json:"version"`
 	LastContact   time.Time    `json:"lastContact"`
 	DiskSpaceUsed int64        `json:"diskSpaceUsed"`
@@ -74,12 +82,21 @@ type NodeInfo struct {
 	BandwidthUsed int64        `json:"bandwidthUsed"`
 	TotalEarned   int64        `json:"totalEarned"`
 	Status        Status       `json:"status"`
+	// CollectedAt is set when the node couldn't be queried and the disk space
+	// and bandwidth are taken from the node history collected at that time.
+	CollectedAt *time.Time `json:"collectedAt,omitempty"`
+}
+
+// Available returns whether the node info was queried from the node itself.
+func (info NodeInfo) Available() bool {
+	return info.Status == StatusOnline || info.Status == StatusOffline
 }
 
 // NodeInfoSatellite contains satellite specific node internal state.
 type NodeInfoSatellite struct {
 	ID              storj.NodeID `json:"id"`
 	Name            string       `json:"name"`
+	Tags            Tags         `json:"tags"`
 	Version         string       `json:"version"`
 	LastContact     time.Time    `json:"lastContact"`
 	OnlineScore     float64      `json:"onlineScore"`
@@ -93,8 +110,9 @@ type NodeInfoSatellite struct {
 
 // Cursor holds cursor entity which is used to create listed page.
 type Cursor struct {
-	Limit int64
-	Page  int64
+	Limit  int64
+	Page   int64
+	Filter Filter
 }
 
 // Page holds nodes page entity which is used to show listed page of nodes.
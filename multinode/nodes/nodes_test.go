@@ -6,11 +6,13 @@ package nodes_test
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/multinode"
@@ -123,3 +125,97 @@ func TestNodesDB(t *testing.T) {
 		})
 	})
 }
+
+func TestNodesDBTags(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		nodesRepository := db.Nodes()
+
+		berlinHDD, berlinSSD, paris := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+		for _, id := range []storj.NodeID{berlinHDD, berlinSSD, paris} {
+			require.NoError(t, nodesRepository.Add(ctx, id, []byte("secret"), "127.0.0.1:13000"))
+		}
+
+		site := func(value string) nodes.Tag { return nodes.Tag{Key: "site", Value: value} }
+		disk := func(value string) nodes.Tag { return nodes.Tag{Key: "disk", Value: value} }
+
+		require.NoError(t, nodesRepository.SetTag(ctx, berlinHDD, site("berlin")))
+		require.NoError(t, nodesRepository.SetTag(ctx, berlinHDD, disk("ssd")))
+		require.NoError(t, nodesRepository.SetTag(ctx, berlinHDD, disk("hdd")))
+		require.NoError(t, nodesRepository.SetTag(ctx, berlinSSD, site("berlin")))
+		require.NoError(t, nodesRepository.SetTag(ctx, berlinSSD, disk("ssd")))
+		require.NoError(t, nodesRepository.SetTag(ctx, paris, site("paris")))
+
+		err := nodesRepository.SetTag(ctx, testrand.NodeID(), site("berlin"))
+		require.True(t, nodes.ErrNoNode.Has(err))
+
+		node, err := nodesRepository.Get(ctx, berlinHDD)
+		require.NoError(t, err)
+		require.Equal(t, nodes.Tags{disk("hdd"), site("berlin")}, node.Tags)
+
+		listIDs := func(filter nodes.Filter) []storj.NodeID {
+			list, err := nodesRepository.ListFiltered(ctx, filter)
+			require.NoError(t, err)
+
+			var ids []storj.NodeID
+			for _, node := range list {
+				ids = append(ids, node.ID)
+			}
+			return ids
+		}
+
+		require.ElementsMatch(t, []storj.NodeID{berlinHDD, berlinSSD, paris}, listIDs(nodes.Filter{}))
+		require.ElementsMatch(t, []storj.NodeID{berlinHDD, berlinSSD}, listIDs(nodes.Filter{Tags: []nodes.Tag{site("berlin")}}))
+		require.ElementsMatch(t, []storj.NodeID{berlinSSD}, listIDs(nodes.Filter{Tags: []nodes.Tag{site("berlin"), disk("ssd")}}))
+
+		_, err = nodesRepository.ListFiltered(ctx, nodes.Filter{Tags: []nodes.Tag{site("paris"), disk("ssd")}})
+		require.True(t, nodes.ErrNoNode.Has(err))
+
+		page, err := nodesRepository.ListPaged(ctx, nodes.Cursor{
+			Limit:  1,
+			Page:   2,
+			Filter: nodes.Filter{Tags: []nodes.Tag{site("berlin")}},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, page.TotalCount)
+		require.EqualValues(t, 2, page.PageCount)
+		require.Len(t, page.Nodes, 1)
+
+		all, err := nodesRepository.List(ctx)
+		require.NoError(t, err)
+		groups := nodes.GroupBy(all, "site")
+		require.Len(t, groups, 2)
+		require.Equal(t, site("berlin"), groups[0].Tag)
+		require.ElementsMatch(t, []storj.NodeID{berlinHDD, berlinSSD}, groups[0].Nodes)
+		require.Equal(t, site("paris"), groups[1].Tag)
+		require.Equal(t, []storj.NodeID{paris}, groups[1].Nodes)
+
+		require.NoError(t, nodesRepository.DeleteTag(ctx, berlinHDD, "disk"))
+		err = nodesRepository.DeleteTag(ctx, berlinHDD, "disk")
+		require.True(t, nodes.ErrNoTag.Has(err))
+
+		node, err = nodesRepository.Get(ctx, berlinHDD)
+		require.NoError(t, err)
+		require.Equal(t, nodes.Tags{site("berlin")}, node.Tags)
+
+		require.NoError(t, nodesRepository.Remove(ctx, berlinHDD))
+		require.NoError(t, nodesRepository.Add(ctx, berlinHDD, []byte("secret"), "127.0.0.1:13000"))
+		node, err = nodesRepository.Get(ctx, berlinHDD)
+		require.NoError(t, err)
+		require.Empty(t, node.Tags)
+	})
+}
+
+func TestParseTag(t *testing.T) {
+	tag, err := nodes.ParseTag(" site : berlin ")
+	require.NoError(t, err)
+	require.Equal(t, nodes.Tag{Key: "site", Value: "berlin"}, tag)
+
+	tag, err = nodes.ParseTag("owner:alice:bob")
+	require.NoError(t, err)
+	require.Equal(t, nodes.Tag{Key: "owner", Value: "alice:bob"}, tag)
+
+	for _, invalid := range []string{"", "site", "site:", ":berlin", "site:" + strings.Repeat("x", nodes.MaxTagLength+1)} {
+		_, err := nodes.ParseTag(invalid)
+		require.True(t, nodes.ErrInvalidTag.Has(err), invalid)
+	}
+}
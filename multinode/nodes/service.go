@@ -80,11 +80,11 @@ func (service *Service) Add(ctx context.Context, id storj.NodeID, apiSecret []by
 	return Error.Wrap(service.nodes.Add(ctx, id, apiSecret, publicAddress))
 }
 
-// List returns list of all nodes.
-func (service *Service) List(ctx context.Context) (_ []Node, err error) {
+// List returns list of all nodes, which match the filter.
+func (service *Service) List(ctx context.Context, filter Filter) (_ []Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	nodes, err := service.nodes.List(ctx)
+	nodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -116,11 +116,43 @@ func (service *Service) Remove(ctx context.Context, id storj.NodeID) (err error)
 	return Error.Wrap(service.nodes.Remove(ctx, id))
 }
 
-// ListInfos queries node basic info from all nodes via rpc.
-func (service *Service) ListInfos(ctx context.Context) (_ []NodeInfo, err error) {
+// SetTag validates the tag and sets its value for the node.
+func (service *Service) SetTag(ctx context.Context, id storj.NodeID, tag Tag) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = tag.Validate(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(service.nodes.SetTag(ctx, id, tag))
+}
+
+// DeleteTag deletes the node's tag with the key.
+func (service *Service) DeleteTag(ctx context.Context, id storj.NodeID, key string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(service.nodes.DeleteTag(ctx, id, key))
+}
+
+// Groups groups the nodes by the value of the tag with the key.
+func (service *Service) Groups(ctx context.Context, key string) (_ []Group, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	nodes, err := service.nodes.List(ctx)
+	if err != nil {
+		if ErrNoNode.Has(err) {
+			return []Group{}, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return GroupBy(nodes, key), nil
+}
+
+// ListInfos queries node basic info from all nodes via rpc.
+func (service *Service) ListInfos(ctx context.Context, filter Filter) (_ []NodeInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		if ErrNoNode.Has(err) {
 			return []NodeInfo{}, nil
@@ -134,6 +166,7 @@ func (service *Service) ListInfos(ctx context.Context) (_ []NodeInfo, err error)
 			nodeInfo := NodeInfo{
 				ID:   node.ID,
 				Name: node.Name,
+				Tags: node.Tags,
 			}
 			conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
 				ID:      node.ID,
@@ -203,7 +236,7 @@ func (service *Service) ListInfos(ctx context.Context) (_ []NodeInfo, err error)
 			nodeInfo.DiskSpaceLeft = diskSpace.GetAvailable()
 			nodeInfo.BandwidthUsed = bandwidthSummary.GetUsed()
 			nodeInfo.TotalEarned = earned.Total
-			nodeInfo.Status = nodeStatus(lastContact.LastContact)
+			nodeInfo.Status = StatusFromLastContact(lastContact.LastContact)
 
 			return nodeInfo
 		}()
@@ -215,10 +248,10 @@ func (service *Service) ListInfos(ctx context.Context) (_ []NodeInfo, err error)
 }
 
 // ListInfosSatellite queries node satellite specific info from all nodes via rpc.
-func (service *Service) ListInfosSatellite(ctx context.Context, satelliteID storj.NodeID) (_ []NodeInfoSatellite, err error) {
+func (service *Service) ListInfosSatellite(ctx context.Context, satelliteID storj.NodeID, filter Filter) (_ []NodeInfoSatellite, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	nodes, err := service.nodes.List(ctx)
+	nodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		if ErrNoNode.Has(err) {
 			return []NodeInfoSatellite{}, nil
@@ -232,6 +265,7 @@ func (service *Service) ListInfosSatellite(ctx context.Context, satelliteID stor
 			nodeInfoSatellite := NodeInfoSatellite{
 				ID:   node.ID,
 				Name: node.Name,
+				Tags: node.Tags,
 			}
 			conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
 				ID:      node.ID,
@@ -293,7 +327,7 @@ func (service *Service) ListInfosSatellite(ctx context.Context, satelliteID stor
 			nodeInfoSatellite.AuditScore = rep.Audit.Score
 			nodeInfoSatellite.SuspensionScore = rep.Audit.SuspensionScore
 			nodeInfoSatellite.TotalEarned = earned.Total
-			nodeInfoSatellite.Status = nodeStatus(lastContact.LastContact)
+			nodeInfoSatellite.Status = StatusFromLastContact(lastContact.LastContact)
 
 			return nodeInfoSatellite
 		}()
@@ -305,10 +339,10 @@ func (service *Service) ListInfosSatellite(ctx context.Context, satelliteID stor
 }
 
 // TrustedSatellites returns list of unique trusted satellites node urls.
-func (service *Service) TrustedSatellites(ctx context.Context) (_ storj.NodeURLs, err error) {
+func (service *Service) TrustedSatellites(ctx context.Context, filter Filter) (_ storj.NodeURLs, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	listNodes, err := service.nodes.List(ctx)
+	listNodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -368,8 +402,8 @@ func (service *Service) trustedSatellites(ctx context.Context, node Node) (_ sto
 	return nodeURLs, nil
 }
 
-// nodeStatus chooses node status offline or online depends on LastContact.
-func nodeStatus(lastContact time.Time) Status {
+// StatusFromLastContact chooses node status offline or online depends on LastContact.
+func StatusFromLastContact(lastContact time.Time) Status {
 	now := time.Now().UTC()
 
 	if now.Sub(lastContact) < time.Hour*3 {
@@ -0,0 +1,139 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodes
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// MaxTagLength is the maximum length of the tag key and value.
+const MaxTagLength = 64
+
+var (
+	// ErrInvalidTag indicates that the tag key or value is malformed.
+	ErrInvalidTag = errs.Class("invalid tag")
+	// ErrNoTag indicates that the node doesn't have the tag.
+	ErrNoTag = errs.Class("no such tag")
+)
+
+// Tag is a key value label of a node, e.g. site:berlin or disk:hdd.
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ParseTag parses the tag from the key:value form.
+func ParseTag(s string) (Tag, error) {
+	key, value := s, ""
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		key, value = s[:i], s[i+1:]
+	}
+
+	tag := Tag{
+		Key:   strings.TrimSpace(key),
+		Value: strings.TrimSpace(value),
+	}
+
+	return tag, tag.Validate()
+}
+
+// Validate checks that the tag key and value are not empty and not too long.
+// The key can't contain a colon, because it separates the key from the value.
+func (tag Tag) Validate() error {
+	switch {
+	case tag.Key == "":
+		return ErrInvalidTag.New("key is empty")
+	case tag.Value == "":
+		return ErrInvalidTag.New("value of %q is empty", tag.Key)
+	case len(tag.Key) > MaxTagLength || len(tag.Value) > MaxTagLength:
+		return ErrInvalidTag.New("key and value can't be longer than %d characters", MaxTagLength)
+	case strings.ContainsRune(tag.Key, ':'):
+		return ErrInvalidTag.New("key %q contains a colon", tag.Key)
+	}
+	return nil
+}
+
+// String returns the tag in the key:value form.
+func (tag Tag) String() string {
+	return tag.Key + ":" + tag.Value
+}
+
+// Tags is a list of node tags sorted by the key.
+type Tags []Tag
+
+// Get returns the value of the tag with the key.
+func (tags Tags) Get(key string) (string, bool) {
+	for _, tag := range tags {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// Filter selects the nodes by their tags, a node has to have all the tags of the filter.
+// The empty filter selects all the nodes.
+type Filter struct {
+	Tags []Tag
+}
+
+// IsEmpty returns true when the filter selects all the nodes.
+func (filter Filter) IsEmpty() bool {
+	return len(filter.Tags) == 0
+}
+
+// Match returns true when the node has all the tags of the filter.
+func (filter Filter) Match(node Node) bool {
+	for _, tag := range filter.Tags {
+		if value, ok := node.Tags.Get(tag.Key); !ok || value != tag.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Group contains the nodes which have the same value of the tag.
+type Group struct {
+	Tag   Tag            `json:"tag"`
+	Nodes []storj.NodeID `json:"nodes"`
+}
+
+// Filter returns the filter, which selects the nodes of the group.
+func (group Group) Filter() Filter {
+	return Filter{Tags: []Tag{group.Tag}}
+}
+
+// GroupBy groups the nodes by the value of the tag with the key.
+// Nodes without the tag aren't part of any group. Groups are sorted by the value.
+func GroupBy(nodes []Node, key string) []Group {
+	byValue := make(map[string]*Group)
+	for _, node := range nodes {
+		value, ok := node.Tags.Get(key)
+		if !ok {
+			continue
+		}
+
+		group, ok := byValue[value]
+		if !ok {
+			group = &Group{Tag: Tag{Key: key, Value: value}}
+			byValue[value] = group
+		}
+		group.Nodes = append(group.Nodes, node.ID)
+	}
+
+	groups := make([]Group, 0, len(byValue))
+	for _, group := range byValue {
+		groups = append(groups, *group)
+	}
+	sort.Slice(groups, func(i, k int) bool {
+		return groups[i].Tag.Value < groups[k].Tag.Value
+	})
+
+	return groups
+}
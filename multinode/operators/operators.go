@@ -5,6 +5,7 @@ package operators
 
 import (
 	"storj.io/common/storj"
+	"storj.io/storj/multinode/nodes"
 )
 
 // Operator contains contains SNO payouts contact details and amount of undistributed payouts.
@@ -18,8 +19,9 @@ type Operator struct {
 
 // Cursor holds operator cursor entity which is used to create listed page.
 type Cursor struct {
-	Limit int64
-	Page  int64
+	Limit  int64
+	Page   int64
+	Filter nodes.Filter
 }
 
 // Page holds operator page entity which is used to show listed page of operators.
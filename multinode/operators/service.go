@@ -56,8 +56,9 @@ func (service *Service) ListPaginated(ctx context.Context, cursor Cursor) (_ Pag
 		return Page{}, Error.Wrap(errs.New("page can not be 0"))
 	}
 	page, err := service.nodes.ListPaged(ctx, nodes.Cursor{
-		Limit: cursor.Limit,
-		Page:  cursor.Page,
+		Limit:  cursor.Limit,
+		Page:   cursor.Page,
+		Filter: cursor.Filter,
 	})
 	if err != nil {
 		return Page{}, Error.Wrap(err)
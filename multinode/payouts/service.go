@@ -42,10 +42,10 @@ func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB) *Service {
 }
 
 // Earned retrieves all nodes earned amount for all time.
-func (service *Service) Earned(ctx context.Context) (earned int64, err error) {
+func (service *Service) Earned(ctx context.Context, filter nodes.Filter) (earned int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	storageNodes, err := service.nodes.List(ctx)
+	storageNodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return 0, Error.Wrap(err)
 	}
@@ -67,10 +67,10 @@ func (service *Service) Earned(ctx context.Context) (earned int64, err error) {
 }
 
 // EarnedSatellite retrieves all nodes earned amount for all time per satellite.
-func (service *Service) EarnedSatellite(ctx context.Context) (earned []SatelliteSummary, err error) {
+func (service *Service) EarnedSatellite(ctx context.Context, filter nodes.Filter) (earned []SatelliteSummary, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	storageNodes, err := service.nodes.List(ctx)
+	storageNodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -120,16 +120,23 @@ func (service *Service) EarnedSatellite(ctx context.Context) (earned []Satellite
 }
 
 // Summary returns all satellites all time stats.
-func (service *Service) Summary(ctx context.Context) (_ Summary, err error) {
+func (service *Service) Summary(ctx context.Context, filter nodes.Filter) (_ Summary, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var summary Summary
-
-	listNodes, err := service.nodes.List(ctx)
+	listNodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return Summary{}, Error.Wrap(err)
 	}
 
+	return service.NodesSummary(ctx, listNodes)
+}
+
+// NodesSummary returns all satellites all time stats of the nodes, unreachable nodes are skipped.
+func (service *Service) NodesSummary(ctx context.Context, listNodes []nodes.Node) (_ Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var summary Summary
+
 	for _, node := range listNodes {
 		info, err := service.summary(ctx, node)
 		if err != nil {
@@ -147,12 +154,12 @@ func (service *Service) Summary(ctx context.Context) (_ Summary, err error) {
 }
 
 // SummaryPeriod returns all satellites stats for specific period.
-func (service *Service) SummaryPeriod(ctx context.Context, period string) (_ Summary, err error) {
+func (service *Service) SummaryPeriod(ctx context.Context, period string, filter nodes.Filter) (_ Summary, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	var summary Summary
 
-	listNodes, err := service.nodes.List(ctx)
+	listNodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return Summary{}, Error.Wrap(err)
 	}
@@ -174,11 +181,11 @@ func (service *Service) SummaryPeriod(ctx context.Context, period string) (_ Sum
 }
 
 // SummarySatellite returns specific satellite all time stats.
-func (service *Service) SummarySatellite(ctx context.Context, satelliteID storj.NodeID) (_ Summary, err error) {
+func (service *Service) SummarySatellite(ctx context.Context, satelliteID storj.NodeID, filter nodes.Filter) (_ Summary, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var summary Summary
 
-	listNodes, err := service.nodes.List(ctx)
+	listNodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return Summary{}, Error.Wrap(err)
 	}
@@ -200,11 +207,11 @@ func (service *Service) SummarySatellite(ctx context.Context, satelliteID storj.
 }
 
 // SummarySatellitePeriod returns specific satellite stats for specific period.
-func (service *Service) SummarySatellitePeriod(ctx context.Context, satelliteID storj.NodeID, period string) (_ Summary, err error) {
+func (service *Service) SummarySatellitePeriod(ctx context.Context, satelliteID storj.NodeID, period string, filter nodes.Filter) (_ Summary, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var summary Summary
 
-	listNodes, err := service.nodes.List(ctx)
+	listNodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return Summary{}, Error.Wrap(err)
 	}
@@ -351,12 +358,12 @@ func (service *Service) NodeExpectations(ctx context.Context, nodeID storj.NodeI
 }
 
 // Expectations returns all nodes estimated and undistributed earnings.
-func (service *Service) Expectations(ctx context.Context) (_ Expectations, err error) {
+func (service *Service) Expectations(ctx context.Context, filter nodes.Filter) (_ Expectations, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	var expectations Expectations
 
-	listNodes, err := service.nodes.List(ctx)
+	listNodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return Expectations{}, Error.Wrap(err)
 	}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
@@ -16,9 +17,14 @@ import (
 	"storj.io/common/peertls/tlsopts"
 	"storj.io/common/rpc"
 	"storj.io/private/debug"
+	"storj.io/storj/multinode/accounts"
+	"storj.io/storj/multinode/alerts"
 	"storj.io/storj/multinode/bandwidth"
 	"storj.io/storj/multinode/console/consoleassets"
 	"storj.io/storj/multinode/console/server"
+	"storj.io/storj/multinode/control"
+	"storj.io/storj/multinode/groups"
+	"storj.io/storj/multinode/history"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/multinode/operators"
 	"storj.io/storj/multinode/payouts"
@@ -37,6 +43,12 @@ var (
 type DB interface {
 	// Nodes returns nodes database.
 	Nodes() nodes.DB
+	// Accounts returns accounts database.
+	Accounts() accounts.DB
+	// History returns node history database.
+	History() history.DB
+	// Alerts returns alerts database.
+	Alerts() alerts.DB
 
 	// MigrateToLatest initializes the database.
 	MigrateToLatest(ctx context.Context) error
@@ -49,7 +61,10 @@ type Config struct {
 	Identity identity.Config
 	Debug    debug.Config
 
-	Console server.Config
+	Console  server.Config
+	Accounts accounts.Config
+	History  history.Config
+	Alerts   alerts.Config
 }
 
 // Peer is the a Multinode Dashboard application itself.
@@ -63,6 +78,11 @@ type Peer struct {
 
 	Dialer rpc.Dialer
 
+	// contains logic of operator accounts and their sessions.
+	Accounts struct {
+		Service *accounts.Service
+	}
+
 	// contains logic of nodes domain.
 	Nodes struct {
 		Service *nodes.Service
@@ -91,13 +111,35 @@ type Peer struct {
 		Service *reputation.Service
 	}
 
+	// collects and exposes historical node metrics.
+	History struct {
+		Collector *history.Collector
+		Service   *history.Service
+	}
+
+	// evaluates the alert rules and notifies about alerts.
+	Alerts struct {
+		Service *alerts.Service
+	}
+
+	// changes configuration of the nodes and controls their services.
+	Control struct {
+		Service *control.Service
+	}
+
+	// aggregates the summaries of the nodes grouped by their tags.
+	Groups struct {
+		Service *groups.Service
+	}
+
 	// Web server with web UI.
 	Console struct {
 		Listener net.Listener
 		Endpoint *server.Server
 	}
 
-	Servers *lifecycle.Group
+	Servers  *lifecycle.Group
+	Services *lifecycle.Group
 }
 
 // New creates a new instance of Multinode Dashboard application.
@@ -107,6 +149,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		Identity: full,
 		DB:       db,
 		Servers:  lifecycle.NewGroup(log.Named("servers")),
+		Services: lifecycle.NewGroup(log.Named("services")),
 	}
 
 	tlsConfig := tlsopts.Config{
@@ -121,6 +164,14 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 
 	peer.Dialer = rpc.NewDefaultDialer(tlsOptions)
 
+	{ // accounts setup
+		peer.Accounts.Service = accounts.NewService(
+			peer.Log.Named("accounts:service"),
+			peer.DB.Accounts(),
+			config.Accounts,
+		)
+	}
+
 	{ // nodes setup
 		peer.Nodes.Service = nodes.NewService(
 			peer.Log.Named("nodes:service"),
@@ -169,6 +220,75 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		)
 	}
 
+	{ // history setup
+		peer.History.Collector = history.NewCollector(
+			peer.Log.Named("history:collector"),
+			peer.Dialer,
+			peer.DB.Nodes(),
+			peer.DB.History(),
+			config.History,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "history:collector",
+			Run:   peer.History.Collector.Run,
+			Close: peer.History.Collector.Close,
+		})
+
+		peer.History.Service = history.NewService(
+			peer.Log.Named("history:service"),
+			peer.DB.History(),
+			config.History,
+		)
+	}
+
+	{ // alerts setup
+		notifiers, err := alerts.NewNotifiers(config.Alerts)
+		if err != nil {
+			return nil, err
+		}
+
+		peer.Alerts.Service, err = alerts.NewService(
+			peer.Log.Named("alerts:service"),
+			peer.DB.Alerts(),
+			alerts.NewServiceSource(
+				peer.Log.Named("alerts:source"),
+				peer.Nodes.Service,
+				peer.Reputation.Service,
+				peer.Payouts.Service,
+				peer.History.Service,
+				config.Alerts.Rules,
+			),
+			notifiers,
+			config.Alerts,
+		)
+		if err != nil {
+			return nil, err
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name:  "alerts:service",
+			Run:   peer.Alerts.Service.Run,
+			Close: peer.Alerts.Service.Close,
+		})
+	}
+
+	{ // control setup
+		peer.Control.Service = control.NewService(
+			peer.Log.Named("control:service"),
+			peer.Dialer,
+			peer.DB.Nodes(),
+		)
+	}
+
+	{ // groups setup
+		peer.Groups.Service = groups.NewService(
+			peer.Log.Named("groups:service"),
+			peer.Nodes.Service,
+			peer.Bandwidth.Service,
+			peer.Storage.Service,
+			peer.Payouts.Service,
+		)
+	}
+
 	{ // console setup
 		peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
 		if err != nil {
@@ -183,15 +303,21 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 
 		peer.Console.Endpoint, err = server.NewServer(
 			peer.Log.Named("console:endpoint"),
+			config.Console,
 			peer.Console.Listener,
 			assets,
 			server.Services{
+				Accounts:   peer.Accounts.Service,
 				Nodes:      peer.Nodes.Service,
 				Payouts:    peer.Payouts.Service,
 				Operators:  peer.Operators.Service,
 				Storage:    peer.Storage.Service,
 				Bandwidth:  peer.Bandwidth.Service,
 				Reputation: peer.Reputation.Service,
+				History:    peer.History.Service,
+				Alerts:     peer.Alerts.Service,
+				Control:    peer.Control.Service,
+				Groups:     peer.Groups.Service,
 			},
 		)
 		if err != nil {
@@ -215,11 +341,15 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group, ctx := errgroup.WithContext(ctx)
 
 	peer.Servers.Run(ctx, group)
+	peer.Services.Run(ctx, group)
 
 	return group.Wait()
 }
 
 // Close closes all the resources.
 func (peer *Peer) Close() error {
-	return peer.Servers.Close()
+	return errs.Combine(
+		peer.Servers.Close(),
+		peer.Services.Close(),
+	)
 }
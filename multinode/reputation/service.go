@@ -44,10 +44,10 @@ func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB) *Service {
 }
 
 // Stats retrieves node reputation stats list for satellite.
-func (service *Service) Stats(ctx context.Context, satelliteID storj.NodeID) (_ []Stats, err error) {
+func (service *Service) Stats(ctx context.Context, satelliteID storj.NodeID, filter nodes.Filter) (_ []Stats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	nodeList, err := service.nodes.List(ctx)
+	nodeList, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -76,10 +76,10 @@ func (service *Service) UsageSatellite(ctx context.Context, nodeID, satelliteID
 }
 
 // TotalUsage retrieves aggregated daily storage usage for provided interval.
-func (service *Service) TotalUsage(ctx context.Context, from, to time.Time) (_ Usage, err error) {
+func (service *Service) TotalUsage(ctx context.Context, from, to time.Time, filter nodes.Filter) (_ Usage, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	nodesList, err := service.nodes.List(ctx)
+	nodesList, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return Usage{}, Error.Wrap(err)
 	}
@@ -110,10 +110,10 @@ func (service *Service) TotalUsage(ctx context.Context, from, to time.Time) (_ U
 }
 
 // TotalUsageSatellite retrieves aggregated daily storage usage for provided interval and satellite.
-func (service *Service) TotalUsageSatellite(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ Usage, err error) {
+func (service *Service) TotalUsageSatellite(ctx context.Context, satelliteID storj.NodeID, from, to time.Time, filter nodes.Filter) (_ Usage, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	nodesList, err := service.nodes.List(ctx)
+	nodesList, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return Usage{}, Error.Wrap(err)
 	}
@@ -144,14 +144,21 @@ func (service *Service) TotalUsageSatellite(ctx context.Context, satelliteID sto
 }
 
 // TotalDiskSpace returns all info about all storagenodes disk space usage.
-func (service *Service) TotalDiskSpace(ctx context.Context) (totalDiskSpace DiskSpace, err error) {
+func (service *Service) TotalDiskSpace(ctx context.Context, filter nodes.Filter) (totalDiskSpace DiskSpace, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	listNodes, err := service.nodes.List(ctx)
+	listNodes, err := service.nodes.ListFiltered(ctx, filter)
 	if err != nil {
 		return DiskSpace{}, Error.Wrap(err)
 	}
 
+	return service.NodesDiskSpace(ctx, listNodes)
+}
+
+// NodesDiskSpace returns total disk space usage of the nodes, unreachable nodes are skipped.
+func (service *Service) NodesDiskSpace(ctx context.Context, listNodes []nodes.Node) (totalDiskSpace DiskSpace, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	for _, node := range listNodes {
 		diskSpace, err := service.dialDiskSpace(ctx, node)
 		if err != nil {
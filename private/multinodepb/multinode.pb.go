@@ -4089,6 +4089,564 @@ func (m *SatellitePeriodPaystubResponse) GetPaystub() *Paystub {
 	return nil
 }
 
+type SetAllocatedDiskSpaceRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Allocated            int64          `protobuf:"varint,2,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetAllocatedDiskSpaceRequest) Reset()         { *m = SetAllocatedDiskSpaceRequest{} }
+func (m *SetAllocatedDiskSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAllocatedDiskSpaceRequest) ProtoMessage()    {}
+func (*SetAllocatedDiskSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{89}
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Unmarshal(m, b)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Marshal(b, m, deterministic)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAllocatedDiskSpaceRequest.Merge(m, src)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Size(m)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAllocatedDiskSpaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAllocatedDiskSpaceRequest proto.InternalMessageInfo
+
+func (m *SetAllocatedDiskSpaceRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetAllocatedDiskSpaceRequest) GetAllocated() int64 {
+	if m != nil {
+		return m.Allocated
+	}
+	return 0
+}
+
+type SetAllocatedDiskSpaceResponse struct {
+	Allocated            int64    `protobuf:"varint,1,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAllocatedDiskSpaceResponse) Reset()         { *m = SetAllocatedDiskSpaceResponse{} }
+func (m *SetAllocatedDiskSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAllocatedDiskSpaceResponse) ProtoMessage()    {}
+func (*SetAllocatedDiskSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{90}
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Unmarshal(m, b)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Marshal(b, m, deterministic)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAllocatedDiskSpaceResponse.Merge(m, src)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Size() int {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Size(m)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAllocatedDiskSpaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAllocatedDiskSpaceResponse proto.InternalMessageInfo
+
+func (m *SetAllocatedDiskSpaceResponse) GetAllocated() int64 {
+	if m != nil {
+		return m.Allocated
+	}
+	return 0
+}
+
+type ControlInitiateGracefulExitRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ControlInitiateGracefulExitRequest) Reset()         { *m = ControlInitiateGracefulExitRequest{} }
+func (m *ControlInitiateGracefulExitRequest) String() string { return proto.CompactTextString(m) }
+func (*ControlInitiateGracefulExitRequest) ProtoMessage()    {}
+func (*ControlInitiateGracefulExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{91}
+}
+func (m *ControlInitiateGracefulExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ControlInitiateGracefulExitRequest.Unmarshal(m, b)
+}
+func (m *ControlInitiateGracefulExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ControlInitiateGracefulExitRequest.Marshal(b, m, deterministic)
+}
+func (m *ControlInitiateGracefulExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlInitiateGracefulExitRequest.Merge(m, src)
+}
+func (m *ControlInitiateGracefulExitRequest) XXX_Size() int {
+	return xxx_messageInfo_ControlInitiateGracefulExitRequest.Size(m)
+}
+func (m *ControlInitiateGracefulExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlInitiateGracefulExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlInitiateGracefulExitRequest proto.InternalMessageInfo
+
+func (m *ControlInitiateGracefulExitRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type ControlInitiateGracefulExitResponse struct {
+	SatelliteAddress     string   `protobuf:"bytes,1,opt,name=satellite_address,json=satelliteAddress,proto3" json:"satellite_address,omitempty"`
+	BytesToTransfer      int64    `protobuf:"varint,2,opt,name=bytes_to_transfer,json=bytesToTransfer,proto3" json:"bytes_to_transfer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ControlInitiateGracefulExitResponse) Reset()         { *m = ControlInitiateGracefulExitResponse{} }
+func (m *ControlInitiateGracefulExitResponse) String() string { return proto.CompactTextString(m) }
+func (*ControlInitiateGracefulExitResponse) ProtoMessage()    {}
+func (*ControlInitiateGracefulExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{92}
+}
+func (m *ControlInitiateGracefulExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ControlInitiateGracefulExitResponse.Unmarshal(m, b)
+}
+func (m *ControlInitiateGracefulExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ControlInitiateGracefulExitResponse.Marshal(b, m, deterministic)
+}
+func (m *ControlInitiateGracefulExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlInitiateGracefulExitResponse.Merge(m, src)
+}
+func (m *ControlInitiateGracefulExitResponse) XXX_Size() int {
+	return xxx_messageInfo_ControlInitiateGracefulExitResponse.Size(m)
+}
+func (m *ControlInitiateGracefulExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlInitiateGracefulExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlInitiateGracefulExitResponse proto.InternalMessageInfo
+
+func (m *ControlInitiateGracefulExitResponse) GetSatelliteAddress() string {
+	if m != nil {
+		return m.SatelliteAddress
+	}
+	return ""
+}
+
+func (m *ControlInitiateGracefulExitResponse) GetBytesToTransfer() int64 {
+	if m != nil {
+		return m.BytesToTransfer
+	}
+	return 0
+}
+
+type TrashRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TrashRequest) Reset()         { *m = TrashRequest{} }
+func (m *TrashRequest) String() string { return proto.CompactTextString(m) }
+func (*TrashRequest) ProtoMessage()    {}
+func (*TrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{93}
+}
+func (m *TrashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrashRequest.Unmarshal(m, b)
+}
+func (m *TrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrashRequest.Marshal(b, m, deterministic)
+}
+func (m *TrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrashRequest.Merge(m, src)
+}
+func (m *TrashRequest) XXX_Size() int {
+	return xxx_messageInfo_TrashRequest.Size(m)
+}
+func (m *TrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrashRequest proto.InternalMessageInfo
+
+func (m *TrashRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type TrashResponse struct {
+	Used                 int64    `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrashResponse) Reset()         { *m = TrashResponse{} }
+func (m *TrashResponse) String() string { return proto.CompactTextString(m) }
+func (*TrashResponse) ProtoMessage()    {}
+func (*TrashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{94}
+}
+func (m *TrashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrashResponse.Unmarshal(m, b)
+}
+func (m *TrashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrashResponse.Marshal(b, m, deterministic)
+}
+func (m *TrashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrashResponse.Merge(m, src)
+}
+func (m *TrashResponse) XXX_Size() int {
+	return xxx_messageInfo_TrashResponse.Size(m)
+}
+func (m *TrashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrashResponse proto.InternalMessageInfo
+
+func (m *TrashResponse) GetUsed() int64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+type EmptyTrashRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *EmptyTrashRequest) Reset()         { *m = EmptyTrashRequest{} }
+func (m *EmptyTrashRequest) String() string { return proto.CompactTextString(m) }
+func (*EmptyTrashRequest) ProtoMessage()    {}
+func (*EmptyTrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{95}
+}
+func (m *EmptyTrashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmptyTrashRequest.Unmarshal(m, b)
+}
+func (m *EmptyTrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmptyTrashRequest.Marshal(b, m, deterministic)
+}
+func (m *EmptyTrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyTrashRequest.Merge(m, src)
+}
+func (m *EmptyTrashRequest) XXX_Size() int {
+	return xxx_messageInfo_EmptyTrashRequest.Size(m)
+}
+func (m *EmptyTrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyTrashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmptyTrashRequest proto.InternalMessageInfo
+
+func (m *EmptyTrashRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type EmptyTrashResponse struct {
+	Freed                int64    `protobuf:"varint,1,opt,name=freed,proto3" json:"freed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmptyTrashResponse) Reset()         { *m = EmptyTrashResponse{} }
+func (m *EmptyTrashResponse) String() string { return proto.CompactTextString(m) }
+func (*EmptyTrashResponse) ProtoMessage()    {}
+func (*EmptyTrashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{96}
+}
+func (m *EmptyTrashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmptyTrashResponse.Unmarshal(m, b)
+}
+func (m *EmptyTrashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmptyTrashResponse.Marshal(b, m, deterministic)
+}
+func (m *EmptyTrashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyTrashResponse.Merge(m, src)
+}
+func (m *EmptyTrashResponse) XXX_Size() int {
+	return xxx_messageInfo_EmptyTrashResponse.Size(m)
+}
+func (m *EmptyTrashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyTrashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmptyTrashResponse proto.InternalMessageInfo
+
+func (m *EmptyTrashResponse) GetFreed() int64 {
+	if m != nil {
+		return m.Freed
+	}
+	return 0
+}
+
+type SetUploadsPausedRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Paused               bool           `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetUploadsPausedRequest) Reset()         { *m = SetUploadsPausedRequest{} }
+func (m *SetUploadsPausedRequest) String() string { return proto.CompactTextString(m) }
+func (*SetUploadsPausedRequest) ProtoMessage()    {}
+func (*SetUploadsPausedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{97}
+}
+func (m *SetUploadsPausedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUploadsPausedRequest.Unmarshal(m, b)
+}
+func (m *SetUploadsPausedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUploadsPausedRequest.Marshal(b, m, deterministic)
+}
+func (m *SetUploadsPausedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUploadsPausedRequest.Merge(m, src)
+}
+func (m *SetUploadsPausedRequest) XXX_Size() int {
+	return xxx_messageInfo_SetUploadsPausedRequest.Size(m)
+}
+func (m *SetUploadsPausedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUploadsPausedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUploadsPausedRequest proto.InternalMessageInfo
+
+func (m *SetUploadsPausedRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetUploadsPausedRequest) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type SetUploadsPausedResponse struct {
+	Paused               bool     `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetUploadsPausedResponse) Reset()         { *m = SetUploadsPausedResponse{} }
+func (m *SetUploadsPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*SetUploadsPausedResponse) ProtoMessage()    {}
+func (*SetUploadsPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{98}
+}
+func (m *SetUploadsPausedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUploadsPausedResponse.Unmarshal(m, b)
+}
+func (m *SetUploadsPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUploadsPausedResponse.Marshal(b, m, deterministic)
+}
+func (m *SetUploadsPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUploadsPausedResponse.Merge(m, src)
+}
+func (m *SetUploadsPausedResponse) XXX_Size() int {
+	return xxx_messageInfo_SetUploadsPausedResponse.Size(m)
+}
+func (m *SetUploadsPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUploadsPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUploadsPausedResponse proto.InternalMessageInfo
+
+func (m *SetUploadsPausedResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type RestartRetainRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RestartRetainRequest) Reset()         { *m = RestartRetainRequest{} }
+func (m *RestartRetainRequest) String() string { return proto.CompactTextString(m) }
+func (*RestartRetainRequest) ProtoMessage()    {}
+func (*RestartRetainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{99}
+}
+func (m *RestartRetainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestartRetainRequest.Unmarshal(m, b)
+}
+func (m *RestartRetainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestartRetainRequest.Marshal(b, m, deterministic)
+}
+func (m *RestartRetainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartRetainRequest.Merge(m, src)
+}
+func (m *RestartRetainRequest) XXX_Size() int {
+	return xxx_messageInfo_RestartRetainRequest.Size(m)
+}
+func (m *RestartRetainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartRetainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartRetainRequest proto.InternalMessageInfo
+
+func (m *RestartRetainRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type RestartRetainResponse struct {
+	Queued               bool     `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestartRetainResponse) Reset()         { *m = RestartRetainResponse{} }
+func (m *RestartRetainResponse) String() string { return proto.CompactTextString(m) }
+func (*RestartRetainResponse) ProtoMessage()    {}
+func (*RestartRetainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{100}
+}
+func (m *RestartRetainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestartRetainResponse.Unmarshal(m, b)
+}
+func (m *RestartRetainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestartRetainResponse.Marshal(b, m, deterministic)
+}
+func (m *RestartRetainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartRetainResponse.Merge(m, src)
+}
+func (m *RestartRetainResponse) XXX_Size() int {
+	return xxx_messageInfo_RestartRetainResponse.Size(m)
+}
+func (m *RestartRetainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartRetainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartRetainResponse proto.InternalMessageInfo
+
+func (m *RestartRetainResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+type RestartUsedSpaceWalkerRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RestartUsedSpaceWalkerRequest) Reset()         { *m = RestartUsedSpaceWalkerRequest{} }
+func (m *RestartUsedSpaceWalkerRequest) String() string { return proto.CompactTextString(m) }
+func (*RestartUsedSpaceWalkerRequest) ProtoMessage()    {}
+func (*RestartUsedSpaceWalkerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{101}
+}
+func (m *RestartUsedSpaceWalkerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestartUsedSpaceWalkerRequest.Unmarshal(m, b)
+}
+func (m *RestartUsedSpaceWalkerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestartUsedSpaceWalkerRequest.Marshal(b, m, deterministic)
+}
+func (m *RestartUsedSpaceWalkerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartUsedSpaceWalkerRequest.Merge(m, src)
+}
+func (m *RestartUsedSpaceWalkerRequest) XXX_Size() int {
+	return xxx_messageInfo_RestartUsedSpaceWalkerRequest.Size(m)
+}
+func (m *RestartUsedSpaceWalkerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartUsedSpaceWalkerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartUsedSpaceWalkerRequest proto.InternalMessageInfo
+
+func (m *RestartUsedSpaceWalkerRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type RestartUsedSpaceWalkerResponse struct {
+	Triggered            bool     `protobuf:"varint,1,opt,name=triggered,proto3" json:"triggered,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestartUsedSpaceWalkerResponse) Reset()         { *m = RestartUsedSpaceWalkerResponse{} }
+func (m *RestartUsedSpaceWalkerResponse) String() string { return proto.CompactTextString(m) }
+func (*RestartUsedSpaceWalkerResponse) ProtoMessage()    {}
+func (*RestartUsedSpaceWalkerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{102}
+}
+func (m *RestartUsedSpaceWalkerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestartUsedSpaceWalkerResponse.Unmarshal(m, b)
+}
+func (m *RestartUsedSpaceWalkerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestartUsedSpaceWalkerResponse.Marshal(b, m, deterministic)
+}
+func (m *RestartUsedSpaceWalkerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartUsedSpaceWalkerResponse.Merge(m, src)
+}
+func (m *RestartUsedSpaceWalkerResponse) XXX_Size() int {
+	return xxx_messageInfo_RestartUsedSpaceWalkerResponse.Size(m)
+}
+func (m *RestartUsedSpaceWalkerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartUsedSpaceWalkerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartUsedSpaceWalkerResponse proto.InternalMessageInfo
+
+func (m *RestartUsedSpaceWalkerResponse) GetTriggered() bool {
+	if m != nil {
+		return m.Triggered
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*RequestHeader)(nil), "multinode.RequestHeader")
 	proto.RegisterType((*DiskSpaceRequest)(nil), "multinode.DiskSpaceRequest")
@@ -4184,188 +4742,224 @@ func init() {
 	proto.RegisterType((*PeriodPaystubResponse)(nil), "multinode.PeriodPaystubResponse")
 	proto.RegisterType((*SatellitePeriodPaystubRequest)(nil), "multinode.SatellitePeriodPaystubRequest")
 	proto.RegisterType((*SatellitePeriodPaystubResponse)(nil), "multinode.SatellitePeriodPaystubResponse")
+	proto.RegisterType((*SetAllocatedDiskSpaceRequest)(nil), "multinode.SetAllocatedDiskSpaceRequest")
+	proto.RegisterType((*SetAllocatedDiskSpaceResponse)(nil), "multinode.SetAllocatedDiskSpaceResponse")
+	proto.RegisterType((*ControlInitiateGracefulExitRequest)(nil), "multinode.ControlInitiateGracefulExitRequest")
+	proto.RegisterType((*ControlInitiateGracefulExitResponse)(nil), "multinode.ControlInitiateGracefulExitResponse")
+	proto.RegisterType((*TrashRequest)(nil), "multinode.TrashRequest")
+	proto.RegisterType((*TrashResponse)(nil), "multinode.TrashResponse")
+	proto.RegisterType((*EmptyTrashRequest)(nil), "multinode.EmptyTrashRequest")
+	proto.RegisterType((*EmptyTrashResponse)(nil), "multinode.EmptyTrashResponse")
+	proto.RegisterType((*SetUploadsPausedRequest)(nil), "multinode.SetUploadsPausedRequest")
+	proto.RegisterType((*SetUploadsPausedResponse)(nil), "multinode.SetUploadsPausedResponse")
+	proto.RegisterType((*RestartRetainRequest)(nil), "multinode.RestartRetainRequest")
+	proto.RegisterType((*RestartRetainResponse)(nil), "multinode.RestartRetainResponse")
+	proto.RegisterType((*RestartUsedSpaceWalkerRequest)(nil), "multinode.RestartUsedSpaceWalkerRequest")
+	proto.RegisterType((*RestartUsedSpaceWalkerResponse)(nil), "multinode.RestartUsedSpaceWalkerResponse")
 }
 
 func init() { proto.RegisterFile("multinode.proto", fileDescriptor_9a45fd79b06f3a1b) }
 
 var fileDescriptor_9a45fd79b06f3a1b = []byte{
-	// 2847 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x73, 0xdb, 0xc6,
-	0x15, 0x2f, 0x45, 0x89, 0x14, 0x1f, 0x49, 0x51, 0xda, 0xe8, 0x83, 0x82, 0xf5, 0x65, 0xc8, 0xb5,
-	0xe5, 0xc6, 0x91, 0x13, 0x25, 0x93, 0x36, 0x69, 0x32, 0x0d, 0x65, 0x3b, 0x91, 0x12, 0x39, 0x56,
-	0x21, 0x3b, 0xcd, 0xc4, 0x9d, 0x30, 0x10, 0xb1, 0x92, 0x90, 0x80, 0x00, 0x03, 0x2c, 0xa5, 0x6a,
-	0xa6, 0x93, 0xf6, 0xd0, 0xa6, 0xa7, 0xce, 0xf4, 0x9c, 0xe9, 0x5f, 0xd1, 0x4b, 0x8f, 0xbd, 0x75,
-	0x32, 0xd3, 0xff, 0xa0, 0x87, 0x74, 0xa6, 0xb7, 0x5e, 0x7a, 0xe9, 0xad, 0xa7, 0xce, 0x7e, 0x00,
-	0xc4, 0xe2, 0x8b, 0x12, 0x68, 0x8f, 0x7a, 0xc3, 0xee, 0xbe, 0xf7, 0xdb, 0xf7, 0xde, 0xee, 0x3e,
-	0xbc, 0xf7, 0x76, 0xa1, 0xd1, 0xed, 0x5b, 0xc4, 0xb4, 0x1d, 0x03, 0x6f, 0xf6, 0x5c, 0x87, 0x38,
-	0xa8, 0x12, 0x74, 0x28, 0x70, 0xec, 0x1c, 0x3b, 0xbc, 0x5b, 0x59, 0x3d, 0x76, 0x9c, 0x63, 0x0b,
-	0xdf, 0x65, 0xad, 0xc3, 0xfe, 0xd1, 0x5d, 0x62, 0x76, 0xb1, 0x47, 0xf4, 0x6e, 0x8f, 0x13, 0xa8,
-	0x1b, 0x50, 0xd7, 0xf0, 0x97, 0x7d, 0xec, 0x91, 0x1d, 0xac, 0x1b, 0xd8, 0x45, 0x0b, 0x50, 0xd6,
-	0x7b, 0x66, 0xfb, 0x0b, 0x7c, 0xde, 0x2c, 0xac, 0x15, 0x36, 0x6a, 0x5a, 0x49, 0xef, 0x99, 0x1f,
-	0xe0, 0x73, 0xf5, 0x3e, 0x4c, 0xdf, 0x37, 0xbd, 0x2f, 0x0e, 0x7a, 0x7a, 0x07, 0x0b, 0x16, 0xf4,
-	0x32, 0x94, 0x4e, 0x18, 0x1b, 0xa3, 0xad, 0x6e, 0x35, 0x37, 0x07, 0x72, 0x49, 0xb0, 0x9a, 0xa0,
-	0x53, 0xff, 0x52, 0x80, 0x99, 0x10, 0x8c, 0xd7, 0x73, 0x6c, 0x0f, 0xa3, 0x25, 0xa8, 0xe8, 0x96,
-	0xe5, 0x74, 0x74, 0x82, 0x0d, 0x06, 0x55, 0xd4, 0x06, 0x1d, 0x68, 0x15, 0xaa, 0x7d, 0x0f, 0x1b,
-	0xed, 0x9e, 0x89, 0x3b, 0xd8, 0x6b, 0x8e, 0xb1, 0x71, 0xa0, 0x5d, 0xfb, 0xac, 0x07, 0x2d, 0x03,
-	0x6b, 0xb5, 0x89, 0xab, 0x7b, 0x27, 0xcd, 0x22, 0xe7, 0xa7, 0x3d, 0x8f, 0x69, 0x07, 0x42, 0x30,
-	0x7e, 0xe4, 0x62, 0xdc, 0x1c, 0x67, 0x03, 0xec, 0x9b, 0xcd, 0x78, 0xaa, 0x9b, 0x96, 0x7e, 0x68,
-	0xe1, 0xe6, 0x84, 0x98, 0xd1, 0xef, 0x40, 0x0a, 0x4c, 0x3a, 0xa7, 0xd8, 0xa5, 0x10, 0xcd, 0x12,
-	0x1b, 0x0c, 0xda, 0xea, 0xaf, 0xa0, 0x76, 0x40, 0x1c, 0x57, 0x3f, 0xc6, 0x4f, 0x3c, 0xfd, 0x18,
-	0x23, 0x15, 0xea, 0x3a, 0x69, 0xbb, 0xd8, 0x23, 0x6d, 0xe2, 0x10, 0xdd, 0x62, 0xf2, 0x17, 0xb4,
-	0xaa, 0x4e, 0x34, 0xec, 0x91, 0xc7, 0xb4, 0x0b, 0x7d, 0x00, 0x53, 0xa6, 0x4d, 0xb0, 0x7b, 0xaa,
-	0x5b, 0x6d, 0x8f, 0xe8, 0x2e, 0x61, 0x4a, 0x54, 0xb7, 0x94, 0x4d, 0xbe, 0x3e, 0x9b, 0xfe, 0xfa,
-	0x6c, 0x3e, 0xf6, 0xd7, 0x67, 0x7b, 0xf2, 0xdb, 0xef, 0x56, 0xbf, 0xf7, 0x87, 0x7f, 0xac, 0x16,
-	0xb4, 0xba, 0xcf, 0x7b, 0x40, 0x59, 0xd5, 0x3f, 0x17, 0xe0, 0x85, 0xb0, 0x04, 0xb9, 0x17, 0x03,
-	0xfd, 0x88, 0x1a, 0xc6, 0xe9, 0x5e, 0x4a, 0x18, 0xc6, 0x81, 0x5e, 0x83, 0x31, 0xe2, 0x30, 0x4b,
-	0x5f, 0x94, 0x6f, 0x8c, 0x38, 0xaa, 0x0d, 0xb3, 0xb2, 0xe0, 0x62, 0xf9, 0xdf, 0x82, 0xba, 0xc7,
-	0xfb, 0xdb, 0x7d, 0x3a, 0xd0, 0x2c, 0xac, 0x15, 0x37, 0xaa, 0x5b, 0x0b, 0x21, 0x05, 0x24, 0xbe,
-	0x9a, 0x17, 0x5e, 0x80, 0x26, 0x94, 0xbd, 0x7e, 0xb7, 0xab, 0xbb, 0xe7, 0x4c, 0x91, 0x82, 0xe6,
-	0x37, 0xd5, 0xff, 0x14, 0x60, 0x29, 0xcc, 0x78, 0xa0, 0x13, 0x6c, 0x59, 0x26, 0x19, 0xc1, 0x64,
-	0xaf, 0x40, 0xcd, 0xf3, 0x51, 0xda, 0xa6, 0xc1, 0x66, 0xac, 0x6d, 0x4f, 0x51, 0x35, 0xff, 0xfe,
-	0xdd, 0x6a, 0xe9, 0x43, 0xc7, 0xc0, 0xbb, 0xf7, 0xb5, 0x6a, 0x40, 0xb3, 0x6b, 0x04, 0x56, 0x2e,
-	0xe6, 0xb4, 0xf2, 0xf8, 0x25, 0xad, 0x7c, 0x06, 0xcb, 0x29, 0x4a, 0x3f, 0x67, 0x73, 0xef, 0xc3,
-	0xd2, 0xb6, 0x6e, 0x1b, 0x67, 0xa6, 0x41, 0x4e, 0x1e, 0x3a, 0x36, 0x39, 0x39, 0xe0, 0x03, 0xf9,
-	0xbd, 0xc5, 0xab, 0xb0, 0x9c, 0x82, 0x28, 0x54, 0x41, 0x30, 0xce, 0x0e, 0x29, 0xf7, 0x19, 0xec,
-	0x5b, 0xfd, 0x5d, 0x01, 0xd6, 0x02, 0x2e, 0xc1, 0x70, 0x25, 0x2b, 0xaf, 0xbe, 0x0d, 0xd7, 0x33,
-	0x04, 0x11, 0x2a, 0x84, 0xec, 0xc9, 0xb5, 0x08, 0xec, 0xf9, 0x01, 0x2c, 0x44, 0xd9, 0xf3, 0x9b,
-	0xf2, 0x35, 0x68, 0xc6, 0xc1, 0x86, 0x8a, 0xf0, 0x9b, 0x02, 0x2c, 0x3f, 0x38, 0x76, 0xb1, 0xe7,
-	0x5d, 0xa9, 0x21, 0xdf, 0x84, 0x95, 0x34, 0x29, 0x86, 0xaa, 0xb0, 0x03, 0xb3, 0x12, 0x6f, 0x7e,
-	0x13, 0xbe, 0x02, 0x73, 0x11, 0xa4, 0xa1, 0x93, 0xff, 0xb6, 0x00, 0x2b, 0xbb, 0xf6, 0xd5, 0x1b,
-	0xf0, 0xc7, 0xb0, 0x9a, 0x2a, 0xc6, 0x50, 0x25, 0x76, 0x61, 0x4e, 0x66, 0xce, 0x6f, 0xc2, 0x2d,
-	0x98, 0x8f, 0x42, 0x0d, 0x9d, 0xfe, 0x97, 0x30, 0x77, 0x5f, 0x37, 0xad, 0x2b, 0xb2, 0xdc, 0x01,
-	0xcc, 0x47, 0x67, 0x17, 0x12, 0xbf, 0x01, 0x35, 0xe6, 0x3e, 0xdb, 0xae, 0x63, 0x59, 0xfd, 0x9e,
-	0xf0, 0xa2, 0xf3, 0x21, 0x21, 0xb8, 0xfb, 0x64, 0xa3, 0x5a, 0xb5, 0x3f, 0x68, 0xa8, 0xef, 0x40,
-	0x8d, 0x81, 0xe6, 0x37, 0xe4, 0xfb, 0x50, 0x17, 0x08, 0xa3, 0x4b, 0xf3, 0xb7, 0x02, 0x54, 0x43,
-	0x83, 0xe8, 0x36, 0x94, 0x30, 0x5b, 0x23, 0x21, 0xcd, 0x4c, 0x08, 0x84, 0x1f, 0x00, 0x4d, 0x10,
-	0xa0, 0x3b, 0x50, 0x36, 0xf9, 0x7a, 0x8a, 0x20, 0x02, 0x85, 0x68, 0xc5, 0x4a, 0x6b, 0x3e, 0x09,
-	0x9a, 0x87, 0x92, 0x81, 0x2d, 0x4c, 0xb0, 0x88, 0xd1, 0x44, 0x2b, 0x21, 0x3c, 0x1a, 0xcf, 0x1f,
-	0x1e, 0xed, 0x41, 0xe9, 0x41, 0x30, 0x9d, 0x8b, 0x7b, 0xba, 0xe9, 0x8a, 0x1d, 0x25, 0x5a, 0x68,
-	0x16, 0x26, 0xf4, 0xbe, 0x61, 0x12, 0x11, 0x49, 0xf2, 0x06, 0xed, 0xe5, 0x7f, 0x43, 0x2e, 0x1b,
-	0x6f, 0xa8, 0x3f, 0x84, 0xf2, 0xae, 0x2d, 0xc3, 0x19, 0x12, 0x9c, 0x31, 0x60, 0x1c, 0x0b, 0x33,
-	0x6e, 0xc3, 0xd4, 0x47, 0xd8, 0xf5, 0x4c, 0xc7, 0xce, 0xbf, 0xc8, 0x2f, 0x42, 0x23, 0xc0, 0x18,
-	0x1c, 0x93, 0x53, 0xde, 0xc5, 0x50, 0x2a, 0x9a, 0xdf, 0x54, 0xdf, 0x05, 0xb4, 0xa7, 0x7b, 0xe4,
-	0x9e, 0x63, 0x13, 0xbd, 0x43, 0xf2, 0x4f, 0xfa, 0x29, 0xbc, 0x20, 0xe1, 0x88, 0x89, 0xdf, 0x83,
-	0x9a, 0xa5, 0x7b, 0xa4, 0xdd, 0xe1, 0xfd, 0x02, 0xee, 0x62, 0x2b, 0x54, 0xb5, 0x06, 0x80, 0xea,
-	0x2f, 0x60, 0x46, 0xc3, 0xbd, 0x3e, 0xd1, 0xc9, 0x28, 0xb6, 0xc9, 0x73, 0x94, 0xbf, 0x29, 0x40,
-	0xb5, 0x45, 0xd7, 0xfa, 0x67, 0xa6, 0x6d, 0x38, 0x67, 0x54, 0xa5, 0x33, 0xf6, 0x25, 0x36, 0xdd,
-	0xa5, 0x54, 0xe2, 0x9c, 0x6c, 0xcb, 0xa1, 0xeb, 0x50, 0x73, 0x6c, 0xcb, 0xb4, 0x71, 0xbb, 0xe3,
-	0xf4, 0x6d, 0xbe, 0xaf, 0x26, 0xb4, 0x2a, 0xef, 0xbb, 0x47, 0xbb, 0x68, 0x0e, 0xc3, 0xb2, 0x03,
-	0x41, 0x51, 0x64, 0x14, 0xc0, 0xba, 0x18, 0x81, 0xfa, 0xdf, 0x32, 0xa0, 0xb0, 0x5d, 0x82, 0x58,
-	0xad, 0xc4, 0x61, 0x84, 0x74, 0x37, 0x24, 0xc3, 0x44, 0xc9, 0x37, 0x1f, 0x31, 0x5a, 0x4d, 0xf0,
-	0xa0, 0x37, 0xc2, 0x3b, 0xbd, 0xba, 0xb5, 0x9e, 0xcd, 0xcc, 0x6c, 0xe3, 0x1f, 0x87, 0x87, 0xd0,
-	0x30, 0x4c, 0xef, 0xcb, 0xbe, 0x6e, 0x99, 0x47, 0x26, 0x36, 0xda, 0x3a, 0xb9, 0x60, 0x00, 0x5b,
-	0x60, 0xf6, 0x99, 0x0a, 0x33, 0xb7, 0x08, 0xb5, 0xb5, 0xd7, 0xf7, 0x7a, 0xd8, 0x36, 0x38, 0xd6,
-	0xf8, 0x25, 0xb0, 0xaa, 0x01, 0x67, 0x8b, 0xa0, 0x8f, 0x60, 0xd6, 0x39, 0x3a, 0x62, 0xc6, 0x96,
-	0x00, 0x27, 0x2e, 0x01, 0x88, 0x04, 0xc2, 0x41, 0x08, 0xf7, 0x29, 0x2c, 0xf8, 0xb8, 0x7d, 0xdb,
-	0xc0, 0x6e, 0xdb, 0xc5, 0xa7, 0x26, 0x3e, 0xa3, 0xd0, 0xa5, 0x4b, 0x40, 0xfb, 0xc2, 0x3d, 0xa1,
-	0x18, 0x1a, 0x83, 0x68, 0x11, 0xd4, 0x82, 0xca, 0x29, 0x26, 0x84, 0x4b, 0x5a, 0xb9, 0x04, 0xdc,
-	0x24, 0x67, 0x6b, 0x11, 0x74, 0x0f, 0xa0, 0xdf, 0x33, 0x74, 0x81, 0x51, 0xbe, 0xc4, 0x56, 0xad,
-	0x08, 0x3e, 0x2e, 0xc7, 0xe7, 0x8e, 0x69, 0x73, 0x8c, 0xc9, 0x4b, 0x60, 0x4c, 0x72, 0xb6, 0x16,
-	0x51, 0x56, 0xa0, 0xc4, 0x37, 0x19, 0xf5, 0x7b, 0x5e, 0xc7, 0x71, 0xb1, 0x48, 0x78, 0x79, 0x43,
-	0xf9, 0xd3, 0x18, 0x4c, 0xb4, 0x7c, 0x87, 0x1a, 0x1f, 0x47, 0xb7, 0x61, 0x9a, 0xaf, 0x1b, 0x75,
-	0x5a, 0x6d, 0x4e, 0xc0, 0xf3, 0x88, 0xc6, 0xa0, 0xff, 0x80, 0x91, 0x26, 0x9c, 0x99, 0x62, 0xf8,
-	0xcc, 0xa0, 0x75, 0xa8, 0x7b, 0xfd, 0x4e, 0x07, 0x7b, 0x9e, 0x20, 0xe1, 0x19, 0x7e, 0x4d, 0x74,
-	0x72, 0x22, 0xea, 0xed, 0xad, 0xde, 0x89, 0xce, 0x76, 0x48, 0x41, 0xe3, 0x0d, 0x9a, 0x38, 0x1c,
-	0x62, 0xa2, 0xb3, 0xb5, 0x2d, 0x68, 0xec, 0x9b, 0xc2, 0xf5, 0xed, 0x2f, 0x6c, 0xe7, 0xcc, 0x6e,
-	0x73, 0x8e, 0x32, 0x1b, 0xac, 0x89, 0xce, 0x16, 0x63, 0xbc, 0x0e, 0x7e, 0xbb, 0xcd, 0x00, 0x26,
-	0x79, 0xb6, 0x2f, 0xfa, 0xb6, 0x29, 0xce, 0xcb, 0x50, 0x3e, 0x31, 0x69, 0xce, 0x74, 0xde, 0xac,
-	0xc4, 0xfe, 0xc2, 0x21, 0x07, 0xa4, 0xf9, 0x64, 0xea, 0x1e, 0x34, 0x1f, 0xbb, 0x7d, 0x8f, 0x60,
-	0x23, 0x08, 0x33, 0xbc, 0xfc, 0x1e, 0xfc, 0xaf, 0x05, 0x58, 0x4c, 0x80, 0x13, 0x1e, 0xe5, 0x29,
-	0x20, 0xc2, 0x07, 0xdb, 0x81, 0x73, 0xf4, 0x44, 0xb8, 0x70, 0x27, 0x84, 0x9d, 0x8a, 0xb0, 0x49,
-	0x7d, 0xeb, 0x13, 0x6d, 0x4f, 0x9b, 0x21, 0x51, 0x12, 0x65, 0x0f, 0xca, 0x62, 0x14, 0xdd, 0x82,
-	0x32, 0xc5, 0x69, 0x8b, 0xff, 0x65, 0xdc, 0x37, 0x97, 0xe8, 0xf0, 0xae, 0x41, 0x7f, 0x69, 0xba,
-	0x61, 0x04, 0x31, 0x44, 0x45, 0xf3, 0x9b, 0xea, 0x3d, 0x68, 0x3c, 0xea, 0x61, 0x57, 0x27, 0x8e,
-	0x9b, 0xdf, 0x1a, 0x26, 0x4c, 0x0f, 0x40, 0x84, 0x0d, 0x66, 0x61, 0x02, 0x77, 0x75, 0xd3, 0x12,
-	0xff, 0x50, 0xde, 0xa0, 0x3f, 0xf8, 0x33, 0xdd, 0xb2, 0x30, 0x11, 0x72, 0x88, 0x16, 0xba, 0x05,
-	0x0d, 0xfe, 0xd5, 0x3e, 0xc2, 0x3a, 0xe9, 0xbb, 0xd8, 0x6b, 0x16, 0xd7, 0x8a, 0x1b, 0x15, 0x6d,
-	0x8a, 0x77, 0xbf, 0x2b, 0x7a, 0xd5, 0xaf, 0x0b, 0xb0, 0xfa, 0xc0, 0x23, 0x66, 0x97, 0x1e, 0xb7,
-	0x7d, 0xfd, 0xdc, 0xe9, 0x93, 0xab, 0x09, 0x5a, 0x7f, 0x0a, 0x6b, 0xe9, 0x72, 0x08, 0x1b, 0xbc,
-	0x04, 0x08, 0xfb, 0x34, 0x6d, 0xac, 0xbb, 0xb6, 0x69, 0x1f, 0x7b, 0x22, 0xb4, 0x99, 0x09, 0x46,
-	0x1e, 0x88, 0x01, 0xf5, 0x7d, 0x98, 0x8f, 0x40, 0xe6, 0x5f, 0x92, 0x1d, 0x58, 0x88, 0x61, 0xe5,
-	0x93, 0x6a, 0x1b, 0xa6, 0x46, 0xce, 0x49, 0x76, 0xa1, 0x11, 0x4d, 0x46, 0x5e, 0x87, 0x6a, 0x8f,
-	0xc9, 0xd5, 0x36, 0xed, 0x23, 0x47, 0x20, 0xcd, 0x85, 0x90, 0xb8, 0xd4, 0xbb, 0xf6, 0x91, 0xa3,
-	0x41, 0x2f, 0xf8, 0x56, 0x3f, 0x83, 0x59, 0x01, 0xb5, 0x8f, 0x5d, 0xd3, 0x31, 0xf2, 0x2f, 0xfa,
-	0x3c, 0x94, 0x7a, 0x0c, 0xc2, 0xdf, 0x8b, 0xbc, 0xa5, 0x3e, 0x82, 0xb9, 0xc8, 0x0c, 0x23, 0x8a,
-	0xfc, 0x15, 0x2c, 0x5c, 0x69, 0x66, 0xaa, 0x41, 0x33, 0x35, 0x25, 0xcd, 0xab, 0xd3, 0x1f, 0x0b,
-	0xb0, 0x1c, 0x05, 0x1d, 0x75, 0x41, 0x72, 0x14, 0xfe, 0x06, 0x6b, 0x58, 0x94, 0xd6, 0xf0, 0x63,
-	0x58, 0x49, 0x93, 0x6e, 0x44, 0xc5, 0x5b, 0x50, 0xa7, 0x47, 0x03, 0xe7, 0xd7, 0x53, 0xbd, 0x09,
-	0x53, 0x3e, 0xc4, 0xc0, 0x59, 0x0e, 0x0a, 0xdb, 0x45, 0x8d, 0x37, 0x98, 0x3f, 0x60, 0x74, 0xa3,
-	0x6f, 0x1b, 0xf5, 0x33, 0x58, 0x88, 0x61, 0x89, 0xc9, 0x1f, 0xc0, 0x34, 0x66, 0x43, 0x83, 0x9f,
-	0x95, 0xf8, 0x57, 0x29, 0xe1, 0xac, 0x34, 0xc2, 0xdd, 0xc0, 0x72, 0x87, 0xfa, 0x09, 0x34, 0x22,
-	0x34, 0xc9, 0x6a, 0xe5, 0xd9, 0xc1, 0x3b, 0x30, 0xfb, 0xc4, 0x36, 0x4c, 0x8f, 0xb8, 0xe6, 0x61,
-	0x9f, 0x8c, 0x62, 0xfb, 0x97, 0x60, 0x2e, 0x82, 0x94, 0xb9, 0x04, 0x5f, 0xc1, 0xc2, 0xbe, 0x7e,
-	0xee, 0x91, 0xfe, 0xe1, 0xd5, 0x1c, 0xdd, 0x1d, 0x68, 0xc6, 0xe7, 0x17, 0x12, 0xdf, 0x81, 0x72,
-	0x8f, 0x8f, 0x09, 0x09, 0x90, 0xbc, 0x7b, 0xe9, 0x88, 0xe6, 0x93, 0x50, 0x37, 0xee, 0xf7, 0xe5,
-	0x36, 0xde, 0x4f, 0xa0, 0x11, 0x60, 0xe4, 0x12, 0xe2, 0x33, 0x98, 0x15, 0x7d, 0xcf, 0xcb, 0x79,
-	0x3f, 0x80, 0xb9, 0xc8, 0x0c, 0xb9, 0x04, 0xa5, 0xee, 0x2d, 0x6a, 0xf8, 0xff, 0x23, 0xf7, 0xf6,
-	0x21, 0xac, 0xa4, 0x49, 0x97, 0x4b, 0xdd, 0xd7, 0x00, 0x06, 0xee, 0x8e, 0x06, 0xee, 0x27, 0xd8,
-	0x0a, 0x2a, 0xfe, 0xf4, 0x9b, 0xf6, 0xb1, 0xba, 0x0c, 0x2f, 0xc0, 0xb0, 0x6f, 0xf5, 0xf7, 0x45,
-	0x28, 0x0b, 0x28, 0xa4, 0x42, 0x9d, 0xd7, 0xc6, 0xc4, 0x45, 0x9d, 0x7f, 0x45, 0xc7, 0x3a, 0x5b,
-	0xec, 0x9e, 0x0e, 0x5d, 0x83, 0x0a, 0xa7, 0x39, 0xc6, 0x7e, 0x61, 0x68, 0x92, 0x75, 0xbc, 0x87,
-	0x09, 0xda, 0x80, 0xe9, 0x60, 0xb0, 0x2d, 0x6a, 0x4a, 0x3c, 0x1d, 0x99, 0xf2, 0x69, 0x34, 0x5e,
-	0x5b, 0xba, 0x09, 0x8d, 0x01, 0x25, 0xcf, 0xbd, 0x79, 0x52, 0x52, 0xf7, 0x09, 0x79, 0x72, 0xb4,
-	0x06, 0xb5, 0x8e, 0xd3, 0xed, 0x05, 0x12, 0xf1, 0x2b, 0x48, 0xa0, 0x7d, 0x42, 0xa0, 0x45, 0x98,
-	0x64, 0x14, 0x54, 0x1e, 0x7e, 0x07, 0x59, 0xa6, 0x6d, 0x2a, 0xce, 0x4d, 0x68, 0xf8, 0x43, 0xbe,
-	0x34, 0x65, 0x3e, 0x89, 0xa0, 0x10, 0xc2, 0xdc, 0x80, 0xa9, 0x80, 0x8e, 0xcb, 0x32, 0xc9, 0x13,
-	0x24, 0x41, 0xc6, 0x45, 0xf1, 0x2d, 0x5a, 0x49, 0xb0, 0x28, 0x0c, 0x2c, 0x8a, 0xd6, 0xa0, 0x1a,
-	0xf2, 0x4d, 0xcd, 0x2a, 0x1b, 0x0a, 0x77, 0x21, 0x05, 0x26, 0x0d, 0xd3, 0xeb, 0x39, 0x1e, 0x36,
-	0x9a, 0x35, 0x6e, 0x42, 0xbf, 0x4d, 0x53, 0x9c, 0x1d, 0x6c, 0x19, 0xad, 0x2e, 0x4d, 0xca, 0x76,
-	0x78, 0xde, 0x93, 0xff, 0xb0, 0x7f, 0x3b, 0x06, 0x8b, 0x09, 0x70, 0x62, 0x7f, 0xed, 0x0f, 0x12,
-	0x30, 0xfe, 0xaf, 0x78, 0x3d, 0x04, 0x98, 0xca, 0x96, 0x30, 0xe2, 0xc3, 0x28, 0x6f, 0x01, 0x0c,
-	0x46, 0x43, 0x3b, 0xbf, 0x10, 0xde, 0xf9, 0xb4, 0x5f, 0xef, 0x06, 0x15, 0xa0, 0xa2, 0x26, 0x5a,
-	0xca, 0x37, 0x05, 0x98, 0x89, 0x81, 0xc7, 0x8e, 0x5c, 0x61, 0xf8, 0x91, 0xd3, 0xa0, 0x46, 0x97,
-	0xa7, 0xcd, 0x71, 0x69, 0xbe, 0x44, 0xb5, 0xbb, 0x7b, 0x49, 0xed, 0xb4, 0xea, 0x49, 0xf0, 0xed,
-	0xa9, 0x8f, 0xe0, 0x5a, 0x24, 0x18, 0x67, 0x77, 0xd6, 0xf9, 0xd7, 0xe6, 0x21, 0x2c, 0x25, 0x03,
-	0xe6, 0x0b, 0xf1, 0x1f, 0xc1, 0xb5, 0x96, 0x65, 0x0d, 0x72, 0xcc, 0x91, 0xe3, 0xfd, 0x8f, 0x60,
-	0x29, 0x19, 0x70, 0xc4, 0xe0, 0xab, 0x0b, 0xd7, 0x25, 0x5c, 0xee, 0xf4, 0x46, 0x15, 0x37, 0xf5,
-	0x67, 0xf2, 0x73, 0x50, 0xb3, 0xa6, 0x7b, 0x06, 0x69, 0x81, 0x0f, 0x3d, 0xb2, 0x0a, 0x39, 0xd3,
-	0x82, 0xd8, 0xfc, 0xcf, 0x22, 0x2d, 0x90, 0x7f, 0x49, 0x57, 0xa0, 0x5a, 0x66, 0x5a, 0x90, 0x22,
-	0xdd, 0x88, 0x8a, 0x3f, 0x84, 0x45, 0x1e, 0xfd, 0xee, 0x63, 0xf7, 0x19, 0x84, 0xeb, 0x1d, 0x50,
-	0x92, 0xe0, 0x9e, 0x6d, 0xc4, 0x1e, 0xde, 0x80, 0xa3, 0xc6, 0x86, 0x39, 0x83, 0xdb, 0xf8, 0xfc,
-	0xb9, 0xe3, 0x4a, 0xb6, 0x9c, 0x23, 0xab, 0x91, 0x15, 0x57, 0xca, 0x33, 0xe4, 0x8e, 0x2b, 0x23,
-	0x3b, 0xf0, 0x0a, 0x2c, 0x9f, 0x15, 0x57, 0xa6, 0x49, 0x97, 0x47, 0xdd, 0xad, 0x5f, 0x8f, 0x41,
-	0x59, 0xbc, 0x73, 0x41, 0xef, 0x42, 0x25, 0x78, 0x95, 0x86, 0xae, 0x85, 0xb8, 0xa2, 0x4f, 0xde,
-	0x94, 0xa5, 0xe4, 0x41, 0x21, 0xc1, 0x0e, 0x4c, 0xf0, 0x57, 0x32, 0x2b, 0x69, 0x8f, 0x69, 0x04,
-	0xcc, 0x6a, 0xea, 0xb8, 0x40, 0xea, 0xc0, 0x94, 0xfc, 0x7c, 0x07, 0xdd, 0x4a, 0x61, 0x89, 0x9e,
-	0x68, 0x65, 0x63, 0x38, 0x21, 0x9f, 0x64, 0xeb, 0x9f, 0x25, 0xa8, 0x04, 0xaf, 0x42, 0x90, 0x0e,
-	0xb5, 0xf0, 0x23, 0x1b, 0x69, 0xc2, 0xac, 0x87, 0x3d, 0xd2, 0x84, 0xd9, 0xef, 0x75, 0x4e, 0x61,
-	0x31, 0xf5, 0x45, 0x0c, 0x7a, 0x31, 0x09, 0x26, 0xa5, 0x38, 0xa5, 0xdc, 0xb9, 0x18, 0x71, 0x50,
-	0xf4, 0x9e, 0x8e, 0x12, 0x21, 0x35, 0x03, 0xc1, 0x9f, 0x65, 0x3d, 0x93, 0x46, 0x80, 0x77, 0x61,
-	0x3e, 0xf9, 0x75, 0x0a, 0xda, 0x88, 0xdd, 0x9c, 0xa7, 0xa9, 0x73, 0xfb, 0x02, 0x94, 0x62, 0x3a,
-	0x0d, 0xea, 0x12, 0x05, 0x5a, 0x4d, 0xe3, 0xf5, 0xc1, 0xd7, 0xd2, 0x09, 0x04, 0x66, 0x0f, 0x16,
-	0x52, 0xde, 0x87, 0xa0, 0xdb, 0xf1, 0x1b, 0xfd, 0x34, 0x25, 0x7e, 0x70, 0x11, 0x52, 0x31, 0xe3,
-	0x13, 0x98, 0x92, 0x49, 0xd0, 0x5a, 0x2a, 0xb7, 0x8f, 0x7f, 0x3d, 0x83, 0x62, 0x00, 0x2b, 0x3f,
-	0xd7, 0x90, 0x60, 0x13, 0xdf, 0x91, 0x48, 0xb0, 0x29, 0x6f, 0x3d, 0xde, 0x84, 0x09, 0x36, 0x82,
-	0x16, 0xa2, 0xb4, 0x3e, 0x48, 0x33, 0x3e, 0x20, 0x0e, 0xd9, 0xd7, 0x45, 0x18, 0xa7, 0x7e, 0x0e,
-	0xbd, 0x03, 0x65, 0x71, 0x9d, 0x8f, 0x16, 0x43, 0xd4, 0xf2, 0x33, 0x01, 0x45, 0x49, 0x1a, 0x12,
-	0x62, 0xec, 0x41, 0x35, 0x74, 0x37, 0x8f, 0x96, 0x43, 0xa4, 0xf1, 0xbb, 0x7f, 0x65, 0x25, 0x6d,
-	0x58, 0xa0, 0xed, 0x02, 0x0c, 0x6e, 0x81, 0xd1, 0x52, 0xca, 0xe5, 0x30, 0xc7, 0x5a, 0xce, 0xbc,
-	0x3a, 0x46, 0x9f, 0xc2, 0x4c, 0xec, 0xbe, 0x08, 0xad, 0x67, 0xdf, 0x26, 0x71, 0xe0, 0x1b, 0x17,
-	0xb9, 0x72, 0x42, 0xf7, 0x60, 0xd2, 0xbf, 0xc4, 0x41, 0x61, 0x03, 0x45, 0xae, 0x87, 0x94, 0x6b,
-	0x89, 0x63, 0x62, 0x21, 0xfe, 0x55, 0x61, 0x25, 0x01, 0xa7, 0x4f, 0x3c, 0xba, 0x16, 0xfe, 0xbe,
-	0x0b, 0xaf, 0x45, 0x64, 0xc3, 0x29, 0x49, 0x43, 0x83, 0x63, 0x28, 0x55, 0xe2, 0xa5, 0x63, 0x98,
-	0x74, 0x0b, 0x20, 0x1d, 0xc3, 0xe4, 0x22, 0xfe, 0x53, 0x98, 0x8e, 0x9d, 0x3f, 0x35, 0xce, 0x15,
-	0xdb, 0xc1, 0xeb, 0x99, 0x34, 0x03, 0x37, 0x95, 0x5c, 0x76, 0x96, 0xdc, 0x54, 0x66, 0xdd, 0x5c,
-	0x72, 0x53, 0x43, 0x6a, 0xd8, 0x6f, 0x43, 0x89, 0x07, 0x79, 0xa8, 0x19, 0x8b, 0xfb, 0x7c, 0xb8,
-	0xc5, 0x84, 0x11, 0xc1, 0xfe, 0x71, 0xbc, 0x62, 0x7b, 0x3d, 0x23, 0x7e, 0x14, 0x80, 0x6a, 0x16,
-	0x89, 0x40, 0xf6, 0xa0, 0x99, 0x76, 0x39, 0x86, 0xc2, 0x1e, 0x6c, 0xc8, 0x4d, 0x9e, 0xf2, 0xe2,
-	0x85, 0x68, 0x43, 0xea, 0xc8, 0x34, 0xb2, 0x3a, 0x89, 0x57, 0x6b, 0xb2, 0x3a, 0x29, 0x37, 0x66,
-	0x1a, 0xd4, 0xa5, 0xa2, 0xb1, 0xb4, 0x0f, 0x93, 0x0a, 0xd3, 0xd2, 0x3e, 0x4c, 0xae, 0x37, 0x3f,
-	0x85, 0xe9, 0x68, 0x09, 0x4f, 0xda, 0x87, 0x29, 0x65, 0x67, 0x69, 0x1f, 0xa6, 0x96, 0x86, 0xdf,
-	0x19, 0x14, 0xe6, 0x16, 0x13, 0xe2, 0xb3, 0x84, 0xa3, 0x17, 0x8d, 0xf3, 0x34, 0xa8, 0x4b, 0x75,
-	0x54, 0x49, 0xe5, 0xa4, 0x1a, 0xae, 0xa4, 0x72, 0x72, 0x09, 0xb6, 0x0b, 0xf3, 0xc9, 0x55, 0x4b,
-	0xe9, 0x74, 0x64, 0x96, 0x5d, 0xa5, 0xd3, 0x31, 0xa4, 0x04, 0xfa, 0x69, 0x52, 0x45, 0x68, 0x3d,
-	0xbb, 0x90, 0x13, 0x77, 0x98, 0xa9, 0xd5, 0x9e, 0xad, 0x7f, 0x57, 0xa0, 0x24, 0xf6, 0xd9, 0x31,
-	0xcc, 0x26, 0xd5, 0x3b, 0xd0, 0xcd, 0xf0, 0xab, 0x84, 0xf4, 0x0a, 0x8b, 0x72, 0x6b, 0x28, 0x9d,
-	0xd0, 0xe9, 0x1c, 0x94, 0xf4, 0x8a, 0x04, 0xba, 0x93, 0x06, 0x93, 0x94, 0x89, 0x2b, 0x2f, 0x5d,
-	0x90, 0x3a, 0xe4, 0x38, 0x23, 0xe5, 0x02, 0xd9, 0x71, 0x26, 0xd7, 0x32, 0x64, 0xc7, 0x99, 0x56,
-	0x6f, 0xa0, 0x8e, 0x33, 0x31, 0x31, 0x97, 0x1d, 0x67, 0x56, 0x65, 0x41, 0x76, 0x9c, 0xd9, 0x59,
-	0xfe, 0x88, 0x8e, 0x53, 0x07, 0x14, 0xcf, 0xce, 0xd1, 0x8d, 0x18, 0x43, 0x42, 0x2d, 0x40, 0xf9,
-	0xfe, 0x10, 0xaa, 0xab, 0xf4, 0xa0, 0xc7, 0x30, 0x9b, 0x54, 0x56, 0x94, 0xb6, 0x71, 0x46, 0x21,
-	0x53, 0xda, 0xc6, 0x99, 0xf5, 0xc9, 0xe7, 0xe4, 0x50, 0xa3, 0xd5, 0x84, 0xe4, 0xfd, 0x19, 0xf1,
-	0x82, 0xeb, 0x99, 0x34, 0xcf, 0xd4, 0xa1, 0x86, 0x33, 0x6a, 0xd9, 0xa1, 0x26, 0x54, 0x02, 0x64,
-	0x87, 0x9a, 0x98, 0x8c, 0xc7, 0x4f, 0x8d, 0x0f, 0x9e, 0x71, 0x6a, 0x22, 0xb3, 0xdc, 0xbe, 0x00,
-	0x25, 0x9f, 0x6e, 0xfb, 0xc6, 0x27, 0x2a, 0xf5, 0x80, 0x9f, 0x6f, 0x9a, 0xce, 0x5d, 0xf6, 0x71,
-	0xb7, 0xe7, 0x9a, 0xa7, 0x3a, 0xc1, 0x77, 0x03, 0x88, 0xde, 0xe1, 0x61, 0x89, 0xbd, 0x72, 0x7b,
-	0xf5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xda, 0x95, 0x8e, 0x70, 0x40, 0x37, 0x00, 0x00,
+	// 3194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x4b, 0x6f, 0x23, 0xc7,
+	0xb5, 0xbe, 0x14, 0x47, 0xa4, 0x78, 0x48, 0x49, 0xa3, 0xb2, 0x1e, 0x54, 0x8f, 0x1e, 0x54, 0x6b,
+	0xee, 0x8c, 0xe4, 0x19, 0x4b, 0xb6, 0x6c, 0xf8, 0x5e, 0x3b, 0x76, 0x62, 0x6a, 0x46, 0xb6, 0x64,
+	0xcf, 0x78, 0xe4, 0xd6, 0xc8, 0x36, 0xec, 0xc0, 0x74, 0x8b, 0x5d, 0x92, 0xda, 0xd3, 0xec, 0xa6,
+	0xbb, 0x8b, 0x92, 0x85, 0x04, 0x4e, 0x16, 0x89, 0x83, 0x2c, 0x02, 0x04, 0xc8, 0xce, 0xc8, 0xaf,
+	0xc8, 0x26, 0xcb, 0xec, 0x02, 0x03, 0xf9, 0x07, 0x59, 0x38, 0x40, 0x76, 0xd9, 0x64, 0x93, 0x5d,
+	0x56, 0x41, 0x3d, 0xfa, 0xfd, 0x20, 0xd5, 0x1c, 0x5b, 0xd9, 0xb1, 0xaa, 0xbe, 0xf3, 0xd5, 0xa9,
+	0x53, 0x55, 0xa7, 0xab, 0xce, 0x29, 0xc2, 0x64, 0xa7, 0x67, 0x10, 0xdd, 0xb4, 0x34, 0xbc, 0xd1,
+	0xb5, 0x2d, 0x62, 0xa1, 0x8a, 0x57, 0x21, 0xc1, 0x89, 0x75, 0x62, 0xf1, 0x6a, 0x69, 0xf9, 0xc4,
+	0xb2, 0x4e, 0x0c, 0xbc, 0xc9, 0x4a, 0x47, 0xbd, 0xe3, 0x4d, 0xa2, 0x77, 0xb0, 0x43, 0xd4, 0x4e,
+	0x97, 0x03, 0xe4, 0x35, 0x18, 0x57, 0xf0, 0xe7, 0x3d, 0xec, 0x90, 0x5d, 0xac, 0x6a, 0xd8, 0x46,
+	0x73, 0x50, 0x56, 0xbb, 0x7a, 0xeb, 0x09, 0xbe, 0xa8, 0x17, 0x1a, 0x85, 0xb5, 0x9a, 0x52, 0x52,
+	0xbb, 0xfa, 0x3b, 0xf8, 0x42, 0xbe, 0x0f, 0xd7, 0xef, 0xeb, 0xce, 0x93, 0x83, 0xae, 0xda, 0xc6,
+	0x42, 0x04, 0x3d, 0x0f, 0xa5, 0x53, 0x26, 0xc6, 0xb0, 0xd5, 0xad, 0xfa, 0x86, 0xaf, 0x57, 0x88,
+	0x56, 0x11, 0x38, 0xf9, 0x4f, 0x05, 0x98, 0x0a, 0xd0, 0x38, 0x5d, 0xcb, 0x74, 0x30, 0x5a, 0x80,
+	0x8a, 0x6a, 0x18, 0x56, 0x5b, 0x25, 0x58, 0x63, 0x54, 0x45, 0xc5, 0xaf, 0x40, 0xcb, 0x50, 0xed,
+	0x39, 0x58, 0x6b, 0x75, 0x75, 0xdc, 0xc6, 0x4e, 0x7d, 0x84, 0xb5, 0x03, 0xad, 0xda, 0x67, 0x35,
+	0x68, 0x11, 0x58, 0xa9, 0x45, 0x6c, 0xd5, 0x39, 0xad, 0x17, 0xb9, 0x3c, 0xad, 0x79, 0x4c, 0x2b,
+	0x10, 0x82, 0x6b, 0xc7, 0x36, 0xc6, 0xf5, 0x6b, 0xac, 0x81, 0xfd, 0x66, 0x3d, 0x9e, 0xa9, 0xba,
+	0xa1, 0x1e, 0x19, 0xb8, 0x3e, 0x2a, 0x7a, 0x74, 0x2b, 0x90, 0x04, 0x63, 0xd6, 0x19, 0xb6, 0x29,
+	0x45, 0xbd, 0xc4, 0x1a, 0xbd, 0xb2, 0xfc, 0x33, 0xa8, 0x1d, 0x10, 0xcb, 0x56, 0x4f, 0xf0, 0xa1,
+	0xa3, 0x9e, 0x60, 0x24, 0xc3, 0xb8, 0x4a, 0x5a, 0x36, 0x76, 0x48, 0x8b, 0x58, 0x44, 0x35, 0x98,
+	0xfe, 0x05, 0xa5, 0xaa, 0x12, 0x05, 0x3b, 0xe4, 0x31, 0xad, 0x42, 0xef, 0xc0, 0x84, 0x6e, 0x12,
+	0x6c, 0x9f, 0xa9, 0x46, 0xcb, 0x21, 0xaa, 0x4d, 0xd8, 0x20, 0xaa, 0x5b, 0xd2, 0x06, 0x9f, 0x9f,
+	0x0d, 0x77, 0x7e, 0x36, 0x1e, 0xbb, 0xf3, 0xb3, 0x3d, 0xf6, 0xcd, 0xb7, 0xcb, 0xff, 0xf3, 0xdb,
+	0xbf, 0x2d, 0x17, 0x94, 0x71, 0x57, 0xf6, 0x80, 0x8a, 0xca, 0x7f, 0x2c, 0xc0, 0x33, 0x41, 0x0d,
+	0x72, 0x4f, 0x06, 0xfa, 0x7f, 0x6a, 0x18, 0xab, 0x73, 0x29, 0x65, 0x98, 0x04, 0x7a, 0x09, 0x46,
+	0x88, 0x55, 0x2f, 0x5e, 0x42, 0x6e, 0x84, 0x58, 0xb2, 0x09, 0xd3, 0x61, 0xc5, 0xc5, 0xf4, 0xbf,
+	0x06, 0xe3, 0x0e, 0xaf, 0x6f, 0xf5, 0x68, 0x43, 0xbd, 0xd0, 0x28, 0xae, 0x55, 0xb7, 0xe6, 0x02,
+	0x03, 0x08, 0xc9, 0xd5, 0x9c, 0xe0, 0x04, 0xd4, 0xa1, 0xec, 0xf4, 0x3a, 0x1d, 0xd5, 0xbe, 0x60,
+	0x03, 0x29, 0x28, 0x6e, 0x51, 0xfe, 0x57, 0x01, 0x16, 0x82, 0x82, 0x07, 0x2a, 0xc1, 0x86, 0xa1,
+	0x93, 0x21, 0x4c, 0xf6, 0x02, 0xd4, 0x1c, 0x97, 0xa5, 0xa5, 0x6b, 0xac, 0xc7, 0xda, 0xf6, 0x04,
+	0x1d, 0xe6, 0x5f, 0xbf, 0x5d, 0x2e, 0xbd, 0x6b, 0x69, 0x78, 0xef, 0xbe, 0x52, 0xf5, 0x30, 0x7b,
+	0x9a, 0x67, 0xe5, 0x62, 0x4e, 0x2b, 0x5f, 0xbb, 0xa4, 0x95, 0xcf, 0x61, 0x31, 0x65, 0xd0, 0xdf,
+	0xb1, 0xb9, 0xf7, 0x61, 0x61, 0x5b, 0x35, 0xb5, 0x73, 0x5d, 0x23, 0xa7, 0x0f, 0x2d, 0x93, 0x9c,
+	0x1e, 0xf0, 0x86, 0xfc, 0xde, 0xe2, 0x45, 0x58, 0x4c, 0x61, 0x14, 0x43, 0x41, 0x70, 0x8d, 0x6d,
+	0x52, 0xee, 0x33, 0xd8, 0x6f, 0xf9, 0x57, 0x05, 0x68, 0x78, 0x52, 0x42, 0xe0, 0x4a, 0x66, 0x5e,
+	0x7e, 0x1d, 0x56, 0x32, 0x14, 0x11, 0x43, 0x08, 0xd8, 0x93, 0x8f, 0xc2, 0xb3, 0xe7, 0x3b, 0x30,
+	0x17, 0x15, 0xcf, 0x6f, 0xca, 0x97, 0xa0, 0x1e, 0x27, 0xeb, 0xab, 0xc2, 0x2f, 0x0a, 0xb0, 0xb8,
+	0x73, 0x62, 0x63, 0xc7, 0xb9, 0x52, 0x43, 0xbe, 0x0a, 0x4b, 0x69, 0x5a, 0xf4, 0x1d, 0xc2, 0x2e,
+	0x4c, 0x87, 0x64, 0xf3, 0x9b, 0xf0, 0x05, 0x98, 0x89, 0x30, 0xf5, 0xed, 0xfc, 0x97, 0x05, 0x58,
+	0xda, 0x33, 0xaf, 0xde, 0x80, 0x3f, 0x80, 0xe5, 0x54, 0x35, 0xfa, 0x0e, 0x62, 0x0f, 0x66, 0xc2,
+	0xc2, 0xf9, 0x4d, 0xb8, 0x05, 0xb3, 0x51, 0xaa, 0xbe, 0xdd, 0xff, 0x14, 0x66, 0xee, 0xab, 0xba,
+	0x71, 0x45, 0x96, 0x3b, 0x80, 0xd9, 0x68, 0xef, 0x42, 0xe3, 0x57, 0xa0, 0xc6, 0xdc, 0x67, 0xcb,
+	0xb6, 0x0c, 0xa3, 0xd7, 0x15, 0x5e, 0x74, 0x36, 0xa0, 0x04, 0x77, 0x9f, 0xac, 0x55, 0xa9, 0xf6,
+	0xfc, 0x82, 0xfc, 0x06, 0xd4, 0x18, 0x69, 0x7e, 0x43, 0xbe, 0x0d, 0xe3, 0x82, 0x61, 0x78, 0x6d,
+	0xfe, 0x52, 0x80, 0x6a, 0xa0, 0x11, 0xad, 0x43, 0x09, 0xb3, 0x39, 0x12, 0xda, 0x4c, 0x05, 0x48,
+	0xf8, 0x06, 0x50, 0x04, 0x00, 0xdd, 0x85, 0xb2, 0xce, 0xe7, 0x53, 0x1c, 0x22, 0x50, 0x00, 0x2b,
+	0x66, 0x5a, 0x71, 0x21, 0x68, 0x16, 0x4a, 0x1a, 0x36, 0x30, 0xc1, 0xe2, 0x8c, 0x26, 0x4a, 0x09,
+	0xc7, 0xa3, 0x6b, 0xf9, 0x8f, 0x47, 0x0f, 0xa0, 0xb4, 0xe3, 0x75, 0x67, 0xe3, 0xae, 0xaa, 0xdb,
+	0x62, 0x45, 0x89, 0x12, 0x9a, 0x86, 0x51, 0xb5, 0xa7, 0xe9, 0x44, 0x9c, 0x24, 0x79, 0x81, 0xd6,
+	0xf2, 0xaf, 0x21, 0xd7, 0x8d, 0x17, 0xe4, 0xff, 0x83, 0xf2, 0x9e, 0x19, 0xa6, 0xd3, 0x42, 0x74,
+	0x9a, 0x2f, 0x38, 0x12, 0x14, 0xdc, 0x86, 0x89, 0xf7, 0xb1, 0xed, 0xe8, 0x96, 0x99, 0x7f, 0x92,
+	0xef, 0xc0, 0xa4, 0xc7, 0xe1, 0x6f, 0x93, 0x33, 0x5e, 0xc5, 0x58, 0x2a, 0x8a, 0x5b, 0x94, 0xdf,
+	0x04, 0xf4, 0x40, 0x75, 0xc8, 0x3d, 0xcb, 0x24, 0x6a, 0x9b, 0xe4, 0xef, 0xf4, 0x13, 0x78, 0x26,
+	0xc4, 0x23, 0x3a, 0x7e, 0x0b, 0x6a, 0x86, 0xea, 0x90, 0x56, 0x9b, 0xd7, 0xd7, 0x0b, 0x97, 0x98,
+	0xa1, 0xaa, 0xe1, 0x13, 0xca, 0x5f, 0xc0, 0x94, 0x82, 0xbb, 0x3d, 0xa2, 0x92, 0x61, 0x6c, 0x93,
+	0x67, 0x2b, 0x7f, 0x5d, 0x80, 0x6a, 0x93, 0xce, 0xf5, 0x07, 0xba, 0xa9, 0x59, 0xe7, 0x74, 0x48,
+	0xe7, 0xec, 0x97, 0x58, 0x74, 0x97, 0x1a, 0x12, 0x97, 0x64, 0x4b, 0x0e, 0xad, 0x40, 0xcd, 0x32,
+	0x0d, 0xdd, 0xc4, 0xad, 0xb6, 0xd5, 0x33, 0xf9, 0xba, 0x1a, 0x55, 0xaa, 0xbc, 0xee, 0x1e, 0xad,
+	0xa2, 0x77, 0x18, 0x76, 0x3b, 0x10, 0x88, 0x22, 0x43, 0x00, 0xab, 0x62, 0x00, 0xf9, 0xdf, 0x65,
+	0x40, 0x41, 0xbb, 0x78, 0x67, 0xb5, 0x12, 0xa7, 0x11, 0xda, 0xdd, 0x0c, 0x19, 0x26, 0x0a, 0xdf,
+	0x78, 0xc4, 0xb0, 0x8a, 0x90, 0x41, 0xaf, 0x04, 0x57, 0x7a, 0x75, 0x6b, 0x35, 0x5b, 0x98, 0xd9,
+	0xc6, 0xdd, 0x0e, 0x0f, 0x61, 0x52, 0xd3, 0x9d, 0xcf, 0x7b, 0xaa, 0xa1, 0x1f, 0xeb, 0x58, 0x6b,
+	0xa9, 0x64, 0xc0, 0x03, 0x6c, 0x81, 0xd9, 0x67, 0x22, 0x28, 0xdc, 0x24, 0xd4, 0xd6, 0x4e, 0xcf,
+	0xe9, 0x62, 0x53, 0xe3, 0x5c, 0xd7, 0x2e, 0xc1, 0x55, 0xf5, 0x24, 0x9b, 0x04, 0xbd, 0x0f, 0xd3,
+	0xd6, 0xf1, 0x31, 0x33, 0x76, 0x88, 0x70, 0xf4, 0x12, 0x84, 0x48, 0x30, 0x1c, 0x04, 0x78, 0x3f,
+	0x86, 0x39, 0x97, 0xb7, 0x67, 0x6a, 0xd8, 0x6e, 0xd9, 0xf8, 0x4c, 0xc7, 0xe7, 0x94, 0xba, 0x74,
+	0x09, 0x6a, 0x57, 0xb9, 0x43, 0xca, 0xa1, 0x30, 0x8a, 0x26, 0x41, 0x4d, 0xa8, 0x9c, 0x61, 0x42,
+	0xb8, 0xa6, 0x95, 0x4b, 0xd0, 0x8d, 0x71, 0xb1, 0x26, 0x41, 0xf7, 0x00, 0x7a, 0x5d, 0x4d, 0x15,
+	0x1c, 0xe5, 0x4b, 0x2c, 0xd5, 0x8a, 0x90, 0xe3, 0x7a, 0x7c, 0x66, 0xe9, 0x26, 0xe7, 0x18, 0xbb,
+	0x04, 0xc7, 0x18, 0x17, 0x6b, 0x12, 0x69, 0x09, 0x4a, 0x7c, 0x91, 0x51, 0xbf, 0xe7, 0xb4, 0x2d,
+	0x1b, 0x8b, 0x0b, 0x2f, 0x2f, 0x48, 0x7f, 0x18, 0x81, 0xd1, 0xa6, 0xeb, 0x50, 0xe3, 0xed, 0x68,
+	0x1d, 0xae, 0xf3, 0x79, 0xa3, 0x4e, 0xab, 0xc5, 0x01, 0xfc, 0x1e, 0x31, 0xe9, 0xd7, 0x1f, 0x30,
+	0x68, 0xc2, 0x9e, 0x29, 0x06, 0xf7, 0x0c, 0x5a, 0x85, 0x71, 0xa7, 0xd7, 0x6e, 0x63, 0xc7, 0x11,
+	0x10, 0x7e, 0xc3, 0xaf, 0x89, 0x4a, 0x0e, 0xa2, 0xde, 0xde, 0xe8, 0x9e, 0xaa, 0x6c, 0x85, 0x14,
+	0x14, 0x5e, 0xa0, 0x17, 0x87, 0x23, 0x4c, 0x54, 0x36, 0xb7, 0x05, 0x85, 0xfd, 0xa6, 0x74, 0x3d,
+	0xf3, 0x89, 0x69, 0x9d, 0x9b, 0x2d, 0x2e, 0x51, 0x66, 0x8d, 0x35, 0x51, 0xd9, 0x64, 0x82, 0x2b,
+	0xe0, 0x96, 0x5b, 0x8c, 0x60, 0x8c, 0xdf, 0xf6, 0x45, 0xdd, 0x36, 0xe5, 0x79, 0x1e, 0xca, 0xa7,
+	0xba, 0x43, 0x2c, 0xfb, 0xa2, 0x5e, 0x89, 0x7d, 0x85, 0x03, 0x0e, 0x48, 0x71, 0x61, 0xf2, 0x03,
+	0xa8, 0x3f, 0xb6, 0x7b, 0x0e, 0xc1, 0x9a, 0x77, 0xcc, 0x70, 0xf2, 0x7b, 0xf0, 0x3f, 0x17, 0x60,
+	0x3e, 0x81, 0x4e, 0x78, 0x94, 0x8f, 0x01, 0x11, 0xde, 0xd8, 0xf2, 0x9c, 0xa3, 0x23, 0x8e, 0x0b,
+	0x77, 0x03, 0xdc, 0xa9, 0x0c, 0x1b, 0xd4, 0xb7, 0x1e, 0x2a, 0x0f, 0x94, 0x29, 0x12, 0x85, 0x48,
+	0x0f, 0xa0, 0x2c, 0x5a, 0xd1, 0x6d, 0x28, 0x53, 0x9e, 0x96, 0xf8, 0x5e, 0xc6, 0x7d, 0x73, 0x89,
+	0x36, 0xef, 0x69, 0xf4, 0x93, 0xa6, 0x6a, 0x9a, 0x77, 0x86, 0xa8, 0x28, 0x6e, 0x51, 0xbe, 0x07,
+	0x93, 0x8f, 0xba, 0xd8, 0x56, 0x89, 0x65, 0xe7, 0xb7, 0x86, 0x0e, 0xd7, 0x7d, 0x12, 0x61, 0x83,
+	0x69, 0x18, 0xc5, 0x1d, 0x55, 0x37, 0xc4, 0x37, 0x94, 0x17, 0xe8, 0x07, 0xfe, 0x5c, 0x35, 0x0c,
+	0x4c, 0x84, 0x1e, 0xa2, 0x84, 0x6e, 0xc3, 0x24, 0xff, 0xd5, 0x3a, 0xc6, 0x2a, 0xe9, 0xd9, 0xd8,
+	0xa9, 0x17, 0x1b, 0xc5, 0xb5, 0x8a, 0x32, 0xc1, 0xab, 0xdf, 0x14, 0xb5, 0xf2, 0x57, 0x05, 0x58,
+	0xde, 0x71, 0x88, 0xde, 0xa1, 0xdb, 0x6d, 0x5f, 0xbd, 0xb0, 0x7a, 0xe4, 0x6a, 0x0e, 0xad, 0xef,
+	0x41, 0x23, 0x5d, 0x0f, 0x61, 0x83, 0xe7, 0x00, 0x61, 0x17, 0xd3, 0xc2, 0xaa, 0x6d, 0xea, 0xe6,
+	0x89, 0x23, 0x8e, 0x36, 0x53, 0x5e, 0xcb, 0x8e, 0x68, 0x90, 0xdf, 0x86, 0xd9, 0x08, 0x65, 0xfe,
+	0x29, 0xd9, 0x85, 0xb9, 0x18, 0x57, 0x3e, 0xad, 0xb6, 0x61, 0x62, 0xe8, 0x3b, 0xc9, 0x1e, 0x4c,
+	0x46, 0x2f, 0x23, 0x2f, 0x43, 0xb5, 0xcb, 0xf4, 0x6a, 0xe9, 0xe6, 0xb1, 0x25, 0x98, 0x66, 0x02,
+	0x4c, 0x5c, 0xeb, 0x3d, 0xf3, 0xd8, 0x52, 0xa0, 0xeb, 0xfd, 0x96, 0x3f, 0x85, 0x69, 0x41, 0xb5,
+	0x8f, 0x6d, 0xdd, 0xd2, 0xf2, 0x4f, 0xfa, 0x2c, 0x94, 0xba, 0x8c, 0xc2, 0x5d, 0x8b, 0xbc, 0x24,
+	0x3f, 0x82, 0x99, 0x48, 0x0f, 0x43, 0xaa, 0xfc, 0x25, 0xcc, 0x5d, 0xe9, 0xcd, 0x54, 0x81, 0x7a,
+	0xea, 0x95, 0x34, 0xef, 0x98, 0x7e, 0x5f, 0x80, 0xc5, 0x28, 0xe9, 0xb0, 0x13, 0x92, 0x23, 0xf0,
+	0xe7, 0xcf, 0x61, 0x31, 0x34, 0x87, 0x1f, 0xc2, 0x52, 0x9a, 0x76, 0x43, 0x0e, 0xbc, 0x09, 0xe3,
+	0x74, 0x6b, 0xe0, 0xfc, 0xe3, 0x94, 0x6f, 0xc1, 0x84, 0x4b, 0xe1, 0x3b, 0x4b, 0x3f, 0xb0, 0x5d,
+	0x54, 0x78, 0x81, 0xf9, 0x03, 0x86, 0x1b, 0x7e, 0xd9, 0xc8, 0x9f, 0xc2, 0x5c, 0x8c, 0x4b, 0x74,
+	0xbe, 0x03, 0xd7, 0x31, 0x6b, 0xf2, 0x3f, 0x56, 0xe2, 0x5b, 0x25, 0x05, 0x6f, 0xa5, 0x11, 0xe9,
+	0x49, 0x1c, 0xae, 0x90, 0x3f, 0x82, 0xc9, 0x08, 0x26, 0x79, 0x58, 0x79, 0x56, 0xf0, 0x2e, 0x4c,
+	0x1f, 0x9a, 0x9a, 0xee, 0x10, 0x5b, 0x3f, 0xea, 0x91, 0x61, 0x6c, 0xff, 0x1c, 0xcc, 0x44, 0x98,
+	0x32, 0xa7, 0xe0, 0x4b, 0x98, 0xdb, 0x57, 0x2f, 0x1c, 0xd2, 0x3b, 0xba, 0x9a, 0xad, 0xbb, 0x0b,
+	0xf5, 0x78, 0xff, 0x42, 0xe3, 0xbb, 0x50, 0xee, 0xf2, 0xb6, 0x7a, 0x21, 0x16, 0x18, 0x10, 0x52,
+	0x8a, 0x0b, 0xa1, 0x6e, 0xdc, 0xad, 0xcb, 0x6d, 0xbc, 0x1f, 0xc1, 0xa4, 0xc7, 0x91, 0x4b, 0x89,
+	0x4f, 0x61, 0x5a, 0xd4, 0x7d, 0x57, 0xce, 0x7b, 0x07, 0x66, 0x22, 0x3d, 0xe4, 0x52, 0x94, 0xba,
+	0xb7, 0xa8, 0xe1, 0xff, 0x8b, 0xdc, 0xdb, 0xbb, 0xb0, 0x94, 0xa6, 0x5d, 0xae, 0xe1, 0xbe, 0x04,
+	0xe0, 0xbb, 0x3b, 0x7a, 0x70, 0x3f, 0xc5, 0x86, 0x17, 0xf1, 0xa7, 0xbf, 0x69, 0x5d, 0x57, 0x15,
+	0x4a, 0x17, 0x15, 0xf6, 0x5b, 0xfe, 0x4d, 0x11, 0xca, 0x82, 0x8a, 0xa6, 0xe8, 0x78, 0x6c, 0x4c,
+	0x24, 0xea, 0xdc, 0x14, 0x1d, 0xab, 0x6c, 0xb2, 0x3c, 0x1d, 0xba, 0x01, 0x15, 0x8e, 0x39, 0xc1,
+	0x6e, 0x60, 0x68, 0x8c, 0x55, 0xbc, 0x85, 0x09, 0x5a, 0x83, 0xeb, 0x5e, 0x63, 0x4b, 0xc4, 0x94,
+	0xf8, 0x75, 0x64, 0xc2, 0xc5, 0x28, 0xac, 0x16, 0xdd, 0x82, 0x49, 0x1f, 0xc9, 0xef, 0xde, 0xfc,
+	0x52, 0x32, 0xee, 0x02, 0xf9, 0xe5, 0xa8, 0x01, 0xb5, 0xb6, 0xd5, 0xe9, 0x7a, 0x1a, 0xf1, 0x14,
+	0x24, 0xd0, 0x3a, 0xa1, 0xd0, 0x3c, 0x8c, 0x31, 0x04, 0xd5, 0x87, 0xe7, 0x20, 0xcb, 0xb4, 0x4c,
+	0xd5, 0xb9, 0x05, 0x93, 0x6e, 0x93, 0xab, 0x4d, 0x99, 0x77, 0x22, 0x10, 0x42, 0x99, 0x9b, 0x30,
+	0xe1, 0xe1, 0xb8, 0x2e, 0x63, 0xfc, 0x82, 0x24, 0x60, 0x5c, 0x15, 0xd7, 0xa2, 0x95, 0x04, 0x8b,
+	0x82, 0x6f, 0x51, 0xd4, 0x80, 0x6a, 0xc0, 0x37, 0xd5, 0xab, 0xac, 0x29, 0x58, 0x45, 0xd3, 0xa6,
+	0x9a, 0xee, 0x74, 0x2d, 0x07, 0x6b, 0xf5, 0x1a, 0x37, 0xa1, 0x5b, 0xa6, 0x57, 0x9c, 0x5d, 0x6c,
+	0x68, 0xcd, 0x0e, 0xbd, 0x94, 0xed, 0xf2, 0x7b, 0x4f, 0xfe, 0xcd, 0xfe, 0xcd, 0x08, 0xcc, 0x27,
+	0xd0, 0x89, 0xf5, 0xb5, 0xef, 0x5f, 0xc0, 0xf8, 0xb7, 0xe2, 0xe5, 0x00, 0x61, 0xaa, 0x58, 0x42,
+	0x8b, 0x4b, 0x23, 0xbd, 0x06, 0xe0, 0xb7, 0x06, 0x56, 0x7e, 0x21, 0xb8, 0xf2, 0x69, 0xbd, 0xda,
+	0xf1, 0x22, 0x40, 0x45, 0x45, 0x94, 0xa4, 0xaf, 0x0b, 0x30, 0x15, 0x23, 0x8f, 0x6d, 0xb9, 0x42,
+	0xff, 0x2d, 0xa7, 0x40, 0x8d, 0x4e, 0x4f, 0x8b, 0xf3, 0xd2, 0xfb, 0x12, 0x1d, 0xdd, 0xe6, 0x25,
+	0x47, 0xa7, 0x54, 0x4f, 0xbd, 0xdf, 0x8e, 0xfc, 0x08, 0x6e, 0x44, 0x0e, 0xe3, 0x2c, 0x67, 0x9d,
+	0x7f, 0x6e, 0x1e, 0xc2, 0x42, 0x32, 0x61, 0xbe, 0x23, 0xfe, 0x23, 0xb8, 0xd1, 0x34, 0x0c, 0xff,
+	0x8e, 0x39, 0xf4, 0x79, 0xff, 0x7d, 0x58, 0x48, 0x26, 0x1c, 0xf2, 0xf0, 0xd5, 0x81, 0x95, 0x10,
+	0x2f, 0x77, 0x7a, 0xc3, 0xaa, 0x9b, 0xfa, 0x31, 0xf9, 0x31, 0xc8, 0x59, 0xdd, 0x3d, 0x85, 0x6b,
+	0x81, 0x4b, 0x3d, 0xf4, 0x10, 0x72, 0x5e, 0x0b, 0x62, 0xfd, 0x3f, 0x8d, 0x6b, 0x41, 0xf8, 0x93,
+	0x74, 0x05, 0x43, 0xcb, 0xbc, 0x16, 0xa4, 0x68, 0x37, 0xe4, 0xc0, 0x1f, 0xc2, 0x3c, 0x3f, 0xfd,
+	0xee, 0x63, 0xfb, 0x29, 0x1c, 0xd7, 0xdb, 0x20, 0x25, 0xd1, 0x3d, 0xdd, 0x13, 0x7b, 0x70, 0x01,
+	0x0e, 0x7b, 0x36, 0xcc, 0x79, 0xb8, 0x8d, 0xf7, 0x9f, 0xfb, 0x5c, 0xc9, 0xa6, 0x73, 0xe8, 0x61,
+	0x64, 0x9d, 0x2b, 0xc3, 0x3d, 0xe4, 0x3e, 0x57, 0x46, 0x56, 0xe0, 0x15, 0x58, 0x3e, 0xeb, 0x5c,
+	0x99, 0xa6, 0x5d, 0xae, 0xe1, 0x9a, 0xb0, 0x70, 0x80, 0x49, 0xd3, 0x7d, 0x66, 0x36, 0xfc, 0xe3,
+	0xb6, 0xf0, 0x33, 0xb6, 0x91, 0xc8, 0x33, 0x36, 0xf9, 0x75, 0x58, 0x4c, 0xe9, 0x6f, 0x90, 0x57,
+	0x70, 0xf2, 0xaf, 0x0b, 0x20, 0xd3, 0x1c, 0x9a, 0x6d, 0x19, 0x7b, 0xa6, 0x4e, 0x74, 0x95, 0xe0,
+	0xb7, 0x6c, 0xb5, 0x8d, 0x8f, 0x7b, 0xc6, 0xce, 0x17, 0x3a, 0xf9, 0x5e, 0x37, 0xc7, 0x97, 0xb0,
+	0x9a, 0xa9, 0x8a, 0x18, 0xd0, 0x1d, 0x98, 0xf2, 0x99, 0xdd, 0x18, 0x2f, 0x3f, 0x32, 0x5d, 0xf7,
+	0x1a, 0x9a, 0xbc, 0x1e, 0x3d, 0x0b, 0x53, 0x47, 0x17, 0x04, 0x3b, 0x2d, 0x62, 0xd1, 0x87, 0x7c,
+	0xa6, 0x73, 0x8c, 0x6d, 0x61, 0xc4, 0x49, 0xd6, 0xf0, 0xd8, 0x7a, 0x2c, 0xaa, 0x69, 0xfe, 0x9c,
+	0x3d, 0xed, 0xcb, 0xef, 0xc3, 0x56, 0x61, 0x5c, 0x30, 0x64, 0xbc, 0x24, 0xda, 0x81, 0xa9, 0x9d,
+	0x4e, 0x97, 0x5c, 0x0c, 0xd9, 0xd7, 0xb3, 0x80, 0x82, 0x34, 0xfe, 0x9d, 0xfe, 0xd8, 0xc6, 0x5e,
+	0x8f, 0xbc, 0x20, 0xb7, 0x61, 0xee, 0x00, 0x93, 0xc3, 0xae, 0x61, 0xa9, 0x9a, 0xb3, 0xaf, 0x52,
+	0x35, 0x86, 0xf3, 0x17, 0x8c, 0x82, 0xd9, 0x71, 0x4c, 0x11, 0x25, 0x79, 0x0b, 0xea, 0xf1, 0x4e,
+	0x84, 0x5a, 0xbe, 0x4c, 0x21, 0x24, 0xf3, 0x13, 0x98, 0x56, 0x30, 0xcb, 0x93, 0x2a, 0x98, 0xa8,
+	0xfa, 0xf7, 0x9b, 0xb9, 0xdd, 0x84, 0x99, 0x48, 0xe7, 0xbe, 0xb6, 0x9f, 0xf7, 0x70, 0xcf, 0xd7,
+	0x96, 0x97, 0xe4, 0xf7, 0x60, 0x51, 0x08, 0x1c, 0x3a, 0x58, 0x63, 0xdb, 0xec, 0x03, 0xd5, 0x78,
+	0x82, 0x87, 0xc8, 0x23, 0xfc, 0x10, 0x96, 0xd2, 0x28, 0xfd, 0xfd, 0x4b, 0x6c, 0xfd, 0xe4, 0x04,
+	0xdb, 0x9e, 0x3e, 0x7e, 0xc5, 0xd6, 0xcf, 0x47, 0xa0, 0x2c, 0x9e, 0xd5, 0xa1, 0x37, 0xa1, 0xe2,
+	0x6d, 0x7f, 0x74, 0x23, 0xd0, 0x75, 0xd4, 0x09, 0x49, 0x0b, 0xc9, 0x8d, 0xa2, 0xc7, 0x5d, 0x18,
+	0xe5, 0x8f, 0xf2, 0x96, 0xd2, 0xde, 0xee, 0x09, 0x9a, 0xe5, 0xd4, 0x76, 0xc1, 0xd4, 0x86, 0x89,
+	0xf0, 0x6b, 0x41, 0x74, 0x3b, 0x45, 0x24, 0x7a, 0x80, 0x90, 0xd6, 0xfa, 0x03, 0x79, 0x27, 0x5b,
+	0x7f, 0x2f, 0x41, 0xc5, 0x7b, 0x84, 0x86, 0x54, 0xa8, 0x05, 0xdf, 0xf4, 0x85, 0x3a, 0xcc, 0x7a,
+	0x47, 0x28, 0xad, 0xf5, 0x07, 0x8a, 0x51, 0x9d, 0xc1, 0x7c, 0xea, 0x03, 0x3c, 0x74, 0x27, 0x89,
+	0x26, 0x25, 0x16, 0x2e, 0xdd, 0x1d, 0x0c, 0xec, 0xe5, 0xd8, 0xae, 0x47, 0x41, 0x48, 0xce, 0x60,
+	0x70, 0x7b, 0x59, 0xcd, 0xc4, 0x08, 0xf2, 0x0e, 0xcc, 0x26, 0x3f, 0x86, 0x43, 0x6b, 0xb1, 0x87,
+	0x3a, 0x69, 0xc3, 0x59, 0x1f, 0x00, 0x29, 0xba, 0x53, 0x60, 0x3c, 0x84, 0x40, 0xcb, 0x69, 0xb2,
+	0x2e, 0x79, 0x23, 0x1d, 0x20, 0x38, 0xbb, 0x30, 0x97, 0xf2, 0x1c, 0x0d, 0xad, 0xc7, 0x1f, 0x10,
+	0xa5, 0x0d, 0xe2, 0xd9, 0x41, 0xa0, 0xa2, 0xc7, 0x43, 0x98, 0x08, 0x43, 0x50, 0x23, 0x55, 0xda,
+	0xe5, 0x5f, 0xc9, 0x40, 0xf8, 0xb4, 0xe1, 0xd7, 0x61, 0x21, 0xda, 0xc4, 0x67, 0x6b, 0xd2, 0x4a,
+	0x06, 0x42, 0xd0, 0xbe, 0x0a, 0xa3, 0xac, 0x05, 0xcd, 0x45, 0xb1, 0x2e, 0x49, 0x3d, 0xde, 0x20,
+	0x36, 0xd9, 0x57, 0x45, 0xb8, 0x46, 0x7d, 0x28, 0x7a, 0x03, 0xca, 0xe2, 0xf5, 0x10, 0x9a, 0x0f,
+	0xa0, 0xc3, 0xaf, 0x92, 0x24, 0x29, 0xa9, 0x49, 0xa8, 0xf1, 0x00, 0xaa, 0x81, 0xa7, 0x40, 0x68,
+	0x31, 0x00, 0x8d, 0x3f, 0x35, 0x92, 0x96, 0xd2, 0x9a, 0x05, 0xdb, 0x1e, 0x80, 0xff, 0xe8, 0x04,
+	0x2d, 0xa4, 0xbc, 0x45, 0xe1, 0x5c, 0x8b, 0x99, 0x2f, 0x55, 0xd0, 0x27, 0x30, 0x15, 0x4b, 0x4f,
+	0xa3, 0xd5, 0xec, 0xe4, 0x35, 0x27, 0xbe, 0x39, 0x48, 0x86, 0x1b, 0xdd, 0x83, 0x31, 0x37, 0x67,
+	0x8c, 0x82, 0x06, 0x8a, 0x64, 0xa3, 0xa5, 0x1b, 0x89, 0x6d, 0x62, 0x22, 0xfe, 0x51, 0x61, 0x11,
+	0x48, 0xab, 0x47, 0x1c, 0x3a, 0x17, 0xee, 0xba, 0x0b, 0xce, 0x45, 0x64, 0xc1, 0x49, 0x49, 0x4d,
+	0xfe, 0x36, 0x0c, 0x25, 0xfe, 0x42, 0xdb, 0x30, 0x29, 0xe9, 0x28, 0x35, 0xd2, 0x01, 0xbe, 0x9b,
+	0x8a, 0xed, 0x3f, 0x39, 0x2e, 0x15, 0x5b, 0xc1, 0xab, 0x99, 0x18, 0xdf, 0x4d, 0x25, 0x67, 0xb9,
+	0x42, 0x6e, 0x2a, 0x33, 0x4d, 0x27, 0xad, 0x0f, 0x80, 0x14, 0xdd, 0xbd, 0x0e, 0x25, 0x7e, 0xa7,
+	0x44, 0xf5, 0xd8, 0x35, 0xd3, 0xa5, 0x9b, 0x4f, 0x68, 0x11, 0xe2, 0x1f, 0xc6, 0x13, 0x44, 0x2b,
+	0x19, 0xd7, 0x55, 0x41, 0x28, 0x67, 0x41, 0x04, 0xb3, 0x03, 0xf5, 0xb4, 0x5c, 0x3c, 0x0a, 0x7a,
+	0xb0, 0x3e, 0x0f, 0x07, 0xa4, 0x3b, 0x03, 0x61, 0x03, 0xc3, 0x09, 0x63, 0xc2, 0xc3, 0x49, 0xcc,
+	0xe4, 0x4b, 0x72, 0x16, 0xc4, 0x5f, 0x87, 0xa1, 0x1c, 0x55, 0x68, 0x1d, 0x26, 0xe5, 0xc1, 0xa4,
+	0x46, 0x3a, 0xc0, 0x5f, 0x87, 0xd1, 0x8c, 0x41, 0x68, 0x1d, 0xa6, 0x64, 0xb9, 0xa4, 0xd5, 0x4c,
+	0x8c, 0x20, 0x7f, 0xc3, 0xcf, 0x03, 0xcc, 0xc7, 0xf1, 0x49, 0x5b, 0x2f, 0x7a, 0xad, 0x54, 0x60,
+	0x3c, 0x94, 0xb6, 0x09, 0x0d, 0x39, 0x29, 0x65, 0x24, 0x35, 0xd2, 0x01, 0xfe, 0xee, 0x48, 0x4e,
+	0x92, 0x84, 0x76, 0x47, 0x66, 0x96, 0x47, 0x5a, 0x1f, 0x00, 0xe9, 0x3b, 0xcc, 0x78, 0x00, 0x7a,
+	0x35, 0x3b, 0x6e, 0x1c, 0x77, 0x98, 0xa9, 0xc1, 0xe5, 0xad, 0x7f, 0x56, 0xa0, 0x24, 0xd6, 0xd9,
+	0x09, 0x4c, 0x27, 0x85, 0x57, 0xd1, 0xad, 0xe0, 0x23, 0xa8, 0xf4, 0x80, 0xae, 0x74, 0xbb, 0x2f,
+	0x4e, 0x8c, 0xe9, 0x02, 0xa4, 0xf4, 0x00, 0x28, 0xba, 0x9b, 0x46, 0x93, 0x14, 0xf8, 0x93, 0x9e,
+	0x1b, 0x10, 0x1d, 0x70, 0x9c, 0x91, 0xe8, 0x64, 0xd8, 0x71, 0x26, 0x87, 0x4e, 0xa5, 0xd5, 0x4c,
+	0x4c, 0xc0, 0x71, 0x26, 0xc6, 0x01, 0xc3, 0x8e, 0x33, 0x2b, 0x90, 0x29, 0xad, 0x0f, 0x80, 0x7c,
+	0x3a, 0x8e, 0x53, 0x05, 0x14, 0x0f, 0x06, 0xa2, 0x9b, 0x31, 0x81, 0x84, 0xd0, 0xa3, 0xf4, 0xbf,
+	0x7d, 0x50, 0x57, 0xe9, 0x41, 0x4f, 0x60, 0x3a, 0x29, 0x8b, 0x11, 0x5a, 0xc6, 0x19, 0x79, 0x13,
+	0xe9, 0x76, 0x5f, 0xdc, 0x77, 0xeb, 0x50, 0xa3, 0xc1, 0xcb, 0xe4, 0xf5, 0x19, 0xf1, 0x82, 0xab,
+	0x99, 0x98, 0xa7, 0xea, 0x50, 0x83, 0x01, 0xbc, 0xb0, 0x43, 0x4d, 0x08, 0x3c, 0x4a, 0x8d, 0x74,
+	0x40, 0xea, 0xae, 0x71, 0xc9, 0x33, 0x76, 0x4d, 0xa4, 0x97, 0xf5, 0x01, 0x90, 0xc2, 0xe1, 0xfd,
+	0x6e, 0x14, 0xca, 0x22, 0x04, 0x86, 0x3e, 0x83, 0x99, 0xc4, 0xc0, 0x5e, 0xf8, 0x0a, 0x9d, 0x11,
+	0x6a, 0x94, 0xd6, 0xfa, 0x03, 0xc5, 0x30, 0x7b, 0x30, 0x9d, 0x14, 0x72, 0x43, 0x41, 0x07, 0xd6,
+	0x3f, 0x4a, 0x28, 0x6d, 0x0c, 0x0a, 0xf7, 0x2f, 0x24, 0xfc, 0xbf, 0xb4, 0x73, 0xa1, 0xf3, 0xb3,
+	0x1f, 0x16, 0x93, 0xea, 0xf1, 0x06, 0xff, 0xdc, 0xef, 0x87, 0xbf, 0x42, 0xe7, 0xfe, 0x58, 0x70,
+	0x4d, 0x5a, 0x4c, 0x69, 0x0d, 0xac, 0xeb, 0x48, 0xe0, 0x2a, 0xbc, 0xae, 0x93, 0x43, 0x67, 0xd2,
+	0x6a, 0x26, 0xc6, 0x5f, 0x95, 0xa1, 0x20, 0x53, 0x68, 0x55, 0x26, 0xc5, 0xbe, 0xa4, 0x46, 0x3a,
+	0xc0, 0x5f, 0x95, 0xc9, 0x41, 0xa3, 0xd0, 0xaa, 0xcc, 0x0c, 0x55, 0x49, 0xeb, 0x03, 0x20, 0x79,
+	0x77, 0xdb, 0x37, 0x3f, 0x92, 0xe9, 0x77, 0xf9, 0xb3, 0x0d, 0xdd, 0xda, 0x64, 0x3f, 0x36, 0xbb,
+	0xb6, 0x7e, 0xa6, 0x12, 0xbc, 0xe9, 0x51, 0x74, 0x8f, 0x8e, 0x4a, 0xec, 0xa9, 0xf7, 0x8b, 0xff,
+	0x19, 0x00, 0x22, 0xcb, 0xb7, 0x6c, 0x45, 0x3e, 0x00, 0x00,
 }
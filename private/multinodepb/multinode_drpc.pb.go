@@ -1973,3 +1973,318 @@ func (x *drpcPayout_SatellitePeriodPaystubStream) SendAndClose(m *SatellitePerio
 	}
 	return x.CloseSend()
 }
+
+type DRPCControlClient interface {
+	DRPCConn() drpc.Conn
+
+	SetAllocatedDiskSpace(ctx context.Context, in *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error)
+	InitiateGracefulExit(ctx context.Context, in *ControlInitiateGracefulExitRequest) (*ControlInitiateGracefulExitResponse, error)
+	Trash(ctx context.Context, in *TrashRequest) (*TrashResponse, error)
+	EmptyTrash(ctx context.Context, in *EmptyTrashRequest) (*EmptyTrashResponse, error)
+	SetUploadsPaused(ctx context.Context, in *SetUploadsPausedRequest) (*SetUploadsPausedResponse, error)
+	RestartRetain(ctx context.Context, in *RestartRetainRequest) (*RestartRetainResponse, error)
+	RestartUsedSpaceWalker(ctx context.Context, in *RestartUsedSpaceWalkerRequest) (*RestartUsedSpaceWalkerResponse, error)
+}
+
+type drpcControlClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCControlClient(cc drpc.Conn) DRPCControlClient {
+	return &drpcControlClient{cc}
+}
+
+func (c *drpcControlClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcControlClient) SetAllocatedDiskSpace(ctx context.Context, in *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error) {
+	out := new(SetAllocatedDiskSpaceResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Control/SetAllocatedDiskSpace", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcControlClient) InitiateGracefulExit(ctx context.Context, in *ControlInitiateGracefulExitRequest) (*ControlInitiateGracefulExitResponse, error) {
+	out := new(ControlInitiateGracefulExitResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Control/InitiateGracefulExit", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcControlClient) Trash(ctx context.Context, in *TrashRequest) (*TrashResponse, error) {
+	out := new(TrashResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Control/Trash", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcControlClient) EmptyTrash(ctx context.Context, in *EmptyTrashRequest) (*EmptyTrashResponse, error) {
+	out := new(EmptyTrashResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Control/EmptyTrash", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcControlClient) SetUploadsPaused(ctx context.Context, in *SetUploadsPausedRequest) (*SetUploadsPausedResponse, error) {
+	out := new(SetUploadsPausedResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Control/SetUploadsPaused", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcControlClient) RestartRetain(ctx context.Context, in *RestartRetainRequest) (*RestartRetainResponse, error) {
+	out := new(RestartRetainResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Control/RestartRetain", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcControlClient) RestartUsedSpaceWalker(ctx context.Context, in *RestartUsedSpaceWalkerRequest) (*RestartUsedSpaceWalkerResponse, error) {
+	out := new(RestartUsedSpaceWalkerResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Control/RestartUsedSpaceWalker", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCControlServer interface {
+	SetAllocatedDiskSpace(context.Context, *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error)
+	InitiateGracefulExit(context.Context, *ControlInitiateGracefulExitRequest) (*ControlInitiateGracefulExitResponse, error)
+	Trash(context.Context, *TrashRequest) (*TrashResponse, error)
+	EmptyTrash(context.Context, *EmptyTrashRequest) (*EmptyTrashResponse, error)
+	SetUploadsPaused(context.Context, *SetUploadsPausedRequest) (*SetUploadsPausedResponse, error)
+	RestartRetain(context.Context, *RestartRetainRequest) (*RestartRetainResponse, error)
+	RestartUsedSpaceWalker(context.Context, *RestartUsedSpaceWalkerRequest) (*RestartUsedSpaceWalkerResponse, error)
+}
+
+type DRPCControlUnimplementedServer struct{}
+
+func (s *DRPCControlUnimplementedServer) SetAllocatedDiskSpace(context.Context, *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCControlUnimplementedServer) InitiateGracefulExit(context.Context, *ControlInitiateGracefulExitRequest) (*ControlInitiateGracefulExitResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCControlUnimplementedServer) Trash(context.Context, *TrashRequest) (*TrashResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCControlUnimplementedServer) EmptyTrash(context.Context, *EmptyTrashRequest) (*EmptyTrashResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCControlUnimplementedServer) SetUploadsPaused(context.Context, *SetUploadsPausedRequest) (*SetUploadsPausedResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCControlUnimplementedServer) RestartRetain(context.Context, *RestartRetainRequest) (*RestartRetainResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCControlUnimplementedServer) RestartUsedSpaceWalker(context.Context, *RestartUsedSpaceWalkerRequest) (*RestartUsedSpaceWalkerResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+type DRPCControlDescription struct{}
+
+func (DRPCControlDescription) NumMethods() int { return 7 }
+
+func (DRPCControlDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/multinode.Control/SetAllocatedDiskSpace", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCControlServer).
+					SetAllocatedDiskSpace(
+						ctx,
+						in1.(*SetAllocatedDiskSpaceRequest),
+					)
+			}, DRPCControlServer.SetAllocatedDiskSpace, true
+	case 1:
+		return "/multinode.Control/InitiateGracefulExit", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCControlServer).
+					InitiateGracefulExit(
+						ctx,
+						in1.(*ControlInitiateGracefulExitRequest),
+					)
+			}, DRPCControlServer.InitiateGracefulExit, true
+	case 2:
+		return "/multinode.Control/Trash", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCControlServer).
+					Trash(
+						ctx,
+						in1.(*TrashRequest),
+					)
+			}, DRPCControlServer.Trash, true
+	case 3:
+		return "/multinode.Control/EmptyTrash", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCControlServer).
+					EmptyTrash(
+						ctx,
+						in1.(*EmptyTrashRequest),
+					)
+			}, DRPCControlServer.EmptyTrash, true
+	case 4:
+		return "/multinode.Control/SetUploadsPaused", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCControlServer).
+					SetUploadsPaused(
+						ctx,
+						in1.(*SetUploadsPausedRequest),
+					)
+			}, DRPCControlServer.SetUploadsPaused, true
+	case 5:
+		return "/multinode.Control/RestartRetain", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCControlServer).
+					RestartRetain(
+						ctx,
+						in1.(*RestartRetainRequest),
+					)
+			}, DRPCControlServer.RestartRetain, true
+	case 6:
+		return "/multinode.Control/RestartUsedSpaceWalker", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCControlServer).
+					RestartUsedSpaceWalker(
+						ctx,
+						in1.(*RestartUsedSpaceWalkerRequest),
+					)
+			}, DRPCControlServer.RestartUsedSpaceWalker, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterControl(mux drpc.Mux, impl DRPCControlServer) error {
+	return mux.Register(impl, DRPCControlDescription{})
+}
+
+type DRPCControl_SetAllocatedDiskSpaceStream interface {
+	drpc.Stream
+	SendAndClose(*SetAllocatedDiskSpaceResponse) error
+}
+
+type drpcControl_SetAllocatedDiskSpaceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcControl_SetAllocatedDiskSpaceStream) SendAndClose(m *SetAllocatedDiskSpaceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCControl_InitiateGracefulExitStream interface {
+	drpc.Stream
+	SendAndClose(*ControlInitiateGracefulExitResponse) error
+}
+
+type drpcControl_InitiateGracefulExitStream struct {
+	drpc.Stream
+}
+
+func (x *drpcControl_InitiateGracefulExitStream) SendAndClose(m *ControlInitiateGracefulExitResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCControl_TrashStream interface {
+	drpc.Stream
+	SendAndClose(*TrashResponse) error
+}
+
+type drpcControl_TrashStream struct {
+	drpc.Stream
+}
+
+func (x *drpcControl_TrashStream) SendAndClose(m *TrashResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCControl_EmptyTrashStream interface {
+	drpc.Stream
+	SendAndClose(*EmptyTrashResponse) error
+}
+
+type drpcControl_EmptyTrashStream struct {
+	drpc.Stream
+}
+
+func (x *drpcControl_EmptyTrashStream) SendAndClose(m *EmptyTrashResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCControl_SetUploadsPausedStream interface {
+	drpc.Stream
+	SendAndClose(*SetUploadsPausedResponse) error
+}
+
+type drpcControl_SetUploadsPausedStream struct {
+	drpc.Stream
+}
+
+func (x *drpcControl_SetUploadsPausedStream) SendAndClose(m *SetUploadsPausedResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCControl_RestartRetainStream interface {
+	drpc.Stream
+	SendAndClose(*RestartRetainResponse) error
+}
+
+type drpcControl_RestartRetainStream struct {
+	drpc.Stream
+}
+
+func (x *drpcControl_RestartRetainStream) SendAndClose(m *RestartRetainResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCControl_RestartUsedSpaceWalkerStream interface {
+	drpc.Stream
+	SendAndClose(*RestartUsedSpaceWalkerResponse) error
+}
+
+type drpcControl_RestartUsedSpaceWalkerStream struct {
+	drpc.Stream
+}
+
+func (x *drpcControl_RestartUsedSpaceWalkerStream) SendAndClose(m *RestartUsedSpaceWalkerResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
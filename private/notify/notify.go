@@ -0,0 +1,275 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package notify delivers notifications outside of the application by e-mail,
+// webhook or a local command.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/post"
+)
+
+var mon = monkit.Package()
+
+// Error is the error class for failing notifiers.
+var Error = errs.Class("notify")
+
+// Message is a notification delivered by the notifiers.
+type Message struct {
+	// Subject is a short description of the message used as the e-mail subject.
+	Subject string
+	// Fields are listed in the e-mail text and passed to commands as environment variables.
+	Fields []Field
+	// Body is appended to the e-mail text.
+	Body string
+	// Payload is posted by the webhook and passed to commands on the standard input as json.
+	Payload interface{}
+}
+
+// Field is a named value of a message.
+type Field struct {
+	// Label is the name of the field in the e-mail text, the field isn't listed when it's empty.
+	Label string
+	// Env is the name of the environment variable of the field, the field isn't passed when it's empty.
+	Env   string
+	Value string
+}
+
+// Text returns a human readable description of the message.
+func (message Message) Text() string {
+	var text strings.Builder
+	for _, field := range message.Fields {
+		if field.Label != "" {
+			fmt.Fprintf(&text, "%s: %s\n", field.Label, field.Value)
+		}
+	}
+	if message.Body != "" {
+		fmt.Fprintf(&text, "\n%s\n", message.Body)
+	}
+	return text.String()
+}
+
+// Environ returns the fields of the message as environment variables.
+func (message Message) Environ() []string {
+	var environ []string
+	for _, field := range message.Fields {
+		if field.Env != "" {
+			environ = append(environ, field.Env+"="+field.Value)
+		}
+	}
+	return environ
+}
+
+// Notifier delivers messages.
+type Notifier interface {
+	// Name returns the name of the notifier used in the logs.
+	Name() string
+	// Notify delivers the message.
+	Notify(ctx context.Context, message Message) error
+}
+
+// Config contains configuration of all notifiers.
+type Config struct {
+	SMTP    SMTPConfig
+	Webhook WebhookConfig
+	Command CommandConfig
+}
+
+// SMTPConfig contains configuration of the e-mail notifier.
+type SMTPConfig struct {
+	ServerAddress string `help:"smtp server address used for notification e-mails, empty disables e-mails" default:""`
+	From          string `help:"sender e-mail address of the notification e-mails" default:""`
+	To            string `help:"comma separated recipients of the notification e-mails" default:""`
+	Login         string `help:"plain auth user login" default:""`
+	Password      string `help:"plain auth user password" default:""`
+}
+
+// WebhookConfig contains configuration of the webhook notifier.
+type WebhookConfig struct {
+	URL     string        `help:"url the notifications are posted to as json, empty disables the webhook" default:""`
+	Timeout time.Duration `help:"timeout of the webhook request" default:"10s"`
+}
+
+// CommandConfig contains configuration of the command notifier.
+type CommandConfig struct {
+	Path    string        `help:"command executed for every notification, empty disables the command" default:""`
+	Timeout time.Duration `help:"timeout of the command execution" default:"30s"`
+}
+
+// NewNotifiers creates the notifiers enabled in the config.
+func NewNotifiers(config Config) (notifiers []Notifier, err error) {
+	if config.SMTP.ServerAddress != "" {
+		notifier, err := NewSMTPNotifier(config.SMTP)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, notifier)
+	}
+	if config.Webhook.URL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(config.Webhook))
+	}
+	if config.Command.Path != "" {
+		notifiers = append(notifiers, NewCommandNotifier(config.Command))
+	}
+	return notifiers, nil
+}
+
+// SMTPNotifier sends the messages as e-mails.
+type SMTPNotifier struct {
+	sender *post.SMTPSender
+	to     []post.Address
+}
+
+// NewSMTPNotifier creates a new e-mail notifier.
+func NewSMTPNotifier(config SMTPConfig) (*SMTPNotifier, error) {
+	host, _, err := net.SplitHostPort(config.ServerAddress)
+	if err != nil {
+		return nil, Error.New("invalid smtp server address: %v", err)
+	}
+
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, Error.New("invalid sender address: %v", err)
+	}
+
+	to, err := mail.ParseAddressList(config.To)
+	if err != nil {
+		return nil, Error.New("invalid recipient addresses: %v", err)
+	}
+
+	notifier := &SMTPNotifier{
+		sender: &post.SMTPSender{
+			ServerAddress: config.ServerAddress,
+			From:          *from,
+			Auth:          smtp.PlainAuth("", config.Login, config.Password, host),
+		},
+	}
+	for _, address := range to {
+		notifier.to = append(notifier.to, *address)
+	}
+	return notifier, nil
+}
+
+// Name implements Notifier.
+func (notifier *SMTPNotifier) Name() string { return "smtp" }
+
+// Notify implements Notifier.
+func (notifier *SMTPNotifier) Notify(ctx context.Context, message Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = notifier.sender.SendEmail(ctx, &post.Message{
+		From:      notifier.sender.From,
+		To:        notifier.to,
+		Subject:   message.Subject,
+		PlainText: message.Text(),
+	})
+	return Error.Wrap(err)
+}
+
+// WebhookNotifier posts the message payloads as json to an url.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a new webhook notifier.
+func NewWebhookNotifier(config WebhookConfig) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    config.URL,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// Name implements Notifier.
+func (notifier *WebhookNotifier) Name() string { return "webhook" }
+
+// Notify implements Notifier.
+func (notifier *WebhookNotifier) Notify(ctx context.Context, message Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(message.Payload)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, notifier.url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := notifier.client.Do(request)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(response.Body.Close())) }()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return Error.New("webhook responded with %s", response.Status)
+	}
+	return nil
+}
+
+// CommandNotifier executes a local command for every message.
+//
+// The payload is passed as json on the standard input and the fields
+// as environment variables.
+type CommandNotifier struct {
+	path    string
+	timeout time.Duration
+}
+
+// NewCommandNotifier creates a new command notifier.
+func NewCommandNotifier(config CommandConfig) *CommandNotifier {
+	return &CommandNotifier{
+		path:    config.Path,
+		timeout: config.Timeout,
+	}
+}
+
+// Name implements Notifier.
+func (notifier *CommandNotifier) Name() string { return "command" }
+
+// Notify implements Notifier.
+func (notifier *CommandNotifier) Notify(ctx context.Context, message Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if notifier.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, notifier.timeout)
+		defer cancel()
+	}
+
+	input, err := json.Marshal(message.Payload)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var output bytes.Buffer
+	cmd := exec.CommandContext(ctx, notifier.path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	cmd.Env = append(os.Environ(), message.Environ()...)
+
+	if err := cmd.Run(); err != nil {
+		return Error.New("%v: %s", err, strings.TrimSpace(output.String()))
+	}
+	return nil
+}
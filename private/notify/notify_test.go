@@ -0,0 +1,115 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notify_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/notify"
+)
+
+type testPayload struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+func testMessage() notify.Message {
+	return notify.Message{
+		Subject: "subject",
+		Fields: []notify.Field{
+			{Label: "Name", Env: "TEST_NAME", Value: "test"},
+			{Label: "Label only", Value: "label"},
+			{Env: "TEST_ENV_ONLY", Value: "env"},
+		},
+		Body:    "body",
+		Payload: testPayload{Name: "test", Value: 1},
+	}
+}
+
+func TestMessage(t *testing.T) {
+	message := testMessage()
+	require.Equal(t, "Name: test\nLabel only: label\n\nbody\n", message.Text())
+	require.Equal(t, []string{"TEST_NAME=test", "TEST_ENV_ONLY=env"}, message.Environ())
+
+	message.Body = ""
+	require.Equal(t, "Name: test\nLabel only: label\n", message.Text())
+}
+
+func TestNewNotifiers(t *testing.T) {
+	notifiers, err := notify.NewNotifiers(notify.Config{})
+	require.NoError(t, err)
+	require.Empty(t, notifiers)
+
+	_, err = notify.NewNotifiers(notify.Config{SMTP: notify.SMTPConfig{ServerAddress: "invalid"}})
+	require.Error(t, err)
+
+	notifiers, err = notify.NewNotifiers(notify.Config{
+		SMTP:    notify.SMTPConfig{ServerAddress: "localhost:25", From: "node@example.test", To: "a@example.test, b@example.test"},
+		Webhook: notify.WebhookConfig{URL: "http://localhost"},
+		Command: notify.CommandConfig{Path: "notify"},
+	})
+	require.NoError(t, err)
+	require.Len(t, notifiers, 3)
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	received := make(chan testPayload, 1)
+	status := int32(http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload testPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err == nil {
+			received <- payload
+		}
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer server.Close()
+
+	notifier := notify.NewWebhookNotifier(notify.WebhookConfig{URL: server.URL, Timeout: time.Second})
+
+	message := testMessage()
+	require.NoError(t, notifier.Notify(ctx, message))
+	require.Equal(t, message.Payload, <-received)
+
+	atomic.StoreInt32(&status, http.StatusInternalServerError)
+	require.Error(t, notifier.Notify(ctx, message))
+}
+
+func TestCommandNotifier(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a shell")
+	}
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	output := ctx.File("output")
+	script := filepath.Join(ctx.Dir("bin"), "notify.sh")
+	require.NoError(t, ioutil.WriteFile(script, []byte("#!/bin/sh\necho \"$TEST_NAME $TEST_ENV_ONLY\" > "+output+"\ncat >> "+output+"\n"), 0700))
+
+	notifier := notify.NewCommandNotifier(notify.CommandConfig{Path: script, Timeout: 10 * time.Second})
+
+	message := testMessage()
+	require.NoError(t, notifier.Notify(ctx, message))
+
+	data, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+	require.Equal(t, "test env\n"+`{"name":"test","value":1}`, string(data))
+
+	failing := notify.NewCommandNotifier(notify.CommandConfig{Path: filepath.Join(ctx.Dir("bin"), "missing")})
+	require.Error(t, failing.Notify(ctx, message))
+}
@@ -192,6 +192,16 @@ func (bad *BadBlobs) WalkNamespace(ctx context.Context, namespace []byte, walkFu
 	return bad.blobs.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkNamespaceWithPrefix executes walkFunc for each locally stored blob in the given
+// namespace, whose key starts with keyPrefix. If walkFunc returns a non-nil error,
+// WalkNamespaceWithPrefix will stop iterating and return the error immediately.
+func (bad *BadBlobs) WalkNamespaceWithPrefix(ctx context.Context, namespace []byte, keyPrefix byte, walkFunc func(storage.BlobInfo) error) error {
+	if err := bad.err.Err(); err != nil {
+		return err
+	}
+	return bad.blobs.WalkNamespaceWithPrefix(ctx, namespace, keyPrefix, walkFunc)
+}
+
 // ListNamespaces returns all namespaces that might be storing data.
 func (bad *BadBlobs) ListNamespaces(ctx context.Context) ([][]byte, error) {
 	if err := bad.err.Err(); err != nil {
@@ -142,6 +142,14 @@ func (slow *SlowBlobs) WalkNamespace(ctx context.Context, namespace []byte, walk
 	return slow.blobs.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkNamespaceWithPrefix executes walkFunc for each locally stored blob in the given
+// namespace, whose key starts with keyPrefix. If walkFunc returns a non-nil error,
+// WalkNamespaceWithPrefix will stop iterating and return the error immediately.
+func (slow *SlowBlobs) WalkNamespaceWithPrefix(ctx context.Context, namespace []byte, keyPrefix byte, walkFunc func(storage.BlobInfo) error) error {
+	slow.sleep()
+	return slow.blobs.WalkNamespaceWithPrefix(ctx, namespace, keyPrefix, walkFunc)
+}
+
 // ListNamespaces returns all namespaces that might be storing data.
 func (slow *SlowBlobs) ListNamespaces(ctx context.Context) ([][]byte, error) {
 	return slow.blobs.ListNamespaces(ctx)
@@ -79,6 +79,12 @@ var monTally = monkit.ScopeNamed("storj.io/storj/satellite/accounting/tally")
 func (service *Service) Tally(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	// the tallies of a partition don't contain the pieces of the other
+	// partitions, saving them would underreport the stored data.
+	if service.segmentLoop.Partitioned() {
+		return Error.New("node tally requires the segment loop to iterate all partitions")
+	}
+
 	// Fetch when the last node tally happened so we can roughly calculate the byte-hours.
 	lastTime, err := service.storagenodeAccountingDB.LastTimestamp(ctx, accounting.LastAtRestTally)
 	if err != nil {
@@ -115,7 +121,7 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 	return nil
 }
 
-var _ segmentloop.Observer = (*Observer)(nil)
+var _ segmentloop.ParallelObserver = (*Observer)(nil)
 
 // Observer observes metainfo and adds up tallies for nodes and buckets.
 type Observer struct {
@@ -169,3 +175,20 @@ func (observer *Observer) RemoteSegment(ctx context.Context, segment *segmentloo
 func (observer *Observer) InlineSegment(ctx context.Context, segment *segmentloop.Segment) (err error) {
 	return nil
 }
+
+// Fork creates an observer for a single range of the segments loop.
+func (observer *Observer) Fork(ctx context.Context) (segmentloop.Partial, error) {
+	return NewObserver(observer.log, observer.now), nil
+}
+
+// Join adds the tallies of a single range to the observer.
+func (observer *Observer) Join(ctx context.Context, partial segmentloop.Partial) error {
+	forked, ok := partial.(*Observer)
+	if !ok {
+		return Error.New("expected partial type %T but got %T", observer, partial)
+	}
+	for nodeID, size := range forked.Node {
+		observer.Node[nodeID] += size
+	}
+	return nil
+}
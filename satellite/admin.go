@@ -20,7 +20,11 @@ import (
 	"storj.io/storj/private/lifecycle"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/inventory"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/invoiceonly"
+	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 )
 
@@ -32,6 +36,7 @@ type Admin struct {
 	Log      *zap.Logger
 	Identity *identity.FullIdentity
 	DB       DB
+	Metabase *metabase.DB
 
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
@@ -47,9 +52,10 @@ type Admin struct {
 	}
 
 	Payments struct {
-		Accounts payments.Accounts
-		Service  *stripecoinpayments.Service
-		Stripe   stripecoinpayments.StripeClient
+		Accounts    payments.Accounts
+		Service     *stripecoinpayments.Service
+		Stripe      stripecoinpayments.StripeClient
+		InvoiceOnly *invoiceonly.Service
 	}
 
 	Admin struct {
@@ -59,12 +65,13 @@ type Admin struct {
 }
 
 // NewAdmin creates a new satellite admin peer.
-func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB,
+func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *metabase.DB,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel) (*Admin, error) {
 	peer := &Admin{
 		Log:      log,
 		Identity: full,
 		DB:       db,
+		Metabase: metabaseDB,
 
 		Servers:  lifecycle.NewGroup(log.Named("servers")),
 		Services: lifecycle.NewGroup(log.Named("services")),
@@ -110,41 +117,60 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB,
 	{ // setup payments
 		pc := config.Payments
 
-		var stripeClient stripecoinpayments.StripeClient
-		var err error
-		switch pc.Provider {
-		default:
-			stripeClient = stripecoinpayments.NewStripeMock(
-				peer.ID(),
-				peer.DB.StripeCoinPayments().Customers(),
+		if pc.Provider == paymentsconfig.ProviderInvoiceOnly {
+			priceModel, err := payments.NewProjectUsagePriceModel(pc.StorageTBPrice, pc.EgressTBPrice, pc.ObjectPrice)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+
+			peer.Payments.InvoiceOnly = invoiceonly.NewService(
+				peer.Log.Named("payments.invoiceonly:service"),
+				peer.DB.InvoiceOnly(),
+				peer.DB.Console().Projects(),
 				peer.DB.Console().Users(),
-			)
-		case "stripecoinpayments":
-			stripeClient = stripecoinpayments.NewStripeClient(log, pc.StripeCoinPayments)
-		}
+				peer.DB.ProjectAccounting(),
+				peer.DB.PricePlans(),
+				priceModel,
+				pc.InvoiceOnly)
+			peer.Payments.Accounts = peer.Payments.InvoiceOnly.Accounts()
+		} else {
+			var stripeClient stripecoinpayments.StripeClient
+			var err error
+			switch pc.Provider {
+			default:
+				stripeClient = stripecoinpayments.NewStripeMock(
+					peer.ID(),
+					peer.DB.StripeCoinPayments().Customers(),
+					peer.DB.Console().Users(),
+				)
+			case paymentsconfig.ProviderStripeCoinPayments:
+				stripeClient = stripecoinpayments.NewStripeClient(log, pc.StripeCoinPayments)
+			}
 
-		peer.Payments.Service, err = stripecoinpayments.NewService(
-			peer.Log.Named("payments.stripe:service"),
-			stripeClient,
-			pc.StripeCoinPayments,
-			peer.DB.StripeCoinPayments(),
-			peer.DB.Console().Projects(),
-			peer.DB.ProjectAccounting(),
-			pc.StorageTBPrice,
-			pc.EgressTBPrice,
-			pc.ObjectPrice,
-			pc.BonusRate,
-			pc.CouponValue,
-			pc.CouponDuration.IntPointer(),
-			pc.CouponProjectLimit,
-			pc.MinCoinPayment)
+			peer.Payments.Service, err = stripecoinpayments.NewService(
+				peer.Log.Named("payments.stripe:service"),
+				stripeClient,
+				pc.StripeCoinPayments,
+				peer.DB.StripeCoinPayments(),
+				peer.DB.Console().Projects(),
+				peer.DB.ProjectAccounting(),
+				peer.DB.PricePlans(),
+				pc.StorageTBPrice,
+				pc.EgressTBPrice,
+				pc.ObjectPrice,
+				pc.BonusRate,
+				pc.CouponValue,
+				pc.CouponDuration.IntPointer(),
+				pc.CouponProjectLimit,
+				pc.MinCoinPayment)
 
-		if err != nil {
-			return nil, errs.Combine(err, peer.Close())
-		}
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
 
-		peer.Payments.Stripe = stripeClient
-		peer.Payments.Accounts = peer.Payments.Service.Accounts()
+			peer.Payments.Stripe = stripeClient
+			peer.Payments.Accounts = peer.Payments.Service.Accounts()
+		}
 	}
 	{ // setup admin endpoint
 		var err error
@@ -156,7 +182,9 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB,
 		adminConfig := config.Admin
 		adminConfig.AuthorizationToken = config.Console.AuthToken
 
-		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, peer.Payments.Accounts, adminConfig)
+		inventories := inventory.NewService(log.Named("inventory"), peer.DB.BucketInventories(), peer.DB.Buckets(), config.Inventory.EncryptionKeys)
+
+		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, peer.Metabase, peer.Payments.Accounts, inventories, adminConfig)
 		peer.Servers.Add(lifecycle.Item{
 			Name:  "admin",
 			Run:   peer.Admin.Server.Run,
@@ -97,6 +97,10 @@ func (server *Server) addAPIKey(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	server.auditEvent(r, "create api key", nil, &projectUUID, map[string]interface{}{
+		"name": input.Name,
+	})
+
 	output.APIKey = key.Serialize()
 	data, err := json.Marshal(output)
 	if err != nil {
@@ -140,6 +144,10 @@ func (server *Server) deleteAPIKey(w http.ResponseWriter, r *http.Request) {
 			err.Error(), http.StatusInternalServerError)
 		return
 	}
+
+	server.auditEvent(r, "delete api key", nil, &info.ProjectID, map[string]interface{}{
+		"name": info.Name,
+	})
 }
 
 func (server *Server) deleteAPIKeyByName(w http.ResponseWriter, r *http.Request) {
@@ -180,6 +188,10 @@ func (server *Server) deleteAPIKeyByName(w http.ResponseWriter, r *http.Request)
 			err.Error(), http.StatusInternalServerError)
 		return
 	}
+
+	server.auditEvent(r, "delete api key", nil, &info.ProjectID, map[string]interface{}{
+		"name": info.Name,
+	})
 }
 
 func (server *Server) listAPIKeys(w http.ResponseWriter, r *http.Request) {
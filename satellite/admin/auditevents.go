@@ -0,0 +1,179 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/schema"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// auditEvent stores an operation done through the admin API in the console audit log.
+// user and projectID are the user and project affected by the operation, either can be nil.
+// Failures are only logged, since the operation has already been done.
+func (server *Server) auditEvent(r *http.Request, operation string, user *console.User, projectID *uuid.UUID, details map[string]interface{}) {
+	ctx := r.Context()
+
+	id, err := uuid.New()
+	if err != nil {
+		server.log.Error("failed to store audit event", zap.String("operation", operation), zap.Error(err))
+		return
+	}
+
+	if details == nil {
+		details = map[string]interface{}{}
+	}
+	if credential := credentialFromContext(ctx); credential != nil {
+		details["adminCredential"] = credential.Name
+	}
+	data, err := json.Marshal(details)
+	if err != nil {
+		server.log.Error("failed to store audit event", zap.String("operation", operation), zap.Error(err))
+		return
+	}
+
+	event := console.AuditEvent{
+		ID:             id,
+		ProjectID:      projectID,
+		Source:         console.AuditEventSourceAdmin,
+		Operation:      operation,
+		Details:        data,
+		SourceIP:       r.RemoteAddr,
+		ForwardedForIP: r.Header.Get("X-Forwarded-For"),
+		CreatedAt:      server.nowFn(),
+	}
+	if user != nil {
+		userID := user.ID
+		event.UserID = &userID
+		event.Email = user.Email
+	}
+
+	err = server.db.Console().AuditEvents().Insert(ctx, event)
+	if err != nil {
+		server.log.Error("failed to store audit event", zap.String("operation", operation), zap.Error(err))
+	}
+}
+
+// auditEventsQuery contains the query arguments of the audit events endpoints.
+type auditEventsQuery struct {
+	Limit  uint   `schema:"limit"`
+	Page   uint   `schema:"page"`
+	Format string `schema:"format"`
+}
+
+func (server *Server) userAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		httpJSONError(w, "user-email missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		httpJSONError(w, fmt.Sprintf("user with email %q not found", userEmail),
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "failed to get user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	server.serveAuditEvents(w, r, "audit-log-"+user.ID.String(), func(ctx context.Context, cursor console.AuditEventsCursor) (*console.AuditEventsPage, error) {
+		return server.db.Console().AuditEvents().GetPagedByUserID(ctx, user.ID, cursor)
+	})
+}
+
+func (server *Server) projectAuditEvents(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		httpJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		httpJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	server.serveAuditEvents(w, r, "audit-log-"+projectUUID.String(), func(ctx context.Context, cursor console.AuditEventsCursor) (*console.AuditEventsPage, error) {
+		return server.db.Console().AuditEvents().GetPagedByProjectID(ctx, projectUUID, cursor)
+	})
+}
+
+// serveAuditEvents writes a single page of events, or all events when an export format is requested.
+func (server *Server) serveAuditEvents(w http.ResponseWriter, r *http.Request, filename string, page func(ctx context.Context, cursor console.AuditEventsCursor) (*console.AuditEventsPage, error)) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		httpJSONError(w, "invalid form",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	query := auditEventsQuery{Limit: 50, Page: 1}
+	decoder := schema.NewDecoder()
+	err := decoder.Decode(&query, r.Form)
+	if err != nil {
+		httpJSONError(w, "invalid arguments",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if query.Format != "" {
+		format, err := console.AuditExportFormatFromString(query.Format)
+		if err != nil {
+			httpJSONError(w, "invalid format",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", format.ContentType())
+		w.Header().Set("Content-Disposition", "attachment; filename=\""+filename+"."+string(format)+"\"")
+		err = console.ExportAuditEvents(ctx, w, format, page)
+		if err != nil {
+			server.log.Error("failed to export audit events", zap.Error(err))
+		}
+		return
+	}
+
+	events, err := page(ctx, console.AuditEventsCursor{
+		Limit: query.Limit,
+		Page:  query.Page,
+	})
+	if err != nil {
+		httpJSONError(w, "failed to get audit events",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(events)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestAuditEvents(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		project := planet.Uplinks[0].Projects[0]
+
+		assertReq(ctx, t, "http://"+address.String()+"/api/projects/"+project.ID.String()+"/apikeys",
+			http.MethodPost, `{"name":"audited"}`, http.StatusOK, "", authToken)
+		assertReq(ctx, t, "http://"+address.String()+"/api/projects/"+project.ID.String()+"/apikeys/audited",
+			http.MethodDelete, "", http.StatusOK, "", authToken)
+
+		t.Run("project", func(t *testing.T) {
+			body := assertReq(ctx, t, "http://"+address.String()+"/api/projects/"+project.ID.String()+"/audit-events?limit=10",
+				http.MethodGet, "", http.StatusOK, "", authToken)
+
+			var page console.AuditEventsPage
+			require.NoError(t, json.Unmarshal(body, &page))
+			// the root api key is created through the console
+			require.EqualValues(t, 3, page.TotalCount)
+			require.Len(t, page.Events, 3)
+
+			var operations []string
+			for _, event := range page.Events {
+				require.NotNil(t, event.ProjectID)
+				require.Equal(t, project.ID, *event.ProjectID)
+				if event.Source != console.AuditEventSourceAdmin {
+					require.Equal(t, console.AuditEventSourceConsole, event.Source)
+					require.Equal(t, project.Owner.Email, event.Email)
+					continue
+				}
+				operations = append(operations, event.Operation)
+				require.JSONEq(t, `{"name":"audited","adminCredential":"auth-token"}`, string(event.Details))
+			}
+			require.ElementsMatch(t, []string{"create api key", "delete api key"}, operations)
+		})
+
+		t.Run("export", func(t *testing.T) {
+			body := assertReq(ctx, t, "http://"+address.String()+"/api/projects/"+project.ID.String()+"/audit-events?format=csv",
+				http.MethodGet, "", http.StatusOK, "", authToken)
+
+			records, err := csv.NewReader(strings.NewReader(string(body))).ReadAll()
+			require.NoError(t, err)
+			require.Len(t, records, 4)
+			require.Equal(t, "operation", records[0][3])
+
+			body = assertReq(ctx, t, "http://"+address.String()+"/api/projects/"+project.ID.String()+"/audit-events?format=json",
+				http.MethodGet, "", http.StatusOK, "", authToken)
+
+			var events []console.AuditEvent
+			require.NoError(t, json.Unmarshal(body, &events))
+			require.Len(t, events, 3)
+
+			assertReq(ctx, t, "http://"+address.String()+"/api/projects/"+project.ID.String()+"/audit-events?format=xml",
+				http.MethodGet, "", http.StatusBadRequest, "", authToken)
+		})
+
+		t.Run("user", func(t *testing.T) {
+			body := assertReq(ctx, t, "http://"+address.String()+"/api/users/"+project.Owner.Email+"/audit-events",
+				http.MethodGet, "", http.StatusOK, "", authToken)
+
+			var before console.AuditEventsPage
+			require.NoError(t, json.Unmarshal(body, &before))
+
+			assertReq(ctx, t, "http://"+address.String()+"/api/users/"+project.Owner.Email,
+				http.MethodPut, `{"projectLimit":10,"adminCredential":"auth-token"}`, http.StatusOK, "", authToken)
+
+			body = assertReq(ctx, t, "http://"+address.String()+"/api/users/"+project.Owner.Email+"/audit-events",
+				http.MethodGet, "", http.StatusOK, "", authToken)
+			var page console.AuditEventsPage
+			require.NoError(t, json.Unmarshal(body, &page))
+			require.Equal(t, before.TotalCount+1, page.TotalCount)
+			require.Equal(t, "update user", page.Events[0].Operation)
+			require.Equal(t, project.Owner.Email, page.Events[0].Email)
+			require.JSONEq(t, `{"projectLimit":10,"adminCredential":"auth-token"}`, string(page.Events[0].Details))
+		})
+	})
+}
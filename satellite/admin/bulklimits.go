@@ -0,0 +1,232 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// maxBulkLimitsRows is the maximum number of projects updated by a single bulk request.
+const maxBulkLimitsRows = 10000
+
+// bulkLimits are the limits of a single project in a bulk update. nil values are not changed.
+type bulkLimits struct {
+	projectID uuid.UUID
+	usage     *memory.Size
+	bandwidth *memory.Size
+	rate      *int
+	burst     *int
+	buckets   *int
+	details   map[string]interface{}
+}
+
+// putBulkProjectLimits updates the limits of the projects in the CSV request body.
+// The first line contains the column names: project and any of usage, bandwidth,
+// rate, burst and buckets. Every row is validated before any project is updated.
+func (server *Server) putBulkProjectLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reader := csv.NewReader(http.MaxBytesReader(w, r.Body, 10*memory.MiB.Int64()))
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		httpJSONError(w, "invalid csv",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(records) < 2 {
+		httpJSONError(w, "csv must contain a header and at least one project",
+			"", http.StatusBadRequest)
+		return
+	}
+	if len(records)-1 > maxBulkLimitsRows {
+		httpJSONError(w, "too many projects",
+			fmt.Sprintf("at most %d projects can be updated at once", maxBulkLimitsRows), http.StatusBadRequest)
+		return
+	}
+
+	header := records[0]
+	columns := map[string]bool{}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+		switch header[i] {
+		case "project", "usage", "bandwidth", "rate", "burst", "buckets":
+		default:
+			httpJSONError(w, "invalid csv header",
+				fmt.Sprintf("unknown column %q", header[i]), http.StatusBadRequest)
+			return
+		}
+		if columns[header[i]] {
+			httpJSONError(w, "invalid csv header",
+				fmt.Sprintf("duplicate column %q", header[i]), http.StatusBadRequest)
+			return
+		}
+		columns[header[i]] = true
+	}
+	if !columns["project"] {
+		httpJSONError(w, "invalid csv header",
+			"project column is missing", http.StatusBadRequest)
+		return
+	}
+
+	all := make([]bulkLimits, 0, len(records)-1)
+	for i, record := range records[1:] {
+		limits, err := parseBulkLimits(header, record)
+		if err != nil {
+			// the header is on line 1.
+			httpJSONError(w, fmt.Sprintf("invalid line %d", i+2),
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		_, err = server.db.Console().Projects().Get(ctx, limits.projectID)
+		if errors.Is(err, sql.ErrNoRows) {
+			httpJSONError(w, fmt.Sprintf("invalid line %d", i+2),
+				fmt.Sprintf("project %s not found", limits.projectID), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			httpJSONError(w, "failed to get project",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		all = append(all, limits)
+	}
+
+	// all projects are updated in a transaction, so that a failure doesn't
+	// leave some of them updated.
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		for _, limits := range all {
+			if err := updateBulkLimits(ctx, tx, limits); err != nil {
+				return fmt.Errorf("project %s: %w", limits.projectID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		httpJSONError(w, "failed to update project limits",
+			fmt.Sprintf("no projects were updated: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	for _, limits := range all {
+		projectID := limits.projectID
+		limits.details["bulk"] = true
+		server.auditEvent(r, "update project limits", nil, &projectID, limits.details)
+	}
+
+	data, err := json.Marshal(map[string]int{"updated": len(all)})
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+// parseBulkLimits parses and validates a row of the bulk limits CSV with the
+// already validated header.
+func parseBulkLimits(header, record []string) (limits bulkLimits, err error) {
+	limits.details = map[string]interface{}{}
+
+	for i, column := range header {
+		value := strings.TrimSpace(record[i])
+		if column != "project" && value == "" {
+			// empty values keep the current limit.
+			continue
+		}
+
+		switch column {
+		case "project":
+			limits.projectID, err = uuid.FromString(value)
+			if err != nil {
+				return bulkLimits{}, fmt.Errorf("invalid project-uuid: %w", err)
+			}
+			continue
+		case "usage", "bandwidth":
+			var size memory.Size
+			if err := size.Set(value); err != nil {
+				return bulkLimits{}, fmt.Errorf("invalid %s: %w", column, err)
+			}
+			if size < 0 {
+				return bulkLimits{}, fmt.Errorf("negative %s: %v", column, value)
+			}
+			if column == "usage" {
+				limits.usage = &size
+			} else {
+				limits.bandwidth = &size
+			}
+		case "rate", "burst", "buckets":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return bulkLimits{}, fmt.Errorf("invalid %s: %w", column, err)
+			}
+			if n < 0 {
+				return bulkLimits{}, fmt.Errorf("negative %s: %v", column, value)
+			}
+			switch column {
+			case "rate":
+				limits.rate = &n
+			case "burst":
+				limits.burst = &n
+			default:
+				limits.buckets = &n
+			}
+		}
+
+		limits.details[column] = value
+	}
+
+	return limits, nil
+}
+
+// updateBulkLimits updates the limits of a project in a bulk update.
+func updateBulkLimits(ctx context.Context, tx console.DBTx, limits bulkLimits) error {
+	if limits.usage != nil {
+		err := tx.Projects().UpdateUsageLimit(ctx, limits.projectID, *limits.usage)
+		if err != nil {
+			return err
+		}
+	}
+	if limits.bandwidth != nil {
+		err := tx.Projects().UpdateBandwidthLimit(ctx, limits.projectID, *limits.bandwidth)
+		if err != nil {
+			return err
+		}
+	}
+	if limits.rate != nil {
+		err := tx.Projects().UpdateRateLimit(ctx, limits.projectID, *limits.rate)
+		if err != nil {
+			return err
+		}
+	}
+	if limits.burst != nil {
+		err := tx.Projects().UpdateBurstLimit(ctx, limits.projectID, limits.burst)
+		if err != nil {
+			return err
+		}
+	}
+	if limits.buckets != nil {
+		err := tx.Projects().UpdateBucketLimit(ctx, limits.projectID, *limits.buckets)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
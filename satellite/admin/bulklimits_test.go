@@ -0,0 +1,90 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestBulkProjectLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      2,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		authToken := sat.Config.Console.AuthToken
+		link := "http://" + sat.Admin.Admin.Listener.Addr().String() + "/api/projects/limits"
+
+		first := planet.Uplinks[0].Projects[0].ID
+		second := planet.Uplinks[1].Projects[0].ID
+
+		before, err := sat.DB.Console().Projects().Get(ctx, second)
+		require.NoError(t, err)
+
+		for _, invalid := range []string{
+			"",
+			"project,usage\n",
+			"usage\n1GB\n",
+			"project,unknown\n" + first.String() + ",1\n",
+			"project,usage,usage\n" + first.String() + ",1GB,2GB\n",
+			"project,usage\n" + first.String() + ",-1GB\n",
+			"project,rate\n" + first.String() + ",fast\n",
+			"project,usage\nnot-a-uuid,1GB\n",
+		} {
+			assertReq(ctx, t, link, http.MethodPost, invalid, http.StatusBadRequest, "", authToken)
+		}
+
+		// nothing is updated when a project doesn't exist.
+		assertReq(ctx, t, link, http.MethodPost, "project,usage\n"+first.String()+",1GB\n"+testrand.UUID().String()+",1GB\n", http.StatusNotFound, "", authToken)
+		project, err := sat.DB.Console().Projects().Get(ctx, first)
+		require.NoError(t, err)
+		require.NotEqual(t, memory.GB, *project.StorageLimit)
+
+		csv := "project, usage, bandwidth, rate\n" +
+			first.String() + ", 1GB, 2GB, 100\n" +
+			second.String() + ", 3GB, , \n"
+		assertReq(ctx, t, link, http.MethodPost, csv, http.StatusOK, `{"updated":2}`, authToken)
+
+		project, err = sat.DB.Console().Projects().Get(ctx, first)
+		require.NoError(t, err)
+		require.Equal(t, memory.GB, *project.StorageLimit)
+		require.Equal(t, 2*memory.GB, *project.BandwidthLimit)
+		require.Equal(t, 100, *project.RateLimit)
+
+		project, err = sat.DB.Console().Projects().Get(ctx, second)
+		require.NoError(t, err)
+		require.Equal(t, 3*memory.GB, *project.StorageLimit)
+		require.Equal(t, before.BandwidthLimit, project.BandwidthLimit)
+		require.Equal(t, before.RateLimit, project.RateLimit)
+
+		events, err := sat.DB.Console().AuditEvents().GetPagedByProjectID(ctx, second, console.AuditEventsCursor{Limit: 50, Page: 1})
+		require.NoError(t, err)
+		var adminEvents []console.AuditEvent
+		for _, event := range events.Events {
+			if event.Source == console.AuditEventSourceAdmin {
+				adminEvents = append(adminEvents, event)
+			}
+		}
+		require.Len(t, adminEvents, 1)
+		require.Equal(t, "update project limits", adminEvents[0].Operation)
+		require.JSONEq(t, `{"usage":"3GB","bulk":true,"adminCredential":"auth-token"}`, string(adminEvents[0].Details))
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/consistency"
+)
+
+// consistencyFixesLimit is the default number of fixes returned by the list endpoint.
+const consistencyFixesLimit = 100
+
+func (server *Server) listConsistencyFixes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	status := consistency.FixPending
+	if value := r.URL.Query().Get("status"); value != "" {
+		status = consistency.FixStatus(value)
+	}
+	switch status {
+	case consistency.FixPending, consistency.FixApplied, consistency.FixRejected:
+	default:
+		httpJSONError(w, "invalid status",
+			fmt.Sprintf("status must be one of %q, %q or %q", consistency.FixPending, consistency.FixApplied, consistency.FixRejected), http.StatusBadRequest)
+		return
+	}
+
+	limit := consistencyFixesLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			httpJSONError(w, "invalid limit",
+				fmt.Sprintf("limit must be a positive integer: %q", value), http.StatusBadRequest)
+			return
+		}
+	}
+
+	fixes, err := server.db.ConsistencyFixes().List(ctx, status, limit)
+	if err != nil {
+		httpJSONError(w, "unable to list consistency fixes",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if fixes == nil {
+		fixes = []consistency.Fix{}
+	}
+
+	data, err := json.Marshal(fixes)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) approveConsistencyFix(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	fix, ok := server.pendingConsistencyFix(w, r)
+	if !ok {
+		return
+	}
+
+	if err := consistency.Apply(ctx, server.metabaseDB, fix); err != nil {
+		httpJSONError(w, "unable to apply consistency fix",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	server.resolveConsistencyFix(w, r, fix, consistency.FixApplied)
+}
+
+func (server *Server) rejectConsistencyFix(w http.ResponseWriter, r *http.Request) {
+	fix, ok := server.pendingConsistencyFix(w, r)
+	if !ok {
+		return
+	}
+
+	server.resolveConsistencyFix(w, r, fix, consistency.FixRejected)
+}
+
+// pendingConsistencyFix loads the fix from the request variables and
+// writes an error response when it is missing or already resolved.
+func (server *Server) pendingConsistencyFix(w http.ResponseWriter, r *http.Request) (consistency.Fix, bool) {
+	ctx := r.Context()
+
+	fixUUIDString, ok := mux.Vars(r)["fix"]
+	if !ok {
+		httpJSONError(w, "fix-uuid missing",
+			"", http.StatusBadRequest)
+		return consistency.Fix{}, false
+	}
+
+	fixUUID, err := uuid.FromString(fixUUIDString)
+	if err != nil {
+		httpJSONError(w, "invalid fix-uuid",
+			err.Error(), http.StatusBadRequest)
+		return consistency.Fix{}, false
+	}
+
+	fix, err := server.db.ConsistencyFixes().Get(ctx, fixUUID)
+	if consistency.ErrNotFound.Has(err) {
+		httpJSONError(w, "consistency fix not found",
+			err.Error(), http.StatusNotFound)
+		return consistency.Fix{}, false
+	}
+	if err != nil {
+		httpJSONError(w, "unable to get consistency fix",
+			err.Error(), http.StatusInternalServerError)
+		return consistency.Fix{}, false
+	}
+
+	if fix.Status != consistency.FixPending {
+		httpJSONError(w, "consistency fix already resolved",
+			fmt.Sprintf("fix status is %q", fix.Status), http.StatusConflict)
+		return consistency.Fix{}, false
+	}
+
+	return fix, true
+}
+
+func (server *Server) resolveConsistencyFix(w http.ResponseWriter, r *http.Request, fix consistency.Fix, status consistency.FixStatus) {
+	ctx := r.Context()
+
+	err := server.db.ConsistencyFixes().Resolve(ctx, fix.ID, status, server.nowFn())
+	if consistency.ErrNotFound.Has(err) {
+		httpJSONError(w, "consistency fix already resolved",
+			err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "unable to resolve consistency fix",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/consistency"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestConsistencyFixes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		authToken := sat.Config.Console.AuthToken
+		metabaseDB := sat.Metainfo.Metabase
+
+		zombie := metabase.ObjectStream{
+			ProjectID:  planet.Uplinks[0].Projects[0].ID,
+			BucketName: "bucket",
+			ObjectKey:  "zombie",
+			Version:    1,
+			StreamID:   testrand.UUID(),
+		}
+		deadline := time.Now().Add(-time.Hour)
+		_, err := metabaseDB.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
+			ObjectStream:           zombie,
+			ZombieDeletionDeadline: &deadline,
+		})
+		require.NoError(t, err)
+
+		fixes := []consistency.Fix{
+			{ID: testrand.UUID(), Kind: consistency.IssueZombieObject, Object: zombie, Status: consistency.FixPending, CreatedAt: time.Now()},
+			{ID: testrand.UUID(), Kind: consistency.IssueOrphanedSegments, Object: metabase.ObjectStream{StreamID: testrand.UUID()}, Status: consistency.FixPending, CreatedAt: time.Now()},
+		}
+		require.NoError(t, sat.DB.ConsistencyFixes().Queue(ctx, fixes))
+
+		link := "http://" + sat.Admin.Admin.Listener.Addr().String() + "/api/consistency/fixes"
+
+		body := assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", authToken)
+		var listed []consistency.Fix
+		require.NoError(t, json.Unmarshal(body, &listed))
+		require.Len(t, listed, 2)
+
+		assertReq(ctx, t, link+"?status=unknown", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link+"/invalid/approve", http.MethodPost, "", http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link+"/"+testrand.UUID().String()+"/approve", http.MethodPost, "", http.StatusNotFound, "", authToken)
+
+		assertReq(ctx, t, link+"/"+fixes[0].ID.String()+"/approve", http.MethodPost, "", http.StatusOK, "", authToken)
+		assertReq(ctx, t, link+"/"+fixes[0].ID.String()+"/reject", http.MethodPost, "", http.StatusConflict, "", authToken)
+		assertReq(ctx, t, link+"/"+fixes[1].ID.String()+"/reject", http.MethodPost, "", http.StatusOK, "", authToken)
+
+		objects, err := metabaseDB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Empty(t, objects)
+
+		body = assertReq(ctx, t, link+"?status=applied", http.MethodGet, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &listed))
+		require.Len(t, listed, 1)
+		require.Equal(t, fixes[0].ID, listed[0].ID)
+		require.NotNil(t, listed[0].ResolvedAt)
+
+		body = assertReq(ctx, t, link+"?status=rejected", http.MethodGet, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &listed))
+		require.Len(t, listed, 1)
+		require.Equal(t, fixes[1].ID, listed[0].ID)
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package credentials implements the named and scoped credentials of the
+// satellite admin API. Only a hash of the secret of a credential is stored.
+package credentials
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the default admin credentials error class.
+	Error = errs.Class("admin credentials")
+	// ErrNotFound is returned when the credential doesn't exist.
+	ErrNotFound = errs.Class("admin credential not found")
+	// ErrNameTaken is returned when a credential with the same name exists.
+	ErrNameTaken = errs.Class("admin credential name taken")
+	// ErrInvalidScope is returned for unknown scopes.
+	ErrInvalidScope = errs.Class("invalid admin credential scope")
+)
+
+// secretPrefix is the prefix of the secrets, which makes them recognizable.
+const secretPrefix = "sadm_"
+
+// Scope is a group of admin API operations, which a credential is allowed to do.
+type Scope string
+
+const (
+	// ScopeRead allows the requests that don't change anything.
+	ScopeRead Scope = "read"
+	// ScopeUsers allows creating, updating and suspending users, and creating
+	// and updating their projects and API keys.
+	ScopeUsers Scope = "users"
+	// ScopeLimits allows changing the limits of projects.
+	ScopeLimits Scope = "limits"
+	// ScopeBilling allows managing coupons and price plans.
+	ScopeBilling Scope = "billing"
+	// ScopeDestructive allows deleting users, projects and API keys, and
+	// applying consistency fixes, which can't be undone.
+	ScopeDestructive Scope = "destructive"
+)
+
+// Scopes are all the scopes.
+var Scopes = []Scope{ScopeRead, ScopeUsers, ScopeLimits, ScopeBilling, ScopeDestructive}
+
+// ParseScopes parses a comma separated list of scopes.
+func ParseScopes(s string) ([]Scope, error) {
+	var scopes []Scope
+	seen := map[Scope]bool{}
+	for _, name := range strings.Split(s, ",") {
+		scope := Scope(strings.TrimSpace(name))
+		if scope == "" || seen[scope] {
+			continue
+		}
+		if !scope.valid() {
+			return nil, ErrInvalidScope.New("%q", scope)
+		}
+		seen[scope] = true
+		scopes = append(scopes, scope)
+	}
+	if len(scopes) == 0 {
+		return nil, ErrInvalidScope.New("at least one scope is required")
+	}
+
+	sort.Slice(scopes, func(i, k int) bool { return scopes[i] < scopes[k] })
+	return scopes, nil
+}
+
+// FormatScopes returns the comma separated list of the scopes.
+func FormatScopes(scopes []Scope) string {
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = string(scope)
+	}
+	return strings.Join(names, ",")
+}
+
+func (scope Scope) valid() bool {
+	for _, known := range Scopes {
+		if scope == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Credential is a named credential of the admin API.
+type Credential struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []Scope    `json:"scopes"`
+	SecretHash []byte     `json:"-"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	RotatedAt  *time.Time `json:"rotatedAt,omitempty"`
+}
+
+// Allows returns whether the credential has the scope.
+func (credential *Credential) Allows(scope Scope) bool {
+	for _, s := range credential.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// NewSecret returns a new random secret and its hash.
+func NewSecret() (secret string, hash []byte, err error) {
+	var data [32]byte
+	if _, err := rand.Read(data[:]); err != nil {
+		return "", nil, Error.Wrap(err)
+	}
+
+	secret = secretPrefix + base64.RawURLEncoding.EncodeToString(data[:])
+	return secret, HashSecret(secret), nil
+}
+
+// HashSecret returns the hash of the secret, which is stored instead of the secret.
+// The secrets are random, so a fast hash is enough.
+func HashSecret(secret string) []byte {
+	hash := sha256.Sum256([]byte(secret))
+	return hash[:]
+}
+
+// DB is the database of the admin API credentials.
+//
+// architecture: Database
+type DB interface {
+	// Insert inserts a credential, it returns ErrNameTaken when a credential
+	// with the same name exists.
+	Insert(ctx context.Context, credential Credential) error
+	// GetByName returns the credential with the given name.
+	GetByName(ctx context.Context, name string) (*Credential, error)
+	// GetBySecretHash returns the credential with the given secret hash.
+	GetBySecretHash(ctx context.Context, hash []byte) (*Credential, error)
+	// List returns all credentials ordered by name.
+	List(ctx context.Context) ([]Credential, error)
+	// UpdateScopes replaces the scopes of a credential.
+	UpdateScopes(ctx context.Context, id uuid.UUID, scopes []Scope) error
+	// Rotate replaces the secret hash of a credential.
+	Rotate(ctx context.Context, id uuid.UUID, hash []byte, rotatedAt time.Time) error
+	// Delete deletes a credential.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package credentials_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/admin/credentials"
+)
+
+func TestParseScopes(t *testing.T) {
+	scopes, err := credentials.ParseScopes("users, read,users")
+	require.NoError(t, err)
+	require.Equal(t, []credentials.Scope{credentials.ScopeRead, credentials.ScopeUsers}, scopes)
+	require.Equal(t, "read,users", credentials.FormatScopes(scopes))
+
+	_, err = credentials.ParseScopes("read,admin")
+	require.True(t, credentials.ErrInvalidScope.Has(err))
+
+	_, err = credentials.ParseScopes(" , ")
+	require.True(t, credentials.ErrInvalidScope.Has(err))
+}
+
+func TestNewSecret(t *testing.T) {
+	secret, hash, err := credentials.NewSecret()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(secret, "sadm_"))
+	require.Equal(t, credentials.HashSecret(secret), hash)
+
+	other, _, err := credentials.NewSecret()
+	require.NoError(t, err)
+	require.NotEqual(t, secret, other)
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/inventory"
+	"storj.io/storj/satellite/metabase"
+)
+
+// inventoryBatchSize is the number of objects listed in a batch when
+// downloading a report through the admin API.
+const inventoryBatchSize = 1000
+
+func (server *Server) getBucketInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bucket, ok := bucketLocationFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	config, err := server.inventories.Get(ctx, bucket.ProjectID, bucket.BucketName)
+	if inventory.ErrNotFound.Has(err) {
+		httpJSONError(w, "inventory not configured",
+			err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "unable to get inventory configuration",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) putBucketInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bucket, ok := bucketLocationFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Format            inventory.Format `json:"format"`
+		DestinationAccess string           `json:"destinationAccess"`
+		DestinationBucket string           `json:"destinationBucket"`
+		DestinationPrefix string           `json:"destinationPrefix"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		httpJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.inventories.Set(ctx, inventory.Configuration{
+		ProjectID:         bucket.ProjectID,
+		BucketName:        bucket.BucketName,
+		Format:            input.Format,
+		DestinationAccess: input.DestinationAccess,
+		DestinationBucket: input.DestinationBucket,
+		DestinationPrefix: input.DestinationPrefix,
+	})
+	switch {
+	case inventory.ErrInvalid.Has(err):
+		httpJSONError(w, "invalid inventory configuration",
+			err.Error(), http.StatusBadRequest)
+		return
+	case storj.ErrBucketNotFound.Has(err):
+		httpJSONError(w, "unable to find bucket",
+			err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		httpJSONError(w, "unable to set inventory configuration",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) deleteBucketInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bucket, ok := bucketLocationFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	err := server.inventories.Delete(ctx, bucket.ProjectID, bucket.BucketName)
+	if err != nil {
+		httpJSONError(w, "unable to delete inventory configuration",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) downloadBucketInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bucket, ok := bucketLocationFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	format := inventory.Format(r.URL.Query().Get("format"))
+	if format == "" {
+		format = inventory.FormatJSON
+	}
+
+	writer, err := inventory.NewWriter(format, w)
+	if err != nil {
+		httpJSONError(w, "invalid format",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, err = server.db.Buckets().GetBucket(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+	if storj.ErrBucketNotFound.Has(err) {
+		httpJSONError(w, "unable to find bucket",
+			err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "unable to get bucket",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", bucket.BucketName+format.Extension()))
+
+	_, err = inventory.Generate(ctx, server.metabaseDB, bucket, inventoryBatchSize, writer)
+	if err != nil {
+		// the status code has been sent already, the best we can do is to log
+		// the failure and leave the client with a truncated report.
+		server.log.Error("failed to generate inventory report", zap.Error(err))
+	}
+}
+
+func bucketLocationFromVars(w http.ResponseWriter, r *http.Request) (_ metabase.BucketLocation, ok bool) {
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		httpJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return metabase.BucketLocation{}, false
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		httpJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return metabase.BucketLocation{}, false
+	}
+
+	bucketName, ok := vars["bucket"]
+	if !ok {
+		httpJSONError(w, "bucket name missing",
+			"", http.StatusBadRequest)
+		return metabase.BucketLocation{}, false
+	}
+
+	return metabase.BucketLocation{
+		ProjectID:  projectUUID,
+		BucketName: bucketName,
+	}, true
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/inventory"
+)
+
+func TestBucketInventory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		authToken := sat.Config.Console.AuthToken
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "a", testrand.Bytes(1*memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "b", testrand.Bytes(2*memory.KiB)))
+
+		link := "http://" + sat.Admin.Admin.Listener.Addr().String() + "/api/projects/" + projectID.String() + "/buckets/bucket/inventory"
+
+		assertReq(ctx, t, link, http.MethodGet, "", http.StatusNotFound, "", authToken)
+		assertReq(ctx, t, link, http.MethodPut, `{"format":"xml"}`, http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link, http.MethodPut, `{"destinationBucket":"reports"}`, http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link, http.MethodPut, `{"format":"csv"}`, http.StatusOK, "", authToken)
+
+		body := assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", authToken)
+		var config inventory.Configuration
+		require.NoError(t, json.Unmarshal(body, &config))
+		require.Equal(t, projectID, config.ProjectID)
+		require.Equal(t, "bucket", config.BucketName)
+		require.Equal(t, inventory.FormatCSV, config.Format)
+		require.Nil(t, config.LastReportAt)
+
+		report := assertReq(ctx, t, link+"/report?format=csv", http.MethodGet, "", http.StatusOK, "", authToken)
+		lines := strings.Split(strings.TrimSpace(string(report)), "\n")
+		require.Len(t, lines, 3)
+		require.Equal(t, "key,version,size,segment_count,created_at,expires_at", lines[0])
+
+		assertReq(ctx, t, link+"/report?format=xml", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+
+		missing := "http://" + sat.Admin.Admin.Listener.Addr().String() + "/api/projects/" + projectID.String() + "/buckets/missing/inventory"
+		assertReq(ctx, t, missing, http.MethodPut, `{"format":"csv"}`, http.StatusNotFound, "", authToken)
+		assertReq(ctx, t, missing+"/report", http.MethodGet, "", http.StatusNotFound, "", authToken)
+
+		assertReq(ctx, t, link, http.MethodPut, `{"destinationAccess":"secret-access","destinationBucket":"reports"}`, http.StatusOK, "", authToken)
+
+		stored, err := sat.DB.BucketInventories().Get(ctx, projectID, "bucket")
+		require.NoError(t, err)
+		require.NotEmpty(t, stored.DestinationAccess)
+		require.NotContains(t, stored.DestinationAccess, "secret-access")
+
+		assertReq(ctx, t, link, http.MethodDelete, "", http.StatusOK, "", authToken)
+		assertReq(ctx, t, link, http.MethodGet, "", http.StatusNotFound, "", authToken)
+	})
+}
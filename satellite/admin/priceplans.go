@@ -0,0 +1,395 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments/priceplans"
+	"storj.io/storj/satellite/rewards"
+)
+
+func (server *Server) listPricePlans(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	plans, err := server.db.PricePlans().List(ctx)
+	if err != nil {
+		httpJSONError(w, "unable to list price plans",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if plans == nil {
+		plans = []priceplans.Plan{}
+	}
+
+	data, err := json.Marshal(plans)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) addPricePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	plan, ok := pricePlanFromBody(w, r)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		httpJSONError(w, "unable to generate price plan id",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	plan.ID = id
+	plan.CreatedAt = server.nowFn()
+
+	err = server.db.PricePlans().Insert(ctx, plan)
+	if priceplans.ErrNameTaken.Has(err) {
+		httpJSONError(w, "price plan name taken",
+			err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "unable to create price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(plan)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) getPricePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	planID, ok := uuidFromVars(w, r, "priceplan")
+	if !ok {
+		return
+	}
+
+	plan, err := server.db.PricePlans().Get(ctx, planID)
+	if priceplans.ErrNotFound.Has(err) {
+		httpJSONError(w, "price plan not found",
+			err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "unable to get price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	assignments, err := server.db.PricePlans().GetAssignments(ctx, planID)
+	if err != nil {
+		httpJSONError(w, "unable to get price plan assignments",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var output struct {
+		priceplans.Plan
+		Assignments priceplans.Assignments `json:"assignments"`
+	}
+	output.Plan = *plan
+	output.Assignments = assignments
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) updatePricePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	planID, ok := uuidFromVars(w, r, "priceplan")
+	if !ok {
+		return
+	}
+
+	plan, ok := pricePlanFromBody(w, r)
+	if !ok {
+		return
+	}
+	plan.ID = planID
+
+	err := server.db.PricePlans().Update(ctx, plan)
+	switch {
+	case priceplans.ErrNotFound.Has(err):
+		httpJSONError(w, "price plan not found",
+			err.Error(), http.StatusNotFound)
+		return
+	case priceplans.ErrNameTaken.Has(err):
+		httpJSONError(w, "price plan name taken",
+			err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		httpJSONError(w, "unable to update price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) deletePricePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	planID, ok := uuidFromVars(w, r, "priceplan")
+	if !ok {
+		return
+	}
+
+	err := server.db.PricePlans().Delete(ctx, planID)
+	switch {
+	case priceplans.ErrNotFound.Has(err):
+		httpJSONError(w, "price plan not found",
+			err.Error(), http.StatusNotFound)
+		return
+	case priceplans.ErrInUse.Has(err):
+		httpJSONError(w, "price plan is assigned to projects or partners",
+			err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		httpJSONError(w, "unable to delete price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) putProjectPricePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := uuidFromVars(w, r, "project")
+	if !ok {
+		return
+	}
+
+	planID, ok := pricePlanIDFromBody(w, r)
+	if !ok {
+		return
+	}
+
+	_, err := server.db.Console().Projects().Get(ctx, projectUUID)
+	if err != nil {
+		httpJSONError(w, "unable to find project",
+			err.Error(), http.StatusNotFound)
+		return
+	}
+
+	err = server.db.PricePlans().AssignProject(ctx, projectUUID, planID)
+	if priceplans.ErrNotFound.Has(err) {
+		httpJSONError(w, "price plan not found",
+			err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "unable to assign price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	server.auditEvent(r, "assign price plan", nil, &projectUUID, map[string]interface{}{
+		"pricePlanId": planID,
+	})
+}
+
+func (server *Server) deleteProjectPricePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := uuidFromVars(w, r, "project")
+	if !ok {
+		return
+	}
+
+	err := server.db.PricePlans().UnassignProject(ctx, projectUUID)
+	if err != nil {
+		httpJSONError(w, "unable to unassign price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	server.auditEvent(r, "unassign price plan", nil, &projectUUID, nil)
+}
+
+func (server *Server) putPartnerPricePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	partner, ok := partnerFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	planID, ok := pricePlanIDFromBody(w, r)
+	if !ok {
+		return
+	}
+
+	err := server.db.PricePlans().AssignPartner(ctx, partner.UUID, planID)
+	if priceplans.ErrNotFound.Has(err) {
+		httpJSONError(w, "price plan not found",
+			err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "unable to assign price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) deletePartnerPricePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	partner, ok := partnerFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	err := server.db.PricePlans().UnassignPartner(ctx, partner.UUID)
+	if err != nil {
+		httpJSONError(w, "unable to unassign price plan",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// pricePlanFromBody reads and validates the price plan definition in the request body.
+func pricePlanFromBody(w http.ResponseWriter, r *http.Request) (_ priceplans.Plan, ok bool) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return priceplans.Plan{}, false
+	}
+
+	var input struct {
+		Name          string          `json:"name"`
+		Storage       priceplans.Rate `json:"storage"`
+		Egress        priceplans.Rate `json:"egress"`
+		Objects       priceplans.Rate `json:"objects"`
+		MinimumCharge int64           `json:"minimumCharge"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		httpJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return priceplans.Plan{}, false
+	}
+
+	plan := priceplans.Plan{
+		Name:          input.Name,
+		Storage:       input.Storage,
+		Egress:        input.Egress,
+		Objects:       input.Objects,
+		MinimumCharge: input.MinimumCharge,
+	}
+	if err := plan.Validate(); err != nil {
+		httpJSONError(w, "invalid price plan",
+			err.Error(), http.StatusBadRequest)
+		return priceplans.Plan{}, false
+	}
+
+	return plan, true
+}
+
+// pricePlanIDFromBody reads the id of the price plan to assign from the request body.
+func pricePlanIDFromBody(w http.ResponseWriter, r *http.Request) (_ uuid.UUID, ok bool) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return uuid.UUID{}, false
+	}
+
+	var input struct {
+		PricePlanID uuid.UUID `json:"pricePlanId"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		httpJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+	if input.PricePlanID.IsZero() {
+		httpJSONError(w, "pricePlanId is required",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+
+	return input.PricePlanID, true
+}
+
+// partnerFromVars returns the partner identified by name or id in the request path.
+func partnerFromVars(w http.ResponseWriter, r *http.Request) (_ rewards.PartnerInfo, ok bool) {
+	ctx := r.Context()
+
+	partnerString, ok := mux.Vars(r)["partner"]
+	if !ok {
+		httpJSONError(w, "partner missing",
+			"", http.StatusBadRequest)
+		return rewards.PartnerInfo{}, false
+	}
+
+	partner, err := findPartner(ctx, partnerString)
+	if err != nil {
+		httpJSONError(w, "unable to find partner",
+			err.Error(), http.StatusNotFound)
+		return rewards.PartnerInfo{}, false
+	}
+
+	return partner, true
+}
+
+// findPartner returns the partner with the given name or id.
+func findPartner(ctx context.Context, nameOrID string) (rewards.PartnerInfo, error) {
+	partner, err := rewards.DefaultPartnersDB.ByName(ctx, nameOrID)
+	if rewards.ErrPartnerNotExist.Has(err) {
+		partner, err = rewards.DefaultPartnersDB.ByID(ctx, nameOrID)
+	}
+	return partner, err
+}
+
+func uuidFromVars(w http.ResponseWriter, r *http.Request, name string) (_ uuid.UUID, ok bool) {
+	value, ok := mux.Vars(r)[name]
+	if !ok {
+		httpJSONError(w, name+"-uuid missing",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+
+	id, err := uuid.FromString(value)
+	if err != nil {
+		httpJSONError(w, "invalid "+name+"-uuid",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+
+	return id, true
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/payments/priceplans"
+	"storj.io/storj/satellite/rewards"
+)
+
+func TestPricePlans(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		authToken := sat.Config.Console.AuthToken
+		projectID := planet.Uplinks[0].Projects[0].ID
+		address := "http://" + sat.Admin.Admin.Listener.Addr().String()
+
+		partners, err := rewards.DefaultPartnersDB.All(ctx)
+		require.NoError(t, err)
+		partner := partners[0]
+
+		plansLink := address + "/api/priceplans"
+		projectLink := address + "/api/projects/" + projectID.String() + "/priceplan"
+		partnerLink := address + "/api/partners/" + partner.Name + "/priceplan"
+
+		rate := `{"included":1000,"tiers":[{"upTo":10000,"price":"0.001"},{"upTo":0,"price":"0.0005"}]}`
+		definition := `{"name":"business","storage":` + rate + `,"egress":` + rate + `,"objects":` + rate + `,"minimumCharge":500}`
+
+		assertReq(ctx, t, plansLink, http.MethodGet, "", http.StatusOK, "[]", authToken)
+		assertReq(ctx, t, plansLink, http.MethodPost, `{"name":"broken","storage":{"tiers":[{"upTo":10,"price":"1"}]}}`, http.StatusBadRequest, "", authToken)
+
+		body := assertReq(ctx, t, plansLink, http.MethodPost, definition, http.StatusOK, "", authToken)
+		var plan priceplans.Plan
+		require.NoError(t, json.Unmarshal(body, &plan))
+		require.False(t, plan.ID.IsZero())
+		require.Equal(t, "business", plan.Name)
+		require.Equal(t, int64(1000), plan.Storage.Included)
+		require.Len(t, plan.Egress.Tiers, 2)
+		require.Equal(t, int64(500), plan.MinimumCharge)
+
+		assertReq(ctx, t, plansLink, http.MethodPost, definition, http.StatusConflict, "", authToken)
+
+		planLink := plansLink + "/" + plan.ID.String()
+		planRef := `{"pricePlanId":"` + plan.ID.String() + `"}`
+
+		assertReq(ctx, t, projectLink, http.MethodPut, `{"pricePlanId":"`+projectID.String()+`"}`, http.StatusNotFound, "", authToken)
+		assertReq(ctx, t, projectLink, http.MethodPut, planRef, http.StatusOK, "", authToken)
+		assertReq(ctx, t, partnerLink, http.MethodPut, planRef, http.StatusOK, "", authToken)
+		assertReq(ctx, t, address+"/api/partners/unknown/priceplan", http.MethodPut, planRef, http.StatusNotFound, "", authToken)
+
+		body = assertReq(ctx, t, planLink, http.MethodGet, "", http.StatusOK, "", authToken)
+		var output struct {
+			priceplans.Plan
+			Assignments priceplans.Assignments `json:"assignments"`
+		}
+		require.NoError(t, json.Unmarshal(body, &output))
+		require.Equal(t, plan.ID, output.ID)
+		require.Equal(t, []uuid.UUID{projectID}, output.Assignments.Projects)
+		require.Equal(t, []uuid.UUID{partner.UUID}, output.Assignments.Partners)
+
+		applied, err := sat.DB.PricePlans().GetForProject(ctx, projectID, partner.UUID)
+		require.NoError(t, err)
+		require.Equal(t, plan.ID, applied.ID)
+
+		assertReq(ctx, t, planLink, http.MethodPut, `{"name":"enterprise","storage":`+rate+`,"egress":`+rate+`,"objects":`+rate+`}`, http.StatusOK, "", authToken)
+		updated, err := sat.DB.PricePlans().Get(ctx, plan.ID)
+		require.NoError(t, err)
+		require.Equal(t, "enterprise", updated.Name)
+		require.Zero(t, updated.MinimumCharge)
+
+		assertReq(ctx, t, planLink, http.MethodDelete, "", http.StatusConflict, "", authToken)
+		assertReq(ctx, t, projectLink, http.MethodDelete, "", http.StatusOK, "", authToken)
+		assertReq(ctx, t, partnerLink, http.MethodDelete, "", http.StatusOK, "", authToken)
+
+		_, err = sat.DB.PricePlans().GetForProject(ctx, projectID, partner.UUID)
+		require.True(t, priceplans.ErrNotFound.Has(err))
+
+		assertReq(ctx, t, planLink, http.MethodDelete, "", http.StatusOK, "", authToken)
+		assertReq(ctx, t, planLink, http.MethodGet, "", http.StatusNotFound, "", authToken)
+	})
+}
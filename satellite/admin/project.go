@@ -126,7 +126,9 @@ func (server *Server) getProjectLimit(w http.ResponseWriter, r *http.Request) {
 			Bytes  int64       `json:"bytes"`
 		} `json:"bandwidth"`
 		Rate struct {
-			RPS int `json:"rps"`
+			RPS        int                       `json:"rps"`
+			Burst      int                       `json:"burst"`
+			Operations map[string]operationLimit `json:"operations,omitempty"`
 		} `json:"rate"`
 		Buckets int `json:"maxBuckets"`
 	}
@@ -144,6 +146,25 @@ func (server *Server) getProjectLimit(w http.ResponseWriter, r *http.Request) {
 	if project.RateLimit != nil {
 		output.Rate.RPS = *project.RateLimit
 	}
+	if project.BurstLimit != nil {
+		output.Rate.Burst = *project.BurstLimit
+	}
+	for name, limit := range operationLimits(project.OperationLimits) {
+		if limit.Rate == nil && limit.Burst == nil {
+			continue
+		}
+		if output.Rate.Operations == nil {
+			output.Rate.Operations = make(map[string]operationLimit)
+		}
+		var value operationLimit
+		if limit.Rate != nil {
+			value.RPS = *limit.Rate
+		}
+		if limit.Burst != nil {
+			value.Burst = *limit.Burst
+		}
+		output.Rate.Operations[name] = value
+	}
 
 	data, err := json.Marshal(output)
 	if err != nil {
@@ -178,7 +199,17 @@ func (server *Server) putProjectLimit(w http.ResponseWriter, r *http.Request) {
 		Usage     *memory.Size `schema:"usage"`
 		Bandwidth *memory.Size `schema:"bandwidth"`
 		Rate      *int         `schema:"rate"`
+		Burst     *int         `schema:"burst"`
 		Buckets   *int         `schema:"buckets"`
+
+		ListRate      *int `schema:"listRate"`
+		ListBurst     *int `schema:"listBurst"`
+		UploadRate    *int `schema:"uploadRate"`
+		UploadBurst   *int `schema:"uploadBurst"`
+		DownloadRate  *int `schema:"downloadRate"`
+		DownloadBurst *int `schema:"downloadBurst"`
+		DeleteRate    *int `schema:"deleteRate"`
+		DeleteBurst   *int `schema:"deleteBurst"`
 	}
 
 	if err := r.ParseForm(); err != nil {
@@ -240,6 +271,80 @@ func (server *Server) putProjectLimit(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if arguments.Burst != nil {
+		if *arguments.Burst < -1 {
+			httpJSONError(w, "negative burst",
+				fmt.Sprintf("%v", *arguments.Burst), http.StatusBadRequest)
+			return
+		}
+
+		// -1 removes the project specific burst, like for the operation limits.
+		burst := arguments.Burst
+		if *burst == -1 {
+			burst = nil
+		}
+		err = server.db.Console().Projects().UpdateBurstLimit(ctx, projectUUID, burst)
+		if err != nil {
+			httpJSONError(w, "failed to update burst",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	operationArguments := []struct {
+		name  string
+		value *int
+		limit func(*console.OperationLimits) **int
+	}{
+		{"listRate", arguments.ListRate, func(l *console.OperationLimits) **int { return &l.List.Rate }},
+		{"listBurst", arguments.ListBurst, func(l *console.OperationLimits) **int { return &l.List.Burst }},
+		{"uploadRate", arguments.UploadRate, func(l *console.OperationLimits) **int { return &l.Upload.Rate }},
+		{"uploadBurst", arguments.UploadBurst, func(l *console.OperationLimits) **int { return &l.Upload.Burst }},
+		{"downloadRate", arguments.DownloadRate, func(l *console.OperationLimits) **int { return &l.Download.Rate }},
+		{"downloadBurst", arguments.DownloadBurst, func(l *console.OperationLimits) **int { return &l.Download.Burst }},
+		{"deleteRate", arguments.DeleteRate, func(l *console.OperationLimits) **int { return &l.Delete.Rate }},
+		{"deleteBurst", arguments.DeleteBurst, func(l *console.OperationLimits) **int { return &l.Delete.Burst }},
+	}
+
+	var changes []func(*console.OperationLimits)
+	for _, argument := range operationArguments {
+		if argument.value == nil {
+			continue
+		}
+		if *argument.value < -1 {
+			httpJSONError(w, "negative "+argument.name,
+				fmt.Sprintf("%v", *argument.value), http.StatusBadRequest)
+			return
+		}
+
+		// -1 removes the project specific limit, a rate of zero blocks the requests.
+		value, limit := argument.value, argument.limit
+		if *value == -1 {
+			value = nil
+		}
+		changes = append(changes, func(limits *console.OperationLimits) {
+			*limit(limits) = value
+		})
+	}
+
+	if len(changes) > 0 {
+		err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+			project, err := tx.Projects().Get(ctx, projectUUID)
+			if err != nil {
+				return err
+			}
+			for _, change := range changes {
+				change(&project.OperationLimits)
+			}
+			return tx.Projects().UpdateOperationLimits(ctx, projectUUID, project.OperationLimits)
+		})
+		if err != nil {
+			httpJSONError(w, "failed to update operation limits",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
 	if arguments.Buckets != nil {
 		if *arguments.Buckets < 0 {
 			httpJSONError(w, "negative bucket coun",
@@ -254,6 +359,24 @@ func (server *Server) putProjectLimit(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
+
+	server.auditEvent(r, "update project limits", nil, &projectUUID, formDetails(r))
+}
+
+// operationLimit is a per operation rate limit in the project limits response.
+type operationLimit struct {
+	RPS   int `json:"rps"`
+	Burst int `json:"burst"`
+}
+
+// operationLimits returns the project operation limits by their name.
+func operationLimits(limits console.OperationLimits) map[string]console.OperationLimit {
+	return map[string]console.OperationLimit{
+		"list":     limits.List,
+		"upload":   limits.Upload,
+		"download": limits.Download,
+		"delete":   limits.Delete,
+	}
 }
 
 func (server *Server) addProject(w http.ResponseWriter, r *http.Request) {
@@ -304,13 +427,18 @@ func (server *Server) addProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = server.db.Console().ProjectMembers().Insert(ctx, project.OwnerID, project.ID)
+	_, err = server.db.Console().ProjectMembers().Insert(ctx, project.OwnerID, project.ID, console.RoleAdmin)
 	if err != nil {
 		httpJSONError(w, "failed to insert project member",
 			err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	server.auditEvent(r, "create project", nil, &project.ID, map[string]interface{}{
+		"ownerId": project.OwnerID.String(),
+		"name":    project.Name,
+	})
+
 	output.ProjectID = project.ID
 	data, err := json.Marshal(output)
 	if err != nil {
@@ -387,6 +515,11 @@ func (server *Server) renameProject(w http.ResponseWriter, r *http.Request) {
 			err.Error(), http.StatusInternalServerError)
 		return
 	}
+
+	server.auditEvent(r, "rename project", nil, &projectUUID, map[string]interface{}{
+		"name":        project.Name,
+		"description": project.Description,
+	})
 }
 
 func (server *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
@@ -449,6 +582,8 @@ func (server *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
 			err.Error(), http.StatusInternalServerError)
 		return
 	}
+
+	server.auditEvent(r, "delete project", nil, &projectUUID, nil)
 }
 
 func (server *Server) checkUsage(ctx context.Context, w http.ResponseWriter, projectID uuid.UUID) (hasUsage bool) {
@@ -48,7 +48,7 @@ func TestAPI(t *testing.T) {
 		t.Run("GetProject", func(t *testing.T) {
 			require.NoError(t, err)
 			expected := fmt.Sprintf(
-				`{"id":"%s","name":"%s","description":"%s","partnerId":"%s","ownerId":"%s","rateLimit":null,"maxBuckets":null,"createdAt":"%s","memberCount":0,"storageLimit":"25.00 GB","bandwidthLimit":"25.00 GB"}`,
+				`{"id":"%s","name":"%s","description":"%s","partnerId":"%s","ownerId":"%s","rateLimit":null,"burstLimit":null,"maxBuckets":null,"createdAt":"%s","memberCount":0,"storageLimit":"25.00 GB","bandwidthLimit":"25.00 GB","operationLimits":{"list":{"rate":null,"burst":null},"upload":{"rate":null,"burst":null},"download":{"rate":null,"burst":null},"delete":{"rate":null,"burst":null}}}`,
 				project.ID.String(),
 				project.Name,
 				project.Description,
@@ -60,7 +60,7 @@ func TestAPI(t *testing.T) {
 		})
 
 		t.Run("GetProjectLimits", func(t *testing.T) {
-			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"25.00 GB","bytes":25000000000},"bandwidth":{"amount":"25.00 GB","bytes":25000000000},"rate":{"rps":0},"maxBuckets":0}`, planet.Satellites[0].Config.Console.AuthToken)
+			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"25.00 GB","bytes":25000000000},"bandwidth":{"amount":"25.00 GB","bytes":25000000000},"rate":{"rps":0,"burst":0},"maxBuckets":0}`, planet.Satellites[0].Config.Console.AuthToken)
 		})
 
 		t.Run("UpdateUsage", func(t *testing.T) {
@@ -75,7 +75,7 @@ func TestAPI(t *testing.T) {
 			require.Equal(t, http.StatusOK, response.StatusCode)
 			require.NoError(t, response.Body.Close())
 
-			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.0 TiB","bytes":1099511627776},"bandwidth":{"amount":"25.00 GB","bytes":25000000000},"rate":{"rps":0},"maxBuckets":0}`, planet.Satellites[0].Config.Console.AuthToken)
+			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.0 TiB","bytes":1099511627776},"bandwidth":{"amount":"25.00 GB","bytes":25000000000},"rate":{"rps":0,"burst":0},"maxBuckets":0}`, planet.Satellites[0].Config.Console.AuthToken)
 
 			req, err = http.NewRequestWithContext(ctx, http.MethodPut, linkLimit+"?usage=1GB", nil)
 			require.NoError(t, err)
@@ -86,7 +86,7 @@ func TestAPI(t *testing.T) {
 			require.Equal(t, http.StatusOK, response.StatusCode)
 			require.NoError(t, response.Body.Close())
 
-			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.00 GB","bytes":1000000000},"bandwidth":{"amount":"25.00 GB","bytes":25000000000},"rate":{"rps":0},"maxBuckets":0}`, planet.Satellites[0].Config.Console.AuthToken)
+			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.00 GB","bytes":1000000000},"bandwidth":{"amount":"25.00 GB","bytes":25000000000},"rate":{"rps":0,"burst":0},"maxBuckets":0}`, planet.Satellites[0].Config.Console.AuthToken)
 		})
 
 		t.Run("UpdateBandwidth", func(t *testing.T) {
@@ -99,7 +99,7 @@ func TestAPI(t *testing.T) {
 			require.Equal(t, http.StatusOK, response.StatusCode)
 			require.NoError(t, response.Body.Close())
 
-			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.00 GB","bytes":1000000000},"bandwidth":{"amount":"1.00 MB","bytes":1000000},"rate":{"rps":0},"maxBuckets":0}`, planet.Satellites[0].Config.Console.AuthToken)
+			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.00 GB","bytes":1000000000},"bandwidth":{"amount":"1.00 MB","bytes":1000000},"rate":{"rps":0,"burst":0},"maxBuckets":0}`, planet.Satellites[0].Config.Console.AuthToken)
 		})
 
 		t.Run("UpdateRate", func(t *testing.T) {
@@ -112,7 +112,7 @@ func TestAPI(t *testing.T) {
 			require.Equal(t, http.StatusOK, response.StatusCode)
 			require.NoError(t, response.Body.Close())
 
-			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.00 GB","bytes":1000000000},"bandwidth":{"amount":"1.00 MB","bytes":1000000},"rate":{"rps":100},"maxBuckets":0}`, planet.Satellites[0].Config.Console.AuthToken)
+			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.00 GB","bytes":1000000000},"bandwidth":{"amount":"1.00 MB","bytes":1000000},"rate":{"rps":100,"burst":0},"maxBuckets":0}`, planet.Satellites[0].Config.Console.AuthToken)
 		})
 		t.Run("UpdateBuckets", func(t *testing.T) {
 			req, err := http.NewRequestWithContext(ctx, http.MethodPut, linkLimit+"?buckets=2000", nil)
@@ -124,7 +124,50 @@ func TestAPI(t *testing.T) {
 			require.Equal(t, http.StatusOK, response.StatusCode)
 			require.NoError(t, response.Body.Close())
 
-			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.00 GB","bytes":1000000000},"bandwidth":{"amount":"1.00 MB","bytes":1000000},"rate":{"rps":100},"maxBuckets":2000}`, planet.Satellites[0].Config.Console.AuthToken)
+			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.00 GB","bytes":1000000000},"bandwidth":{"amount":"1.00 MB","bytes":1000000},"rate":{"rps":100,"burst":0},"maxBuckets":2000}`, planet.Satellites[0].Config.Console.AuthToken)
+		})
+
+		t.Run("UpdateOperationRates", func(t *testing.T) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodPut, linkLimit+"?burst=200&listRate=10&listBurst=20&deleteRate=5", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", planet.Satellites[0].Config.Console.AuthToken)
+
+			response, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, response.StatusCode)
+			require.NoError(t, response.Body.Close())
+
+			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.00 GB","bytes":1000000000},"bandwidth":{"amount":"1.00 MB","bytes":1000000},"rate":{"rps":100,"burst":200,"operations":{"delete":{"rps":5,"burst":0},"list":{"rps":10,"burst":20}}},"maxBuckets":2000}`, planet.Satellites[0].Config.Console.AuthToken)
+
+			req, err = http.NewRequestWithContext(ctx, http.MethodPut, linkLimit+"?deleteRate=-1&uploadRate=50&downloadRate=0", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", planet.Satellites[0].Config.Console.AuthToken)
+
+			response, err = http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, response.StatusCode)
+			require.NoError(t, response.Body.Close())
+
+			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.00 GB","bytes":1000000000},"bandwidth":{"amount":"1.00 MB","bytes":1000000},"rate":{"rps":100,"burst":200,"operations":{"download":{"rps":0,"burst":0},"list":{"rps":10,"burst":20},"upload":{"rps":50,"burst":0}}},"maxBuckets":2000}`, planet.Satellites[0].Config.Console.AuthToken)
+		})
+
+		t.Run("ResetBurst", func(t *testing.T) {
+			authToken := sat.Config.Console.AuthToken
+
+			assertReq(ctx, t, linkLimit+"?burst=-2", http.MethodPut, "", http.StatusBadRequest, "", authToken)
+
+			assertReq(ctx, t, linkLimit+"?burst=0", http.MethodPut, "", http.StatusOK, "", authToken)
+			updated, err := sat.DB.Console().Projects().Get(ctx, project.ID)
+			require.NoError(t, err)
+			require.NotNil(t, updated.BurstLimit)
+			require.Zero(t, *updated.BurstLimit)
+
+			assertReq(ctx, t, linkLimit+"?burst=-1", http.MethodPut, "", http.StatusOK, "", authToken)
+			updated, err = sat.DB.Console().Projects().Get(ctx, project.ID)
+			require.NoError(t, err)
+			require.Nil(t, updated.BurstLimit)
+
+			assertGet(ctx, t, linkLimit, `{"usage":{"amount":"1.00 GB","bytes":1000000000},"bandwidth":{"amount":"1.00 MB","bytes":1000000},"rate":{"rps":100,"burst":0,"operations":{"download":{"rps":0,"burst":0},"list":{"rps":10,"burst":20},"upload":{"rps":50,"burst":0}}},"maxBuckets":2000}`, authToken)
 		})
 	})
 }
@@ -0,0 +1,322 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/schema"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// maxSearchLimit is the maximum number of results returned by a search.
+const maxSearchLimit = 1000
+
+// userStatuses are the user statuses by their name in the search arguments.
+var userStatuses = map[string]console.UserStatus{
+	"inactive":  console.Inactive,
+	"active":    console.Active,
+	"deleted":   console.Deleted,
+	"suspended": console.Suspended,
+}
+
+// searchArguments contains the query arguments shared by the search endpoints.
+type searchArguments struct {
+	Partner       string `schema:"partner"`
+	CreatedAfter  string `schema:"createdAfter"`
+	CreatedBefore string `schema:"createdBefore"`
+	Limit         int    `schema:"limit"`
+	Offset        int64  `schema:"offset"`
+}
+
+func (server *Server) searchUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var arguments struct {
+		searchArguments
+		Email  string `schema:"email"`
+		Name   string `schema:"name"`
+		Paid   *bool  `schema:"paid"`
+		Status string `schema:"status"`
+	}
+	if !decodeSearchArguments(w, r, &arguments) {
+		return
+	}
+
+	criteria, ok := parseSearchArguments(w, r, arguments.searchArguments)
+	if !ok {
+		return
+	}
+
+	search := console.UserSearch{
+		Email:         arguments.Email,
+		Name:          arguments.Name,
+		PartnerID:     criteria.partnerID,
+		CreatedAfter:  criteria.createdAfter,
+		CreatedBefore: criteria.createdBefore,
+		PaidTier:      arguments.Paid,
+		Limit:         criteria.limit,
+		Offset:        criteria.offset,
+	}
+	if arguments.Status != "" {
+		status, ok := userStatuses[arguments.Status]
+		if !ok {
+			httpJSONError(w, "invalid status",
+				fmt.Sprintf("unknown status %q", arguments.Status), http.StatusBadRequest)
+			return
+		}
+		search.Status = &status
+	}
+
+	users, err := server.db.Console().Users().Search(ctx, search)
+	if err != nil {
+		httpJSONError(w, "failed to search users",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type User struct {
+		ID           uuid.UUID  `json:"id"`
+		FullName     string     `json:"fullName"`
+		Email        string     `json:"email"`
+		Status       string     `json:"status"`
+		PartnerID    *uuid.UUID `json:"partnerId,omitempty"`
+		PaidTier     bool       `json:"paidTier"`
+		ProjectLimit int        `json:"projectLimit"`
+		CreatedAt    time.Time  `json:"createdAt"`
+	}
+
+	output := make([]User, 0, len(users))
+	for _, user := range users {
+		item := User{
+			ID:           user.ID,
+			FullName:     user.FullName,
+			Email:        user.Email,
+			Status:       userStatusName(user.Status),
+			PaidTier:     user.PaidTier,
+			ProjectLimit: user.ProjectLimit,
+			CreatedAt:    user.CreatedAt,
+		}
+		if !user.PartnerID.IsZero() {
+			partnerID := user.PartnerID
+			item.PartnerID = &partnerID
+		}
+		output = append(output, item)
+	}
+
+	server.auditEvent(r, "search users", nil, nil, formDetails(r))
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+func (server *Server) searchProjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var arguments struct {
+		searchArguments
+		Name  string `schema:"name"`
+		Owner string `schema:"owner"`
+	}
+	if !decodeSearchArguments(w, r, &arguments) {
+		return
+	}
+
+	criteria, ok := parseSearchArguments(w, r, arguments.searchArguments)
+	if !ok {
+		return
+	}
+
+	search := console.ProjectSearch{
+		Name:          arguments.Name,
+		PartnerID:     criteria.partnerID,
+		CreatedAfter:  criteria.createdAfter,
+		CreatedBefore: criteria.createdBefore,
+		Limit:         criteria.limit,
+		Offset:        criteria.offset,
+	}
+	if arguments.Owner != "" {
+		owner, err := server.db.Console().Users().GetByEmail(ctx, arguments.Owner)
+		if errors.Is(err, sql.ErrNoRows) {
+			httpJSONError(w, fmt.Sprintf("user with email %q not found", arguments.Owner),
+				"", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			httpJSONError(w, "failed to get user",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+		search.OwnerID = &owner.ID
+	}
+
+	projects, err := server.db.Console().Projects().Search(ctx, search)
+	if err != nil {
+		httpJSONError(w, "failed to search projects",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type Project struct {
+		ID          uuid.UUID  `json:"id"`
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		OwnerID     uuid.UUID  `json:"ownerId"`
+		PartnerID   *uuid.UUID `json:"partnerId,omitempty"`
+		CreatedAt   time.Time  `json:"createdAt"`
+	}
+
+	output := make([]Project, 0, len(projects))
+	for _, project := range projects {
+		item := Project{
+			ID:          project.ID,
+			Name:        project.Name,
+			Description: project.Description,
+			OwnerID:     project.OwnerID,
+			CreatedAt:   project.CreatedAt,
+		}
+		if !project.PartnerID.IsZero() {
+			partnerID := project.PartnerID
+			item.PartnerID = &partnerID
+		}
+		output = append(output, item)
+	}
+
+	server.auditEvent(r, "search projects", nil, nil, formDetails(r))
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+// decodeSearchArguments decodes the query arguments of a search into arguments.
+func decodeSearchArguments(w http.ResponseWriter, r *http.Request, arguments interface{}) (ok bool) {
+	if err := r.ParseForm(); err != nil {
+		httpJSONError(w, "invalid form",
+			err.Error(), http.StatusBadRequest)
+		return false
+	}
+
+	decoder := schema.NewDecoder()
+	err := decoder.Decode(arguments, r.Form)
+	if err != nil {
+		httpJSONError(w, "invalid arguments",
+			err.Error(), http.StatusBadRequest)
+		return false
+	}
+
+	return true
+}
+
+// searchCriteria are the parsed search arguments.
+type searchCriteria struct {
+	partnerID     *uuid.UUID
+	createdAfter  *time.Time
+	createdBefore *time.Time
+	limit         int
+	offset        int64
+}
+
+// parseSearchArguments validates the shared search arguments.
+func parseSearchArguments(w http.ResponseWriter, r *http.Request, arguments searchArguments) (_ searchCriteria, ok bool) {
+	criteria := searchCriteria{
+		limit:  arguments.Limit,
+		offset: arguments.Offset,
+	}
+
+	if arguments.Partner != "" {
+		partner, err := findPartner(r.Context(), arguments.Partner)
+		if err != nil {
+			httpJSONError(w, "unable to find partner",
+				err.Error(), http.StatusNotFound)
+			return searchCriteria{}, false
+		}
+		criteria.partnerID = &partner.UUID
+	}
+
+	for _, argument := range []struct {
+		name  string
+		value string
+		time  **time.Time
+	}{
+		{"createdAfter", arguments.CreatedAfter, &criteria.createdAfter},
+		{"createdBefore", arguments.CreatedBefore, &criteria.createdBefore},
+	} {
+		if argument.value == "" {
+			continue
+		}
+		t, err := parseSearchTime(argument.value)
+		if err != nil {
+			httpJSONError(w, "invalid "+argument.name,
+				err.Error(), http.StatusBadRequest)
+			return searchCriteria{}, false
+		}
+		*argument.time = &t
+	}
+
+	switch {
+	case criteria.limit < 0 || criteria.limit > maxSearchLimit:
+		httpJSONError(w, "invalid limit",
+			fmt.Sprintf("limit must be between 1 and %d", maxSearchLimit), http.StatusBadRequest)
+		return searchCriteria{}, false
+	case criteria.offset < 0:
+		httpJSONError(w, "invalid offset",
+			"offset can not be negative", http.StatusBadRequest)
+		return searchCriteria{}, false
+	case criteria.limit == 0:
+		criteria.limit = 50
+	}
+
+	return criteria, true
+}
+
+// parseSearchTime parses a RFC 3339 timestamp or a date.
+func parseSearchTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// formDetails returns the request arguments for the audit log.
+func formDetails(r *http.Request) map[string]interface{} {
+	details := make(map[string]interface{}, len(r.Form))
+	for key, values := range r.Form {
+		if len(values) > 0 {
+			details[key] = values[0]
+		}
+	}
+	return details
+}
+
+// userStatusName returns the name of the user status used by the search arguments.
+func userStatusName(status console.UserStatus) string {
+	for name, value := range userStatuses {
+		if value == status {
+			return name
+		}
+	}
+	return fmt.Sprint(int(status))
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestSearch(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		authToken := sat.Config.Console.AuthToken
+		address := "http://" + sat.Admin.Admin.Listener.Addr().String()
+
+		alice, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Alice Liddell",
+			Email:    "alice@wonderland.test",
+		}, 2)
+		require.NoError(t, err)
+		bob, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Bob Percent%",
+			Email:    "bob@builders.test",
+		}, 2)
+		require.NoError(t, err)
+		require.NoError(t, sat.DB.Console().Users().UpdatePaidTier(ctx, bob.ID, true))
+
+		aliceProject, err := sat.AddProject(ctx, alice.ID, "Rabbit Hole")
+		require.NoError(t, err)
+		_, err = sat.AddProject(ctx, bob.ID, "Construction")
+		require.NoError(t, err)
+
+		searchUsers := func(query url.Values) []uuid.UUID {
+			body := assertReq(ctx, t, address+"/api/users?"+query.Encode(), http.MethodGet, "", http.StatusOK, "", authToken)
+			var users []struct {
+				ID uuid.UUID `json:"id"`
+			}
+			require.NoError(t, json.Unmarshal(body, &users))
+
+			ids := []uuid.UUID{}
+			for _, user := range users {
+				ids = append(ids, user.ID)
+			}
+			return ids
+		}
+
+		require.Equal(t, []uuid.UUID{bob.ID, alice.ID}, searchUsers(url.Values{}))
+		require.Equal(t, []uuid.UUID{alice.ID}, searchUsers(url.Values{"email": {"WONDERLAND"}}))
+		require.Equal(t, []uuid.UUID{alice.ID}, searchUsers(url.Values{"name": {"liddell"}}))
+		require.Equal(t, []uuid.UUID{bob.ID}, searchUsers(url.Values{"name": {"t%"}}))
+		require.Equal(t, []uuid.UUID{}, searchUsers(url.Values{"name": {"%"}, "email": {"alice"}}))
+		require.Equal(t, []uuid.UUID{bob.ID}, searchUsers(url.Values{"paid": {"true"}}))
+		require.Equal(t, []uuid.UUID{alice.ID}, searchUsers(url.Values{"paid": {"false"}, "status": {"active"}}))
+		require.Equal(t, []uuid.UUID{}, searchUsers(url.Values{"status": {"suspended"}}))
+		require.Equal(t, []uuid.UUID{alice.ID}, searchUsers(url.Values{"limit": {"1"}, "offset": {"1"}}))
+
+		tomorrow := time.Now().Add(24 * time.Hour).Format("2006-01-02")
+		require.Equal(t, []uuid.UUID{}, searchUsers(url.Values{"createdAfter": {tomorrow}}))
+		require.Equal(t, []uuid.UUID{bob.ID, alice.ID}, searchUsers(url.Values{"createdBefore": {tomorrow}}))
+
+		assertReq(ctx, t, address+"/api/users?status=unknown", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, address+"/api/users?createdAfter=yesterday", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, address+"/api/users?limit=5000", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, address+"/api/users?unknown=1", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, address+"/api/users?partner=unknown", http.MethodGet, "", http.StatusNotFound, "", authToken)
+
+		body := assertReq(ctx, t, address+"/api/projects?name=rabbit&owner="+alice.Email, http.MethodGet, "", http.StatusOK, "", authToken)
+		var projects []struct {
+			ID      uuid.UUID `json:"id"`
+			OwnerID uuid.UUID `json:"ownerId"`
+		}
+		require.NoError(t, json.Unmarshal(body, &projects))
+		require.Len(t, projects, 1)
+		require.Equal(t, aliceProject.ID, projects[0].ID)
+		require.Equal(t, alice.ID, projects[0].OwnerID)
+
+		assertReq(ctx, t, address+"/api/projects?name=rabbit&owner="+bob.Email, http.MethodGet, "", http.StatusOK, "[]", authToken)
+		assertReq(ctx, t, address+"/api/projects?owner=unknown@mail.test", http.MethodGet, "", http.StatusNotFound, "", authToken)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"crypto/subtle"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"time"
@@ -18,9 +19,14 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/admin/credentials"
+	"storj.io/storj/satellite/consistency"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/inventory"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/priceplans"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 )
 
@@ -29,6 +35,7 @@ type Config struct {
 	Address string `help:"admin peer http listening address" releaseDefault:"" devDefault:""`
 
 	AuthorizationToken string `internal:"true"`
+	AuthTokenReadOnly  bool   `help:"allow the deprecated console auth token only read-only admin requests" default:"false"`
 }
 
 // DB is databases needed for the admin server.
@@ -41,6 +48,14 @@ type DB interface {
 	StripeCoinPayments() stripecoinpayments.DB
 	// Buckets returns database for satellite buckets
 	Buckets() metainfo.BucketsDB
+	// BucketInventories returns database for bucket inventory configurations
+	BucketInventories() inventory.DB
+	// ConsistencyFixes returns database for fixes queued by the metabase consistency checker
+	ConsistencyFixes() consistency.DB
+	// PricePlans returns database for usage based price plans
+	PricePlans() priceplans.DB
+	// AdminCredentials returns database for the named admin API credentials
+	AdminCredentials() credentials.DB
 }
 
 // Server provides endpoints for administrative tasks.
@@ -51,72 +66,124 @@ type Server struct {
 	server   http.Server
 	mux      *mux.Router
 
-	db       DB
-	payments payments.Accounts
+	db          DB
+	metabaseDB  *metabase.DB
+	payments    payments.Accounts
+	inventories *inventory.Service
 
 	nowFn func() time.Time
 }
 
 // NewServer returns a new administration Server.
-func NewServer(log *zap.Logger, listener net.Listener, db DB, accounts payments.Accounts, config Config) *Server {
+func NewServer(log *zap.Logger, listener net.Listener, db DB, metabaseDB *metabase.DB, accounts payments.Accounts, inventories *inventory.Service, config Config) *Server {
 	server := &Server{
 		log: log,
 
 		listener: listener,
 		mux:      mux.NewRouter(),
 
-		db:       db,
-		payments: accounts,
+		db:          db,
+		metabaseDB:  metabaseDB,
+		payments:    accounts,
+		inventories: inventories,
 
 		nowFn: time.Now,
 	}
 
+	staticScopes := credentials.Scopes
+	if config.AuthTokenReadOnly {
+		staticScopes = []credentials.Scope{credentials.ScopeRead}
+	}
+
+	if config.AuthorizationToken != "" {
+		log.Warn("The shared console auth token is deprecated for the admin API and will be allowed only read-only requests "+
+			"in a future release, create named credentials with the storj-admin credentials commands instead.",
+			zap.Bool("read-only", config.AuthTokenReadOnly))
+	}
+
 	server.server.Handler = &protectedServer{
+		log:         log,
+		credentials: db.AdminCredentials(),
+
 		allowedAuthorization: config.AuthorizationToken,
+		staticScopes:         staticScopes,
 		next:                 server.mux,
 	}
 
 	// When adding new options, also update README.md
-	server.mux.HandleFunc("/api/users", server.addUser).Methods("POST")
-	server.mux.HandleFunc("/api/users/{useremail}", server.updateUser).Methods("PUT")
-	server.mux.HandleFunc("/api/users/{useremail}", server.userInfo).Methods("GET")
-	server.mux.HandleFunc("/api/users/{useremail}", server.deleteUser).Methods("DELETE")
-	server.mux.HandleFunc("/api/coupons", server.addCoupon).Methods("POST")
-	server.mux.HandleFunc("/api/coupons/{couponid}", server.couponInfo).Methods("GET")
-	server.mux.HandleFunc("/api/coupons/{couponid}", server.deleteCoupon).Methods("DELETE")
-	server.mux.HandleFunc("/api/projects", server.addProject).Methods("POST")
-	server.mux.HandleFunc("/api/projects/{project}/usage", server.checkProjectUsage).Methods("GET")
-	server.mux.HandleFunc("/api/projects/{project}/limit", server.getProjectLimit).Methods("GET")
-	server.mux.HandleFunc("/api/projects/{project}/limit", server.putProjectLimit).Methods("PUT", "POST")
-	server.mux.HandleFunc("/api/projects/{project}", server.getProject).Methods("GET")
-	server.mux.HandleFunc("/api/projects/{project}", server.renameProject).Methods("PUT")
-	server.mux.HandleFunc("/api/projects/{project}", server.deleteProject).Methods("DELETE")
-	server.mux.HandleFunc("/api/projects/{project}/apikeys", server.listAPIKeys).Methods("GET")
-	server.mux.HandleFunc("/api/projects/{project}/apikeys", server.addAPIKey).Methods("POST")
-	server.mux.HandleFunc("/api/projects/{project}/apikeys/{name}", server.deleteAPIKeyByName).Methods("DELETE")
-	server.mux.HandleFunc("/api/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
+	server.route(credentials.ScopeUsers, "/api/users", server.addUser).Methods("POST")
+	server.route(credentials.ScopeRead, "/api/users", server.searchUsers).Methods("GET")
+	server.route(credentials.ScopeUsers, "/api/users/{useremail}", server.updateUser).Methods("PUT")
+	server.route(credentials.ScopeRead, "/api/users/{useremail}", server.userInfo).Methods("GET")
+	server.route(credentials.ScopeDestructive, "/api/users/{useremail}", server.deleteUser).Methods("DELETE")
+	server.route(credentials.ScopeRead, "/api/users/{useremail}/audit-events", server.userAuditEvents).Methods("GET")
+	server.route(credentials.ScopeUsers, "/api/users/{useremail}/suspend", server.suspendUser).Methods("POST")
+	server.route(credentials.ScopeUsers, "/api/users/{useremail}/unsuspend", server.unsuspendUser).Methods("POST")
+	server.route(credentials.ScopeUsers, "/api/users/{useremail}/freeze-projects", server.freezeUserProjects).Methods("POST")
+	server.route(credentials.ScopeUsers, "/api/users/{useremail}/unfreeze-projects", server.unfreezeUserProjects).Methods("POST")
+	server.route(credentials.ScopeBilling, "/api/coupons", server.addCoupon).Methods("POST")
+	server.route(credentials.ScopeRead, "/api/coupons/{couponid}", server.couponInfo).Methods("GET")
+	server.route(credentials.ScopeBilling, "/api/coupons/{couponid}", server.deleteCoupon).Methods("DELETE")
+	server.route(credentials.ScopeUsers, "/api/projects", server.addProject).Methods("POST")
+	server.route(credentials.ScopeRead, "/api/projects", server.searchProjects).Methods("GET")
+	server.route(credentials.ScopeLimits, "/api/projects/limits", server.putBulkProjectLimits).Methods("PUT", "POST")
+	server.route(credentials.ScopeRead, "/api/projects/{project}/usage", server.checkProjectUsage).Methods("GET")
+	server.route(credentials.ScopeRead, "/api/projects/{project}/limit", server.getProjectLimit).Methods("GET")
+	server.route(credentials.ScopeLimits, "/api/projects/{project}/limit", server.putProjectLimit).Methods("PUT", "POST")
+	server.route(credentials.ScopeRead, "/api/projects/{project}", server.getProject).Methods("GET")
+	server.route(credentials.ScopeUsers, "/api/projects/{project}", server.renameProject).Methods("PUT")
+	server.route(credentials.ScopeDestructive, "/api/projects/{project}", server.deleteProject).Methods("DELETE")
+	server.route(credentials.ScopeRead, "/api/projects/{project}/audit-events", server.projectAuditEvents).Methods("GET")
+	server.route(credentials.ScopeRead, "/api/projects/{project}/apikeys", server.listAPIKeys).Methods("GET")
+	server.route(credentials.ScopeUsers, "/api/projects/{project}/apikeys", server.addAPIKey).Methods("POST")
+	server.route(credentials.ScopeDestructive, "/api/projects/{project}/apikeys/{name}", server.deleteAPIKeyByName).Methods("DELETE")
+	server.route(credentials.ScopeRead, "/api/projects/{project}/buckets/{bucket}/inventory", server.getBucketInventory).Methods("GET")
+	server.route(credentials.ScopeUsers, "/api/projects/{project}/buckets/{bucket}/inventory", server.putBucketInventory).Methods("PUT")
+	server.route(credentials.ScopeUsers, "/api/projects/{project}/buckets/{bucket}/inventory", server.deleteBucketInventory).Methods("DELETE")
+	server.route(credentials.ScopeRead, "/api/projects/{project}/buckets/{bucket}/inventory/report", server.downloadBucketInventory).Methods("GET")
+	server.route(credentials.ScopeBilling, "/api/projects/{project}/priceplan", server.putProjectPricePlan).Methods("PUT")
+	server.route(credentials.ScopeBilling, "/api/projects/{project}/priceplan", server.deleteProjectPricePlan).Methods("DELETE")
+	server.route(credentials.ScopeDestructive, "/api/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
+	server.route(credentials.ScopeRead, "/api/consistency/fixes", server.listConsistencyFixes).Methods("GET")
+	server.route(credentials.ScopeDestructive, "/api/consistency/fixes/{fix}/approve", server.approveConsistencyFix).Methods("POST")
+	server.route(credentials.ScopeDestructive, "/api/consistency/fixes/{fix}/reject", server.rejectConsistencyFix).Methods("POST")
+	server.route(credentials.ScopeRead, "/api/priceplans", server.listPricePlans).Methods("GET")
+	server.route(credentials.ScopeBilling, "/api/priceplans", server.addPricePlan).Methods("POST")
+	server.route(credentials.ScopeRead, "/api/priceplans/{priceplan}", server.getPricePlan).Methods("GET")
+	server.route(credentials.ScopeBilling, "/api/priceplans/{priceplan}", server.updatePricePlan).Methods("PUT")
+	server.route(credentials.ScopeBilling, "/api/priceplans/{priceplan}", server.deletePricePlan).Methods("DELETE")
+	server.route(credentials.ScopeBilling, "/api/partners/{partner}/priceplan", server.putPartnerPricePlan).Methods("PUT")
+	server.route(credentials.ScopeBilling, "/api/partners/{partner}/priceplan", server.deletePartnerPricePlan).Methods("DELETE")
 
 	return server
 }
 
+// staticCredentialName is the name of the credential of the shared authorization token.
+const staticCredentialName = "auth-token"
+
 type protectedServer struct {
+	log         *zap.Logger
+	credentials credentials.DB
+
 	allowedAuthorization string
+	staticScopes         []credentials.Scope
 
 	next http.Handler
 }
 
 func (server *protectedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if server.allowedAuthorization == "" {
-		httpJSONError(w, "Authorization not enabled.",
-			"", http.StatusForbidden)
+	credential, err := server.authenticate(r)
+	if err != nil {
+		server.log.Error("failed to authenticate admin request", zap.Error(err))
+		httpJSONError(w, "failed to authenticate",
+			"", http.StatusInternalServerError)
 		return
 	}
-
-	equality := subtle.ConstantTimeCompare(
-		[]byte(r.Header.Get("Authorization")),
-		[]byte(server.allowedAuthorization),
-	)
-	if equality != 1 {
+	if credential == nil {
+		server.log.Info("unauthorized admin request",
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path),
+			zap.String("remoteAddr", r.RemoteAddr))
 		httpJSONError(w, "Forbidden",
 			"", http.StatusForbidden)
 		return
@@ -124,7 +191,90 @@ func (server *protectedServer) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 	r.Header.Set("Cache-Control", "must-revalidate")
 
-	server.next.ServeHTTP(w, r)
+	recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	server.next.ServeHTTP(recorder, r.WithContext(withCredential(r.Context(), credential)))
+
+	server.log.Info("admin request",
+		zap.String("credential", credential.Name),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+		zap.Int("status", recorder.status),
+		zap.String("remoteAddr", r.RemoteAddr))
+}
+
+// authenticate returns the credential of the request authorization, or nil
+// when it doesn't match any credential. The deprecated shared authorization
+// token is allowed the configured static scopes.
+func (server *protectedServer) authenticate(r *http.Request) (*credentials.Credential, error) {
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" {
+		return nil, nil
+	}
+
+	if server.allowedAuthorization != "" {
+		equality := subtle.ConstantTimeCompare(
+			[]byte(authorization),
+			[]byte(server.allowedAuthorization),
+		)
+		if equality == 1 {
+			return &credentials.Credential{
+				Name:   staticCredentialName,
+				Scopes: server.staticScopes,
+			}, nil
+		}
+	}
+
+	credential, err := server.credentials.GetBySecretHash(r.Context(), credentials.HashSecret(authorization))
+	if credentials.ErrNotFound.Has(err) {
+		return nil, nil
+	}
+	return credential, err
+}
+
+// statusRecorder records the status code of the response for the request log.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the status code and writes it to the response.
+func (recorder *statusRecorder) WriteHeader(status int) {
+	recorder.status = status
+	recorder.ResponseWriter.WriteHeader(status)
+}
+
+// Flush flushes the response when the underlying writer supports it.
+func (recorder *statusRecorder) Flush() {
+	if flusher, ok := recorder.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+type credentialKey struct{}
+
+// withCredential returns a context with the credential of the request.
+func withCredential(ctx context.Context, credential *credentials.Credential) context.Context {
+	return context.WithValue(ctx, credentialKey{}, credential)
+}
+
+// credentialFromContext returns the credential of the request.
+func credentialFromContext(ctx context.Context) *credentials.Credential {
+	credential, _ := ctx.Value(credentialKey{}).(*credentials.Credential)
+	return credential
+}
+
+// route registers the handler for the path, which requires the credential of
+// the request to have the scope.
+func (server *Server) route(scope credentials.Scope, path string, handler http.HandlerFunc) *mux.Route {
+	return server.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		credential := credentialFromContext(r.Context())
+		if credential == nil || !credential.Allows(scope) {
+			httpJSONError(w, "Forbidden",
+				fmt.Sprintf("credential requires the %q scope", scope), http.StatusForbidden)
+			return
+		}
+		handler(w, r)
+	})
 }
 
 // Run starts the admin endpoint.
@@ -6,13 +6,16 @@ package admin_test
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/admin/credentials"
 )
 
 func TestBasic(t *testing.T) {
@@ -64,5 +67,93 @@ func TestBasic(t *testing.T) {
 			require.Equal(t, http.StatusNotFound, response.StatusCode)
 			require.NoError(t, response.Body.Close())
 		})
+
+		t.Run("AuthTokenFullScope", func(t *testing.T) {
+			// the deprecated auth token is allowed every scope, unless it's configured read-only.
+			assertReq(ctx, t, "http://"+address.String()+"/api/users/alice@mail.test", http.MethodDelete, "", http.StatusNotFound,
+				"", sat.Config.Console.AuthToken)
+		})
+	})
+}
+
+func TestAuthTokenReadOnly(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+				config.Admin.AuthTokenReadOnly = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		authToken := sat.Config.Console.AuthToken
+		link := "http://" + sat.Admin.Admin.Listener.Addr().String() + "/api/users/alice@mail.test"
+
+		assertReq(ctx, t, link, http.MethodGet, "", http.StatusNotFound, "", authToken)
+		assertReq(ctx, t, link, http.MethodDelete, "", http.StatusForbidden,
+			`{"error":"Forbidden","detail":"credential requires the \"destructive\" scope"}`, authToken)
+		assertReq(ctx, t, link, http.MethodPut, `{"fullName":"Alice"}`, http.StatusForbidden,
+			`{"error":"Forbidden","detail":"credential requires the \"users\" scope"}`, authToken)
+	})
+}
+
+func TestCredentials(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		db := sat.DB.AdminCredentials()
+		project := planet.Uplinks[0].Projects[0]
+		link := "http://" + address.String() + "/api/projects/" + project.ID.String()
+
+		secret, hash, err := credentials.NewSecret()
+		require.NoError(t, err)
+
+		credential := credentials.Credential{
+			ID:         testrand.UUID(),
+			Name:       "support",
+			Scopes:     []credentials.Scope{credentials.ScopeRead},
+			SecretHash: hash,
+			CreatedAt:  time.Now(),
+		}
+		require.NoError(t, db.Insert(ctx, credential))
+		require.True(t, credentials.ErrNameTaken.Has(db.Insert(ctx, credential)))
+
+		t.Run("Scopes", func(t *testing.T) {
+			assertReq(ctx, t, link+"/limit", http.MethodGet, "", http.StatusOK, "", secret)
+			assertReq(ctx, t, link+"/limit?usage=1GB", http.MethodPut, "", http.StatusForbidden,
+				`{"error":"Forbidden","detail":"credential requires the \"limits\" scope"}`, secret)
+			assertReq(ctx, t, link, http.MethodDelete, "", http.StatusForbidden, "", secret)
+
+			require.NoError(t, db.UpdateScopes(ctx, credential.ID, []credentials.Scope{credentials.ScopeLimits, credentials.ScopeRead}))
+			assertReq(ctx, t, link+"/limit?usage=1GB", http.MethodPut, "", http.StatusOK, "", secret)
+		})
+
+		t.Run("Rotate", func(t *testing.T) {
+			newSecret, newHash, err := credentials.NewSecret()
+			require.NoError(t, err)
+			require.NoError(t, db.Rotate(ctx, credential.ID, newHash, time.Now()))
+
+			assertReq(ctx, t, link+"/limit", http.MethodGet, "", http.StatusForbidden, "", secret)
+			assertReq(ctx, t, link+"/limit", http.MethodGet, "", http.StatusOK, "", newSecret)
+
+			rotated, err := db.GetByName(ctx, "support")
+			require.NoError(t, err)
+			require.NotNil(t, rotated.RotatedAt)
+
+			require.NoError(t, db.Delete(ctx, credential.ID))
+			assertReq(ctx, t, link+"/limit", http.MethodGet, "", http.StatusForbidden, "", newSecret)
+		})
 	})
 }
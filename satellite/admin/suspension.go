@@ -0,0 +1,285 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// freezeReasonAdmin is the reason of the freezes done with the freeze-projects endpoint.
+const freezeReasonAdmin = "admin"
+
+// suspendUser suspends the user and freezes their projects. It can be retried
+// on a suspended user, to finish a suspension that failed half way.
+func (server *Server) suspendUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	user, ok := server.userFromVars(w, r)
+	if !ok {
+		return
+	}
+	if user.Status != console.Active && user.Status != console.Suspended {
+		httpJSONError(w, "only active users can be suspended",
+			fmt.Sprintf("user status is %s", userStatusName(user.Status)), http.StatusConflict)
+		return
+	}
+
+	var frozen []uuid.UUID
+	err := server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) (err error) {
+		if user.Status != console.Suspended {
+			suspended := *user
+			suspended.Status = console.Suspended
+			if err := tx.Users().Update(ctx, &suspended); err != nil {
+				return err
+			}
+		}
+
+		frozen, err = freezeOwnProjects(ctx, tx, user.ID, console.FreezeReasonSuspension)
+		return err
+	})
+	if err != nil {
+		httpJSONError(w, "failed to suspend user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	server.auditFreezes(r, user, frozen, console.FreezeReasonSuspension)
+
+	// the sessions are deleted, so that the user is logged out of the console.
+	sessions, err := server.db.Console().WebappSessions().DeleteAllByUserID(ctx, user.ID)
+	if err != nil {
+		httpJSONError(w, "failed to delete user sessions",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	server.auditEvent(r, "suspend user", user, nil, map[string]interface{}{
+		"deletedSessions": sessions,
+		"frozenProjects":  frozen,
+	})
+
+	writeProjectIDs(w, "frozenProjects", frozen)
+}
+
+// unsuspendUser reactivates the user and unfreezes the projects frozen by the
+// suspension.
+func (server *Server) unsuspendUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	user, ok := server.userFromVars(w, r)
+	if !ok {
+		return
+	}
+	if user.Status != console.Suspended {
+		httpJSONError(w, "user is not suspended",
+			"", http.StatusConflict)
+		return
+	}
+
+	var unfrozen []console.ProjectFreeze
+	err := server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) (err error) {
+		active := *user
+		active.Status = console.Active
+		if err := tx.Users().Update(ctx, &active); err != nil {
+			return err
+		}
+
+		// only the projects frozen by the suspension are unfrozen.
+		unfrozen, err = unfreezeOwnProjects(ctx, tx, user.ID, console.FreezeReasonSuspension)
+		return err
+	})
+	if err != nil {
+		httpJSONError(w, "failed to unsuspend user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	unfrozenIDs := server.auditUnfreezes(r, user, unfrozen)
+	server.auditEvent(r, "unsuspend user", user, nil, map[string]interface{}{
+		"unfrozenProjects": unfrozenIDs,
+	})
+
+	writeProjectIDs(w, "unfrozenProjects", unfrozenIDs)
+}
+
+func (server *Server) freezeUserProjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	user, ok := server.userFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	var frozen []uuid.UUID
+	err := server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) (err error) {
+		frozen, err = freezeOwnProjects(ctx, tx, user.ID, freezeReasonAdmin)
+		return err
+	})
+	if err != nil {
+		httpJSONError(w, "failed to freeze projects",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	server.auditFreezes(r, user, frozen, freezeReasonAdmin)
+	server.auditEvent(r, "freeze projects", user, nil, map[string]interface{}{
+		"frozenProjects": frozen,
+	})
+
+	writeProjectIDs(w, "frozenProjects", frozen)
+}
+
+func (server *Server) unfreezeUserProjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	user, ok := server.userFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	var unfrozen []console.ProjectFreeze
+	err := server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) (err error) {
+		unfrozen, err = unfreezeOwnProjects(ctx, tx, user.ID, "")
+		return err
+	})
+	if err != nil {
+		httpJSONError(w, "failed to unfreeze projects",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	unfrozenIDs := server.auditUnfreezes(r, user, unfrozen)
+	server.auditEvent(r, "unfreeze projects", user, nil, map[string]interface{}{
+		"unfrozenProjects": unfrozenIDs,
+	})
+
+	writeProjectIDs(w, "unfrozenProjects", unfrozenIDs)
+}
+
+// freezeOwnProjects freezes the projects owned by the user, which aren't frozen yet,
+// and returns the ids of the frozen projects.
+func freezeOwnProjects(ctx context.Context, tx console.DBTx, userID uuid.UUID, reason string) (frozen []uuid.UUID, err error) {
+	projects, err := tx.Projects().GetOwn(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen = []uuid.UUID{}
+	for _, project := range projects {
+		changed, err := tx.ProjectFreezes().Freeze(ctx, project.ID, reason)
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			frozen = append(frozen, project.ID)
+		}
+	}
+
+	return frozen, nil
+}
+
+// unfreezeOwnProjects unfreezes the projects owned by the user, which were frozen
+// with the given reason, or for any reason when it's empty. It returns the
+// removed freezes.
+func unfreezeOwnProjects(ctx context.Context, tx console.DBTx, userID uuid.UUID, reason string) (unfrozen []console.ProjectFreeze, err error) {
+	projects, err := tx.Projects().GetOwn(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range projects {
+		freeze, err := tx.ProjectFreezes().Get(ctx, project.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" && freeze.Reason != reason {
+			continue
+		}
+
+		changed, err := tx.ProjectFreezes().Unfreeze(ctx, project.ID)
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			unfrozen = append(unfrozen, *freeze)
+		}
+	}
+
+	return unfrozen, nil
+}
+
+// auditFreezes stores an audit event for each of the frozen projects.
+func (server *Server) auditFreezes(r *http.Request, user *console.User, projectIDs []uuid.UUID, reason string) {
+	for _, projectID := range projectIDs {
+		projectID := projectID
+		server.auditEvent(r, "freeze project", user, &projectID, map[string]interface{}{
+			"reason": reason,
+		})
+	}
+}
+
+// auditUnfreezes stores an audit event for each of the removed freezes and
+// returns the ids of the unfrozen projects.
+func (server *Server) auditUnfreezes(r *http.Request, user *console.User, unfrozen []console.ProjectFreeze) []uuid.UUID {
+	projectIDs := []uuid.UUID{}
+	for _, freeze := range unfrozen {
+		projectID := freeze.ProjectID
+		server.auditEvent(r, "unfreeze project", user, &projectID, map[string]interface{}{
+			"reason": freeze.Reason,
+		})
+		projectIDs = append(projectIDs, projectID)
+	}
+	return projectIDs
+}
+
+// userFromVars returns the user identified by the email in the request path.
+func (server *Server) userFromVars(w http.ResponseWriter, r *http.Request) (_ *console.User, ok bool) {
+	ctx := r.Context()
+
+	userEmail, ok := mux.Vars(r)["useremail"]
+	if !ok {
+		httpJSONError(w, "user-email missing",
+			"", http.StatusBadRequest)
+		return nil, false
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		httpJSONError(w, fmt.Sprintf("user with email %q not found", userEmail),
+			"", http.StatusNotFound)
+		return nil, false
+	}
+	if err != nil {
+		httpJSONError(w, "failed to get user",
+			err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return user, true
+}
+
+// writeProjectIDs writes a response with the project ids under the given key.
+func writeProjectIDs(w http.ResponseWriter, key string, projectIDs []uuid.UUID) {
+	data, err := json.Marshal(map[string][]uuid.UUID{key: projectIDs})
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+)
+
+func TestSuspendUser(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		authToken := sat.Config.Console.AuthToken
+		address := "http://" + sat.Admin.Admin.Listener.Addr().String()
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Suspended User",
+			Email:    "suspended@mail.test",
+		}, 2)
+		require.NoError(t, err)
+
+		limited, err := sat.AddProject(ctx, user.ID, "limited")
+		require.NoError(t, err)
+		other, err := sat.AddProject(ctx, user.ID, "other")
+		require.NoError(t, err)
+		require.NoError(t, sat.DB.ProjectAccounting().UpdateProjectUsageLimit(ctx, limited.ID, 5*memory.GB))
+
+		usageLimit := func(projectID uuid.UUID) int64 {
+			project, err := sat.DB.Console().Projects().Get(ctx, projectID)
+			require.NoError(t, err)
+			require.NotNil(t, project.StorageLimit)
+			return project.StorageLimit.Int64()
+		}
+		isFrozen := func(projectID uuid.UUID) bool {
+			frozen, err := sat.DB.Console().ProjectFreezes().IsFrozen(ctx, projectID)
+			require.NoError(t, err)
+			return frozen
+		}
+		otherLimit := usageLimit(other.ID)
+		require.NotZero(t, otherLimit)
+
+		login, err := service.Login(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+		require.NoError(t, err)
+
+		userLink := address + "/api/users/" + user.Email
+
+		assertReq(ctx, t, userLink+"/unsuspend", http.MethodPost, "", http.StatusConflict, "", authToken)
+		assertReq(ctx, t, address+"/api/users/unknown@mail.test/suspend", http.MethodPost, "", http.StatusNotFound, "", authToken)
+		assertReq(ctx, t, userLink+"/suspend", http.MethodPost, "", http.StatusOK,
+			`{"frozenProjects":["`+limited.ID.String()+`","`+other.ID.String()+`"]}`, authToken)
+		// suspending again finishes a failed suspension, without freezing the projects again.
+		assertReq(ctx, t, userLink+"/suspend", http.MethodPost, "", http.StatusOK,
+			`{"frozenProjects":[]}`, authToken)
+
+		suspended, err := sat.DB.Console().Users().Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, console.Suspended, suspended.Status)
+
+		_, err = service.Login(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+		require.True(t, console.ErrUnauthorized.Has(err), err)
+		_, err = service.Authorize(consoleauth.WithAPIKey(ctx, []byte(login.Token)))
+		require.Error(t, err)
+
+		// freezing keeps the limits of the projects.
+		require.True(t, isFrozen(limited.ID))
+		require.True(t, isFrozen(other.ID))
+		require.Equal(t, 5*memory.GB.Int64(), usageLimit(limited.ID))
+		require.Equal(t, otherLimit, usageLimit(other.ID))
+
+		freeze, err := sat.DB.Console().ProjectFreezes().Get(ctx, limited.ID)
+		require.NoError(t, err)
+		require.Equal(t, console.FreezeReasonSuspension, freeze.Reason)
+
+		// updating the limits doesn't unfreeze the project.
+		require.NoError(t, sat.DB.ProjectAccounting().UpdateProjectUsageLimit(ctx, limited.ID, 10*memory.GB))
+		require.True(t, isFrozen(limited.ID))
+
+		// a project frozen by an admin stays frozen after unsuspending.
+		_, err = sat.DB.Console().ProjectFreezes().Unfreeze(ctx, other.ID)
+		require.NoError(t, err)
+		require.False(t, isFrozen(other.ID))
+		assertReq(ctx, t, userLink+"/freeze-projects", http.MethodPost, "", http.StatusOK,
+			`{"frozenProjects":["`+other.ID.String()+`"]}`, authToken)
+
+		assertReq(ctx, t, userLink+"/unsuspend", http.MethodPost, "", http.StatusOK,
+			`{"unfrozenProjects":["`+limited.ID.String()+`"]}`, authToken)
+
+		_, err = service.Login(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+		require.NoError(t, err)
+		require.False(t, isFrozen(limited.ID))
+		require.True(t, isFrozen(other.ID))
+		// unfreezing doesn't overwrite the limits updated in the meantime.
+		require.Equal(t, 10*memory.GB.Int64(), usageLimit(limited.ID))
+
+		assertReq(ctx, t, userLink+"/unfreeze-projects", http.MethodPost, "", http.StatusOK,
+			`{"unfrozenProjects":["`+other.ID.String()+`"]}`, authToken)
+		require.False(t, isFrozen(other.ID))
+		require.Equal(t, otherLimit, usageLimit(other.ID))
+
+		events, err := sat.DB.Console().AuditEvents().GetPagedByUserID(ctx, user.ID, console.AuditEventsCursor{Limit: 50, Page: 1})
+		require.NoError(t, err)
+		operations := map[string]int{}
+		for _, event := range events.Events {
+			if event.Source == console.AuditEventSourceAdmin {
+				operations[event.Operation]++
+			}
+		}
+		require.Equal(t, map[string]int{
+			"suspend user":      2,
+			"unsuspend user":    1,
+			"freeze project":    3,
+			"unfreeze project":  2,
+			"freeze projects":   1,
+			"unfreeze projects": 1,
+		}, operations)
+	})
+}
@@ -118,6 +118,8 @@ func (server *Server) addUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	server.auditEvent(r, "create user", newuser, nil, nil)
+
 	data, err := json.Marshal(newuser)
 	if err != nil {
 		httpJSONError(w, "json encoding failed",
@@ -252,6 +254,27 @@ func (server *Server) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	details := map[string]interface{}{}
+	if input.FullName != "" {
+		details["fullName"] = input.FullName
+	}
+	if input.ShortName != "" {
+		details["shortName"] = input.ShortName
+	}
+	if input.Email != "" {
+		details["previousEmail"] = user.Email
+		details["email"] = input.Email
+	}
+	if !input.PartnerID.IsZero() {
+		details["partnerId"] = input.PartnerID.String()
+	}
+	if len(input.PasswordHash) > 0 {
+		details["passwordChanged"] = true
+	}
+	if input.ProjectLimit > 0 {
+		details["projectLimit"] = input.ProjectLimit
+	}
+
 	if input.FullName != "" {
 		user.FullName = input.FullName
 	}
@@ -277,6 +300,8 @@ func (server *Server) updateUser(w http.ResponseWriter, r *http.Request) {
 			err.Error(), http.StatusInternalServerError)
 		return
 	}
+
+	server.auditEvent(r, "update user", user, nil, details)
 }
 
 func (server *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
@@ -376,6 +401,8 @@ func (server *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	server.auditEvent(r, "delete user", user, nil, nil)
+
 	err = server.payments.CreditCards().RemoveAll(ctx, user.ID)
 	if err != nil {
 		httpJSONError(w, "unable to delete credit card(s) from stripe account",
@@ -39,15 +39,18 @@ import (
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/inspector"
 	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/inventory"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/apikeyusage"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
 	"storj.io/storj/satellite/nodestats"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/invoiceonly"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/reputation"
@@ -105,6 +108,7 @@ type API struct {
 		Metabase      *metabase.DB
 		Service       *metainfo.Service
 		PieceDeletion *piecedeletion.Service
+		APIKeyUsage   *apikeyusage.Tracker
 		Endpoint      *metainfo.Endpoint
 	}
 
@@ -129,10 +133,11 @@ type API struct {
 	}
 
 	Payments struct {
-		Accounts   payments.Accounts
-		Conversion *stripecoinpayments.ConversionService
-		Service    *stripecoinpayments.Service
-		Stripe     stripecoinpayments.StripeClient
+		Accounts    payments.Accounts
+		Conversion  *stripecoinpayments.ConversionService
+		Service     *stripecoinpayments.Service
+		Stripe      stripecoinpayments.StripeClient
+		InvoiceOnly *invoiceonly.Service
 	}
 
 	Console struct {
@@ -398,6 +403,19 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			Close: peer.Metainfo.PieceDeletion.Close,
 		})
 
+		peer.Metainfo.APIKeyUsage = apikeyusage.NewTracker(
+			peer.Log.Named("metainfo:apikeyusage"),
+			peer.DB.Console().APIKeys(),
+			config.Metainfo.APIKeyUsage,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "metainfo:apikeyusage",
+			Run:   peer.Metainfo.APIKeyUsage.Run,
+			Close: peer.Metainfo.APIKeyUsage.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Metainfo API Key Usage", peer.Metainfo.APIKeyUsage.Loop))
+
 		peer.Metainfo.Endpoint, err = metainfo.NewEndpoint(
 			peer.Log.Named("metainfo:endpoint"),
 			peer.Metainfo.Service,
@@ -408,8 +426,10 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Marketing.PartnersService,
 			peer.DB.PeerIdentities(),
 			peer.DB.Console().APIKeys(),
+			peer.Metainfo.APIKeyUsage,
 			peer.Accounting.ProjectUsage,
 			peer.DB.Console().Projects(),
+			peer.DB.Console().ProjectFreezes(),
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.DB.Revocation(),
 			config.Metainfo,
@@ -513,50 +533,69 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 	{ // setup payments
 		pc := config.Payments
 
-		var stripeClient stripecoinpayments.StripeClient
-		switch pc.Provider {
-		default:
-			stripeClient = stripecoinpayments.NewStripeMock(
-				peer.ID(),
-				peer.DB.StripeCoinPayments().Customers(),
-				peer.DB.Console().Users(),
-			)
-		case "stripecoinpayments":
-			stripeClient = stripecoinpayments.NewStripeClient(log, pc.StripeCoinPayments)
-		}
+		if pc.Provider == paymentsconfig.ProviderInvoiceOnly {
+			priceModel, err := payments.NewProjectUsagePriceModel(pc.StorageTBPrice, pc.EgressTBPrice, pc.ObjectPrice)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
 
-		peer.Payments.Service, err = stripecoinpayments.NewService(
-			peer.Log.Named("payments.stripe:service"),
-			stripeClient,
-			pc.StripeCoinPayments,
-			peer.DB.StripeCoinPayments(),
-			peer.DB.Console().Projects(),
-			peer.DB.ProjectAccounting(),
-			pc.StorageTBPrice,
-			pc.EgressTBPrice,
-			pc.ObjectPrice,
-			pc.BonusRate,
-			pc.CouponValue,
-			pc.CouponDuration.IntPointer(),
-			pc.CouponProjectLimit,
-			pc.MinCoinPayment)
+			peer.Payments.InvoiceOnly = invoiceonly.NewService(
+				peer.Log.Named("payments.invoiceonly:service"),
+				peer.DB.InvoiceOnly(),
+				peer.DB.Console().Projects(),
+				peer.DB.Console().Users(),
+				peer.DB.ProjectAccounting(),
+				peer.DB.PricePlans(),
+				priceModel,
+				pc.InvoiceOnly)
+			peer.Payments.Accounts = peer.Payments.InvoiceOnly.Accounts()
+		} else {
+			var stripeClient stripecoinpayments.StripeClient
+			switch pc.Provider {
+			default:
+				stripeClient = stripecoinpayments.NewStripeMock(
+					peer.ID(),
+					peer.DB.StripeCoinPayments().Customers(),
+					peer.DB.Console().Users(),
+				)
+			case paymentsconfig.ProviderStripeCoinPayments:
+				stripeClient = stripecoinpayments.NewStripeClient(log, pc.StripeCoinPayments)
+			}
 
-		if err != nil {
-			return nil, errs.Combine(err, peer.Close())
-		}
+			peer.Payments.Service, err = stripecoinpayments.NewService(
+				peer.Log.Named("payments.stripe:service"),
+				stripeClient,
+				pc.StripeCoinPayments,
+				peer.DB.StripeCoinPayments(),
+				peer.DB.Console().Projects(),
+				peer.DB.ProjectAccounting(),
+				peer.DB.PricePlans(),
+				pc.StorageTBPrice,
+				pc.EgressTBPrice,
+				pc.ObjectPrice,
+				pc.BonusRate,
+				pc.CouponValue,
+				pc.CouponDuration.IntPointer(),
+				pc.CouponProjectLimit,
+				pc.MinCoinPayment)
 
-		peer.Payments.Stripe = stripeClient
-		peer.Payments.Accounts = peer.Payments.Service.Accounts()
-		peer.Payments.Conversion = stripecoinpayments.NewConversionService(
-			peer.Log.Named("payments.stripe:version"),
-			peer.Payments.Service,
-			pc.StripeCoinPayments.ConversionRatesCycleInterval)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
 
-		peer.Services.Add(lifecycle.Item{
-			Name:  "payments.stripe:version",
-			Run:   peer.Payments.Conversion.Run,
-			Close: peer.Payments.Conversion.Close,
-		})
+			peer.Payments.Stripe = stripeClient
+			peer.Payments.Accounts = peer.Payments.Service.Accounts()
+			peer.Payments.Conversion = stripecoinpayments.NewConversionService(
+				peer.Log.Named("payments.stripe:version"),
+				peer.Payments.Service,
+				pc.StripeCoinPayments.ConversionRatesCycleInterval)
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "payments.stripe:version",
+				Run:   peer.Payments.Conversion.Run,
+				Close: peer.Payments.Conversion.Close,
+			})
+		}
 	}
 
 	{ // setup console
@@ -576,6 +615,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.ProjectAccounting(),
 			peer.Accounting.ProjectUsage,
 			peer.DB.Buckets(),
+			inventory.NewService(peer.Log.Named("inventory:service"), peer.DB.BucketInventories(), peer.DB.Buckets(), config.Inventory.EncryptionKeys),
 			peer.Marketing.PartnersService,
 			peer.Payments.Accounts,
 			peer.Analytics.Service,
@@ -0,0 +1,392 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consistency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+// Report contains the result of a single consistency check.
+type Report struct {
+	Started  time.Time
+	Finished time.Time
+
+	ObjectCount  int64
+	SegmentCount int64
+	// SkippedObjects is the number of objects, whose segments were skipped
+	// because of Config.MaxStreams; they are checked by the following runs.
+	SkippedObjects int64
+
+	// Counts contains the number of issues found for each kind.
+	Counts map[IssueKind]int64
+	// Issues contains at most Config.MaxIssues issues.
+	Issues []Issue
+}
+
+func (report *Report) add(maxIssues int, issue Issue) {
+	report.Counts[issue.Kind]++
+	if len(report.Issues) < maxIssues {
+		report.Issues = append(report.Issues, issue)
+	}
+}
+
+// Chore periodically checks metabase consistency.
+//
+// architecture: Chore
+type Chore struct {
+	log         *zap.Logger
+	config      Config
+	db          DB
+	metabase    *metabase.DB
+	segmentLoop *segmentloop.Service
+
+	asOfSystemInterval time.Duration
+	// cursor is the first stream ID summarized by the next run.
+	cursor uuid.UUID
+	// aliasCursor is the first stream ID scanned for unknown aliases by the
+	// next run.
+	aliasCursor uuid.UUID
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new consistency checker chore. The objects are queried
+// with the same as of system interval as the segments by the segment loop.
+func NewChore(log *zap.Logger, config Config, db DB, metabase *metabase.DB, segmentLoop *segmentloop.Service, loopConfig segmentloop.Config) *Chore {
+	return &Chore{
+		log:         log,
+		config:      config,
+		db:          db,
+		metabase:    metabase,
+		segmentLoop: segmentLoop,
+
+		asOfSystemInterval: loopConfig.AsOfSystemInterval,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the consistency checker chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	// the objects are compared with the segments of all partitions.
+	if chore.segmentLoop.Partitioned() {
+		return Error.New("consistency checks require the segment loop to iterate all partitions, disable them on partitioned processes")
+	}
+
+	return chore.Loop.Run(ctx, chore.RunOnce)
+}
+
+// RunOnce checks consistency, reports metrics and queues fixes when enabled.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report, err := chore.Check(ctx)
+	if err != nil {
+		chore.log.Error("consistency check failed", zap.Error(err))
+	}
+
+	for _, kind := range IssueKinds {
+		mon.IntVal("consistency_issues", monkit.NewSeriesTag("kind", string(kind))).Observe(report.Counts[kind])
+	}
+	mon.IntVal("consistency_checked_objects").Observe(report.ObjectCount)
+	mon.IntVal("consistency_checked_segments").Observe(report.SegmentCount)
+	mon.IntVal("consistency_skipped_objects").Observe(report.SkippedObjects)
+
+	for _, issue := range report.Issues {
+		chore.log.Warn("metabase inconsistency",
+			zap.String("Kind", string(issue.Kind)),
+			zap.Stringer("Project ID", issue.Object.ProjectID),
+			zap.String("Bucket", issue.Object.BucketName),
+			zap.Stringer("Stream ID", issue.Object.StreamID),
+			zap.String("Description", issue.Description))
+	}
+
+	if !chore.config.QueueFixes {
+		return nil
+	}
+
+	var fixes []Fix
+	for _, issue := range report.Issues {
+		if !issue.Kind.Fixable() {
+			continue
+		}
+		id, err := uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		fixes = append(fixes, Fix{
+			ID:          id,
+			Kind:        issue.Kind,
+			Object:      issue.Object,
+			Description: issue.Description,
+			Status:      FixPending,
+			CreatedAt:   report.Finished,
+		})
+	}
+
+	if err := chore.db.Queue(ctx, fixes); err != nil {
+		chore.log.Error("queueing consistency fixes failed", zap.Error(err))
+	}
+
+	return nil
+}
+
+// Check runs a single consistency check. The returned report is partial when
+// an error occurs.
+func (chore *Chore) Check(ctx context.Context) (report Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report = Report{
+		Started: chore.nowFn(),
+		Counts:  make(map[IssueKind]int64),
+	}
+	defer func() { report.Finished = chore.nowFn() }()
+
+	// The segment loop fails on unknown aliases, hence they are checked first
+	// when enabled.
+	if err := chore.checkAliases(ctx, &report); err != nil {
+		return report, err
+	}
+
+	observer := NewObserver(chore.cursor, chore.config.MaxStreams)
+	if err := chore.segmentLoop.Join(ctx, observer); err != nil {
+		return report, Error.Wrap(err)
+	}
+
+	if err := chore.checkObjects(ctx, &report, observer); err != nil {
+		return report, err
+	}
+	chore.cursor = observer.NextCursor()
+
+	if err := chore.checkZombies(ctx, &report); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// errAliasScanLimit stops the alias scan once Config.MaxAliasSegments segments
+// were scanned.
+var errAliasScanLimit = errs.New("alias scan limit reached")
+
+// checkAliases scans the segments for pieces on unknown aliases. The scan reads
+// the segments besides the segment loop, hence it's opt-in and scans at most
+// Config.MaxAliasSegments segments per run; the following runs continue from
+// the first stream that wasn't scanned.
+func (chore *Chore) checkAliases(ctx context.Context, report *Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.CheckAliases {
+		return nil
+	}
+
+	entries, err := chore.metabase.ListNodeAliases(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	aliases := metabase.NewNodeAliasMap(entries)
+
+	var candidates []metabase.SegmentAliasPieces
+	var scanned int
+	var lastStreamID, nextCursor uuid.UUID
+	err = chore.metabase.IterateSegmentAliasPieces(ctx, metabase.IterateSegmentAliasPieces{
+		BatchSize:          chore.config.BatchSize,
+		AsOfSystemInterval: chore.asOfSystemInterval,
+		StartStreamID:      chore.aliasCursor,
+	}, func(ctx context.Context, segments []metabase.SegmentAliasPieces) error {
+		for _, segment := range segments {
+			// streams are scanned fully, so that the next run can start from
+			// a stream boundary.
+			limited := chore.config.MaxAliasSegments > 0 && scanned >= chore.config.MaxAliasSegments
+			if limited && segment.StreamID != lastStreamID {
+				nextCursor = segment.StreamID
+				return errAliasScanLimit
+			}
+			scanned++
+			lastStreamID = segment.StreamID
+
+			if !containsAliases(aliases, segment.AliasPieces) {
+				candidates = append(candidates, segment)
+			}
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errAliasScanLimit) {
+		return Error.Wrap(err)
+	}
+	chore.aliasCursor = nextCursor
+	mon.IntVal("consistency_alias_scanned_segments").Observe(int64(scanned))
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// Aliases are only ever added, so reloading them after the iteration
+	// includes every alias a segment could refer to.
+	entries, err = chore.metabase.ListNodeAliases(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	aliases = metabase.NewNodeAliasMap(entries)
+
+	for _, segment := range candidates {
+		for _, piece := range segment.AliasPieces {
+			if _, ok := aliases.Node(piece.Alias); ok {
+				continue
+			}
+			report.add(chore.config.MaxIssues, Issue{
+				Kind:        IssueUnknownNodeAlias,
+				Object:      metabase.ObjectStream{StreamID: segment.StreamID},
+				Description: fmt.Sprintf("segment %d/%d piece %d refers to unknown alias %d", segment.Position.Part, segment.Position.Index, piece.Number, piece.Alias),
+			})
+			break
+		}
+	}
+
+	return nil
+}
+
+// containsAliases returns false when any of the aliases are missing from the map.
+func containsAliases(aliases *metabase.NodeAliasMap, pieces metabase.AliasPieces) bool {
+	for _, piece := range pieces {
+		if _, ok := aliases.Node(piece.Alias); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (chore *Chore) checkObjects(ctx context.Context, report *Report, observer *Observer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, summary := range observer.Streams {
+		report.SegmentCount += int64(summary.SegmentCount)
+	}
+
+	err = chore.metabase.IterateLoopObjects(ctx, metabase.IterateLoopObjects{
+		BatchSize:          chore.config.BatchSize,
+		AsOfSystemInterval: chore.asOfSystemInterval,
+	}, func(ctx context.Context, it metabase.LoopObjectsIterator) error {
+		var object metabase.LoopObjectEntry
+		for it.Next(ctx, &object) {
+			report.ObjectCount++
+
+			summary, ok := observer.Streams[object.StreamID]
+			delete(observer.Streams, object.StreamID)
+			if !ok {
+				if observer.IsSkipped(object.StreamID) {
+					report.SkippedObjects++
+					continue
+				}
+				summary = &StreamSummary{}
+			}
+
+			// Segments of pending objects are still being uploaded and objects
+			// created after the loop started may not be fully observed.
+			if object.Status != metabase.Committed || object.CreatedAt.After(observer.Started) {
+				continue
+			}
+
+			chore.checkObject(report, object, summary)
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// Segments are always created after their object, so the remaining streams
+	// can't get an object anymore.
+	for streamID, summary := range observer.Streams {
+		report.add(chore.config.MaxIssues, Issue{
+			Kind:        IssueOrphanedSegments,
+			Object:      metabase.ObjectStream{StreamID: streamID},
+			Description: fmt.Sprintf("%d segments without an object", summary.SegmentCount),
+		})
+	}
+
+	return nil
+}
+
+func (chore *Chore) checkObject(report *Report, object metabase.LoopObjectEntry, summary *StreamSummary) {
+	if object.SegmentCount != summary.SegmentCount {
+		report.add(chore.config.MaxIssues, Issue{
+			Kind:        IssueSegmentCountMismatch,
+			Object:      object.ObjectStream,
+			Description: fmt.Sprintf("object has segment count %d, found %d segments", object.SegmentCount, summary.SegmentCount),
+		})
+	}
+	if object.TotalEncryptedSize != summary.EncryptedSize {
+		report.add(chore.config.MaxIssues, Issue{
+			Kind:        IssueEncryptedSizeMismatch,
+			Object:      object.ObjectStream,
+			Description: fmt.Sprintf("object has encrypted size %d, segments have %d", object.TotalEncryptedSize, summary.EncryptedSize),
+		})
+	}
+	if summary.MissingPosition {
+		report.add(chore.config.MaxIssues, Issue{
+			Kind:        IssueMissingPosition,
+			Object:      object.ObjectStream,
+			Description: "segment positions or plain offsets have gaps",
+		})
+	}
+	if summary.Overlapping {
+		report.add(chore.config.MaxIssues, Issue{
+			Kind:        IssueOverlappingSegments,
+			Object:      object.ObjectStream,
+			Description: "segment plain offsets overlap",
+		})
+	}
+}
+
+func (chore *Chore) checkZombies(ctx context.Context, report *Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = chore.metabase.IterateZombieObjects(ctx, metabase.IterateZombieObjects{
+		DeadlineBefore:     report.Started,
+		BatchSize:          chore.config.BatchSize,
+		AsOfSystemInterval: chore.asOfSystemInterval,
+	}, func(ctx context.Context, objects []metabase.ZombieObject) error {
+		for _, object := range objects {
+			report.add(chore.config.MaxIssues, Issue{
+				Kind:        IssueZombieObject,
+				Object:      object.ObjectStream,
+				Description: fmt.Sprintf("pending object past its zombie deletion deadline %s", object.ZombieDeletionDeadline.UTC().Format(time.RFC3339)),
+			})
+		}
+		return nil
+	})
+	return Error.Wrap(err)
+}
+
+// Close stops the consistency checker chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consistency_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/consistency"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Consistency.QueueFixes = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		metabaseDB := sat.Metainfo.Metabase
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "inline", testrand.Bytes(1*memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "remote", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "orphan", testrand.Bytes(10*memory.KiB)))
+
+		objects, err := metabaseDB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		// object keys are encrypted, so any two of the objects will do.
+		orphan, mismatch := objects[0].ObjectStream, objects[1].ObjectStream
+
+		_, err = metabaseDB.UnderlyingTagSQL().ExecContext(ctx, `DELETE FROM objects WHERE stream_id = $1`, orphan.StreamID)
+		require.NoError(t, err)
+		_, err = metabaseDB.UnderlyingTagSQL().ExecContext(ctx, `UPDATE objects SET segment_count = segment_count + 1 WHERE stream_id = $1`, mismatch.StreamID)
+		require.NoError(t, err)
+
+		zombie := metabase.ObjectStream{
+			ProjectID:  projectID,
+			BucketName: "bucket",
+			ObjectKey:  "zombie",
+			Version:    1,
+			StreamID:   testrand.UUID(),
+		}
+		deadline := time.Now().Add(-time.Hour)
+		_, err = metabaseDB.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
+			ObjectStream:           zombie,
+			ZombieDeletionDeadline: &deadline,
+		})
+		require.NoError(t, err)
+
+		chore := sat.Consistency.Chore
+
+		report, err := chore.Check(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, report.ObjectCount)
+		require.Equal(t, map[consistency.IssueKind]int64{
+			consistency.IssueOrphanedSegments:     1,
+			consistency.IssueSegmentCountMismatch: 1,
+			consistency.IssueZombieObject:         1,
+		}, report.Counts)
+
+		require.NoError(t, chore.RunOnce(ctx))
+		// running again must not queue the same fixes twice.
+		require.NoError(t, chore.RunOnce(ctx))
+
+		fixes, err := sat.DB.ConsistencyFixes().List(ctx, consistency.FixPending, 10)
+		require.NoError(t, err)
+		require.Len(t, fixes, 2)
+
+		for _, fix := range fixes {
+			require.NoError(t, consistency.Apply(ctx, metabaseDB, fix))
+			require.NoError(t, sat.DB.ConsistencyFixes().Resolve(ctx, fix.ID, consistency.FixApplied, time.Now()))
+		}
+
+		err = sat.DB.ConsistencyFixes().Resolve(ctx, fixes[0].ID, consistency.FixRejected, time.Now())
+		require.True(t, consistency.ErrNotFound.Has(err))
+
+		report, err = chore.Check(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[consistency.IssueKind]int64{
+			consistency.IssueSegmentCountMismatch: 1,
+		}, report.Counts)
+	})
+}
+
+func TestChore_UnknownAliases(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Consistency.CheckAliases = true
+				config.Consistency.MaxAliasSegments = 1
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		metabaseDB := sat.Metainfo.Metabase
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "first", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "second", testrand.Bytes(10*memory.KiB)))
+
+		segments, err := metabaseDB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+
+		// the segments are scanned in stream order, hence the unknown alias is
+		// only found by the second run.
+		unknown := segments[0]
+		if unknown.StreamID.Less(segments[1].StreamID) {
+			unknown = segments[1]
+		}
+
+		var original []byte
+		err = metabaseDB.UnderlyingTagSQL().QueryRowContext(ctx, `SELECT remote_alias_pieces FROM segments WHERE stream_id = $1`, unknown.StreamID).Scan(&original)
+		require.NoError(t, err)
+
+		corrupted, err := metabase.AliasPieces{{Number: 0, Alias: math.MaxInt32}}.Bytes()
+		require.NoError(t, err)
+		_, err = metabaseDB.UnderlyingTagSQL().ExecContext(ctx, `UPDATE segments SET remote_alias_pieces = $1 WHERE stream_id = $2`, corrupted, unknown.StreamID)
+		require.NoError(t, err)
+
+		chore := sat.Consistency.Chore
+
+		// the segment loop fails on the unknown alias.
+		report, err := chore.Check(ctx)
+		require.Error(t, err)
+		require.Zero(t, report.Counts[consistency.IssueUnknownNodeAlias])
+
+		report, err = chore.Check(ctx)
+		require.Error(t, err)
+		require.EqualValues(t, 1, report.Counts[consistency.IssueUnknownNodeAlias])
+		require.Equal(t, unknown.StreamID, report.Issues[0].Object.StreamID)
+
+		_, err = metabaseDB.UnderlyingTagSQL().ExecContext(ctx, `UPDATE segments SET remote_alias_pieces = $1 WHERE stream_id = $2`, original, unknown.StreamID)
+		require.NoError(t, err)
+
+		report, err = chore.Check(ctx)
+		require.NoError(t, err)
+		require.Empty(t, report.Counts)
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package consistency implements an online consistency checker for metabase.
+//
+// The checker observes the segment loop, iterates over all objects afterwards
+// and reports segments without objects, objects whose segment count or size
+// doesn't match their segments, missing or overlapping segment positions and
+// zombie pending objects. Pieces on unknown node aliases are found by an
+// optional scan of the segments, which is bounded per run. Issues that can be
+// fixed safely are optionally queued for operator approval.
+package consistency
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error is the default error class for the consistency checker.
+	Error = errs.Class("consistency")
+	// ErrNotFound is returned when a queued fix does not exist or is already resolved.
+	ErrNotFound = errs.Class("consistency fix not found")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the consistency checker.
+type Config struct {
+	Enabled    bool          `help:"set if the metabase consistency checker is run" default:"false"`
+	Interval   time.Duration `help:"how often to run the metabase consistency checker" releaseDefault:"168h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
+	QueueFixes bool          `help:"queue safe fixes for operator approval through the admin API" default:"false"`
+	BatchSize  int           `help:"how many objects or segments to query in a batch" default:"2500"`
+	MaxIssues  int           `help:"maximum number of issues to report and queue in a single run" default:"10000"`
+	MaxStreams int           `help:"maximum number of streams summarized in memory per range of the segment loop, the remaining streams are checked by the following runs" default:"2000000"`
+
+	CheckAliases     bool `help:"scan segments for pieces on unknown node aliases, the scan reads the segments besides the segment loop" default:"false"`
+	MaxAliasSegments int  `help:"maximum number of remote segments scanned for unknown node aliases in a single run, the remaining segments are scanned by the following runs" default:"1000000"`
+}
+
+// IssueKind identifies the kind of inconsistency.
+type IssueKind string
+
+const (
+	// IssueOrphanedSegments is reported for segments without an object.
+	IssueOrphanedSegments = IssueKind("orphaned_segments")
+	// IssueSegmentCountMismatch is reported when the object segment count doesn't match its segments.
+	IssueSegmentCountMismatch = IssueKind("segment_count_mismatch")
+	// IssueEncryptedSizeMismatch is reported when the object encrypted size doesn't match its segments.
+	IssueEncryptedSizeMismatch = IssueKind("encrypted_size_mismatch")
+	// IssueMissingPosition is reported when segment positions or plain offsets have gaps.
+	IssueMissingPosition = IssueKind("missing_position")
+	// IssueOverlappingSegments is reported when segment plain offsets overlap.
+	IssueOverlappingSegments = IssueKind("overlapping_segments")
+	// IssueUnknownNodeAlias is reported for segments with pieces on an alias missing from node_aliases.
+	IssueUnknownNodeAlias = IssueKind("unknown_node_alias")
+	// IssueZombieObject is reported for pending objects past their zombie deletion deadline.
+	IssueZombieObject = IssueKind("zombie_object")
+)
+
+// IssueKinds lists all issue kinds.
+var IssueKinds = []IssueKind{
+	IssueOrphanedSegments,
+	IssueSegmentCountMismatch,
+	IssueEncryptedSizeMismatch,
+	IssueMissingPosition,
+	IssueOverlappingSegments,
+	IssueUnknownNodeAlias,
+	IssueZombieObject,
+}
+
+// Fixable returns whether the issue kind has a safe fix.
+func (kind IssueKind) Fixable() bool {
+	return kind == IssueOrphanedSegments || kind == IssueZombieObject
+}
+
+// Issue describes a single inconsistency found by the checker.
+type Issue struct {
+	Kind IssueKind
+	// Object contains the object stream; only StreamID is set for orphaned segments.
+	Object      metabase.ObjectStream
+	Description string
+}
+
+// FixStatus is the status of a queued fix.
+type FixStatus string
+
+const (
+	// FixPending is a fix waiting for operator approval.
+	FixPending = FixStatus("pending")
+	// FixApplied is a fix that was approved and applied.
+	FixApplied = FixStatus("applied")
+	// FixRejected is a fix that was rejected by an operator.
+	FixRejected = FixStatus("rejected")
+)
+
+// Fix is a queued fix for an issue.
+type Fix struct {
+	ID          uuid.UUID             `json:"id"`
+	Kind        IssueKind             `json:"kind"`
+	Object      metabase.ObjectStream `json:"object"`
+	Description string                `json:"description"`
+	Status      FixStatus             `json:"status"`
+	CreatedAt   time.Time             `json:"createdAt"`
+	ResolvedAt  *time.Time            `json:"resolvedAt"`
+}
+
+// DB stores fixes queued by the consistency checker.
+//
+// architecture: Database
+type DB interface {
+	// Queue inserts fixes, skipping the ones already queued for the same stream and kind.
+	Queue(ctx context.Context, fixes []Fix) error
+	// Get returns the fix with the specified id.
+	Get(ctx context.Context, id uuid.UUID) (Fix, error)
+	// List returns at most limit fixes with the specified status, oldest first.
+	List(ctx context.Context, status FixStatus, limit int) ([]Fix, error)
+	// Resolve changes the status of a pending fix.
+	Resolve(ctx context.Context, id uuid.UUID, status FixStatus, resolvedAt time.Time) error
+}
+
+// Apply applies the fix to metabase.
+func Apply(ctx context.Context, metabaseDB *metabase.DB, fix Fix) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch fix.Kind {
+	case IssueOrphanedSegments:
+		_, err = metabaseDB.DeleteOrphanedSegments(ctx, metabase.DeleteOrphanedSegments{
+			StreamIDs: []uuid.UUID{fix.Object.StreamID},
+		})
+		return Error.Wrap(err)
+	case IssueZombieObject:
+		_, err = metabaseDB.DeletePendingObject(ctx, metabase.DeletePendingObject{
+			ObjectStream: fix.Object,
+		})
+		if storj.ErrObjectNotFound.Has(err) {
+			// the object was committed or deleted in the meantime.
+			return nil
+		}
+		return Error.Wrap(err)
+	default:
+		return Error.New("no fix for %q", fix.Kind)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consistency
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+var _ segmentloop.ParallelObserver = (*Observer)(nil)
+
+// StreamSummary aggregates the segments of a single stream.
+type StreamSummary struct {
+	SegmentCount  int32
+	EncryptedSize int64
+
+	// MissingPosition is set when segment indexes within a part or plain offsets have gaps.
+	MissingPosition bool
+	// Overlapping is set when segment plain offsets overlap.
+	Overlapping bool
+
+	lastPosition   metabase.SegmentPosition
+	expectedOffset int64
+}
+
+// Observer collects a StreamSummary for every stream seen by the segment loop.
+//
+// The summaries are kept in memory until the objects are iterated. To bound
+// the memory usage, streams before Cursor and streams after MaxStreams
+// summaries are skipped; the skipped ranges are checked by later runs.
+type Observer struct {
+	Started time.Time
+	Streams map[uuid.UUID]*StreamSummary
+
+	// Cursor is the first stream ID which is summarized.
+	Cursor uuid.UUID
+	// MaxStreams is the maximum number of summaries kept by a single range
+	// of the segment loop, zero means unlimited.
+	MaxStreams int
+	// Skipped contains the ranges of stream IDs, which were not summarized.
+	Skipped []StreamRange
+
+	current  *StreamSummary
+	streamID uuid.UUID
+	skipping bool
+	full     bool
+}
+
+// StreamRange is an inclusive range of stream IDs.
+type StreamRange struct {
+	First uuid.UUID
+	Last  uuid.UUID
+}
+
+// NewObserver returns a new observer, which summarizes at most maxStreams
+// streams starting from cursor.
+func NewObserver(cursor uuid.UUID, maxStreams int) *Observer {
+	return &Observer{
+		Streams:    make(map[uuid.UUID]*StreamSummary),
+		Cursor:     cursor,
+		MaxStreams: maxStreams,
+	}
+}
+
+// LoopStarted is called at each start of a loop.
+func (observer *Observer) LoopStarted(ctx context.Context, info segmentloop.LoopInfo) (err error) {
+	observer.Started = info.Started
+	return nil
+}
+
+// RemoteSegment is called for each remote segment.
+func (observer *Observer) RemoteSegment(ctx context.Context, segment *segmentloop.Segment) error {
+	observer.add(segment)
+	return nil
+}
+
+// InlineSegment is called for each inline segment.
+func (observer *Observer) InlineSegment(ctx context.Context, segment *segmentloop.Segment) error {
+	observer.add(segment)
+	return nil
+}
+
+// Fork creates an observer for a single range of the segment loop.
+func (observer *Observer) Fork(ctx context.Context) (segmentloop.Partial, error) {
+	return NewObserver(observer.Cursor, observer.MaxStreams), nil
+}
+
+// Join adds the stream summaries of a single range to the observer.
+func (observer *Observer) Join(ctx context.Context, partial segmentloop.Partial) error {
+	forked, ok := partial.(*Observer)
+	if !ok {
+		return Error.New("expected partial type %T but got %T", observer, partial)
+	}
+	for streamID, summary := range forked.Streams {
+		observer.Streams[streamID] = summary
+	}
+	observer.Skipped = append(observer.Skipped, forked.Skipped...)
+	return nil
+}
+
+// IsSkipped returns whether the segments of the stream were skipped.
+func (observer *Observer) IsSkipped(streamID uuid.UUID) bool {
+	for _, skipped := range observer.Skipped {
+		if streamID.Less(skipped.First) || skipped.Last.Less(streamID) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// NextCursor returns the cursor for the next run, which starts from the
+// first stream skipped because of MaxStreams. It returns the zero stream ID
+// when every stream after Cursor was summarized.
+func (observer *Observer) NextCursor() uuid.UUID {
+	var next uuid.UUID
+	for _, skipped := range observer.Skipped {
+		if skipped.First.Less(observer.Cursor) {
+			continue
+		}
+		if next.IsZero() || skipped.First.Less(next) {
+			next = skipped.First
+		}
+	}
+	return next
+}
+
+// skip records the stream as skipped; the loop returns segments of a range
+// ordered by stream, hence consecutive skipped streams form a single range.
+func (observer *Observer) skip(streamID uuid.UUID) {
+	observer.current = nil
+	if observer.skipping {
+		observer.Skipped[len(observer.Skipped)-1].Last = streamID
+		return
+	}
+	observer.skipping = true
+	observer.Skipped = append(observer.Skipped, StreamRange{First: streamID, Last: streamID})
+}
+
+// add updates the stream summary; the loop returns segments ordered by stream and position.
+func (observer *Observer) add(segment *segmentloop.Segment) {
+	summary := observer.current
+	if summary == nil || observer.streamID != segment.StreamID {
+		if observer.full || segment.StreamID.Less(observer.Cursor) {
+			observer.skip(segment.StreamID)
+			return
+		}
+		if observer.MaxStreams > 0 && len(observer.Streams) >= observer.MaxStreams {
+			observer.full = true
+			observer.skip(segment.StreamID)
+			return
+		}
+		observer.skipping = false
+
+		summary = &StreamSummary{}
+		observer.Streams[segment.StreamID] = summary
+		observer.current, observer.streamID = summary, segment.StreamID
+
+		if segment.Position.Index != 0 {
+			summary.MissingPosition = true
+		}
+	} else {
+		last := summary.lastPosition
+		switch {
+		case segment.Position.Part == last.Part && segment.Position.Index != last.Index+1:
+			summary.MissingPosition = true
+		case segment.Position.Part != last.Part && segment.Position.Index != 0:
+			summary.MissingPosition = true
+		}
+	}
+
+	switch {
+	case segment.PlainOffset < summary.expectedOffset:
+		summary.Overlapping = true
+	case segment.PlainOffset > summary.expectedOffset:
+		summary.MissingPosition = true
+	}
+
+	summary.SegmentCount++
+	summary.EncryptedSize += int64(segment.EncryptedSize)
+	summary.lastPosition = segment.Position
+	summary.expectedOffset = segment.PlainOffset + int64(segment.PlainSize)
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consistency_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/consistency"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+func TestObserver(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	type segment struct {
+		part, index uint32
+		offset      int64
+		size        int32
+	}
+
+	for _, tc := range []struct {
+		name        string
+		segments    []segment
+		missing     bool
+		overlapping bool
+	}{
+		{
+			name:     "single part",
+			segments: []segment{{0, 0, 0, 10}, {0, 1, 10, 10}, {0, 2, 20, 5}},
+		},
+		{
+			name:     "multiple parts",
+			segments: []segment{{0, 0, 0, 10}, {0, 1, 10, 10}, {2, 0, 20, 10}},
+		},
+		{
+			name:     "missing index",
+			segments: []segment{{0, 0, 0, 10}, {0, 2, 10, 10}},
+			missing:  true,
+		},
+		{
+			name:     "missing first index",
+			segments: []segment{{0, 1, 0, 10}},
+			missing:  true,
+		},
+		{
+			name:     "offset gap",
+			segments: []segment{{0, 0, 0, 10}, {0, 1, 15, 10}},
+			missing:  true,
+		},
+		{
+			name:        "overlapping offsets",
+			segments:    []segment{{0, 0, 0, 10}, {0, 1, 5, 10}},
+			overlapping: true,
+		},
+	} {
+		observer := consistency.NewObserver(uuid.UUID{}, 0)
+		streamID := testrand.UUID()
+
+		for _, seg := range tc.segments {
+			require.NoError(t, observer.RemoteSegment(ctx, &segmentloop.Segment{
+				StreamID:      streamID,
+				Position:      metabase.SegmentPosition{Part: seg.part, Index: seg.index},
+				PlainOffset:   seg.offset,
+				PlainSize:     seg.size,
+				EncryptedSize: seg.size + 1,
+			}))
+		}
+
+		summary := observer.Streams[streamID]
+		require.NotNil(t, summary, tc.name)
+		require.EqualValues(t, len(tc.segments), summary.SegmentCount, tc.name)
+		require.Equal(t, tc.missing, summary.MissingPosition, tc.name)
+		require.Equal(t, tc.overlapping, summary.Overlapping, tc.name)
+	}
+}
+
+func TestObserver_MultipleStreams(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	observer := consistency.NewObserver(uuid.UUID{}, 0)
+	first, second := testrand.UUID(), testrand.UUID()
+
+	require.NoError(t, observer.InlineSegment(ctx, &segmentloop.Segment{StreamID: first, PlainSize: 10, EncryptedSize: 20}))
+	require.NoError(t, observer.RemoteSegment(ctx, &segmentloop.Segment{
+		StreamID:      first,
+		Position:      metabase.SegmentPosition{Index: 1},
+		PlainOffset:   10,
+		PlainSize:     10,
+		EncryptedSize: 30,
+	}))
+	require.NoError(t, observer.InlineSegment(ctx, &segmentloop.Segment{StreamID: second, PlainSize: 5, EncryptedSize: 7}))
+
+	require.Len(t, observer.Streams, 2)
+	require.Equal(t, &consistency.StreamSummary{SegmentCount: 2, EncryptedSize: 50}, withoutState(observer.Streams[first]))
+	require.Equal(t, &consistency.StreamSummary{SegmentCount: 1, EncryptedSize: 7}, withoutState(observer.Streams[second]))
+}
+
+func TestObserver_MaxStreams(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	streams := []uuid.UUID{{1}, {2}, {3}, {4}, {5}}
+	add := func(observer *consistency.Observer) {
+		for _, streamID := range streams {
+			for index := uint32(0); index < 2; index++ {
+				require.NoError(t, observer.RemoteSegment(ctx, &segmentloop.Segment{
+					StreamID:    streamID,
+					Position:    metabase.SegmentPosition{Index: index},
+					PlainOffset: int64(index) * 10,
+					PlainSize:   10,
+				}))
+			}
+		}
+	}
+
+	first := consistency.NewObserver(uuid.UUID{}, 2)
+	add(first)
+	require.Len(t, first.Streams, 2)
+	require.EqualValues(t, 2, first.Streams[streams[1]].SegmentCount)
+	require.False(t, first.IsSkipped(streams[1]))
+	require.True(t, first.IsSkipped(streams[2]))
+	require.True(t, first.IsSkipped(streams[4]))
+	require.False(t, first.IsSkipped(uuid.UUID{6}))
+	require.Equal(t, streams[2], first.NextCursor())
+
+	second := consistency.NewObserver(first.NextCursor(), 2)
+	add(second)
+	require.Len(t, second.Streams, 2)
+	require.True(t, second.IsSkipped(streams[0]))
+	require.False(t, second.IsSkipped(streams[2]))
+	require.True(t, second.IsSkipped(streams[4]))
+	require.Equal(t, streams[4], second.NextCursor())
+
+	third := consistency.NewObserver(second.NextCursor(), 2)
+	add(third)
+	require.Len(t, third.Streams, 1)
+	require.False(t, third.IsSkipped(streams[4]))
+	require.True(t, third.NextCursor().IsZero())
+}
+
+// withoutState drops the unexported iteration state for comparison.
+func withoutState(summary *consistency.StreamSummary) *consistency.StreamSummary {
+	return &consistency.StreamSummary{
+		SegmentCount:    summary.SegmentCount,
+		EncryptedSize:   summary.EncryptedSize,
+		MissingPosition: summary.MissingPosition,
+		Overlapping:     summary.Overlapping,
+	}
+}
+
+func TestObserverForkJoin(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	observer := consistency.NewObserver(uuid.UUID{}, 0)
+
+	streams := []segmentloop.Segment{
+		{StreamID: testrand.UUID(), EncryptedSize: 10, PlainSize: 10},
+		{StreamID: testrand.UUID(), EncryptedSize: 20, PlainSize: 20},
+	}
+
+	var partials []segmentloop.Partial
+	for _, segment := range streams {
+		partial, err := observer.Fork(ctx)
+		require.NoError(t, err)
+
+		segment := segment
+		require.NoError(t, partial.InlineSegment(ctx, &segment))
+		partials = append(partials, partial)
+	}
+
+	for _, partial := range partials {
+		require.NoError(t, observer.Join(ctx, partial))
+	}
+
+	require.Len(t, observer.Streams, 2)
+	for _, segment := range streams {
+		summary := observer.Streams[segment.StreamID]
+		require.NotNil(t, summary)
+		require.EqualValues(t, 1, summary.SegmentCount)
+		require.EqualValues(t, segment.EncryptedSize, summary.EncryptedSize)
+	}
+
+	require.Error(t, observer.Join(ctx, segmentloop.NullObserver{}))
+}
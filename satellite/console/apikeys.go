@@ -5,8 +5,10 @@ package console
 
 import (
 	"context"
+	"reflect"
 	"time"
 
+	"storj.io/common/macaroon"
 	"storj.io/common/uuid"
 )
 
@@ -28,6 +30,8 @@ type APIKeys interface {
 	Update(ctx context.Context, key APIKeyInfo) error
 	// Delete deletes APIKeyInfo from store
 	Delete(ctx context.Context, id uuid.UUID) error
+	// UpdateLastUsed updates the time the api keys were last used, it never moves the time backwards
+	UpdateLastUsed(ctx context.Context, lastUsed map[uuid.UUID]time.Time) error
 }
 
 // APIKeyInfo describing api key model in the database.
@@ -38,6 +42,76 @@ type APIKeyInfo struct {
 	Name      string    `json:"name"`
 	Secret    []byte    `json:"-"`
 	CreatedAt time.Time `json:"createdAt"`
+	// ExpiresAt is the time after which the key is refused, nil when it never expires.
+	ExpiresAt *time.Time `json:"expiresAt"`
+	// LastUsedAt is the last time the key was used, it's updated periodically.
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
+// Expired returns whether the api key can't be used anymore.
+func (info *APIKeyInfo) Expired(now time.Time) bool {
+	return info.ExpiresAt != nil && !now.Before(*info.ExpiresAt)
+}
+
+// APIKeyRestrictions restricts what a newly created api key can be used for.
+// The zero value creates a key with full access to the project.
+type APIKeyRestrictions struct {
+	// ExpiresAt is the time after which the key is refused, nil when it never expires.
+	ExpiresAt *time.Time `json:"expiresAt"`
+	// Buckets restricts the key to the given buckets and path prefixes,
+	// an empty list allows all buckets.
+	Buckets []APIKeyBucketRestriction `json:"buckets"`
+
+	DisallowReads   bool `json:"disallowReads"`
+	DisallowWrites  bool `json:"disallowWrites"`
+	DisallowLists   bool `json:"disallowLists"`
+	DisallowDeletes bool `json:"disallowDeletes"`
+}
+
+// APIKeyBucketRestriction restricts an api key to a bucket.
+type APIKeyBucketRestriction struct {
+	Bucket string `json:"bucket"`
+	// EncryptedPathPrefix restricts the key to the objects under the prefix,
+	// it has to be encrypted the same way as the object keys of the bucket.
+	EncryptedPathPrefix []byte `json:"encryptedPathPrefix"`
+}
+
+// validate checks that the restrictions leave the key usable.
+func (restrictions *APIKeyRestrictions) validate(now time.Time) error {
+	if restrictions.ExpiresAt != nil && !restrictions.ExpiresAt.After(now) {
+		return ErrValidation.New(apiKeyExpirationErrMsg)
+	}
+	if restrictions.DisallowReads && restrictions.DisallowWrites && restrictions.DisallowLists && restrictions.DisallowDeletes {
+		return ErrValidation.New(apiKeyNoOperationsErrMsg)
+	}
+	for _, bucket := range restrictions.Buckets {
+		if bucket.Bucket == "" {
+			return ErrValidation.New(apiKeyBucketNameErrMsg)
+		}
+	}
+	return nil
+}
+
+// restrict adds the restrictions as a caveat to the key.
+func (restrictions *APIKeyRestrictions) restrict(key *macaroon.APIKey) (*macaroon.APIKey, error) {
+	caveat := macaroon.Caveat{
+		DisallowReads:   restrictions.DisallowReads,
+		DisallowWrites:  restrictions.DisallowWrites,
+		DisallowLists:   restrictions.DisallowLists,
+		DisallowDeletes: restrictions.DisallowDeletes,
+		NotAfter:        restrictions.ExpiresAt,
+	}
+	for _, bucket := range restrictions.Buckets {
+		caveat.AllowedPaths = append(caveat.AllowedPaths, &macaroon.Caveat_Path{
+			Bucket:              []byte(bucket.Bucket),
+			EncryptedPathPrefix: bucket.EncryptedPathPrefix,
+		})
+	}
+
+	if reflect.DeepEqual(caveat, macaroon.Caveat{}) {
+		return key, nil
+	}
+	return key.Restrict(macaroon.WithNonce(caveat))
 }
 
 // APIKeyCursor holds info for api keys cursor pagination.
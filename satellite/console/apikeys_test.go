@@ -6,11 +6,15 @@ package console_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/common/macaroon"
 	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
@@ -144,6 +148,40 @@ func TestApiKeysRepository(t *testing.T) {
 			assert.NoError(t, err)
 		})
 
+		t.Run("Expiration and last used success", func(t *testing.T) {
+			key, err := macaroon.NewAPIKey([]byte("testSecret"))
+			assert.NoError(t, err)
+
+			expiresAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+			createdKey, err := apikeys.Create(ctx, key.Head(), console.APIKeyInfo{
+				Name:      "expiring key",
+				ProjectID: project.ID,
+				Secret:    []byte("testSecret"),
+				ExpiresAt: &expiresAt,
+			})
+			assert.NoError(t, err)
+			if assert.NotNil(t, createdKey.ExpiresAt) {
+				assert.True(t, expiresAt.Equal(*createdKey.ExpiresAt))
+			}
+			assert.Nil(t, createdKey.LastUsedAt)
+			assert.False(t, createdKey.Expired(time.Now()))
+			assert.True(t, createdKey.Expired(expiresAt))
+
+			usedAt := time.Now().Truncate(time.Microsecond)
+			err = apikeys.UpdateLastUsed(ctx, map[uuid.UUID]time.Time{createdKey.ID: usedAt})
+			assert.NoError(t, err)
+
+			// the last used time never moves backwards.
+			err = apikeys.UpdateLastUsed(ctx, map[uuid.UUID]time.Time{createdKey.ID: usedAt.Add(-time.Minute)})
+			assert.NoError(t, err)
+
+			updatedKey, err := apikeys.Get(ctx, createdKey.ID)
+			assert.NoError(t, err)
+			if assert.NotNil(t, updatedKey.LastUsedAt) {
+				assert.True(t, usedAt.Equal(*updatedKey.LastUsedAt))
+			}
+		})
+
 		t.Run("GetPageByProjectID with 0 page error", func(t *testing.T) {
 			cursor := console.APIKeyCursor{
 				Page:   0,
@@ -158,3 +196,70 @@ func TestApiKeysRepository(t *testing.T) {
 
 	})
 }
+
+func TestCreateRestrictedAPIKey(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		project := planet.Uplinks[0].Projects[0]
+
+		authCtx, err := sat.AuthenticatedContext(ctx, project.Owner.ID)
+		require.NoError(t, err)
+
+		t.Run("invalid restrictions", func(t *testing.T) {
+			past := time.Now().Add(-time.Hour)
+			for _, restrictions := range []console.APIKeyRestrictions{
+				{ExpiresAt: &past},
+				{DisallowReads: true, DisallowWrites: true, DisallowLists: true, DisallowDeletes: true},
+				{Buckets: []console.APIKeyBucketRestriction{{Bucket: ""}}},
+			} {
+				_, _, err := service.CreateRestrictedAPIKey(authCtx, project.ID, "invalid key", restrictions)
+				require.True(t, console.ErrValidation.Has(err), err)
+			}
+		})
+
+		t.Run("restricted key", func(t *testing.T) {
+			expiresAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+			info, key, err := service.CreateRestrictedAPIKey(authCtx, project.ID, "restricted key", console.APIKeyRestrictions{
+				ExpiresAt: &expiresAt,
+				Buckets: []console.APIKeyBucketRestriction{
+					{Bucket: "bucket", EncryptedPathPrefix: []byte("prefix")},
+				},
+				DisallowWrites:  true,
+				DisallowDeletes: true,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, info.ExpiresAt)
+			require.True(t, expiresAt.Equal(*info.ExpiresAt))
+
+			stored, err := sat.DB.Console().APIKeys().GetByHead(ctx, key.Head())
+			require.NoError(t, err)
+			require.Equal(t, info.ID, stored.ID)
+
+			check := func(op macaroon.ActionType, path string) error {
+				return key.Check(ctx, stored.Secret, macaroon.Action{
+					Op:            op,
+					Bucket:        []byte("bucket"),
+					EncryptedPath: []byte(path),
+					Time:          time.Now(),
+				}, nil)
+			}
+
+			require.NoError(t, check(macaroon.ActionRead, "prefix/object"))
+			require.NoError(t, check(macaroon.ActionList, "prefix/object"))
+			require.Error(t, check(macaroon.ActionRead, "other/object"))
+			require.Error(t, check(macaroon.ActionWrite, "prefix/object"))
+			require.Error(t, check(macaroon.ActionDelete, "prefix/object"))
+		})
+
+		t.Run("unrestricted key", func(t *testing.T) {
+			_, key, err := service.CreateAPIKey(authCtx, project.ID, "unrestricted key")
+			require.NoError(t, err)
+			mac, err := macaroon.ParseMacaroon(key.SerializeRaw())
+			require.NoError(t, err)
+			require.Empty(t, mac.Caveats())
+		})
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// AuditEvents exposes methods to manage the audit log in the database.
+//
+// architecture: Database
+type AuditEvents interface {
+	// Insert is a method for inserting an audit event into the database.
+	Insert(ctx context.Context, event AuditEvent) error
+	// GetPagedByProjectID is a method for querying the audit events of a project, newest first.
+	GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor AuditEventsCursor) (*AuditEventsPage, error)
+	// GetPagedByUserID is a method for querying the audit events of a user, newest first.
+	GetPagedByUserID(ctx context.Context, userID uuid.UUID, cursor AuditEventsCursor) (*AuditEventsPage, error)
+	// DeleteBefore is a method for deleting audit events created before the given time.
+	// Events are deleted in batches of batchSize.
+	DeleteBefore(ctx context.Context, before time.Time, batchSize int) (deleted int64, err error)
+}
+
+// AuditEventSource is the component that recorded an audit event.
+type AuditEventSource string
+
+const (
+	// AuditEventSourceConsole is used for events recorded by the satellite console.
+	AuditEventSourceConsole AuditEventSource = "console"
+	// AuditEventSourceAdmin is used for events recorded by the satellite admin API.
+	AuditEventSourceAdmin AuditEventSource = "admin"
+)
+
+const (
+	// AuditOutcomeSuccess is stored in the details of operations that succeeded.
+	AuditOutcomeSuccess = "success"
+	// AuditOutcomeFailure is stored in the details of operations that failed
+	// after the user was authenticated, e.g. because of missing permissions.
+	AuditOutcomeFailure = "failure"
+)
+
+// AuditEvent is a database object that describes an audited operation.
+type AuditEvent struct {
+	ID uuid.UUID `json:"id"`
+	// UserID is the user that performed the operation for console events
+	// and the user affected by the operation for admin events.
+	UserID    *uuid.UUID `json:"userId,omitempty"`
+	Email     string     `json:"email"`
+	ProjectID *uuid.UUID `json:"projectId,omitempty"`
+
+	Source    AuditEventSource `json:"source"`
+	Operation string           `json:"operation"`
+	// Details is a JSON object with the operation arguments and the outcome.
+	Details json.RawMessage `json:"details"`
+
+	SourceIP       string `json:"sourceIp"`
+	ForwardedForIP string `json:"forwardedForIp"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// AuditEventsCursor holds info for audit events cursor pagination.
+type AuditEventsCursor struct {
+	Limit uint
+	Page  uint
+	// Before limits the events to the ones created before the time, zero means no limit.
+	Before time.Time
+}
+
+// AuditEventsPage represent audit events page result.
+type AuditEventsPage struct {
+	Events []AuditEvent `json:"events"`
+
+	Limit  uint   `json:"limit"`
+	Offset uint64 `json:"offset"`
+
+	PageCount   uint   `json:"pageCount"`
+	CurrentPage uint   `json:"currentPage"`
+	TotalCount  uint64 `json:"totalCount"`
+}
+
+// auditedOperations contains the operations that are stored in the audit log,
+// other operations are only logged.
+var auditedOperations = map[string]bool{
+	"create user":                           true,
+	"activate account":                      true,
+	"password reset":                        true,
+	"update account":                        true,
+	"change email":                          true,
+	"change password":                       true,
+	"delete account":                        true,
+	"link sso identity":                     true,
+	"login lockout":                         true,
+	"sso login":                             true,
+	"revoke session":                        true,
+	"revoke all sessions":                   true,
+	"create project":                        true,
+	"delete project":                        true,
+	"update project name and description":   true,
+	"add project members":                   true,
+	"delete project members":                true,
+	"update project member role":            true,
+	"create api key":                        true,
+	"delete api keys":                       true,
+	"delete api key by name and project ID": true,
+	"setup payment account":                 true,
+	"add credit card":                       true,
+	"remove credit card":                    true,
+	"make credit card default":              true,
+	"apply coupon code":                     true,
+	"set bucket inventory":                  true,
+	"delete bucket inventory":               true,
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAuditEventsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		auditEvents := db.Console().AuditEvents()
+
+		userID := testrand.UUID()
+		projectID := testrand.UUID()
+		now := time.Now()
+
+		for i := 0; i < 5; i++ {
+			event := console.AuditEvent{
+				ID:        testrand.UUID(),
+				UserID:    &userID,
+				Email:     "user@mail.test",
+				Source:    console.AuditEventSourceConsole,
+				Operation: "create api key",
+				Details:   []byte(`{"name":"key"}`),
+				SourceIP:  "127.0.0.1:5000",
+				CreatedAt: now.Add(-time.Duration(i) * time.Hour),
+			}
+			if i%2 == 0 {
+				event.ProjectID = &projectID
+			}
+			require.NoError(t, auditEvents.Insert(ctx, event))
+		}
+
+		t.Run("by user", func(t *testing.T) {
+			page, err := auditEvents.GetPagedByUserID(ctx, userID, console.AuditEventsCursor{Limit: 2, Page: 1})
+			require.NoError(t, err)
+			require.EqualValues(t, 5, page.TotalCount)
+			require.EqualValues(t, 3, page.PageCount)
+			require.Len(t, page.Events, 2)
+			require.True(t, page.Events[0].CreatedAt.After(page.Events[1].CreatedAt))
+			require.Equal(t, userID, *page.Events[0].UserID)
+			require.JSONEq(t, `{"name":"key"}`, string(page.Events[0].Details))
+
+			page, err = auditEvents.GetPagedByUserID(ctx, userID, console.AuditEventsCursor{Limit: 2, Page: 3})
+			require.NoError(t, err)
+			require.Len(t, page.Events, 1)
+
+			_, err = auditEvents.GetPagedByUserID(ctx, userID, console.AuditEventsCursor{Limit: 2, Page: 4})
+			require.Error(t, err)
+
+			page, err = auditEvents.GetPagedByUserID(ctx, userID, console.AuditEventsCursor{Limit: 10, Page: 1, Before: now.Add(-90 * time.Minute)})
+			require.NoError(t, err)
+			require.EqualValues(t, 3, page.TotalCount)
+		})
+
+		t.Run("by project", func(t *testing.T) {
+			page, err := auditEvents.GetPagedByProjectID(ctx, projectID, console.AuditEventsCursor{Limit: 10, Page: 1})
+			require.NoError(t, err)
+			require.EqualValues(t, 3, page.TotalCount)
+			for _, event := range page.Events {
+				require.Equal(t, projectID, *event.ProjectID)
+			}
+
+			page, err = auditEvents.GetPagedByProjectID(ctx, testrand.UUID(), console.AuditEventsCursor{Limit: 10, Page: 1})
+			require.NoError(t, err)
+			require.Zero(t, page.TotalCount)
+		})
+
+		t.Run("delete before", func(t *testing.T) {
+			deleted, err := auditEvents.DeleteBefore(ctx, now.Add(-90*time.Minute), 2)
+			require.NoError(t, err)
+			require.EqualValues(t, 3, deleted)
+
+			page, err := auditEvents.GetPagedByUserID(ctx, userID, console.AuditEventsCursor{Limit: 10, Page: 1})
+			require.NoError(t, err)
+			require.EqualValues(t, 2, page.TotalCount)
+		})
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrAuditExport is error class for audit log export errors.
+var ErrAuditExport = errs.Class("audit log export")
+
+// auditExportBatchSize is the number of events read at once during an export.
+const auditExportBatchSize = 1000
+
+// AuditExportFormat is a format of an audit log export.
+type AuditExportFormat string
+
+const (
+	// AuditExportCSV exports the audit log as CSV with a header row.
+	AuditExportCSV AuditExportFormat = "csv"
+	// AuditExportJSON exports the audit log as a JSON array.
+	AuditExportJSON AuditExportFormat = "json"
+)
+
+// AuditExportFormatFromString parses the name of an export format.
+func AuditExportFormatFromString(name string) (AuditExportFormat, error) {
+	switch format := AuditExportFormat(strings.ToLower(name)); format {
+	case AuditExportCSV, AuditExportJSON:
+		return format, nil
+	case "":
+		return AuditExportJSON, nil
+	default:
+		return "", ErrAuditExport.New("unsupported format %q", name)
+	}
+}
+
+// ContentType returns the MIME type of the format.
+func (format AuditExportFormat) ContentType() string {
+	if format == AuditExportCSV {
+		return "text/csv"
+	}
+	return "application/json"
+}
+
+// auditEventsCSVHeader contains the column names of a CSV export.
+var auditEventsCSVHeader = []string{
+	"id", "created_at", "source", "operation", "user_id", "email",
+	"project_id", "source_ip", "forwarded_for_ip", "details",
+}
+
+// AuditExportWriter writes audit events in the export format.
+type AuditExportWriter struct {
+	format AuditExportFormat
+	buffer *bufio.Writer
+	csv    *csv.Writer
+	count  int
+}
+
+// NewAuditExportWriter creates a writer for the format.
+func NewAuditExportWriter(w io.Writer, format AuditExportFormat) *AuditExportWriter {
+	writer := &AuditExportWriter{
+		format: format,
+		buffer: bufio.NewWriter(w),
+	}
+	if format == AuditExportCSV {
+		writer.csv = csv.NewWriter(writer.buffer)
+	}
+	return writer
+}
+
+// Write writes a single event.
+func (writer *AuditExportWriter) Write(event AuditEvent) error {
+	defer func() { writer.count++ }()
+
+	if writer.format == AuditExportCSV {
+		if writer.count == 0 {
+			if err := writer.csv.Write(auditEventsCSVHeader); err != nil {
+				return ErrAuditExport.Wrap(err)
+			}
+		}
+		return ErrAuditExport.Wrap(writer.csv.Write([]string{
+			event.ID.String(),
+			event.CreatedAt.UTC().Format(time.RFC3339Nano),
+			string(event.Source),
+			event.Operation,
+			optionalUUIDString(event.UserID),
+			event.Email,
+			optionalUUIDString(event.ProjectID),
+			event.SourceIP,
+			event.ForwardedForIP,
+			string(event.Details),
+		}))
+	}
+
+	separator := ",\n"
+	if writer.count == 0 {
+		separator = "[\n"
+	}
+	if _, err := writer.buffer.WriteString(separator); err != nil {
+		return ErrAuditExport.Wrap(err)
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return ErrAuditExport.Wrap(err)
+	}
+	_, err = writer.buffer.Write(data)
+	return ErrAuditExport.Wrap(err)
+}
+
+// Close finishes the export and flushes the buffered data.
+func (writer *AuditExportWriter) Close() error {
+	if writer.format == AuditExportCSV {
+		if writer.count == 0 {
+			if err := writer.csv.Write(auditEventsCSVHeader); err != nil {
+				return ErrAuditExport.Wrap(err)
+			}
+		}
+		writer.csv.Flush()
+		if err := writer.csv.Error(); err != nil {
+			return ErrAuditExport.Wrap(err)
+		}
+	} else {
+		end := "\n]\n"
+		if writer.count == 0 {
+			end = "[]\n"
+		}
+		if _, err := writer.buffer.WriteString(end); err != nil {
+			return ErrAuditExport.Wrap(err)
+		}
+	}
+	return ErrAuditExport.Wrap(writer.buffer.Flush())
+}
+
+// ExportAuditEvents writes all events returned by page to w, starting with the newest.
+//
+// Only events created before the start of the export are written, so events
+// recorded during the export don't shift the pages.
+func ExportAuditEvents(ctx context.Context, w io.Writer, format AuditExportFormat, page func(ctx context.Context, cursor AuditEventsCursor) (*AuditEventsPage, error)) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	writer := NewAuditExportWriter(w, format)
+	cursor := AuditEventsCursor{
+		Limit:  auditExportBatchSize,
+		Page:   1,
+		Before: time.Now(),
+	}
+	for {
+		events, err := page(ctx, cursor)
+		if err != nil {
+			return err
+		}
+		for _, event := range events.Events {
+			if err := writer.Write(event); err != nil {
+				return err
+			}
+		}
+		if cursor.Page >= events.PageCount {
+			break
+		}
+		cursor.Page++
+	}
+	return writer.Close()
+}
+
+func optionalUUIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
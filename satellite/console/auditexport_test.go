@@ -0,0 +1,115 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/console"
+)
+
+func TestExportAuditEvents(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	projectID := testrand.UUID()
+	events := make([]console.AuditEvent, 2500)
+	for i := range events {
+		events[i] = console.AuditEvent{
+			ID:        testrand.UUID(),
+			Email:     "user@mail.test",
+			Source:    console.AuditEventSourceAdmin,
+			Operation: "delete project",
+			Details:   []byte(`{"reason":"a, \"quoted\" reason"}`),
+			CreatedAt: time.Date(2021, 10, 18, 12, 0, 0, 0, time.UTC),
+		}
+		if i%2 == 0 {
+			events[i].ProjectID = &projectID
+		}
+	}
+
+	page := func(ctx context.Context, cursor console.AuditEventsCursor) (*console.AuditEventsPage, error) {
+		require.False(t, cursor.Before.IsZero())
+
+		start := int((cursor.Page - 1) * cursor.Limit)
+		end := start + int(cursor.Limit)
+		if end > len(events) {
+			end = len(events)
+		}
+		pageCount := uint(len(events)) / cursor.Limit
+		if uint(len(events))%cursor.Limit != 0 {
+			pageCount++
+		}
+		return &console.AuditEventsPage{
+			Events:      events[start:end],
+			PageCount:   pageCount,
+			CurrentPage: cursor.Page,
+			TotalCount:  uint64(len(events)),
+		}, nil
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		var buffer bytes.Buffer
+		require.NoError(t, console.ExportAuditEvents(ctx, &buffer, console.AuditExportCSV, page))
+
+		records, err := csv.NewReader(&buffer).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, len(events)+1)
+		require.Equal(t, "id", records[0][0])
+		require.Equal(t, events[0].ID.String(), records[1][0])
+		require.Equal(t, "2021-10-18T12:00:00Z", records[1][1])
+		require.Equal(t, projectID.String(), records[1][6])
+		require.Equal(t, "", records[2][6])
+		require.Equal(t, string(events[0].Details), records[1][9])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buffer bytes.Buffer
+		require.NoError(t, console.ExportAuditEvents(ctx, &buffer, console.AuditExportJSON, page))
+
+		var exported []console.AuditEvent
+		require.NoError(t, json.Unmarshal(buffer.Bytes(), &exported))
+		require.Len(t, exported, len(events))
+		require.Equal(t, events[1].ID, exported[1].ID)
+		require.JSONEq(t, string(events[1].Details), string(exported[1].Details))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		empty := func(ctx context.Context, cursor console.AuditEventsCursor) (*console.AuditEventsPage, error) {
+			return &console.AuditEventsPage{}, nil
+		}
+
+		var buffer bytes.Buffer
+		require.NoError(t, console.ExportAuditEvents(ctx, &buffer, console.AuditExportJSON, empty))
+		require.Equal(t, "[]\n", buffer.String())
+
+		buffer.Reset()
+		require.NoError(t, console.ExportAuditEvents(ctx, &buffer, console.AuditExportCSV, empty))
+		records, err := csv.NewReader(&buffer).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+	})
+
+	t.Run("format", func(t *testing.T) {
+		format, err := console.AuditExportFormatFromString("CSV")
+		require.NoError(t, err)
+		require.Equal(t, console.AuditExportCSV, format)
+
+		format, err = console.AuditExportFormatFromString("")
+		require.NoError(t, err)
+		require.Equal(t, console.AuditExportJSON, format)
+
+		_, err = console.AuditExportFormatFromString("xml")
+		require.True(t, console.ErrAuditExport.Has(err))
+	})
+}
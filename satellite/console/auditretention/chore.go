@@ -0,0 +1,85 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package auditretention implements removal of expired console audit events.
+package auditretention
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/console"
+)
+
+// Error is a standard error class for this package.
+var (
+	Error = errs.Class("auditretention")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the audit log retention chore.
+type Config struct {
+	Interval  time.Duration `help:"how frequently expired audit events should be deleted" releaseDefault:"24h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
+	Retention time.Duration `help:"how long audit events are kept" default:"8760h"`
+	BatchSize int           `help:"number of audit events to delete per delete execution" default:"1000"`
+	Enabled   bool          `help:"whether or not expired audit events are deleted" default:"true"`
+}
+
+// Chore deletes audit events older than the retention period.
+//
+// architecture: Chore
+type Chore struct {
+	log       *zap.Logger
+	Loop      *sync2.Cycle
+	retention time.Duration
+	batchSize int
+	events    console.AuditEvents
+}
+
+// NewChore creates a new audit log retention chore.
+func NewChore(log *zap.Logger, events console.AuditEvents, config Config) *Chore {
+	return &Chore{
+		log:       log,
+		Loop:      sync2.NewCycle(config.Interval),
+		retention: config.Retention,
+		batchSize: config.BatchSize,
+		events:    events,
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if chore.retention <= 0 {
+		return Error.New("retention must be greater than 0")
+	}
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.DeleteExpired(ctx, time.Now().Add(-chore.retention))
+		if err != nil {
+			chore.log.Error("error deleting expired audit events", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// DeleteExpired deletes the audit events created before cutoff.
+func (chore *Chore) DeleteExpired(ctx context.Context, cutoff time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := chore.events.DeleteBefore(ctx, cutoff, chore.batchSize)
+	if deleted > 0 {
+		chore.log.Info("deleted expired audit events", zap.Int64("count", deleted))
+	}
+	return Error.Wrap(err)
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -18,6 +18,8 @@ type Claims struct {
 	ID         uuid.UUID `json:"id"`
 	Email      string    `json:"email,omitempty"`
 	Expiration time.Time `json:"expires,omitempty"`
+	// SessionID is the server-side session of an auth token.
+	SessionID uuid.UUID `json:"sessionId,omitempty"`
 }
 
 // JSON returns json representation of Claims.
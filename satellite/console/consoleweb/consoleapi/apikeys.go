@@ -4,6 +4,7 @@
 package consoleapi
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/zeebo/errs"
@@ -32,6 +33,56 @@ func NewAPIKeys(log *zap.Logger, service *console.Service) *APIKeys {
 	}
 }
 
+// Create creates a new api key restricted to the requested buckets and operations.
+func (keys *APIKeys) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request struct {
+		ProjectID uuid.UUID `json:"projectId"`
+		Name      string    `json:"name"`
+		console.APIKeyRestrictions
+	}
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		keys.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if request.Name == "" {
+		keys.serveJSONError(w, http.StatusBadRequest, errs.New("missing api key name"))
+		return
+	}
+
+	info, key, err := keys.service.CreateRestrictedAPIKey(ctx, request.ProjectID, request.Name, request.APIKeyRestrictions)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			keys.serveJSONError(w, http.StatusUnauthorized, err)
+		case console.ErrForbidden.Has(err):
+			keys.serveJSONError(w, http.StatusForbidden, err)
+		case console.ErrValidation.Has(err):
+			keys.serveJSONError(w, http.StatusBadRequest, err)
+		default:
+			keys.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(struct {
+		Key     string              `json:"key"`
+		KeyInfo *console.APIKeyInfo `json:"keyInfo"`
+	}{
+		Key:     key.Serialize(),
+		KeyInfo: info,
+	})
+	if err != nil {
+		keys.log.Error("failed to write json create api key response", zap.Error(ErrAPIKeysAPI.Wrap(err)))
+	}
+}
+
 // DeleteByNameAndProjectID deletes specific api key by it's name and project ID.
 func (keys *APIKeys) DeleteByNameAndProjectID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -59,6 +110,11 @@ func (keys *APIKeys) DeleteByNameAndProjectID(w http.ResponseWriter, r *http.Req
 			return
 		}
 
+		if console.ErrForbidden.Has(err) {
+			keys.serveJSONError(w, http.StatusForbidden, err)
+			return
+		}
+
 		if console.ErrNoAPIKey.Has(err) {
 			keys.serveJSONError(w, http.StatusNoContent, err)
 			return
@@ -0,0 +1,211 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrAuditEventsAPI - console audit events api error type.
+	ErrAuditEventsAPI = errs.Class("console audit events")
+)
+
+// defaultAuditEventsLimit is the page size used when the limit isn't specified.
+const defaultAuditEventsLimit = 50
+
+// AuditEvents is an api controller that exposes the console audit log.
+type AuditEvents struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewAuditEvents is a constructor for api audit events controller.
+func NewAuditEvents(log *zap.Logger, service *console.Service) *AuditEvents {
+	return &AuditEvents{
+		log:     log,
+		service: service,
+	}
+}
+
+// ProjectAuditEvents returns a page of the project audit log.
+func (events *AuditEvents) ProjectAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := projectIDFromRoute(r)
+	if err != nil {
+		events.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	cursor, err := auditEventsCursorFromQuery(r)
+	if err != nil {
+		events.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	page, err := events.service.GetProjectAuditEvents(ctx, projectID, cursor)
+	if err != nil {
+		events.serveServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(page)
+	if err != nil {
+		events.log.Error("failed to write json project audit events response", zap.Error(ErrAuditEventsAPI.Wrap(err)))
+	}
+}
+
+// ExportProjectAuditEvents writes the whole project audit log as CSV or JSON.
+func (events *AuditEvents) ExportProjectAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := projectIDFromRoute(r)
+	if err != nil {
+		events.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	format, err := console.AuditExportFormatFromString(r.URL.Query().Get("format"))
+	if err != nil {
+		events.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// the export is written directly to the response, so the permissions
+	// are checked before writing any headers.
+	_, err = events.service.GetProjectAuditEvents(ctx, projectID, console.AuditEventsCursor{Limit: 1, Page: 1})
+	if err != nil {
+		events.serveServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", "attachment; filename=\"audit-log-"+projectID.String()+"."+string(format)+"\"")
+	err = events.service.ExportProjectAuditEvents(ctx, projectID, format, w)
+	if err != nil {
+		events.log.Error("failed to export project audit events", zap.Error(ErrAuditEventsAPI.Wrap(err)))
+	}
+}
+
+// UserAuditEvents returns a page of the audit log of the authorized user.
+func (events *AuditEvents) UserAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	cursor, err := auditEventsCursorFromQuery(r)
+	if err != nil {
+		events.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	page, err := events.service.GetUserAuditEvents(ctx, cursor)
+	if err != nil {
+		events.serveServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(page)
+	if err != nil {
+		events.log.Error("failed to write json user audit events response", zap.Error(ErrAuditEventsAPI.Wrap(err)))
+	}
+}
+
+// ExportUserAuditEvents writes the whole audit log of the authorized user as CSV or JSON.
+func (events *AuditEvents) ExportUserAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	format, err := console.AuditExportFormatFromString(r.URL.Query().Get("format"))
+	if err != nil {
+		events.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	_, err = events.service.GetUserAuditEvents(ctx, console.AuditEventsCursor{Limit: 1, Page: 1})
+	if err != nil {
+		events.serveServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", "attachment; filename=\"audit-log."+string(format)+"\"")
+	err = events.service.ExportUserAuditEvents(ctx, format, w)
+	if err != nil {
+		events.log.Error("failed to export user audit events", zap.Error(ErrAuditEventsAPI.Wrap(err)))
+	}
+}
+
+// serveServiceError writes the JSON error matching a service error.
+func (events *AuditEvents) serveServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err):
+		events.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrForbidden.Has(err):
+		events.serveJSONError(w, http.StatusForbidden, err)
+	default:
+		events.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (events *AuditEvents) serveJSONError(w http.ResponseWriter, status int, err error) {
+	serveJSONError(events.log, w, status, err)
+}
+
+// projectIDFromRoute parses the project ID route param.
+func projectIDFromRoute(r *http.Request) (uuid.UUID, error) {
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		return uuid.UUID{}, errs.New("missing project id route param")
+	}
+
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		return uuid.UUID{}, errs.New("invalid project id: %v", err)
+	}
+	return projectID, nil
+}
+
+// auditEventsCursorFromQuery parses the limit and page query params.
+func auditEventsCursorFromQuery(r *http.Request) (console.AuditEventsCursor, error) {
+	cursor := console.AuditEventsCursor{
+		Limit: defaultAuditEventsLimit,
+		Page:  1,
+	}
+
+	query := r.URL.Query()
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || limit == 0 {
+			return cursor, errs.New("invalid limit %q", value)
+		}
+		cursor.Limit = uint(limit)
+	}
+	if value := query.Get("page"); value != "" {
+		page, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || page == 0 {
+			return cursor, errs.New("invalid page %q", value)
+		}
+		cursor.Page = uint(page)
+	}
+	return cursor, nil
+}
@@ -4,6 +4,7 @@
 package consoleapi
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -82,7 +83,7 @@ func (a *Auth) Token(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := a.service.Token(ctx, tokenRequest)
+	login, err := a.service.Login(ctx, tokenRequest)
 	if err != nil {
 		if !console.ErrMFAMissing.Has(err) {
 			a.log.Info("Error authenticating token request", zap.String("email", tokenRequest.Email), zap.Error(ErrAuthAPI.Wrap(err)))
@@ -91,26 +92,117 @@ func (a *Auth) Token(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	a.cookieAuth.SetTokenCookie(w, token)
+	if login.NewDevice {
+		a.sendNewDeviceEmail(ctx, login)
+	}
+
+	a.cookieAuth.SetTokenCookie(w, login.Token)
 
 	w.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(w).Encode(token)
+	err = json.NewEncoder(w).Encode(login.Token)
 	if err != nil {
 		a.log.Error("token handler could not encode token response", zap.Error(ErrAuthAPI.Wrap(err)))
 		return
 	}
 }
 
-// Logout removes auth cookie.
+// sendNewDeviceEmail notifies the user about a login from a device the user hasn't logged in from before.
+func (a *Auth) sendNewDeviceEmail(ctx context.Context, login *console.LoginInfo) {
+	userName := login.User.ShortName
+	if login.User.ShortName == "" {
+		userName = login.User.FullName
+	}
+
+	a.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: login.User.Email, Name: userName}},
+		&consoleql.NewDeviceLoginEmail{
+			Origin:       a.ExternalAddress,
+			UserName:     userName,
+			LoginTime:    login.Session.CreatedAt.UTC().Format(time.RFC1123),
+			IPAddress:    login.Session.IPAddress,
+			UserAgent:    login.Session.UserAgent,
+			SettingsLink: a.ExternalAddress + "account/settings",
+		},
+	)
+}
+
+// Logout ends the session and removes auth cookie.
 func (a *Auth) Logout(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	defer mon.Task()(&ctx)(nil)
+	var err error
+	defer mon.Task()(&ctx)(&err)
 
 	a.cookieAuth.RemoveTokenCookie(w)
 
+	// the cookie is removed even when the session has already ended.
+	err = a.service.Logout(ctx)
+	if err != nil && !console.ErrUnauthorized.Has(err) {
+		a.log.Error("failed to end session on logout", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 }
 
+// GetSessions returns the active sessions of the user.
+func (a *Auth) GetSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	sessions, err := a.service.GetSessions(ctx)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(sessions)
+	if err != nil {
+		a.log.Error("could not encode sessions", zap.Error(ErrAuthAPI.Wrap(err)))
+		return
+	}
+}
+
+// RevokeSession logs out a session of the user.
+func (a *Auth) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		a.serveJSONError(w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	err = a.service.RevokeSession(ctx, id)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	auth, err := console.GetAuth(ctx)
+	if err == nil && auth.Claims.SessionID == id {
+		a.cookieAuth.RemoveTokenCookie(w)
+	}
+}
+
+// RevokeAllSessions logs out all sessions of the user, including the current one.
+func (a *Auth) RevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	err = a.service.RevokeAllSessions(ctx)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	a.cookieAuth.RemoveTokenCookie(w)
+}
+
 // Register creates new user, sends activation e-mail.
 func (a *Auth) Register(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -606,6 +698,10 @@ func (a *Auth) getStatusCode(err error) int {
 		return http.StatusBadRequest
 	case console.ErrUnauthorized.Has(err), console.ErrRecoveryToken.Has(err):
 		return http.StatusUnauthorized
+	case console.ErrSSORequired.Has(err):
+		return http.StatusForbidden
+	case console.ErrLoginLocked.Has(err):
+		return http.StatusTooManyRequests
 	case console.ErrEmailUsed.Has(err), console.ErrMFAConflict.Has(err):
 		return http.StatusConflict
 	case errors.Is(err, errNotImplemented):
@@ -642,6 +738,10 @@ func (a *Auth) getUserErrorMessage(err error) string {
 		return "The MFA passcode is not valid or has expired"
 	case console.ErrMFARecoveryCode.Has(err):
 		return "The MFA recovery code is not valid or has been previously used"
+	case console.ErrSSORequired.Has(err):
+		return "Your organization requires you to log in through single sign-on"
+	case console.ErrLoginLocked.Has(err):
+		return "Too many failed login attempts, please try again later"
 	case errors.Is(err, errNotImplemented):
 		return "The server is incapable of fulfilling the request"
 	default:
@@ -10,8 +10,10 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/inventory"
 )
 
 var (
@@ -56,6 +58,11 @@ func (b *Buckets) AllBucketNames(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if console.ErrForbidden.Has(err) {
+			b.serveJSONError(w, http.StatusForbidden, err)
+			return
+		}
+
 		b.serveJSONError(w, http.StatusInternalServerError, err)
 		return
 	}
@@ -66,6 +73,121 @@ func (b *Buckets) AllBucketNames(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetInventory returns the inventory configuration of a bucket.
+func (b *Buckets) GetInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	config, err := b.service.GetBucketInventory(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveInventoryError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(config)
+	if err != nil {
+		b.log.Error("failed to write json bucket inventory response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// SetInventory creates or replaces the inventory configuration of a bucket.
+func (b *Buckets) SetInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var request struct {
+		Format            inventory.Format `json:"format"`
+		DestinationAccess string           `json:"destinationAccess"`
+		DestinationBucket string           `json:"destinationBucket"`
+		DestinationPrefix string           `json:"destinationPrefix"`
+	}
+
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.SetBucketInventory(ctx, inventory.Configuration{
+		ProjectID:         projectID,
+		BucketName:        bucketName,
+		Format:            request.Format,
+		DestinationAccess: request.DestinationAccess,
+		DestinationBucket: request.DestinationBucket,
+		DestinationPrefix: request.DestinationPrefix,
+	})
+	if err != nil {
+		b.serveInventoryError(w, err)
+		return
+	}
+}
+
+// DeleteInventory removes the inventory configuration of a bucket.
+func (b *Buckets) DeleteInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.DeleteBucketInventory(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveInventoryError(w, err)
+		return
+	}
+}
+
+// bucketFromQuery parses the projectID and bucket query parameters.
+func bucketFromQuery(r *http.Request) (projectID uuid.UUID, bucketName string, err error) {
+	projectID, err = uuid.FromString(r.URL.Query().Get("projectID"))
+	if err != nil {
+		return uuid.UUID{}, "", ErrBucketsAPI.Wrap(err)
+	}
+
+	bucketName = r.URL.Query().Get("bucket")
+	if bucketName == "" {
+		return uuid.UUID{}, "", ErrBucketsAPI.New("missing bucket name")
+	}
+
+	return projectID, bucketName, nil
+}
+
+// serveInventoryError maps the errors of the inventory service to http statuses.
+func (b *Buckets) serveInventoryError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err):
+		b.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrForbidden.Has(err):
+		b.serveJSONError(w, http.StatusForbidden, err)
+	case inventory.ErrInvalid.Has(err):
+		b.serveJSONError(w, http.StatusBadRequest, err)
+	case inventory.ErrNotFound.Has(err), storj.ErrBucketNotFound.Has(err):
+		b.serveJSONError(w, http.StatusNotFound, err)
+	default:
+		b.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(w http.ResponseWriter, status int, err error) {
 	serveJSONError(b.log, w, status, err)
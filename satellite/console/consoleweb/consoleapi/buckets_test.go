@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/inventory"
 )
 
 func Test_AllBucketNames(t *testing.T) {
@@ -103,3 +105,84 @@ func Test_AllBucketNames(t *testing.T) {
 		}()
 	})
 }
+
+func Test_BucketInventory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OpenRegistrationEnabled = true
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Jack-inventory",
+			Email:    "inventorytest@test.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "inventorytest")
+		require.NoError(t, err)
+
+		_, err = sat.DB.Buckets().CreateBucket(ctx, storj.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "bucket",
+			ProjectID: project.ID,
+		})
+		require.NoError(t, err)
+
+		// we are using full name as a password
+		token, err := sat.API.Console.Service.Token(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+		require.NoError(t, err)
+
+		link := "http://" + sat.API.Console.Listener.Addr().String() + "/api/v0/buckets/inventory?projectID=" + project.ID.String()
+
+		do := func(method, url, body string) (int, []byte) {
+			req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+			require.NoError(t, err)
+			req.AddCookie(&http.Cookie{Name: "_tokenKey", Value: token})
+
+			result, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, result.Body.Close()) }()
+
+			data, err := ioutil.ReadAll(result.Body)
+			require.NoError(t, err)
+			return result.StatusCode, data
+		}
+
+		status, _ := do(http.MethodGet, link+"&bucket=bucket", "")
+		require.Equal(t, http.StatusNotFound, status)
+
+		status, _ = do(http.MethodPut, link+"&bucket=missing", `{"format":"csv"}`)
+		require.Equal(t, http.StatusNotFound, status)
+
+		status, _ = do(http.MethodPut, link+"&bucket=bucket", `{"format":"xml"}`)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = do(http.MethodPut, link+"&bucket=bucket", `{"format":"csv","destinationAccess":"secret-access","destinationBucket":"reports"}`)
+		require.Equal(t, http.StatusOK, status)
+
+		status, body := do(http.MethodGet, link+"&bucket=bucket", "")
+		require.Equal(t, http.StatusOK, status)
+		require.NotContains(t, string(body), "secret-access")
+
+		var config inventory.Configuration
+		require.NoError(t, json.Unmarshal(body, &config))
+		require.Equal(t, inventory.FormatCSV, config.Format)
+		require.Equal(t, "reports", config.DestinationBucket)
+
+		stored, err := sat.DB.BucketInventories().Get(ctx, project.ID, "bucket")
+		require.NoError(t, err)
+		require.NotContains(t, stored.DestinationAccess, "secret-access")
+
+		status, _ = do(http.MethodDelete, link+"&bucket=bucket", "")
+		require.Equal(t, http.StatusOK, status)
+
+		status, _ = do(http.MethodGet, link+"&bucket=bucket", "")
+		require.Equal(t, http.StatusNotFound, status)
+	})
+}
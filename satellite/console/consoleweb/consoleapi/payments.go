@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"strconv"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
 )
 
 var (
@@ -247,6 +249,75 @@ func (p *Payments) BillingHistory(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// InvoicePreview returns an estimate of the invoice of the current billing period.
+func (p *Payments) InvoicePreview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	preview, err := p.service.Payments().InvoicePreview(ctx)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			p.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(preview)
+	if err != nil {
+		p.log.Error("failed to write json invoice preview response", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
+// InvoiceDocument returns a rendered invoice of the payment account.
+func (p *Payments) InvoiceDocument(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	invoiceID := mux.Vars(r)["id"]
+	if invoiceID == "" {
+		p.serveJSONError(w, http.StatusBadRequest, errs.New("invoice id is missing"))
+		return
+	}
+
+	format := payments.InvoiceFormat(r.URL.Query().Get("format"))
+	switch format {
+	case "":
+		format = payments.InvoiceFormatPDF
+	case payments.InvoiceFormatPDF, payments.InvoiceFormatHTML:
+	default:
+		p.serveJSONError(w, http.StatusBadRequest, errs.New("unknown invoice format %q", format))
+		return
+	}
+
+	document, err := p.service.Payments().InvoiceDocument(ctx, invoiceID, format)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			p.serveJSONError(w, http.StatusUnauthorized, err)
+		case payments.ErrInvoiceNotFound.Has(err):
+			p.serveJSONError(w, http.StatusNotFound, err)
+		case payments.ErrUnsupported.Has(err):
+			p.serveJSONError(w, http.StatusNotImplemented, err)
+		default:
+			p.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", document.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": document.FileName}))
+	if _, err = w.Write(document.Data); err != nil {
+		p.log.Error("failed to write invoice document", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
 // TokenDeposit creates new deposit transaction and info about address and amount of newly created tx.
 func (p *Payments) TokenDeposit(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
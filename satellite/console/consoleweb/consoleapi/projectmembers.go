@@ -0,0 +1,84 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrProjectMembersAPI - console project members api error type.
+	ErrProjectMembersAPI = errs.Class("console project members")
+)
+
+// ProjectMembers is an api controller that exposes project members related functionality.
+type ProjectMembers struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewProjectMembers is a constructor for api project members controller.
+func NewProjectMembers(log *zap.Logger, service *console.Service) *ProjectMembers {
+	return &ProjectMembers{
+		log:     log,
+		service: service,
+	}
+}
+
+// UpdateRole changes the role of a project member.
+func (members *ProjectMembers) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		members.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		members.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	var request struct {
+		Email string                    `json:"email"`
+		Role  console.ProjectMemberRole `json:"role"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		members.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = members.service.UpdateProjectMemberRole(ctx, projectID, request.Email, request.Role)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			members.serveJSONError(w, http.StatusUnauthorized, err)
+		case console.ErrForbidden.Has(err):
+			members.serveJSONError(w, http.StatusForbidden, err)
+		case console.ErrValidation.Has(err):
+			members.serveJSONError(w, http.StatusBadRequest, err)
+		default:
+			members.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (members *ProjectMembers) serveJSONError(w http.ResponseWriter, status int, err error) {
+	serveJSONError(members.log, w, status, err)
+}
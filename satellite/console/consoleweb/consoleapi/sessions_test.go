@@ -0,0 +1,108 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestSessionEndpoints(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.RateLimit.Burst = 20
+				config.Console.LoginLockout.AccountAttempts = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		baseURL := sat.ConsoleURL() + "/api/v0/auth"
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Session User",
+			Email:    "sessions@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		do := func(method, url, token string, body interface{}) *http.Response {
+			data, err := json.Marshal(body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("User-Agent", "session-test")
+			if token != "" {
+				req.AddCookie(&http.Cookie{Name: "_tokenKey", Value: token})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			return resp
+		}
+
+		login := func(password string) (token string, status int) {
+			resp := do(http.MethodPost, baseURL+"/token", "", console.AuthUser{Email: user.Email, Password: password})
+			if resp.StatusCode == http.StatusOK {
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&token))
+			}
+			require.NoError(t, resp.Body.Close())
+			return token, resp.StatusCode
+		}
+
+		first, status := login(user.FullName)
+		require.Equal(t, http.StatusOK, status)
+		second, status := login(user.FullName)
+		require.Equal(t, http.StatusOK, status)
+
+		resp := do(http.MethodGet, baseURL+"/sessions", first, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var sessions []console.SessionInfo
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&sessions))
+		require.NoError(t, resp.Body.Close())
+		require.Len(t, sessions, 2)
+
+		for _, session := range sessions {
+			require.Equal(t, "session-test", session.UserAgent)
+			if session.Current {
+				continue
+			}
+
+			resp = do(http.MethodDelete, baseURL+"/sessions/"+session.ID.String(), first, nil)
+			require.NoError(t, resp.Body.Close())
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+
+		resp = do(http.MethodGet, baseURL+"/account", second, nil)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp = do(http.MethodPost, baseURL+"/logout", first, nil)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = do(http.MethodGet, baseURL+"/account", first, nil)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		for i := 0; i < 2; i++ {
+			_, status = login("wrong")
+			require.Equal(t, http.StatusUnauthorized, status)
+		}
+		_, status = login(user.FullName)
+		require.Equal(t, http.StatusTooManyRequests, status)
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleweb/consolewebauth"
+)
+
+var (
+	// ErrSSOAPI - console single sign-on api error type.
+	ErrSSOAPI = errs.Class("console sso")
+)
+
+const (
+	// ssoStateCookie is the cookie keeping the login state between the login and the callback.
+	ssoStateCookie = "_ssoState"
+	// ssoStateCookieExpiration is the time the user has to log in at the identity provider.
+	ssoStateCookieExpiration = 10 * time.Minute
+	// SSOCallbackPath is the path of the callback, which has to be registered with the identity provider.
+	SSOCallbackPath = "api/v0/auth/sso/callback"
+)
+
+// SSO is an api controller that exposes OpenID Connect single sign-on.
+type SSO struct {
+	log             *zap.Logger
+	service         *console.Service
+	cookieAuth      *consolewebauth.CookieAuth
+	externalAddress string
+}
+
+// NewSSO is a constructor for api single sign-on controller.
+// externalAddress is the address of the console, ending with a slash.
+func NewSSO(log *zap.Logger, service *console.Service, cookieAuth *consolewebauth.CookieAuth, externalAddress string) *SSO {
+	return &SSO{
+		log:             log,
+		service:         service,
+		cookieAuth:      cookieAuth,
+		externalAddress: externalAddress,
+	}
+}
+
+// Login redirects the user to the identity provider.
+func (sso *SSO) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	authURL, state, err := sso.service.BeginSSOLogin(ctx, sso.externalAddress+SSOCallbackPath)
+	if err != nil {
+		sso.serveJSONError(w, err)
+		return
+	}
+
+	sso.setStateCookie(w, state, time.Now().Add(ssoStateCookieExpiration))
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// Callback completes the login after the user has been authenticated by the
+// identity provider and redirects the logged in user to the console.
+func (sso *SSO) Callback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	query := r.URL.Query()
+	if code := query.Get("error"); code != "" {
+		err = console.ErrUnauthorized.New("identity provider returned %q: %s", code, query.Get("error_description"))
+		sso.serveJSONError(w, err)
+		return
+	}
+
+	cookie, err := r.Cookie(ssoStateCookie)
+	if err != nil {
+		sso.serveJSONError(w, console.ErrUnauthorized.New("missing single sign-on state"))
+		return
+	}
+	// the state can be used only once.
+	sso.setStateCookie(w, "", time.Unix(0, 0))
+
+	token, err := sso.service.FinishSSOLogin(ctx, cookie.Value, query.Get("state"), query.Get("code"))
+	if err != nil {
+		sso.log.Info("Error authenticating single sign-on request", zap.Error(ErrSSOAPI.Wrap(err)))
+		sso.serveJSONError(w, err)
+		return
+	}
+
+	sso.cookieAuth.SetTokenCookie(w, token)
+	http.Redirect(w, r, sso.externalAddress, http.StatusFound)
+}
+
+// setStateCookie sets the login state cookie. The cookie has to be sent with
+// the redirect from the identity provider, so it can't be strict.
+func (sso *SSO) setStateCookie(w http.ResponseWriter, state string, expires time.Time) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookie,
+		Value:    state,
+		Path:     "/api/v0/auth/sso",
+		Expires:  expires,
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(sso.externalAddress, "https://"),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (sso *SSO) serveJSONError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case console.ErrUnauthorized.Has(err):
+		status = http.StatusUnauthorized
+	case console.ErrSSO.Has(err):
+		status = http.StatusBadGateway
+	}
+	serveJSONError(sso.log, w, status, err)
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console/oidc/oidctest"
+)
+
+func TestSSO(t *testing.T) {
+	idp, err := oidctest.New("satellite", "secret")
+	require.NoError(t, err)
+	defer idp.Close()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.SSO.Enabled = true
+				config.Console.SSO.ProvisionUsers = true
+				config.Console.SSO.OIDC = idp.Config()
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		consoleURL := planet.Satellites[0].ConsoleURL()
+
+		idp.SetUser(oidctest.User{
+			Subject:       "sso-user",
+			Email:         "sso-user@example.test",
+			EmailVerified: true,
+			Name:          "SSO User",
+		})
+
+		jar, err := cookiejar.New(nil)
+		require.NoError(t, err)
+		client := &http.Client{
+			Jar: jar,
+			// follow the redirects through the identity provider back to the console.
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if req.URL.Path == "/" {
+					return http.ErrUseLastResponse
+				}
+				return nil
+			},
+		}
+
+		get := func(client *http.Client, url string) *http.Response {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			require.NoError(t, err)
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			return resp
+		}
+
+		resp := get(client, consoleURL+"/api/v0/auth/sso/login")
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		require.Equal(t, consoleURL+"/", resp.Header.Get("Location"))
+
+		accountURL, err := url.Parse(consoleURL + "/api/v0/auth/account")
+		require.NoError(t, err)
+		require.NotEmpty(t, jar.Cookies(accountURL))
+
+		resp = get(client, accountURL.String())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var account struct {
+			Email    string `json:"email"`
+			FullName string `json:"fullName"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&account))
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, "sso-user@example.test", account.Email)
+		require.Equal(t, "SSO User", account.FullName)
+
+		// the callback can't be replayed without the state cookie.
+		resp = get(http.DefaultClient, consoleURL+"/api/v0/auth/sso/callback?state=state&code=code")
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
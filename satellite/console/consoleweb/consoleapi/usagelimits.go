@@ -63,6 +63,9 @@ func (ul *UsageLimits) ProjectUsageLimits(w http.ResponseWriter, r *http.Request
 		case console.ErrUnauthorized.Has(err):
 			ul.serveJSONError(w, http.StatusUnauthorized, err)
 			return
+		case console.ErrForbidden.Has(err):
+			ul.serveJSONError(w, http.StatusForbidden, err)
+			return
 		case accounting.ErrInvalidArgument.Has(err):
 			ul.serveJSONError(w, http.StatusBadRequest, err)
 			return
@@ -17,6 +17,8 @@ const (
 	CreateAPIKeyType = "graphqlCreateAPIKey"
 	// FieldKey is field name for the actual key in createAPIKey.
 	FieldKey = "key"
+	// FieldLastUsedAt is field name for the last time an api key was used.
+	FieldLastUsedAt = "lastUsedAt"
 )
 
 // graphqlAPIKeyInfo creates satellite.APIKeyInfo graphql object.
@@ -39,6 +41,12 @@ func graphqlAPIKeyInfo() *graphql.Object {
 			FieldPartnerID: &graphql.Field{
 				Type: graphql.String,
 			},
+			FieldExpiresAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			FieldLastUsedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
 		},
 	})
 }
@@ -70,3 +70,19 @@ func (*ProjectInvitationEmail) Template() string { return "Invite" }
 func (email *ProjectInvitationEmail) Subject() string {
 	return "You were invited to join the Project " + email.ProjectName
 }
+
+// NewDeviceLoginEmail is mailservice template for the notification of a login from a new device.
+type NewDeviceLoginEmail struct {
+	Origin       string
+	UserName     string
+	LoginTime    string
+	IPAddress    string
+	UserAgent    string
+	SettingsLink string
+}
+
+// Template returns email template name.
+func (*NewDeviceLoginEmail) Template() string { return "NewDeviceLogin" }
+
+// Subject gets email subject.
+func (*NewDeviceLoginEmail) Subject() string { return "New login to your account" }
@@ -154,6 +154,7 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 						users = append(users, projectMember{
 							User:     user,
 							JoinedAt: member.CreatedAt,
+							Role:     member.Role,
 						})
 					}
 
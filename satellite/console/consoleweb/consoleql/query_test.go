@@ -23,7 +23,9 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
+	"storj.io/storj/satellite/inventory"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/rewards"
@@ -70,6 +72,7 @@ func TestGraphqlQuery(t *testing.T) {
 			db.StripeCoinPayments(),
 			db.Console().Projects(),
 			db.ProjectAccounting(),
+			db.PricePlans(),
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.ObjectPrice,
@@ -87,6 +90,7 @@ func TestGraphqlQuery(t *testing.T) {
 			db.ProjectAccounting(),
 			projectUsage,
 			db.Buckets(),
+			inventory.NewService(log.Named("inventory"), db.BucketInventories(), db.Buckets(), orders.EncryptionKeys{}),
 			partnersService,
 			paymentsService.Accounts(),
 			analyticsService,
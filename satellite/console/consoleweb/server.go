@@ -213,6 +213,22 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 		server.withAuth(http.HandlerFunc(usageLimitsController.TotalUsageLimits)),
 	).Methods(http.MethodGet)
 
+	projectMembersController := consoleapi.NewProjectMembers(logger, service)
+	router.Handle(
+		"/api/v0/projects/{id}/members/role",
+		server.withAuth(http.HandlerFunc(projectMembersController.UpdateRole)),
+	).Methods(http.MethodPatch)
+
+	auditEventsController := consoleapi.NewAuditEvents(logger, service)
+	router.Handle(
+		"/api/v0/projects/{id}/audit-events",
+		server.withAuth(http.HandlerFunc(auditEventsController.ProjectAuditEvents)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/audit-events/export",
+		server.withAuth(http.HandlerFunc(auditEventsController.ExportProjectAuditEvents)),
+	).Methods(http.MethodGet)
+
 	authController := consoleapi.NewAuth(logger, service, mailService, server.cookieAuth, partners, server.analytics, server.config.ExternalAddress, config.LetUsKnowURL, config.TermsAndConditionsURL, config.ContactInfoURL)
 	authRouter := router.PathPrefix("/api/v0/auth").Subrouter()
 	authRouter.Handle("/account", server.withAuth(http.HandlerFunc(authController.GetAccount))).Methods(http.MethodGet)
@@ -220,17 +236,28 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 	authRouter.Handle("/account/change-email", server.withAuth(http.HandlerFunc(authController.ChangeEmail))).Methods(http.MethodPost)
 	authRouter.Handle("/account/change-password", server.withAuth(http.HandlerFunc(authController.ChangePassword))).Methods(http.MethodPost)
 	authRouter.Handle("/account/delete", server.withAuth(http.HandlerFunc(authController.DeleteAccount))).Methods(http.MethodPost)
+	authRouter.Handle("/account/audit-events", server.withAuth(http.HandlerFunc(auditEventsController.UserAuditEvents))).Methods(http.MethodGet)
+	authRouter.Handle("/account/audit-events/export", server.withAuth(http.HandlerFunc(auditEventsController.ExportUserAuditEvents))).Methods(http.MethodGet)
 	authRouter.Handle("/mfa/enable", server.withAuth(http.HandlerFunc(authController.EnableUserMFA))).Methods(http.MethodPost)
 	authRouter.Handle("/mfa/disable", server.withAuth(http.HandlerFunc(authController.DisableUserMFA))).Methods(http.MethodPost)
 	authRouter.Handle("/mfa/generate-secret-key", server.withAuth(http.HandlerFunc(authController.GenerateMFASecretKey))).Methods(http.MethodPost)
 	authRouter.Handle("/mfa/generate-recovery-codes", server.withAuth(http.HandlerFunc(authController.GenerateMFARecoveryCodes))).Methods(http.MethodPost)
-	authRouter.HandleFunc("/logout", authController.Logout).Methods(http.MethodPost)
+	authRouter.Handle("/sessions", server.withAuth(http.HandlerFunc(authController.GetSessions))).Methods(http.MethodGet)
+	authRouter.Handle("/sessions", server.withAuth(http.HandlerFunc(authController.RevokeAllSessions))).Methods(http.MethodDelete)
+	authRouter.Handle("/sessions/{id}", server.withAuth(http.HandlerFunc(authController.RevokeSession))).Methods(http.MethodDelete)
+	authRouter.Handle("/logout", server.withAuth(http.HandlerFunc(authController.Logout))).Methods(http.MethodPost)
 	authRouter.Handle("/token", server.rateLimiter.Limit(http.HandlerFunc(authController.Token))).Methods(http.MethodPost)
 	authRouter.Handle("/register", server.rateLimiter.Limit(http.HandlerFunc(authController.Register))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/forgot-password/{email}", server.rateLimiter.Limit(http.HandlerFunc(authController.ForgotPassword))).Methods(http.MethodPost)
 	authRouter.Handle("/resend-email/{id}", server.rateLimiter.Limit(http.HandlerFunc(authController.ResendEmail))).Methods(http.MethodPost)
 	authRouter.Handle("/reset-password", server.rateLimiter.Limit(http.HandlerFunc(authController.ResetPassword))).Methods(http.MethodPost)
 
+	if service.SSOEnabled() {
+		ssoController := consoleapi.NewSSO(logger, service, server.cookieAuth, server.config.ExternalAddress)
+		authRouter.Handle("/sso/login", server.rateLimiter.Limit(http.HandlerFunc(ssoController.Login))).Methods(http.MethodGet)
+		authRouter.Handle("/sso/callback", server.rateLimiter.Limit(http.HandlerFunc(ssoController.Callback))).Methods(http.MethodGet)
+	}
+
 	paymentController := consoleapi.NewPayments(logger, service)
 	paymentsRouter := router.PathPrefix("/api/v0/payments").Subrouter()
 	paymentsRouter.Use(server.withAuth)
@@ -242,6 +269,8 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 	paymentsRouter.HandleFunc("/account/balance", paymentController.AccountBalance).Methods(http.MethodGet)
 	paymentsRouter.HandleFunc("/account", paymentController.SetupAccount).Methods(http.MethodPost)
 	paymentsRouter.HandleFunc("/billing-history", paymentController.BillingHistory).Methods(http.MethodGet)
+	paymentsRouter.HandleFunc("/invoices/preview", paymentController.InvoicePreview).Methods(http.MethodGet)
+	paymentsRouter.HandleFunc("/invoices/{id}/document", paymentController.InvoiceDocument).Methods(http.MethodGet)
 	paymentsRouter.HandleFunc("/tokens/deposit", paymentController.TokenDeposit).Methods(http.MethodPost)
 	paymentsRouter.HandleFunc("/coupon/apply", paymentController.ApplyCouponCode).Methods(http.MethodPatch)
 	paymentsRouter.HandleFunc("/coupon", paymentController.GetCoupon).Methods(http.MethodGet)
@@ -250,10 +279,14 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 	bucketsRouter := router.PathPrefix("/api/v0/buckets").Subrouter()
 	bucketsRouter.Use(server.withAuth)
 	bucketsRouter.HandleFunc("/bucket-names", bucketsController.AllBucketNames).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.GetInventory).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.SetInventory).Methods(http.MethodPut)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.DeleteInventory).Methods(http.MethodDelete)
 
 	apiKeysController := consoleapi.NewAPIKeys(logger, service)
 	apiKeysRouter := router.PathPrefix("/api/v0/api-keys").Subrouter()
 	apiKeysRouter.Use(server.withAuth)
+	apiKeysRouter.HandleFunc("/create", apiKeysController.Create).Methods(http.MethodPost)
 	apiKeysRouter.HandleFunc("/delete-by-name", apiKeysController.DeleteByNameAndProjectID).Methods(http.MethodDelete)
 
 	analyticsController := consoleapi.NewAnalytics(logger, service, server.analytics)
@@ -365,6 +398,7 @@ func (server *Server) appHandler(w http.ResponseWriter, r *http.Request) {
 		ObjectPrice                     string
 		RecaptchaEnabled                bool
 		RecaptchaSiteKey                string
+		SSOEnabled                      bool
 	}
 
 	data.ExternalAddress = server.config.ExternalAddress
@@ -389,6 +423,7 @@ func (server *Server) appHandler(w http.ResponseWriter, r *http.Request) {
 	data.EgressTBPrice = server.pricing.EgressTBPrice
 	data.ObjectPrice = server.pricing.ObjectPrice
 	data.RecaptchaEnabled = server.config.Recaptcha.Enabled
+	data.SSOEnabled = server.service.SSOEnabled()
 	data.RecaptchaSiteKey = server.config.Recaptcha.SiteKey
 
 	if server.templates.index == nil {
@@ -23,6 +23,16 @@ type DB interface {
 	RegistrationTokens() RegistrationTokens
 	// ResetPasswordTokens is a getter for ResetPasswordTokens repository.
 	ResetPasswordTokens() ResetPasswordTokens
+	// AuditEvents is a getter for AuditEvents repository.
+	AuditEvents() AuditEvents
+	// OIDCIdentities is a getter for OIDCIdentities repository.
+	OIDCIdentities() OIDCIdentities
+	// WebappSessions is a getter for WebappSessions repository.
+	WebappSessions() WebappSessions
+	// LoginLockouts is a getter for LoginLockouts repository.
+	LoginLockouts() LoginLockouts
+	// ProjectFreezes is a getter for ProjectFreezes repository.
+	ProjectFreezes() ProjectFreezes
 
 	// WithTx is a method for executing transactions with retrying as necessary.
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx DBTx) error) error
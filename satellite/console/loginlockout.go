@@ -0,0 +1,187 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/web"
+)
+
+const loginLockedErrMsg = "Too many failed login attempts, please try again later"
+
+// ErrLoginLocked is error type of logins that are refused after too many failed attempts.
+var ErrLoginLocked = errs.Class("login locked")
+
+// LoginLockoutConfig contains configurations for locking out logins after failed attempts.
+type LoginLockoutConfig struct {
+	AccountAttempts int           `help:"number of failed login attempts after which an account is locked (0 disables)" default:"5"`
+	IPAttempts      int           `help:"number of failed login attempts after which logins from an IP address are refused (0 disables)" default:"20"`
+	Duration        time.Duration `help:"duration of the first lockout, doubled with every further failed attempt" default:"1m"`
+	MaxDuration     time.Duration `help:"maximum duration of a lockout" default:"24h"`
+	ResetAfter      time.Duration `help:"duration without failed attempts after which the failed attempts are forgotten" default:"24h"`
+}
+
+// LoginLockouts exposes methods to track failed login attempts.
+//
+// architecture: Database
+type LoginLockouts interface {
+	// Get is a method for querying the failed attempts of a key.
+	Get(ctx context.Context, key string) (*LoginLockout, error)
+	// RecordFailure is a method for counting a failed attempt of a key. The count
+	// starts over when the previous failure happened before resetBefore.
+	RecordFailure(ctx context.Context, key string, now, resetBefore time.Time) (*LoginLockout, error)
+	// Lock is a method for refusing the logins of a key until the given time.
+	Lock(ctx context.Context, key string, until time.Time) error
+	// Delete is a method for forgetting the failed attempts of a key.
+	Delete(ctx context.Context, key string) error
+	// DeleteBefore is a method for deleting the records whose last failed attempt
+	// happened before the given time and that are not locked anymore.
+	// Records are deleted in batches of batchSize.
+	DeleteBefore(ctx context.Context, before time.Time, batchSize int) (deleted int64, err error)
+}
+
+// LoginLockout is a database object that describes the failed login attempts
+// of an account or an IP address.
+type LoginLockout struct {
+	Key          string
+	FailedCount  int
+	LastFailedAt time.Time
+	LockedUntil  *time.Time
+}
+
+// locked returns whether the logins of the key are refused.
+func (lockout *LoginLockout) locked(now time.Time) bool {
+	return lockout.LockedUntil != nil && now.Before(*lockout.LockedUntil)
+}
+
+// LockoutDuration returns how long logins are refused after failedCount failed
+// attempts, where maxAttempts is the number of attempts allowed before the first lockout.
+func LockoutDuration(config LoginLockoutConfig, maxAttempts, failedCount int) time.Duration {
+	if maxAttempts <= 0 || failedCount < maxAttempts {
+		return 0
+	}
+
+	duration := config.Duration
+	for i := maxAttempts; i < failedCount && duration < config.MaxDuration; i++ {
+		duration *= 2
+	}
+	if duration > config.MaxDuration {
+		return config.MaxDuration
+	}
+	return duration
+}
+
+// loginAttempt keeps the lockout keys of a login attempt.
+type loginAttempt struct {
+	ip   string
+	user string
+}
+
+// accountLockoutKey returns the lockout key of the account with the email.
+// Attempts are counted by email, so that unknown accounts are locked out the
+// same way and the lockout doesn't reveal whether an account exists.
+func accountLockoutKey(email string) string {
+	return "email:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// newLoginAttempt returns the lockout keys of the account with the email and
+// of the requesting IP address.
+func (s *Service) newLoginAttempt(ctx context.Context, email string) loginAttempt {
+	var attempt loginAttempt
+	if s.config.LoginLockout.AccountAttempts > 0 {
+		attempt.user = accountLockoutKey(email)
+	}
+	if req := GetRequest(ctx); req != nil && s.config.LoginLockout.IPAttempts > 0 {
+		if ip, err := web.GetRequestIP(req); err == nil && ip != "" {
+			attempt.ip = "ip:" + ip
+		}
+	}
+	return attempt
+}
+
+// checkLocked returns ErrLoginLocked when the logins of the attempt are refused.
+func (s *Service) checkLocked(ctx context.Context, attempt loginAttempt) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	for _, key := range []string{attempt.ip, attempt.user} {
+		if key == "" {
+			continue
+		}
+
+		lockout, err := s.store.LoginLockouts().Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return Error.Wrap(err)
+		}
+		if lockout.locked(now) {
+			return ErrLoginLocked.New(loginLockedErrMsg)
+		}
+	}
+	return nil
+}
+
+// recordFailedLogin counts a failed login attempt and locks the keys that
+// reached their maximum attempts. user is nil when the account doesn't exist.
+func (s *Service) recordFailedLogin(ctx context.Context, attempt loginAttempt, user *User) {
+	config := s.config.LoginLockout
+	now := time.Now()
+
+	record := func(key string, maxAttempts int) {
+		if key == "" || maxAttempts <= 0 {
+			return
+		}
+
+		lockout, err := s.store.LoginLockouts().RecordFailure(ctx, key, now, now.Add(-config.ResetAfter))
+		if err != nil {
+			s.log.Error("failed to record failed login attempt", zap.String("key", key), zap.Error(err))
+			return
+		}
+
+		duration := LockoutDuration(config, maxAttempts, lockout.FailedCount)
+		if duration <= 0 {
+			return
+		}
+
+		err = s.store.LoginLockouts().Lock(ctx, key, now.Add(duration))
+		if err != nil {
+			s.log.Error("failed to lock out login", zap.String("key", key), zap.Error(err))
+			return
+		}
+
+		if key == attempt.user && user != nil {
+			s.auditLog(ctx, "login lockout", &user.ID, user.Email,
+				zap.Int("failedAttempts", lockout.FailedCount),
+				zap.Duration("duration", duration))
+		} else {
+			s.log.Info("logins locked out",
+				zap.String("key", key),
+				zap.Int("failedAttempts", lockout.FailedCount),
+				zap.Duration("duration", duration))
+		}
+	}
+
+	record(attempt.ip, config.IPAttempts)
+	record(attempt.user, config.AccountAttempts)
+}
+
+// resetFailedLogins forgets the failed attempts of the account after a successful login.
+func (s *Service) resetFailedLogins(ctx context.Context, attempt loginAttempt) {
+	if attempt.user == "" {
+		return
+	}
+	if err := s.store.LoginLockouts().Delete(ctx, attempt.user); err != nil {
+		s.log.Error("failed to reset failed login attempts", zap.String("key", attempt.user), zap.Error(err))
+	}
+}
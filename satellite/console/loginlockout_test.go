@@ -0,0 +1,138 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestLockoutDuration(t *testing.T) {
+	config := console.LoginLockoutConfig{
+		Duration:    time.Minute,
+		MaxDuration: 10 * time.Minute,
+	}
+
+	for _, tt := range []struct {
+		maxAttempts int
+		failedCount int
+		expected    time.Duration
+	}{
+		{maxAttempts: 5, failedCount: 0, expected: 0},
+		{maxAttempts: 5, failedCount: 4, expected: 0},
+		{maxAttempts: 5, failedCount: 5, expected: time.Minute},
+		{maxAttempts: 5, failedCount: 6, expected: 2 * time.Minute},
+		{maxAttempts: 5, failedCount: 8, expected: 8 * time.Minute},
+		{maxAttempts: 5, failedCount: 9, expected: 10 * time.Minute},
+		{maxAttempts: 5, failedCount: 1000, expected: 10 * time.Minute},
+		{maxAttempts: 0, failedCount: 1000, expected: 0},
+	} {
+		require.Equal(t, tt.expected, console.LockoutDuration(config, tt.maxAttempts, tt.failedCount), "%+v", tt)
+	}
+}
+
+func TestLoginLockout(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.LoginLockout.AccountAttempts = 3
+				config.Console.LoginLockout.IPAttempts = 5
+				config.Console.LoginLockout.Duration = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		lockouts := sat.DB.Console().LoginLockouts()
+
+		newUser := func(email string) *console.User {
+			user, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "Lockout User",
+				Email:    email,
+			}, 1)
+			require.NoError(t, err)
+			return user
+		}
+
+		t.Run("account", func(t *testing.T) {
+			user := newUser("account@mail.test")
+			good := console.AuthUser{Email: user.Email, Password: user.FullName}
+			bad := console.AuthUser{Email: user.Email, Password: "wrong"}
+
+			// a successful login resets the failed attempts.
+			for i := 0; i < 2; i++ {
+				_, err := service.Token(ctx, bad)
+				require.True(t, console.ErrUnauthorized.Has(err), err)
+			}
+			_, err := service.Token(ctx, good)
+			require.NoError(t, err)
+
+			for i := 0; i < 3; i++ {
+				_, err := service.Token(ctx, bad)
+				require.True(t, console.ErrUnauthorized.Has(err), err)
+			}
+
+			_, err = service.Token(ctx, good)
+			require.True(t, console.ErrLoginLocked.Has(err), err)
+
+			lockout, err := lockouts.Get(ctx, "email:"+user.Email)
+			require.NoError(t, err)
+			require.Equal(t, 3, lockout.FailedCount)
+			require.NotNil(t, lockout.LockedUntil)
+			require.WithinDuration(t, time.Now().Add(time.Hour), *lockout.LockedUntil, time.Minute)
+
+			// the lockout is over.
+			require.NoError(t, lockouts.Lock(ctx, "email:"+user.Email, time.Now().Add(-time.Second)))
+			_, err = service.Token(ctx, good)
+			require.NoError(t, err)
+
+			_, err = lockouts.Get(ctx, "email:"+user.Email)
+			require.Error(t, err)
+		})
+
+		t.Run("unknown account", func(t *testing.T) {
+			// unknown accounts are locked out like existing ones.
+			bad := console.AuthUser{Email: "unknown@mail.test", Password: "wrong"}
+			for i := 0; i < 3; i++ {
+				_, err := service.Token(ctx, bad)
+				require.True(t, console.ErrUnauthorized.Has(err), err)
+			}
+
+			_, err := service.Token(ctx, bad)
+			require.True(t, console.ErrLoginLocked.Has(err), err)
+		})
+
+		t.Run("ip address", func(t *testing.T) {
+			user := newUser("ip@mail.test")
+
+			req := httptest.NewRequest("POST", "/api/v0/auth/token", nil)
+			req.RemoteAddr = "198.51.100.1:1234"
+			reqCtx := console.WithRequest(ctx, req)
+
+			// unknown accounts count towards the lockout of the address.
+			for i := 0; i < 5; i++ {
+				_, err := service.Token(reqCtx, console.AuthUser{Email: fmt.Sprintf("unknown%d@mail.test", i), Password: "wrong"})
+				require.True(t, console.ErrUnauthorized.Has(err), err)
+			}
+
+			_, err := service.Token(reqCtx, console.AuthUser{Email: user.Email, Password: user.FullName})
+			require.True(t, console.ErrLoginLocked.Has(err), err)
+
+			// other addresses aren't affected.
+			_, err = service.Token(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+			require.NoError(t, err)
+		})
+	})
+}
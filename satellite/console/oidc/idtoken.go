@@ -0,0 +1,357 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// clockSkew is the allowed difference between the clocks of the satellite and the identity provider.
+const clockSkew = time.Minute
+
+// jsonWebKey is a public key in the JWK format.
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+
+	// RSA keys.
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC keys.
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+// audience is the aud claim, which can be a single string or an array.
+type audience []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (aud *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*aud = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*aud = multiple
+	return nil
+}
+
+// contains returns whether the audience includes the client.
+func (aud audience) contains(clientID string) bool {
+	for _, value := range aud {
+		if value == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// flexibleBool is a boolean claim, some providers encode it as a string.
+type flexibleBool bool
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (value *flexibleBool) UnmarshalJSON(data []byte) error {
+	var b bool
+	if err := json.Unmarshal(data, &b); err == nil {
+		*value = flexibleBool(b)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*value = flexibleBool(strings.EqualFold(s, "true"))
+	return nil
+}
+
+// idTokenClaims contains the ID token claims used by the satellite.
+type idTokenClaims struct {
+	Issuer          string       `json:"iss"`
+	Subject         string       `json:"sub"`
+	Audience        audience     `json:"aud"`
+	AuthorizedParty string       `json:"azp"`
+	Expiration      float64      `json:"exp"`
+	IssuedAt        float64      `json:"iat"`
+	Nonce           string       `json:"nonce"`
+	Email           string       `json:"email"`
+	EmailVerified   flexibleBool `json:"email_verified"`
+	Name            string       `json:"name"`
+}
+
+// VerifyIDToken validates the signature and the claims of the ID token and
+// returns the identity it asserts. nonce must match the nonce sent in the
+// authorization request.
+func (provider *Provider) VerifyIDToken(ctx context.Context, rawToken, nonce string) (_ *Identity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	parts := strings.Split(rawToken, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken.New("malformed token")
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, ErrInvalidToken.New("malformed header: %v", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken.New("malformed signature: %v", err)
+	}
+
+	key, err := provider.signingKey(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(header.Algorithm, key, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return nil, err
+	}
+
+	var claims idTokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrInvalidToken.New("malformed claims: %v", err)
+	}
+
+	if err := provider.validateClaims(&claims, nonce); err != nil {
+		return nil, err
+	}
+
+	return &Identity{
+		Issuer:        provider.config.Issuer,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+	}, nil
+}
+
+// validateClaims checks the claims required by the OpenID Connect specification.
+func (provider *Provider) validateClaims(claims *idTokenClaims, nonce string) error {
+	now := provider.nowFn()
+
+	if strings.TrimSuffix(claims.Issuer, "/") != provider.config.Issuer {
+		return ErrInvalidToken.New("unexpected issuer %q", claims.Issuer)
+	}
+	if claims.Subject == "" {
+		return ErrInvalidToken.New("missing subject")
+	}
+	if !claims.Audience.contains(provider.config.ClientID) {
+		return ErrInvalidToken.New("token isn't issued for this client")
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != provider.config.ClientID {
+		return ErrInvalidToken.New("token isn't authorized for this client")
+	}
+	if claims.Expiration == 0 || now.Add(-clockSkew).After(unixTime(claims.Expiration)) {
+		return ErrInvalidToken.New("token has expired")
+	}
+	if claims.IssuedAt != 0 && unixTime(claims.IssuedAt).After(now.Add(clockSkew)) {
+		return ErrInvalidToken.New("token is issued in the future")
+	}
+	if nonce == "" || subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return ErrInvalidToken.New("nonce mismatch")
+	}
+	return nil
+}
+
+// signingKey returns the key with the ID. The keys are fetched again when the
+// key isn't known, since the provider may have rotated its keys.
+func (provider *Provider) signingKey(ctx context.Context, keyID string) (_ crypto.PublicKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	meta, err := provider.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if key, ok := findKey(provider.keys, keyID); ok {
+		return key, nil
+	}
+
+	keys, err := provider.fetchKeys(ctx, meta.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+	provider.keys = keys
+
+	if key, ok := findKey(provider.keys, keyID); ok {
+		return key, nil
+	}
+	return nil, ErrInvalidToken.New("unknown signing key %q", keyID)
+}
+
+// findKey returns the key with the ID, or the only key when the token doesn't specify the key.
+func findKey(keys map[string]crypto.PublicKey, keyID string) (crypto.PublicKey, bool) {
+	if keyID == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+	key, ok := keys[keyID]
+	return key, ok
+}
+
+// fetchKeys downloads the signing keys of the provider.
+func (provider *Provider) fetchKeys(ctx context.Context, jwksURI string) (_ map[string]crypto.PublicKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	status, err := provider.do(req, &set)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, Error.New("fetching keys failed with status %d", status)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// skip keys of unsupported types, other keys may still be used.
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+	return keys, nil
+}
+
+// publicKey decodes the key.
+func (jwk *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, Error.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if jwk.Curve != "P-256" {
+			return nil, Error.New("unsupported curve %q", jwk.Curve)
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		curve := elliptic.P256()
+		if !curve.IsOnCurve(x, y) {
+			return nil, Error.New("invalid EC key")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, Error.New("unsupported key type %q", jwk.KeyType)
+	}
+}
+
+// verifySignature verifies the JWS signature of the signed data.
+func verifySignature(algorithm string, key crypto.PublicKey, signed, signature []byte) error {
+	var hash crypto.Hash
+	switch algorithm {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384":
+		hash = crypto.SHA384
+	case "RS512":
+		hash = crypto.SHA512
+	default:
+		// notably rejects "none" and the HMAC algorithms.
+		return ErrInvalidToken.New("unsupported signing algorithm %q", algorithm)
+	}
+
+	hasher := hash.New()
+	_, _ = hasher.Write(signed)
+	digest := hasher.Sum(nil)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(algorithm, "RS") {
+			return ErrInvalidToken.New("algorithm %q doesn't match the RSA key", algorithm)
+		}
+		if err := rsa.VerifyPKCS1v15(key, hash, digest, signature); err != nil {
+			return ErrInvalidToken.New("invalid signature")
+		}
+	case *ecdsa.PublicKey:
+		if algorithm != "ES256" || len(signature) != 64 {
+			return ErrInvalidToken.New("algorithm %q doesn't match the EC key", algorithm)
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return ErrInvalidToken.New("invalid signature")
+		}
+	default:
+		return ErrInvalidToken.New("unsupported key")
+	}
+	return nil
+}
+
+// decodeSegment decodes a base64url encoded JSON segment of the token.
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// decodeBigInt decodes a base64url encoded big-endian integer.
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(data) == 0 {
+		return nil, Error.New("empty integer")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// unixTime converts a NumericDate claim to time.
+func unixTime(seconds float64) time.Time {
+	return time.Unix(int64(seconds), 0)
+}
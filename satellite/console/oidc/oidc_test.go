@@ -0,0 +1,173 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/console/oidc"
+	"storj.io/storj/satellite/console/oidc/oidctest"
+)
+
+const redirectURL = "https://satellite.test/api/v0/auth/sso/callback"
+
+func TestCodeChallenge(t *testing.T) {
+	// example from RFC 7636, appendix B.
+	require.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", oidc.CodeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	idp, err := oidctest.New("satellite", "secret")
+	require.NoError(t, err)
+	defer idp.Close()
+
+	user := oidctest.User{
+		Subject:       "subject-1",
+		Email:         "user@example.test",
+		EmailVerified: true,
+		Name:          "Example User",
+	}
+	idp.SetUser(user)
+
+	provider := oidc.NewProvider(idp.Config())
+
+	request, err := oidc.NewAuthRequest(redirectURL)
+	require.NoError(t, err)
+
+	authorize := func() (code string) {
+		authURL, err := provider.AuthCodeURL(ctx, request)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(authURL, idp.Issuer()+"/authorize?"))
+
+		client := &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, authURL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+
+		location, err := url.Parse(resp.Header.Get("Location"))
+		require.NoError(t, err)
+		require.Equal(t, redirectURL, location.Scheme+"://"+location.Host+location.Path)
+		require.Equal(t, request.State, location.Query().Get("state"))
+		return location.Query().Get("code")
+	}
+
+	code := authorize()
+	identity, err := provider.Exchange(ctx, request, code)
+	require.NoError(t, err)
+	require.Equal(t, &oidc.Identity{
+		Issuer:        idp.Issuer(),
+		Subject:       user.Subject,
+		Email:         user.Email,
+		EmailVerified: true,
+		Name:          user.Name,
+	}, identity)
+
+	// codes can be used only once.
+	_, err = provider.Exchange(ctx, request, code)
+	require.Error(t, err)
+
+	// the code verifier must match the code challenge.
+	wrongVerifier := request
+	wrongVerifier.Verifier += "x"
+	_, err = provider.Exchange(ctx, wrongVerifier, authorize())
+	require.Error(t, err)
+
+	// the nonce must match the one sent in the authorization request.
+	wrongNonce := request
+	wrongNonce.Nonce = "other-nonce"
+	_, err = provider.Exchange(ctx, wrongNonce, authorize())
+	require.True(t, oidc.ErrInvalidToken.Has(err), err)
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	idp, err := oidctest.New("satellite", "secret")
+	require.NoError(t, err)
+	defer idp.Close()
+
+	provider := oidc.NewProvider(idp.Config())
+
+	const nonce = "nonce"
+	user := oidctest.User{Subject: "subject-1", Email: "user@example.test", EmailVerified: true}
+
+	token, err := idp.SignToken(idp.Claims(user, nonce))
+	require.NoError(t, err)
+	identity, err := provider.VerifyIDToken(ctx, token, nonce)
+	require.NoError(t, err)
+	require.Equal(t, user.Subject, identity.Subject)
+
+	for _, tt := range []struct {
+		name   string
+		modify func(claims map[string]interface{})
+	}{
+		{"wrong issuer", func(claims map[string]interface{}) { claims["iss"] = "https://other.test" }},
+		{"missing subject", func(claims map[string]interface{}) { delete(claims, "sub") }},
+		{"wrong audience", func(claims map[string]interface{}) { claims["aud"] = "other" }},
+		{"multiple audiences without azp", func(claims map[string]interface{}) { claims["aud"] = []string{"satellite", "other"} }},
+		{"expired", func(claims map[string]interface{}) { claims["exp"] = time.Now().Add(-time.Hour).Unix() }},
+		{"issued in the future", func(claims map[string]interface{}) { claims["iat"] = time.Now().Add(time.Hour).Unix() }},
+		{"nonce mismatch", func(claims map[string]interface{}) { claims["nonce"] = "other" }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := idp.Claims(user, nonce)
+			tt.modify(claims)
+
+			token, err := idp.SignToken(claims)
+			require.NoError(t, err)
+
+			_, err = provider.VerifyIDToken(ctx, token, nonce)
+			require.True(t, oidc.ErrInvalidToken.Has(err), err)
+		})
+	}
+
+	t.Run("multiple audiences with azp", func(t *testing.T) {
+		claims := idp.Claims(user, nonce)
+		claims["aud"] = []string{"satellite", "other"}
+		claims["azp"] = "satellite"
+
+		token, err := idp.SignToken(claims)
+		require.NoError(t, err)
+
+		_, err = provider.VerifyIDToken(ctx, token, nonce)
+		require.NoError(t, err)
+	})
+
+	t.Run("tampered claims", func(t *testing.T) {
+		parts := strings.Split(token, ".")
+		claims := idp.Claims(oidctest.User{Subject: "attacker"}, nonce)
+		tampered, err := idp.SignToken(claims)
+		require.NoError(t, err)
+		parts[1] = strings.Split(tampered, ".")[1]
+
+		_, err = provider.VerifyIDToken(ctx, strings.Join(parts, "."), nonce)
+		require.True(t, oidc.ErrInvalidToken.Has(err), err)
+	})
+
+	t.Run("unsigned token", func(t *testing.T) {
+		parts := strings.Split(token, ".")
+		parts[0] = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","kid":"test-key"}`))
+		parts[2] = ""
+
+		_, err = provider.VerifyIDToken(ctx, strings.Join(parts, "."), nonce)
+		require.True(t, oidc.ErrInvalidToken.Has(err), err)
+	})
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// randomBytes is the number of random bytes in values generated by RandomString.
+const randomBytes = 32
+
+// RandomString returns an unguessable URL safe string, suitable for the
+// state, the nonce and the PKCE code verifier.
+func RandomString() (string, error) {
+	var data [randomBytes]byte
+	if _, err := rand.Read(data[:]); err != nil {
+		return "", Error.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data[:]), nil
+}
+
+// CodeChallenge returns the S256 PKCE code challenge of the code verifier.
+func CodeChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
@@ -0,0 +1,281 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package oidc implements the OpenID Connect authorization code flow used
+// for single sign-on to the satellite console.
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for OpenID Connect errors.
+	Error = errs.Class("oidc")
+
+	// ErrInvalidToken is the error class for ID tokens that failed the validation.
+	ErrInvalidToken = errs.Class("oidc invalid id token")
+)
+
+// discoveryPath is the path of the provider metadata relative to the issuer.
+const discoveryPath = "/.well-known/openid-configuration"
+
+// maxResponseSize limits the size of the responses read from the identity provider.
+const maxResponseSize = 1 << 20
+
+// Config contains the configuration of the identity provider.
+type Config struct {
+	Issuer       string        `help:"issuer URL of the OpenID Connect identity provider" default:""`
+	ClientID     string        `help:"client ID registered with the identity provider, with <console.external-address>api/v0/auth/sso/callback as the redirect URL" default:""`
+	ClientSecret string        `help:"client secret registered with the identity provider" default:""`
+	Scopes       string        `help:"space separated scopes requested from the identity provider" default:"openid email profile"`
+	Timeout      time.Duration `help:"timeout of requests to the identity provider" default:"10s"`
+}
+
+// Identity is the user identity asserted by a validated ID token.
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// metadata contains the parts of the provider metadata used by the client.
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is an OpenID Connect relying party of a single identity provider.
+//
+// The provider metadata and signing keys are fetched on first use and cached,
+// so an unavailable identity provider doesn't prevent the satellite from starting.
+type Provider struct {
+	config Config
+	client *http.Client
+	nowFn  func() time.Time
+
+	mu       sync.Mutex
+	metadata *metadata
+	keys     map[string]crypto.PublicKey
+}
+
+// NewProvider creates a relying party for the configured identity provider.
+func NewProvider(config Config) *Provider {
+	config.Issuer = strings.TrimSuffix(config.Issuer, "/")
+	return &Provider{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		nowFn:  time.Now,
+	}
+}
+
+// TestSetNow sets the function used for getting the current time.
+func (provider *Provider) TestSetNow(nowFn func() time.Time) {
+	provider.nowFn = nowFn
+}
+
+// AuthRequest contains the values of a single login attempt. It must be
+// kept by the user agent between the authorization request and the callback.
+type AuthRequest struct {
+	// RedirectURL is the callback URL registered with the identity provider.
+	RedirectURL string `json:"redirectUrl"`
+	// State binds the callback to the user agent that started the login.
+	State string `json:"state"`
+	// Nonce binds the ID token to the login attempt.
+	Nonce string `json:"nonce"`
+	// Verifier is the PKCE code verifier, only its challenge is sent in the authorization request.
+	Verifier string `json:"verifier"`
+}
+
+// NewAuthRequest creates a login attempt with new random state, nonce and code verifier.
+func NewAuthRequest(redirectURL string) (_ AuthRequest, err error) {
+	request := AuthRequest{RedirectURL: redirectURL}
+	if request.State, err = RandomString(); err != nil {
+		return AuthRequest{}, err
+	}
+	if request.Nonce, err = RandomString(); err != nil {
+		return AuthRequest{}, err
+	}
+	if request.Verifier, err = RandomString(); err != nil {
+		return AuthRequest{}, err
+	}
+	return request, nil
+}
+
+// AuthCodeURL returns the URL of the identity provider where the user
+// should be redirected to authenticate.
+func (provider *Provider) AuthCodeURL(ctx context.Context, request AuthRequest) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	meta, err := provider.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(meta.AuthorizationEndpoint)
+	if err != nil {
+		return "", Error.New("invalid authorization endpoint: %v", err)
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", provider.config.ClientID)
+	query.Set("redirect_uri", request.RedirectURL)
+	query.Set("scope", provider.scopes())
+	query.Set("state", request.State)
+	query.Set("nonce", request.Nonce)
+	query.Set("code_challenge", CodeChallenge(request.Verifier))
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+// Exchange exchanges the authorization code returned to the callback of the
+// login attempt for an ID token, validates the token and returns the identity
+// it asserts.
+func (provider *Provider) Exchange(ctx context.Context, request AuthRequest, code string) (_ *Identity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if code == "" {
+		return nil, Error.New("missing authorization code")
+	}
+
+	meta, err := provider.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {request.RedirectURL},
+		"client_id":     {provider.config.ClientID},
+		"code_verifier": {request.Verifier},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if provider.config.ClientSecret != "" {
+		// client_secret_basic requires the credentials to be form encoded.
+		req.SetBasicAuth(url.QueryEscape(provider.config.ClientID), url.QueryEscape(provider.config.ClientSecret))
+	}
+
+	var response struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := provider.do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		if response.Error != "" {
+			return nil, Error.New("token request failed: %s: %s", response.Error, response.ErrorDescription)
+		}
+		return nil, Error.New("token request failed with status %d", status)
+	}
+	if response.IDToken == "" {
+		return nil, Error.New("token response is missing the id token")
+	}
+
+	return provider.VerifyIDToken(ctx, response.IDToken, request.Nonce)
+}
+
+// discover returns the provider metadata, fetching it when it isn't cached.
+func (provider *Provider) discover(ctx context.Context) (_ *metadata, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if provider.metadata != nil {
+		return provider.metadata, nil
+	}
+
+	if provider.config.Issuer == "" {
+		return nil, Error.New("issuer is not configured")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, provider.config.Issuer+discoveryPath, nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var meta metadata
+	status, err := provider.do(req, &meta)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, Error.New("discovery failed with status %d", status)
+	}
+
+	// the issuer in the metadata must be identical to the configured one,
+	// otherwise ID tokens of another issuer could be accepted.
+	if strings.TrimSuffix(meta.Issuer, "/") != provider.config.Issuer {
+		return nil, Error.New("discovered issuer %q doesn't match the configured issuer %q", meta.Issuer, provider.config.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, Error.New("provider metadata is missing required endpoints")
+	}
+
+	provider.metadata = &meta
+	return provider.metadata, nil
+}
+
+// scopes returns the requested scopes, always including openid.
+func (provider *Provider) scopes() string {
+	scopes := strings.Fields(provider.config.Scopes)
+	for _, scope := range scopes {
+		if scope == "openid" {
+			return strings.Join(scopes, " ")
+		}
+	}
+	return strings.Join(append([]string{"openid"}, scopes...), " ")
+}
+
+// do sends the request and decodes the JSON response body into v.
+func (provider *Provider) do(req *http.Request, v interface{}) (status int, err error) {
+	resp, err := provider.client.Do(req)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return resp.StatusCode, nil
+		}
+		return 0, Error.New("invalid response: %v", err)
+	}
+	return resp.StatusCode, nil
+}
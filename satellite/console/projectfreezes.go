@@ -0,0 +1,41 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// FreezeReasonSuspension is the reason of the freezes caused by suspending the project owner.
+const FreezeReasonSuspension = "suspension"
+
+// ProjectFreezes exposes methods to freeze and unfreeze projects.
+//
+// A frozen project refuses uploads and downloads while keeping the stored data.
+// Freezing doesn't change the limits of the project, so that they can be
+// updated independently.
+//
+// architecture: Database
+type ProjectFreezes interface {
+	// Freeze is a method for freezing a project. It returns false when the
+	// project was already frozen.
+	Freeze(ctx context.Context, projectID uuid.UUID, reason string) (frozen bool, err error)
+	// Unfreeze is a method for unfreezing a project. It returns false when the
+	// project was not frozen.
+	Unfreeze(ctx context.Context, projectID uuid.UUID) (unfrozen bool, err error)
+	// Get is a method for querying the freeze of a project.
+	Get(ctx context.Context, projectID uuid.UUID) (*ProjectFreeze, error)
+	// IsFrozen is a method for checking whether a project is frozen.
+	IsFrozen(ctx context.Context, projectID uuid.UUID) (bool, error)
+}
+
+// ProjectFreeze is a database object that describes a frozen project.
+type ProjectFreeze struct {
+	ProjectID uuid.UUID `json:"projectId"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"createdAt"`
+}
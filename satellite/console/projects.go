@@ -36,9 +36,20 @@ type Projects interface {
 	List(ctx context.Context, offset int64, limit int, before time.Time) (ProjectsPage, error)
 	// ListByOwnerID is a method for querying all projects from the database by ownerID. It also includes the number of members for each project.
 	ListByOwnerID(ctx context.Context, userID uuid.UUID, cursor ProjectsCursor) (ProjectsPage, error)
+	// Search is a method for querying the projects matching all of the given criteria,
+	// newest first.
+	Search(ctx context.Context, search ProjectSearch) ([]Project, error)
 
 	// UpdateRateLimit is a method for updating projects rate limit.
 	UpdateRateLimit(ctx context.Context, id uuid.UUID, newLimit int) error
+	// UpdateBurstLimit is a method for updating projects burst limit, nil removes the project specific limit.
+	UpdateBurstLimit(ctx context.Context, id uuid.UUID, newLimit *int) error
+	// UpdateOperationLimits is a method for updating projects per operation rate limits.
+	UpdateOperationLimits(ctx context.Context, id uuid.UUID, limits OperationLimits) error
+	// UpdateUsageLimit is a method for updating projects storage usage limit.
+	UpdateUsageLimit(ctx context.Context, id uuid.UUID, newLimit memory.Size) error
+	// UpdateBandwidthLimit is a method for updating projects bandwidth limit.
+	UpdateBandwidthLimit(ctx context.Context, id uuid.UUID, newLimit memory.Size) error
 
 	// GetMaxBuckets is a method to get the maximum number of buckets allowed for the project
 	GetMaxBuckets(ctx context.Context, id uuid.UUID) (*int, error)
@@ -73,11 +84,29 @@ type Project struct {
 	PartnerID      uuid.UUID    `json:"partnerId"`
 	OwnerID        uuid.UUID    `json:"ownerId"`
 	RateLimit      *int         `json:"rateLimit"`
+	BurstLimit     *int         `json:"burstLimit"`
 	MaxBuckets     *int         `json:"maxBuckets"`
 	CreatedAt      time.Time    `json:"createdAt"`
 	MemberCount    int          `json:"memberCount"`
 	StorageLimit   *memory.Size `json:"storageLimit"`
 	BandwidthLimit *memory.Size `json:"bandwidthLimit"`
+
+	OperationLimits OperationLimits `json:"operationLimits"`
+}
+
+// OperationLimits contains project specific rate limits for classes of metainfo requests.
+// A nil rate means that the request class uses the project rate limit.
+type OperationLimits struct {
+	List     OperationLimit `json:"list"`
+	Upload   OperationLimit `json:"upload"`
+	Download OperationLimit `json:"download"`
+	Delete   OperationLimit `json:"delete"`
+}
+
+// OperationLimit is a rate limit, in requests per second, with a burst for a class of metainfo requests.
+type OperationLimit struct {
+	Rate  *int `json:"rate"`
+	Burst *int `json:"burst"`
 }
 
 // ProjectInfo holds data needed to create/update Project.
@@ -89,6 +118,19 @@ type ProjectInfo struct {
 	CreatedAt      time.Time   `json:"createdAt"`
 }
 
+// ProjectSearch holds the criteria for searching projects. Empty criteria are ignored.
+type ProjectSearch struct {
+	// Name matches any part of the project name, case insensitively.
+	Name          string
+	OwnerID       *uuid.UUID
+	PartnerID     *uuid.UUID
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+
+	Limit  int
+	Offset int64
+}
+
 // ProjectsCursor holds info for project
 // cursor pagination.
 type ProjectsCursor struct {
@@ -0,0 +1,148 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// ProjectMemberRole defines what a project member is allowed to do within the project.
+type ProjectMemberRole int
+
+const (
+	// RoleAdmin can manage and delete the project, its members and API keys.
+	RoleAdmin ProjectMemberRole = 1
+	// RoleDeveloper can create and delete API keys and view the project usage.
+	RoleDeveloper ProjectMemberRole = 2
+	// RoleBillingViewer can only view the project usage and limits.
+	RoleBillingViewer ProjectMemberRole = 3
+	// RoleReadOnly can view the project, its members, API keys and usage.
+	RoleReadOnly ProjectMemberRole = 4
+
+	// DefaultProjectMemberRole is the role of members added to a project
+	// and of members that existed before roles were introduced.
+	DefaultProjectMemberRole = RoleDeveloper
+)
+
+// ErrInvalidRole is returned for an unknown project member role.
+var ErrInvalidRole = errs.Class("invalid project member role")
+
+// ProjectMemberRoleFromString parses the name of a role.
+func ProjectMemberRoleFromString(name string) (ProjectMemberRole, error) {
+	switch strings.ToLower(name) {
+	case "admin":
+		return RoleAdmin, nil
+	case "developer":
+		return RoleDeveloper, nil
+	case "billing-viewer":
+		return RoleBillingViewer, nil
+	case "read-only":
+		return RoleReadOnly, nil
+	default:
+		return 0, ErrInvalidRole.New("%q", name)
+	}
+}
+
+// String returns the name of the role.
+func (role ProjectMemberRole) String() string {
+	switch role {
+	case RoleAdmin:
+		return "admin"
+	case RoleDeveloper:
+		return "developer"
+	case RoleBillingViewer:
+		return "billing-viewer"
+	case RoleReadOnly:
+		return "read-only"
+	default:
+		return "unknown"
+	}
+}
+
+// Valid returns whether the role is known.
+func (role ProjectMemberRole) Valid() bool {
+	return role >= RoleAdmin && role <= RoleReadOnly
+}
+
+// MarshalJSON marshals the role as its name.
+func (role ProjectMemberRole) MarshalJSON() ([]byte, error) {
+	return json.Marshal(role.String())
+}
+
+// UnmarshalJSON unmarshals the role from its name.
+func (role *ProjectMemberRole) UnmarshalJSON(data []byte) (err error) {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return ErrInvalidRole.Wrap(err)
+	}
+	*role, err = ProjectMemberRoleFromString(name)
+	return err
+}
+
+// Permission is an action on a project that requires a role.
+type Permission int
+
+const (
+	// PermissionViewProject allows getting the project details.
+	PermissionViewProject Permission = iota
+	// PermissionUpdateProject allows changing the project name, description and limits.
+	PermissionUpdateProject
+	// PermissionViewMembers allows listing the project members.
+	PermissionViewMembers
+	// PermissionManageMembers allows adding and removing members and changing their roles.
+	PermissionManageMembers
+	// PermissionViewAPIKeys allows listing the project API keys.
+	PermissionViewAPIKeys
+	// PermissionManageAPIKeys allows creating and deleting API keys.
+	PermissionManageAPIKeys
+	// PermissionViewUsage allows viewing the project usage and limits.
+	PermissionViewUsage
+	// PermissionViewBuckets allows listing the project buckets.
+	PermissionViewBuckets
+	// PermissionViewAuditLog allows viewing and exporting the project audit log.
+	PermissionViewAuditLog
+	// PermissionManageBucketInventories allows configuring the bucket inventory reports.
+	PermissionManageBucketInventories
+	// PermissionDeleteProject allows deleting the project.
+	PermissionDeleteProject
+)
+
+// rolePermissions contains the permissions of every role.
+var rolePermissions = map[ProjectMemberRole][]Permission{
+	RoleAdmin: {
+		PermissionViewProject, PermissionUpdateProject,
+		PermissionViewMembers, PermissionManageMembers,
+		PermissionViewAPIKeys, PermissionManageAPIKeys,
+		PermissionViewUsage, PermissionViewBuckets,
+		PermissionViewAuditLog, PermissionManageBucketInventories,
+		PermissionDeleteProject,
+	},
+	RoleDeveloper: {
+		PermissionViewProject, PermissionViewMembers,
+		PermissionViewAPIKeys, PermissionManageAPIKeys,
+		PermissionViewUsage, PermissionViewBuckets,
+		PermissionManageBucketInventories,
+	},
+	RoleBillingViewer: {
+		PermissionViewProject, PermissionViewUsage,
+	},
+	RoleReadOnly: {
+		PermissionViewProject, PermissionViewMembers,
+		PermissionViewAPIKeys, PermissionViewUsage,
+		PermissionViewBuckets,
+	},
+}
+
+// Has returns whether the role grants the permission.
+func (role ProjectMemberRole) Has(permission Permission) bool {
+	for _, granted := range rolePermissions[role] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/console"
+)
+
+func TestProjectMemberRole(t *testing.T) {
+	for _, role := range []console.ProjectMemberRole{console.RoleAdmin, console.RoleDeveloper, console.RoleBillingViewer, console.RoleReadOnly} {
+		require.True(t, role.Valid())
+
+		parsed, err := console.ProjectMemberRoleFromString(role.String())
+		require.NoError(t, err)
+		require.Equal(t, role, parsed)
+
+		data, err := json.Marshal(role)
+		require.NoError(t, err)
+
+		var decoded console.ProjectMemberRole
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Equal(t, role, decoded)
+
+		require.True(t, role.Has(console.PermissionViewProject))
+	}
+
+	_, err := console.ProjectMemberRoleFromString("owner")
+	require.True(t, console.ErrInvalidRole.Has(err))
+	require.False(t, console.ProjectMemberRole(0).Valid())
+
+	var decoded console.ProjectMemberRole
+	require.Error(t, json.Unmarshal([]byte(`"owner"`), &decoded))
+
+	require.True(t, console.RoleAdmin.Has(console.PermissionManageMembers))
+	require.True(t, console.RoleDeveloper.Has(console.PermissionManageAPIKeys))
+	require.False(t, console.RoleDeveloper.Has(console.PermissionManageMembers))
+	require.False(t, console.RoleBillingViewer.Has(console.PermissionViewAPIKeys))
+	require.True(t, console.RoleBillingViewer.Has(console.PermissionViewUsage))
+	require.False(t, console.RoleReadOnly.Has(console.PermissionManageAPIKeys))
+	require.True(t, console.RoleReadOnly.Has(console.PermissionViewBuckets))
+	require.True(t, console.RoleDeveloper.Has(console.PermissionManageBucketInventories))
+	require.False(t, console.RoleReadOnly.Has(console.PermissionManageBucketInventories))
+	require.True(t, console.RoleAdmin.Has(console.PermissionDeleteProject))
+	require.False(t, console.RoleDeveloper.Has(console.PermissionDeleteProject))
+}
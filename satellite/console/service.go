@@ -7,8 +7,10 @@ import (
 	"context"
 	"crypto/subtle"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/mail"
 	"sort"
 	"time"
@@ -18,6 +20,7 @@ import (
 	"github.com/stripe/stripe-go/v72"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"golang.org/x/crypto/bcrypt"
 
 	"storj.io/common/macaroon"
@@ -28,6 +31,8 @@ import (
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/oidc"
+	"storj.io/storj/satellite/inventory"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/rewards"
 )
@@ -52,10 +57,16 @@ const (
 	emailUsedErrMsg                      = "This email is already in use, try another"
 	passwordRecoveryTokenIsExpiredErrMsg = "Your password recovery link has expired, please request another one"
 	credentialsErrMsg                    = "Your email or password was incorrect, please try again"
+	accountSuspendedErrMsg               = "Your account has been suspended, please contact support"
 	passwordIncorrectErrMsg              = "Your password needs at least %d characters long"
 	projectOwnerDeletionForbiddenErrMsg  = "%s is a project owner and can not be deleted"
+	projectOwnerRoleForbiddenErrMsg      = "%s is a project owner and their role can not be changed"
+	invalidRoleErrMsg                    = "Unknown project member role"
 	apiKeyWithNameExistsErrMsg           = "An API Key with this name already exists in this project, please use a different name"
 	apiKeyWithNameDoesntExistErrMsg      = "An API Key with this name doesn't exist in this project."
+	apiKeyExpirationErrMsg               = "The expiration date of an API Key must be in the future"
+	apiKeyNoOperationsErrMsg             = "An API Key must allow at least one operation"
+	apiKeyBucketNameErrMsg               = "Bucket names of an API Key restriction can't be empty"
 	teamMemberDoesNotExistErrMsg         = `There is no account on this Satellite for the user(s) you have entered.
 									     Please add team members with active accounts`
 
@@ -70,6 +81,9 @@ var (
 	// ErrNoMembership is error type of not belonging to a specific project.
 	ErrNoMembership = errs.Class("no membership")
 
+	// ErrForbidden is error type of a project member role not allowing an action.
+	ErrForbidden = errs.Class("forbidden")
+
 	// ErrTokenExpiration is error type of token reached expiration time.
 	ErrTokenExpiration = errs.Class("token expiration")
 
@@ -106,11 +120,15 @@ type Service struct {
 	projectAccounting accounting.ProjectAccounting
 	projectUsage      *accounting.Service
 	buckets           Buckets
+	inventories       *inventory.Service
 	partners          *rewards.PartnersService
 	accounts          payments.Accounts
 	recaptchaHandler  RecaptchaHandler
 	analytics         *analytics.Service
 
+	sso        *oidc.Provider
+	ssoDomains map[string]bool
+
 	config Config
 
 	minCoinPayment int64
@@ -135,6 +153,9 @@ type Config struct {
 	DefaultProjectLimit     int  `help:"default project limits for users" default:"3" testDefault:"5"`
 	UsageLimits             UsageLimitsConfig
 	Recaptcha               RecaptchaConfig
+	SSO                     SSOConfig
+	Session                 SessionConfig
+	LoginLockout            LoginLockoutConfig
 }
 
 // RecaptchaConfig contains configurations for the reCAPTCHA system.
@@ -150,7 +171,7 @@ type PaymentsService struct {
 }
 
 // NewService returns new instance of Service.
-func NewService(log *zap.Logger, signer Signer, store DB, projectAccounting accounting.ProjectAccounting, projectUsage *accounting.Service, buckets Buckets, partners *rewards.PartnersService, accounts payments.Accounts, analytics *analytics.Service, config Config, minCoinPayment int64) (*Service, error) {
+func NewService(log *zap.Logger, signer Signer, store DB, projectAccounting accounting.ProjectAccounting, projectUsage *accounting.Service, buckets Buckets, inventories *inventory.Service, partners *rewards.PartnersService, accounts payments.Accounts, analytics *analytics.Service, config Config, minCoinPayment int64) (*Service, error) {
 	if signer == nil {
 		return nil, errs.New("signer can't be nil")
 	}
@@ -164,7 +185,7 @@ func NewService(log *zap.Logger, signer Signer, store DB, projectAccounting acco
 		config.PasswordCost = bcrypt.DefaultCost
 	}
 
-	return &Service{
+	service := &Service{
 		log:               log,
 		auditLogger:       log.Named("auditlog"),
 		Signer:            signer,
@@ -172,13 +193,21 @@ func NewService(log *zap.Logger, signer Signer, store DB, projectAccounting acco
 		projectAccounting: projectAccounting,
 		projectUsage:      projectUsage,
 		buckets:           buckets,
+		inventories:       inventories,
 		partners:          partners,
 		accounts:          accounts,
 		recaptchaHandler:  NewDefaultRecaptcha(config.Recaptcha.SecretKey),
 		analytics:         analytics,
 		config:            config,
 		minCoinPayment:    minCoinPayment,
-	}, nil
+	}
+
+	if config.SSO.Enabled {
+		service.sso = oidc.NewProvider(config.SSO.OIDC)
+		service.ssoDomains = parseSSODomains(config.SSO.EnforcedDomains)
+	}
+
+	return service, nil
 }
 
 func getRequestingIP(ctx context.Context) (source, forwardedFor string) {
@@ -189,6 +218,15 @@ func getRequestingIP(ctx context.Context) (source, forwardedFor string) {
 }
 
 func (s *Service) auditLog(ctx context.Context, operation string, userID *uuid.UUID, email string, extra ...zap.Field) {
+	s.logActivity(ctx, operation, userID, email, extra...)
+
+	if auditedOperations[operation] {
+		s.storeAuditEvent(ctx, operation, userID, email, nil, extra)
+	}
+}
+
+// logActivity writes the operation to the audit logger.
+func (s *Service) logActivity(ctx context.Context, operation string, userID *uuid.UUID, email string, extra ...zap.Field) {
 	sourceIP, forwardedForIP := getRequestingIP(ctx)
 	fields := append(
 		make([]zap.Field, 0, len(extra)+5),
@@ -202,10 +240,58 @@ func (s *Service) auditLog(ctx context.Context, operation string, userID *uuid.U
 	if email != "" {
 		fields = append(fields, zap.String("email", email))
 	}
-	fields = append(fields, fields...)
+	fields = append(fields, extra...)
 	s.auditLogger.Info("console activity", fields...)
 }
 
+// storeAuditEvent stores the operation and its outcome in the audit log.
+// Failures are only logged, so that an unavailable audit log doesn't block
+// the console.
+func (s *Service) storeAuditEvent(ctx context.Context, operation string, userID *uuid.UUID, email string, opErr error, extra []zap.Field) {
+	id, err := uuid.New()
+	if err != nil {
+		s.log.Error("failed to store audit event", zap.String("operation", operation), zap.Error(err))
+		return
+	}
+
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range extra {
+		field.AddTo(encoder)
+	}
+	encoder.Fields["outcome"] = AuditOutcomeSuccess
+	if opErr != nil {
+		encoder.Fields["outcome"] = AuditOutcomeFailure
+	}
+	details, err := json.Marshal(encoder.Fields)
+	if err != nil {
+		s.log.Error("failed to store audit event", zap.String("operation", operation), zap.Error(err))
+		return
+	}
+
+	sourceIP, forwardedForIP := getRequestingIP(ctx)
+	event := AuditEvent{
+		ID:             id,
+		UserID:         userID,
+		Email:          email,
+		Source:         AuditEventSourceConsole,
+		Operation:      operation,
+		Details:        details,
+		SourceIP:       sourceIP,
+		ForwardedForIP: forwardedForIP,
+		CreatedAt:      time.Now(),
+	}
+	if value, ok := encoder.Fields["projectID"].(string); ok {
+		if projectID, err := uuid.FromString(value); err == nil {
+			event.ProjectID = &projectID
+		}
+	}
+
+	err = s.store.AuditEvents().Insert(ctx, event)
+	if err != nil {
+		s.log.Error("failed to store audit event", zap.String("operation", operation), zap.Error(err))
+	}
+}
+
 func (s *Service) getAuthAndAuditLog(ctx context.Context, operation string, extra ...zap.Field) (Authorization, error) {
 	auth, err := GetAuth(ctx)
 	if err != nil {
@@ -220,10 +306,24 @@ func (s *Service) getAuthAndAuditLog(ctx context.Context, operation string, extr
 			), extra...)...)
 		return Authorization{}, err
 	}
-	s.auditLog(ctx, operation, &auth.User.ID, auth.User.Email, extra...)
+	s.logActivity(ctx, operation, &auth.User.ID, auth.User.Email, extra...)
 	return auth, nil
 }
 
+// getAuthAndAuditEvent gets the authorization and logs the operation like
+// getAuthAndAuditLog. The returned function stores the operation with its
+// outcome in the audit log, it should be deferred with the error returned by
+// the operation, so that failed permission checks are recorded as well.
+func (s *Service) getAuthAndAuditEvent(ctx context.Context, operation string, extra ...zap.Field) (Authorization, func(*error), error) {
+	auth, err := s.getAuthAndAuditLog(ctx, operation, extra...)
+	if err != nil {
+		return Authorization{}, nil, err
+	}
+	return auth, func(err *error) {
+		s.storeAuditEvent(ctx, operation, &auth.User.ID, auth.User.Email, *err, extra)
+	}, nil
+}
+
 // Payments separates all payment related functionality.
 func (s *Service) Payments() PaymentsService {
 	return PaymentsService{service: s}
@@ -233,10 +333,11 @@ func (s *Service) Payments() PaymentsService {
 func (paymentService PaymentsService) SetupAccount(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	auth, err := paymentService.service.getAuthAndAuditLog(ctx, "setup payment account")
+	auth, audit, err := paymentService.service.getAuthAndAuditEvent(ctx, "setup payment account")
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	return paymentService.service.accounts.Setup(ctx, auth.User.ID, auth.User.Email)
 }
@@ -257,10 +358,11 @@ func (paymentService PaymentsService) AccountBalance(ctx context.Context) (balan
 func (paymentService PaymentsService) AddCreditCard(ctx context.Context, creditCardToken string) (err error) {
 	defer mon.Task()(&ctx, creditCardToken)(&err)
 
-	auth, err := paymentService.service.getAuthAndAuditLog(ctx, "add credit card")
+	auth, audit, err := paymentService.service.getAuthAndAuditEvent(ctx, "add credit card")
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	err = paymentService.service.accounts.CreditCards().Add(ctx, auth.User.ID, creditCardToken)
 	if err != nil {
@@ -301,10 +403,11 @@ func (paymentService PaymentsService) AddCreditCard(ctx context.Context, creditC
 func (paymentService PaymentsService) MakeCreditCardDefault(ctx context.Context, cardID string) (err error) {
 	defer mon.Task()(&ctx, cardID)(&err)
 
-	auth, err := paymentService.service.getAuthAndAuditLog(ctx, "make credit card default")
+	auth, audit, err := paymentService.service.getAuthAndAuditEvent(ctx, "make credit card default")
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	return paymentService.service.accounts.CreditCards().MakeDefault(ctx, auth.User.ID, cardID)
 }
@@ -337,14 +440,47 @@ func (paymentService PaymentsService) ListCreditCards(ctx context.Context) (_ []
 func (paymentService PaymentsService) RemoveCreditCard(ctx context.Context, cardID string) (err error) {
 	defer mon.Task()(&ctx, cardID)(&err)
 
-	auth, err := paymentService.service.getAuthAndAuditLog(ctx, "remove credit card")
+	auth, audit, err := paymentService.service.getAuthAndAuditEvent(ctx, "remove credit card")
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	return paymentService.service.accounts.CreditCards().Remove(ctx, auth.User.ID, cardID)
 }
 
+// InvoiceDocument renders an invoice of the payment account in the given format.
+func (paymentService PaymentsService) InvoiceDocument(ctx context.Context, invoiceID string, format payments.InvoiceFormat) (_ *payments.InvoiceDocument, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := paymentService.service.getAuthAndAuditLog(ctx, "get invoice document", zap.String("invoiceID", invoiceID))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	document, err := paymentService.service.accounts.Invoices().Document(ctx, auth.User.ID, invoiceID, format)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return document, nil
+}
+
+// InvoicePreview estimates the invoice of the current billing period from the usage so far.
+func (paymentService PaymentsService) InvoicePreview(ctx context.Context) (_ *payments.InvoicePreview, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := paymentService.service.getAuthAndAuditLog(ctx, "get invoice preview")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	preview, err := paymentService.service.accounts.Invoices().Preview(ctx, auth.User.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return preview, nil
+}
+
 // BillingHistory returns a list of billing history items for payment account.
 func (paymentService PaymentsService) BillingHistory(ctx context.Context) (billingHistory []*BillingHistoryItem, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -540,10 +676,11 @@ func (paymentService PaymentsService) checkProjectInvoicingStatus(ctx context.Co
 func (paymentService PaymentsService) ApplyCouponCode(ctx context.Context, couponCode string) (coupon *payments.Coupon, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	auth, err := paymentService.service.getAuthAndAuditLog(ctx, "apply coupon code")
+	auth, audit, err := paymentService.service.getAuthAndAuditEvent(ctx, "apply coupon code")
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	coupon, err = paymentService.service.accounts.Coupons().ApplyCouponCode(ctx, auth.User.ID, couponCode)
 	if err != nil {
@@ -812,6 +949,11 @@ func (s *Service) ResetPassword(ctx context.Context, resetPasswordToken, passwor
 	}
 	s.auditLog(ctx, "password reset", &user.ID, user.Email)
 
+	_, err = s.store.WebappSessions().DeleteAllByUserID(ctx, user.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	if err = s.store.ResetPasswordTokens().Delete(ctx, token.Secret); err != nil {
 		return Error.Wrap(err)
 	}
@@ -835,19 +977,41 @@ func (s *Service) RevokeResetPasswordToken(ctx context.Context, resetPasswordTok
 func (s *Service) Token(ctx context.Context, request AuthUser) (token string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	login, err := s.Login(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	return login.Token, nil
+}
+
+// Login authenticates User by credentials and starts a new session.
+func (s *Service) Login(ctx context.Context, request AuthUser) (_ *LoginInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.ssoRequired(request.Email) {
+		return nil, ErrSSORequired.New(ssoRequiredErrMsg)
+	}
+
+	attempt := s.newLoginAttempt(ctx, request.Email)
+	if err := s.checkLocked(ctx, attempt); err != nil {
+		return nil, err
+	}
+
 	user, err := s.store.Users().GetByEmail(ctx, request.Email)
 	if err != nil {
-		return "", ErrUnauthorized.New(credentialsErrMsg)
+		s.recordFailedLogin(ctx, attempt, nil)
+		return nil, ErrUnauthorized.New(credentialsErrMsg)
 	}
 
 	err = bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(request.Password))
 	if err != nil {
-		return "", ErrUnauthorized.New(credentialsErrMsg)
+		s.recordFailedLogin(ctx, attempt, user)
+		return nil, ErrUnauthorized.New(credentialsErrMsg)
 	}
 
 	if user.MFAEnabled {
 		if request.MFARecoveryCode != "" && request.MFAPasscode != "" {
-			return "", ErrMFAConflict.New(mfaConflictErrMsg)
+			return nil, ErrMFAConflict.New(mfaConflictErrMsg)
 		}
 
 		if request.MFARecoveryCode != "" {
@@ -861,42 +1025,44 @@ func (s *Service) Token(ctx context.Context, request AuthUser) (token string, er
 				}
 			}
 			if !found {
-				return "", ErrUnauthorized.New(mfaRecoveryInvalidErrMsg)
+				s.recordFailedLogin(ctx, attempt, user)
+				return nil, ErrUnauthorized.New(mfaRecoveryInvalidErrMsg)
 			}
 
 			user.MFARecoveryCodes = append(user.MFARecoveryCodes[:codeIndex], user.MFARecoveryCodes[codeIndex+1:]...)
 
 			err = s.store.Users().Update(ctx, user)
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 		} else if request.MFAPasscode != "" {
 			valid, err := ValidateMFAPasscode(request.MFAPasscode, user.MFASecretKey, time.Now())
 			if err != nil {
-				return "", ErrUnauthorized.Wrap(err)
+				s.recordFailedLogin(ctx, attempt, user)
+				return nil, ErrUnauthorized.Wrap(err)
 			}
 			if !valid {
-				return "", ErrUnauthorized.New(mfaPasscodeInvalidErrMsg)
+				s.recordFailedLogin(ctx, attempt, user)
+				return nil, ErrUnauthorized.New(mfaPasscodeInvalidErrMsg)
 			}
 		} else {
-			return "", ErrMFALogin.Wrap(ErrMFAMissing.New(mfaRequiredErrMsg))
+			return nil, ErrMFALogin.Wrap(ErrMFAMissing.New(mfaRequiredErrMsg))
 		}
 	}
 
-	claims := consoleauth.Claims{
-		ID:         user.ID,
-		Expiration: time.Now().Add(TokenExpirationTime),
-	}
+	s.resetFailedLogins(ctx, attempt)
 
-	token, err = s.createToken(ctx, &claims)
+	login, err := s.createSession(ctx, user)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	s.auditLog(ctx, "login", &user.ID, user.Email)
+	s.auditLog(ctx, "login", &user.ID, user.Email,
+		zap.String("sessionID", login.Session.ID.String()),
+		zap.Bool("newDevice", login.NewDevice))
 
 	s.analytics.TrackSignedIn(user.ID, user.Email)
 
-	return token, nil
+	return login, nil
 }
 
 // GetUser returns User by id.
@@ -937,10 +1103,11 @@ func (s *Service) GetUserByEmail(ctx context.Context, email string) (u *User, er
 // UpdateAccount updates User.
 func (s *Service) UpdateAccount(ctx context.Context, fullName string, shortName string) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	auth, err := s.getAuthAndAuditLog(ctx, "update account")
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "update account")
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	// validate fullName
 	err = ValidateFullName(fullName)
@@ -966,10 +1133,11 @@ func (s *Service) UpdateAccount(ctx context.Context, fullName string, shortName
 // ChangeEmail updates email for a given user.
 func (s *Service) ChangeEmail(ctx context.Context, newEmail string) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	auth, err := s.getAuthAndAuditLog(ctx, "change email")
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "change email", zap.String("newEmail", newEmail))
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	if _, err := mail.ParseAddress(newEmail); err != nil {
 		return ErrValidation.Wrap(err)
@@ -992,10 +1160,11 @@ func (s *Service) ChangeEmail(ctx context.Context, newEmail string) (err error)
 // ChangePassword updates password for a given user.
 func (s *Service) ChangePassword(ctx context.Context, pass, newPass string) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	auth, err := s.getAuthAndAuditLog(ctx, "change password")
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "change password")
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	err = bcrypt.CompareHashAndPassword(auth.User.PasswordHash, []byte(pass))
 	if err != nil {
@@ -1017,16 +1186,23 @@ func (s *Service) ChangePassword(ctx context.Context, pass, newPass string) (err
 		return Error.Wrap(err)
 	}
 
+	// the sessions started with the old password are logged out.
+	err = s.revokeOtherSessions(ctx, auth.User.ID, auth.Claims.SessionID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	return nil
 }
 
 // DeleteAccount deletes User.
 func (s *Service) DeleteAccount(ctx context.Context, password string) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	auth, err := s.getAuthAndAuditLog(ctx, "delete account")
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "delete account")
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	err = bcrypt.CompareHashAndPassword(auth.User.PasswordHash, []byte(password))
 	if err != nil {
@@ -1054,7 +1230,7 @@ func (s *Service) GetProject(ctx context.Context, projectID uuid.UUID) (p *Proje
 		return nil, Error.Wrap(err)
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if _, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject); err != nil {
 		return nil, Error.Wrap(err)
 	}
 
@@ -1101,10 +1277,11 @@ func (s *Service) GetUsersOwnedProjectsPage(ctx context.Context, cursor Projects
 // CreateProject is a method for creating new project.
 func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p *Project, err error) {
 	defer mon.Task()(&ctx)(&err)
-	auth, err := s.getAuthAndAuditLog(ctx, "create project")
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "create project", zap.String("name", projectInfo.Name))
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	currentProjectCount, err := s.checkProjectLimit(ctx, auth.User.ID)
 	if err != nil {
@@ -1133,7 +1310,7 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 			return Error.Wrap(err)
 		}
 
-		_, err = tx.ProjectMembers().Insert(ctx, auth.User.ID, p.ID)
+		_, err = tx.ProjectMembers().Insert(ctx, auth.User.ID, p.ID, RoleAdmin)
 		if err != nil {
 			return Error.Wrap(err)
 		}
@@ -1161,12 +1338,13 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 // DeleteProject is a method for deleting project by id.
 func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	auth, err := s.getAuthAndAuditLog(ctx, "delete project", zap.String("projectID", projectID.String()))
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "delete project", zap.String("projectID", projectID.String()))
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
-	_, err = s.isProjectOwner(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionDeleteProject)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1188,17 +1366,22 @@ func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, projectInfo ProjectInfo) (p *Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	auth, err := s.getAuthAndAuditLog(ctx, "update project name and description", zap.String("projectID", projectID.String()))
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "update project name and description",
+		zap.String("projectID", projectID.String()),
+		zap.Int64("storageLimit", projectInfo.StorageLimit.Int64()),
+		zap.Int64("bandwidthLimit", projectInfo.BandwidthLimit.Int64()),
+	)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	err = ValidateNameAndDescription(projectInfo.Name, projectInfo.Description)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	isMember, err := s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionUpdateProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1252,12 +1435,13 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, projec
 // AddProjectMembers adds users by email to given project.
 func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (users []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
-	auth, err := s.getAuthAndAuditLog(ctx, "add project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails))
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "add project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails))
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
+	defer audit(&err)
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if _, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageMembers); err != nil {
 		return nil, Error.Wrap(err)
 	}
 
@@ -1281,7 +1465,7 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 	// add project members in transaction scope
 	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
 		for _, user := range users {
-			if _, err := tx.ProjectMembers().Insert(ctx, user.ID, projectID); err != nil {
+			if _, err := tx.ProjectMembers().Insert(ctx, user.ID, projectID, DefaultProjectMemberRole); err != nil {
 				return err
 			}
 		}
@@ -1297,12 +1481,14 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 // DeleteProjectMembers removes users by email from given project.
 func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	auth, err := s.getAuthAndAuditLog(ctx, "delete project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails))
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "delete project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails))
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	isMember, err := s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageMembers)
+	if err != nil {
 		return Error.Wrap(err)
 	}
 
@@ -1318,13 +1504,9 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 			continue
 		}
 
-		isOwner, err := s.isProjectOwner(ctx, user.ID, projectID)
-		if isOwner {
+		if user.ID == isMember.project.OwnerID {
 			return ErrValidation.New(projectOwnerDeletionForbiddenErrMsg, user.Email)
 		}
-		if err != nil && !ErrUnauthorized.Has(err) {
-			return Error.Wrap(err)
-		}
 
 		userIDs = append(userIDs, user.ID)
 	}
@@ -1346,6 +1528,40 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 	return Error.Wrap(err)
 }
 
+// UpdateProjectMemberRole changes the role of the project member with the email.
+func (s *Service) UpdateProjectMemberRole(ctx context.Context, projectID uuid.UUID, email string, role ProjectMemberRole) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "update project member role", zap.String("projectID", projectID.String()), zap.String("email", email), zap.Stringer("role", role))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer audit(&err)
+
+	if !role.Valid() {
+		return ErrValidation.New(invalidRoleErrMsg)
+	}
+
+	isMember, err := s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageMembers)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	user, err := s.store.Users().GetByEmail(ctx, email)
+	if err != nil {
+		return ErrValidation.New(teamMemberDoesNotExistErrMsg)
+	}
+
+	if user.ID == isMember.project.OwnerID {
+		return ErrValidation.New(projectOwnerRoleForbiddenErrMsg, user.Email)
+	}
+
+	err = s.store.ProjectMembers().UpdateRole(ctx, user.ID, projectID, role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrValidation.New(teamMemberDoesNotExistErrMsg)
+	}
+	return Error.Wrap(err)
+}
+
 // GetProjectMembers returns ProjectMembers for given Project.
 func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, cursor ProjectMembersCursor) (pmp *ProjectMembersPage, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1355,7 +1571,7 @@ func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, cu
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewMembers)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1375,13 +1591,37 @@ func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, cu
 // CreateAPIKey creates new api key.
 func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name string) (_ *APIKeyInfo, _ *macaroon.APIKey, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return s.CreateRestrictedAPIKey(ctx, projectID, name, APIKeyRestrictions{})
+}
+
+// CreateRestrictedAPIKey creates new api key that can only be used as the restrictions allow.
+func (s *Service) CreateRestrictedAPIKey(ctx context.Context, projectID uuid.UUID, name string, restrictions APIKeyRestrictions) (_ *APIKeyInfo, _ *macaroon.APIKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	buckets := make([]string, 0, len(restrictions.Buckets))
+	for _, bucket := range restrictions.Buckets {
+		buckets = append(buckets, bucket.Bucket)
+	}
 
-	auth, err := s.getAuthAndAuditLog(ctx, "create api key", zap.String("projectID", projectID.String()))
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "create api key",
+		zap.String("projectID", projectID.String()),
+		zap.String("name", name),
+		zap.Timep("expiresAt", restrictions.ExpiresAt),
+		zap.Strings("buckets", buckets),
+		zap.Bool("disallowReads", restrictions.DisallowReads),
+		zap.Bool("disallowWrites", restrictions.DisallowWrites),
+		zap.Bool("disallowLists", restrictions.DisallowLists),
+		zap.Bool("disallowDeletes", restrictions.DisallowDeletes))
 	if err != nil {
 		return nil, nil, Error.Wrap(err)
 	}
+	defer audit(&err)
+
+	if err := restrictions.validate(time.Now()); err != nil {
+		return nil, nil, err
+	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageAPIKeys)
 	if err != nil {
 		return nil, nil, Error.Wrap(err)
 	}
@@ -1401,11 +1641,17 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, Error.Wrap(err)
 	}
 
+	key, err = restrictions.restrict(key)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
 	apikey := APIKeyInfo{
 		Name:      name,
 		ProjectID: projectID,
 		Secret:    secret,
 		PartnerID: auth.User.PartnerID,
+		ExpiresAt: restrictions.ExpiresAt,
 	}
 
 	info, err := s.store.APIKeys().Create(ctx, key.Head(), apikey)
@@ -1432,7 +1678,7 @@ func (s *Service) GetAPIKeyInfo(ctx context.Context, id uuid.UUID) (_ *APIKeyInf
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, key.ProjectID, PermissionViewAPIKeys)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1449,10 +1695,11 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 		idStrings = append(idStrings, id.String())
 	}
 
-	auth, err := s.getAuthAndAuditLog(ctx, "delete api keys", zap.Strings("apiKeyIDs", idStrings))
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "delete api keys", zap.Strings("apiKeyIDs", idStrings))
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
 	var keysErr errs.Group
 
@@ -1463,7 +1710,7 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			continue
 		}
 
-		_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID)
+		_, err = s.checkProjectPermission(ctx, auth.User.ID, key.ProjectID, PermissionManageAPIKeys)
 		if err != nil {
 			keysErr.Add(ErrUnauthorized.Wrap(err))
 			continue
@@ -1491,12 +1738,13 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 func (s *Service) DeleteAPIKeyByNameAndProjectID(ctx context.Context, name string, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	auth, err := s.getAuthAndAuditLog(ctx, "delete api key by name and project ID", zap.String("apiKeyName", name), zap.String("projectID", projectID.String()))
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "delete api key by name and project ID", zap.String("apiKeyName", name), zap.String("projectID", projectID.String()))
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	defer audit(&err)
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageAPIKeys)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1523,7 +1771,7 @@ func (s *Service) GetAPIKeys(ctx context.Context, projectID uuid.UUID, cursor AP
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewAPIKeys)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1549,7 +1797,7 @@ func (s *Service) GetProjectUsage(ctx context.Context, projectID uuid.UUID, sinc
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewUsage)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1571,7 +1819,7 @@ func (s *Service) GetBucketTotals(ctx context.Context, projectID uuid.UUID, curs
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	isMember, err := s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewUsage)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1593,7 +1841,7 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewBuckets)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1619,6 +1867,64 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 	return list, nil
 }
 
+// GetBucketInventory returns the inventory configuration of the bucket.
+func (s *Service) GetBucketInventory(ctx context.Context, projectID uuid.UUID, bucketName string) (_ inventory.Configuration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "get bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return inventory.Configuration{}, Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewBuckets)
+	if err != nil {
+		return inventory.Configuration{}, Error.Wrap(err)
+	}
+
+	config, err := s.inventories.Get(ctx, projectID, bucketName)
+	if err != nil {
+		return inventory.Configuration{}, Error.Wrap(err)
+	}
+
+	return config, nil
+}
+
+// SetBucketInventory creates or replaces the inventory configuration of the bucket.
+func (s *Service) SetBucketInventory(ctx context.Context, config inventory.Configuration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "set bucket inventory", zap.String("projectID", config.ProjectID.String()), zap.String("bucket", config.BucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer audit(&err)
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, config.ProjectID, PermissionManageBucketInventories)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(s.inventories.Set(ctx, config))
+}
+
+// DeleteBucketInventory removes the inventory configuration of the bucket.
+func (s *Service) DeleteBucketInventory(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "delete bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer audit(&err)
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageBucketInventories)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(s.inventories.Delete(ctx, projectID, bucketName))
+}
+
 // GetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period.
 func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.BucketUsageRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1628,7 +1934,7 @@ func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewUsage)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1653,7 +1959,7 @@ func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 		return nil, Error.Wrap(err)
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if _, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewUsage); err != nil {
 		return nil, Error.Wrap(err)
 	}
 
@@ -1738,6 +2044,70 @@ func (s *Service) getProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 	}, nil
 }
 
+// GetProjectAuditEvents returns the audit log of the project, newest first.
+func (s *Service) GetProjectAuditEvents(ctx context.Context, projectID uuid.UUID, cursor AuditEventsCursor) (_ *AuditEventsPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "get project audit events", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewAuditLog)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	page, err := s.store.AuditEvents().GetPagedByProjectID(ctx, projectID, cursor)
+	return page, Error.Wrap(err)
+}
+
+// GetUserAuditEvents returns the audit log of the authorized user, newest first.
+func (s *Service) GetUserAuditEvents(ctx context.Context, cursor AuditEventsCursor) (_ *AuditEventsPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "get user audit events")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	page, err := s.store.AuditEvents().GetPagedByUserID(ctx, auth.User.ID, cursor)
+	return page, Error.Wrap(err)
+}
+
+// ExportProjectAuditEvents writes the whole audit log of the project to w.
+func (s *Service) ExportProjectAuditEvents(ctx context.Context, projectID uuid.UUID, format AuditExportFormat, w io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "export project audit events", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewAuditLog)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return ExportAuditEvents(ctx, w, format, func(ctx context.Context, cursor AuditEventsCursor) (*AuditEventsPage, error) {
+		return s.store.AuditEvents().GetPagedByProjectID(ctx, projectID, cursor)
+	})
+}
+
+// ExportUserAuditEvents writes the whole audit log of the authorized user to w.
+func (s *Service) ExportUserAuditEvents(ctx context.Context, format AuditExportFormat, w io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "export user audit events")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return ExportAuditEvents(ctx, w, format, func(ctx context.Context, cursor AuditEventsCursor) (*AuditEventsPage, error) {
+		return s.store.AuditEvents().GetPagedByUserID(ctx, auth.User.ID, cursor)
+	})
+}
+
 // Authorize validates token from context and returns authorized Authorization.
 func (s *Service) Authorize(ctx context.Context) (a Authorization, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1877,10 +2247,17 @@ func (s *Service) authorize(ctx context.Context, claims *consoleauth.Claims) (_
 		return nil, ErrTokenExpiration.New("")
 	}
 
+	if err := s.checkSession(ctx, claims); err != nil {
+		return nil, err
+	}
+
 	user, err := s.store.Users().Get(ctx, claims.ID)
 	if err != nil {
 		return nil, ErrValidation.New("authorization failed. no user with id: %s", claims.ID.String())
 	}
+	if user.Status == Suspended {
+		return nil, ErrUnauthorized.New(accountSuspendedErrMsg)
+	}
 
 	return user, nil
 }
@@ -1891,21 +2268,6 @@ type isProjectMember struct {
 	membership *ProjectMember
 }
 
-// isProjectOwner checks if the user is an owner of a project.
-func (s *Service) isProjectOwner(ctx context.Context, userID uuid.UUID, projectID uuid.UUID) (isOwner bool, err error) {
-	defer mon.Task()(&ctx)(&err)
-	project, err := s.store.Projects().Get(ctx, projectID)
-	if err != nil {
-		return false, err
-	}
-
-	if project.OwnerID != userID {
-		return false, ErrUnauthorized.New(unauthorizedErrMsg)
-	}
-
-	return true, nil
-}
-
 // isProjectMember checks if the user is a member of given project.
 func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, projectID uuid.UUID) (_ isProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1930,6 +2292,22 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 	return isProjectMember{}, ErrNoMembership.New(unauthorizedErrMsg)
 }
 
+// checkProjectPermission checks if the user is a member of given project
+// with a role that grants the permission. The project owner has all permissions.
+func (s *Service) checkProjectPermission(ctx context.Context, userID uuid.UUID, projectID uuid.UUID, permission Permission) (_ isProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	isMember, err := s.isProjectMember(ctx, userID, projectID)
+	if err != nil {
+		return isProjectMember{}, err
+	}
+
+	if isMember.project.OwnerID == userID || isMember.membership.Role.Has(permission) {
+		return isMember, nil
+	}
+
+	return isProjectMember{}, ErrForbidden.New(unauthorizedErrMsg)
+}
+
 func findMembershipByProjectID(memberships []ProjectMember, projectID uuid.UUID) (ProjectMember, bool) {
 	for _, membership := range memberships {
 		if membership.ProjectID == projectID {
@@ -5,6 +5,7 @@ package console_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -253,6 +254,148 @@ func TestService(t *testing.T) {
 		})
 }
 
+func TestProjectMemberRoles(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 2},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			sat := planet.Satellites[0]
+			service := sat.API.Console.Service
+
+			project, err := sat.API.DB.Console().Projects().Get(ctx, planet.Uplinks[0].Projects[0].ID)
+			require.NoError(t, err)
+			owner, err := sat.API.DB.Console().Users().Get(ctx, project.OwnerID)
+			require.NoError(t, err)
+			member, err := sat.API.DB.Console().Users().Get(ctx, planet.Uplinks[1].Projects[0].Owner.ID)
+			require.NoError(t, err)
+
+			ownerCtx, err := sat.AuthenticatedContext(ctx, owner.ID)
+			require.NoError(t, err)
+			memberCtx, err := sat.AuthenticatedContext(ctx, member.ID)
+			require.NoError(t, err)
+
+			_, err = service.AddProjectMembers(ownerCtx, project.ID, []string{member.Email})
+			require.NoError(t, err)
+
+			page, err := service.GetProjectMembers(ownerCtx, project.ID, console.ProjectMembersCursor{Page: 1, Limit: 10})
+			require.NoError(t, err)
+			require.Len(t, page.ProjectMembers, 2)
+			for _, projectMember := range page.ProjectMembers {
+				if projectMember.MemberID == owner.ID {
+					require.Equal(t, console.RoleAdmin, projectMember.Role)
+				} else {
+					require.Equal(t, console.DefaultProjectMemberRole, projectMember.Role)
+				}
+			}
+
+			projectInfo := console.ProjectInfo{Name: "renamed", Description: "renamed"}
+
+			t.Run("developer", func(t *testing.T) {
+				_, _, err := service.CreateAPIKey(memberCtx, project.ID, "developer key")
+				require.NoError(t, err)
+
+				_, err = service.UpdateProject(memberCtx, project.ID, projectInfo)
+				require.True(t, console.ErrForbidden.Has(err))
+
+				err = service.UpdateProjectMemberRole(memberCtx, project.ID, member.Email, console.RoleAdmin)
+				require.True(t, console.ErrForbidden.Has(err))
+
+				err = service.DeleteProject(memberCtx, project.ID)
+				require.True(t, console.ErrForbidden.Has(err))
+			})
+
+			t.Run("billing viewer", func(t *testing.T) {
+				err := service.UpdateProjectMemberRole(ownerCtx, project.ID, member.Email, console.RoleBillingViewer)
+				require.NoError(t, err)
+
+				_, err = service.GetProjectUsageLimits(memberCtx, project.ID)
+				require.NoError(t, err)
+
+				_, err = service.GetProjectMembers(memberCtx, project.ID, console.ProjectMembersCursor{Page: 1, Limit: 10})
+				require.True(t, console.ErrForbidden.Has(err))
+
+				_, _, err = service.CreateAPIKey(memberCtx, project.ID, "billing key")
+				require.True(t, console.ErrForbidden.Has(err))
+			})
+
+			t.Run("read only", func(t *testing.T) {
+				err := service.UpdateProjectMemberRole(ownerCtx, project.ID, member.Email, console.RoleReadOnly)
+				require.NoError(t, err)
+
+				_, err = service.GetProjectMembers(memberCtx, project.ID, console.ProjectMembersCursor{Page: 1, Limit: 10})
+				require.NoError(t, err)
+
+				_, err = service.GetAllBucketNames(memberCtx, project.ID)
+				require.NoError(t, err)
+
+				err = service.DeleteAPIKeyByNameAndProjectID(memberCtx, "developer key", project.ID)
+				require.True(t, console.ErrForbidden.Has(err))
+			})
+
+			t.Run("admin", func(t *testing.T) {
+				err := service.UpdateProjectMemberRole(ownerCtx, project.ID, member.Email, console.RoleAdmin)
+				require.NoError(t, err)
+
+				_, err = service.UpdateProject(memberCtx, project.ID, projectInfo)
+				require.NoError(t, err)
+
+				// the owner role can't be changed, even by an admin
+				err = service.UpdateProjectMemberRole(memberCtx, project.ID, owner.Email, console.RoleReadOnly)
+				require.True(t, console.ErrValidation.Has(err))
+
+				// an admin can delete the project, it fails only because the API keys still exist
+				err = service.DeleteProject(memberCtx, project.ID)
+				require.Error(t, err)
+				require.False(t, console.ErrForbidden.Has(err))
+			})
+
+			t.Run("invalid role", func(t *testing.T) {
+				err := service.UpdateProjectMemberRole(ownerCtx, project.ID, member.Email, console.ProjectMemberRole(100))
+				require.True(t, console.ErrValidation.Has(err))
+			})
+		})
+}
+
+func TestAuditEventOutcome(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 2},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			sat := planet.Satellites[0]
+			service := sat.API.Console.Service
+
+			project := planet.Uplinks[0].Projects[0]
+			member := planet.Uplinks[1].Projects[0].Owner
+
+			ownerCtx, err := sat.AuthenticatedContext(ctx, project.Owner.ID)
+			require.NoError(t, err)
+			memberCtx, err := sat.AuthenticatedContext(ctx, member.ID)
+			require.NoError(t, err)
+
+			_, err = service.UpdateProject(ownerCtx, project.ID, console.ProjectInfo{Name: "renamed", Description: "renamed"})
+			require.NoError(t, err)
+
+			// the event is stored after the permission check failed.
+			_, err = service.UpdateProject(memberCtx, project.ID, console.ProjectInfo{Name: "other", Description: "other"})
+			require.Error(t, err)
+
+			page, err := sat.API.DB.Console().AuditEvents().GetPagedByProjectID(ctx, project.ID, console.AuditEventsCursor{Limit: 10, Page: 1})
+			require.NoError(t, err)
+			require.Len(t, page.Events, 2)
+
+			outcomes := map[uuid.UUID]string{}
+			for _, event := range page.Events {
+				require.Equal(t, "update project name and description", event.Operation)
+
+				var details struct {
+					Outcome string `json:"outcome"`
+				}
+				require.NoError(t, json.Unmarshal(event.Details, &details))
+				outcomes[*event.UserID] = details.Outcome
+			}
+			require.Equal(t, console.AuditOutcomeSuccess, outcomes[project.Owner.ID])
+			require.Equal(t, console.AuditOutcomeFailure, outcomes[member.ID])
+		})
+}
+
 func TestPaidTier(t *testing.T) {
 	usageConfig := console.UsageLimitsConfig{
 		Storage: console.StorageLimitConfig{
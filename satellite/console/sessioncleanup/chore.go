@@ -0,0 +1,91 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package sessioncleanup implements removal of expired console sessions and
+// stale failed login attempts.
+package sessioncleanup
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/console"
+)
+
+// Error is a standard error class for this package.
+var (
+	Error = errs.Class("sessioncleanup")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the session cleanup chore.
+type Config struct {
+	Interval         time.Duration `help:"how frequently expired sessions and failed login attempts should be deleted" releaseDefault:"24h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
+	SessionRetention time.Duration `help:"how long expired sessions are kept, so that their devices aren't reported as new" default:"720h"`
+	LockoutRetention time.Duration `help:"how long failed login attempts are kept after the last failure and the end of the lockout" default:"24h"`
+	BatchSize        int           `help:"number of records to delete per delete execution" default:"1000"`
+	Enabled          bool          `help:"whether or not expired sessions and failed login attempts are deleted" default:"true"`
+}
+
+// Chore deletes expired console sessions and stale failed login attempts.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	Loop     *sync2.Cycle
+	config   Config
+	sessions console.WebappSessions
+	lockouts console.LoginLockouts
+}
+
+// NewChore creates a new session cleanup chore.
+func NewChore(log *zap.Logger, sessions console.WebappSessions, lockouts console.LoginLockouts, config Config) *Chore {
+	return &Chore{
+		log:      log,
+		Loop:     sync2.NewCycle(config.Interval),
+		config:   config,
+		sessions: sessions,
+		lockouts: lockouts,
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.DeleteExpired(ctx, time.Now())
+		if err != nil {
+			chore.log.Error("error deleting expired sessions", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// DeleteExpired deletes the sessions and the failed login attempts that are
+// past their retention at the given time.
+func (chore *Chore) DeleteExpired(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sessions, sessionsErr := chore.sessions.DeleteExpiredBefore(ctx, now.Add(-chore.config.SessionRetention), chore.config.BatchSize)
+	if sessions > 0 {
+		chore.log.Info("deleted expired sessions", zap.Int64("count", sessions))
+	}
+
+	lockouts, lockoutsErr := chore.lockouts.DeleteBefore(ctx, now.Add(-chore.config.LockoutRetention), chore.config.BatchSize)
+	if lockouts > 0 {
+		chore.log.Info("deleted failed login attempts", zap.Int64("count", lockouts))
+	}
+
+	return Error.Wrap(errs.Combine(sessionsErr, lockoutsErr))
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
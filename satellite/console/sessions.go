@@ -0,0 +1,292 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console/consoleauth"
+)
+
+const (
+	sessionNotFoundErrMsg = "The session was not found"
+	sessionExpiredErrMsg  = "Your session has expired, please log in again"
+)
+
+// sessionActivityInterval is how often the last activity of a session is
+// updated, so that not every request writes to the database.
+const sessionActivityInterval = time.Minute
+
+// SessionConfig contains configurations for the console sessions.
+type SessionConfig struct {
+	IdleTimeout time.Duration `help:"duration of inactivity after which a session expires (0 disables)" default:"1h"`
+}
+
+// WebappSessions exposes methods to manage the console sessions of the users.
+//
+// architecture: Database
+type WebappSessions interface {
+	// Insert is a method for inserting a session into the database.
+	Insert(ctx context.Context, session WebappSession) error
+	// Get is a method for querying a session by its id.
+	Get(ctx context.Context, id uuid.UUID) (*WebappSession, error)
+	// GetAllByUserID is a method for querying all sessions of a user, most recently active first.
+	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]WebappSession, error)
+	// UpdateLastActive is a method for updating the time of the last activity of a session.
+	UpdateLastActive(ctx context.Context, id uuid.UUID, lastActiveAt time.Time) error
+	// Delete is a method for deleting a session of a user, it returns sql.ErrNoRows
+	// when the user has no such session.
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+	// DeleteAllByUserID is a method for deleting all sessions of a user.
+	DeleteAllByUserID(ctx context.Context, userID uuid.UUID) (deleted int64, err error)
+	// DeleteExpiredBefore is a method for deleting sessions that expired before the given time.
+	// Sessions are deleted in batches of batchSize.
+	DeleteExpiredBefore(ctx context.Context, before time.Time, batchSize int) (deleted int64, err error)
+}
+
+// WebappSession is a database object that describes a logged in console session.
+type WebappSession struct {
+	ID           uuid.UUID `json:"id"`
+	UserID       uuid.UUID `json:"-"`
+	IPAddress    string    `json:"ipAddress"`
+	UserAgent    string    `json:"userAgent"`
+	CreatedAt    time.Time `json:"createdAt"`
+	LastActiveAt time.Time `json:"lastActiveAt"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
+
+// SessionInfo describes a session of the authorized user.
+type SessionInfo struct {
+	WebappSession
+	// Current is whether the session is the one making the request.
+	Current bool `json:"current"`
+}
+
+// LoginInfo describes a successful login.
+type LoginInfo struct {
+	Token   string
+	User    *User
+	Session WebappSession
+	// NewDevice is whether the user has not logged in from the device before.
+	NewDevice bool
+}
+
+// expired returns whether the session can't be used anymore.
+func (session *WebappSession) expired(now time.Time, idleTimeout time.Duration) bool {
+	if !now.Before(session.ExpiresAt) {
+		return true
+	}
+	return idleTimeout > 0 && now.Sub(session.LastActiveAt) > idleTimeout
+}
+
+// createSession starts a new session of the user for the requesting device
+// and returns the auth token of the session. Suspended users can not start
+// sessions.
+func (s *Service) createSession(ctx context.Context, user *User) (_ *LoginInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if user.Status == Suspended {
+		return nil, ErrUnauthorized.New(accountSuspendedErrMsg)
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	now := time.Now()
+	session := WebappSession{
+		ID:           id,
+		UserID:       user.ID,
+		CreatedAt:    now,
+		LastActiveAt: now,
+		ExpiresAt:    now.Add(TokenExpirationTime),
+	}
+	if req := GetRequest(ctx); req != nil {
+		session.IPAddress, _ = web.GetRequestIP(req)
+		session.UserAgent = req.UserAgent()
+	}
+
+	known, err := s.store.WebappSessions().GetAllByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	newDevice := true
+	for _, other := range known {
+		if other.UserAgent == session.UserAgent {
+			newDevice = false
+			break
+		}
+	}
+
+	err = s.store.WebappSessions().Insert(ctx, session)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	claims := consoleauth.Claims{
+		ID:         user.ID,
+		Expiration: session.ExpiresAt,
+		SessionID:  session.ID,
+	}
+
+	token, err := s.createToken(ctx, &claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginInfo{
+		Token:     token,
+		User:      user,
+		Session:   session,
+		NewDevice: newDevice,
+	}, nil
+}
+
+// checkSession verifies that the session of the claims is active and records the activity.
+func (s *Service) checkSession(ctx context.Context, claims *consoleauth.Claims) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// auth tokens issued before the sessions were introduced and other tokens,
+	// e.g. the activation tokens, don't belong to a session. They can't be
+	// revoked, so the user has to log in again.
+	if claims.SessionID.IsZero() {
+		return ErrUnauthorized.New(sessionExpiredErrMsg)
+	}
+
+	session, err := s.store.WebappSessions().Get(ctx, claims.SessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUnauthorized.New(sessionExpiredErrMsg)
+		}
+		return Error.Wrap(err)
+	}
+	if session.UserID != claims.ID {
+		return ErrUnauthorized.New(sessionExpiredErrMsg)
+	}
+
+	now := time.Now()
+	if session.expired(now, s.config.Session.IdleTimeout) {
+		return ErrTokenExpiration.New(sessionExpiredErrMsg)
+	}
+
+	if now.Sub(session.LastActiveAt) >= sessionActivityInterval {
+		err = s.store.WebappSessions().UpdateLastActive(ctx, session.ID, now)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// GetSessions returns the active sessions of the authorized user.
+func (s *Service) GetSessions(ctx context.Context) (_ []SessionInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "get sessions")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	sessions, err := s.store.WebappSessions().GetAllByUserID(ctx, auth.User.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	now := time.Now()
+	infos := make([]SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		if session.expired(now, s.config.Session.IdleTimeout) {
+			continue
+		}
+		infos = append(infos, SessionInfo{
+			WebappSession: session,
+			Current:       session.ID == auth.Claims.SessionID,
+		})
+	}
+
+	return infos, nil
+}
+
+// RevokeSession logs out a session of the authorized user.
+func (s *Service) RevokeSession(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "revoke session", zap.String("sessionID", id.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer audit(&err)
+
+	err = s.store.WebappSessions().Delete(ctx, auth.User.ID, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrValidation.New(sessionNotFoundErrMsg)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// RevokeAllSessions logs out all sessions of the authorized user, including
+// the one making the request.
+func (s *Service) RevokeAllSessions(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, audit, err := s.getAuthAndAuditEvent(ctx, "revoke all sessions")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer audit(&err)
+
+	_, err = s.store.WebappSessions().DeleteAllByUserID(ctx, auth.User.ID)
+	return Error.Wrap(err)
+}
+
+// revokeOtherSessions logs out all sessions of the user except the current one.
+func (s *Service) revokeOtherSessions(ctx context.Context, userID, current uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sessions, err := s.store.WebappSessions().GetAllByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.ID == current {
+			continue
+		}
+		err = s.store.WebappSessions().Delete(ctx, userID, session.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Logout ends the session of the authorized user.
+func (s *Service) Logout(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "logout")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = s.store.WebappSessions().Delete(ctx, auth.User.ID, auth.Claims.SessionID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+)
+
+func TestSessions(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		sessions := sat.DB.Console().WebappSessions()
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Session User",
+			Email:    "session@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		// login logs in the user from a device with the given user agent.
+		login := func(userAgent string) *console.LoginInfo {
+			req := httptest.NewRequest("POST", "/api/v0/auth/token", nil)
+			req.Header.Set("User-Agent", userAgent)
+
+			info, err := service.Login(console.WithRequest(ctx, req), console.AuthUser{Email: user.Email, Password: user.FullName})
+			require.NoError(t, err)
+			return info
+		}
+
+		// legacyToken creates an auth token like the ones issued before the sessions,
+		// which don't have a session id.
+		legacyToken := func(expiration time.Time) string {
+			payload, err := (&consoleauth.Claims{ID: user.ID, Expiration: expiration}).JSON()
+			require.NoError(t, err)
+			signature, err := service.Signer.Sign([]byte(base64.URLEncoding.EncodeToString(payload)))
+			require.NoError(t, err)
+			return consoleauth.Token{Payload: payload, Signature: signature}.String()
+		}
+
+		authorize := func(token string) (context.Context, error) {
+			auth, err := service.Authorize(consoleauth.WithAPIKey(ctx, []byte(token)))
+			if err != nil {
+				return nil, err
+			}
+			return console.WithAuth(ctx, auth), nil
+		}
+
+		first := login("device-a")
+		require.True(t, first.NewDevice)
+		require.Equal(t, "device-a", first.Session.UserAgent)
+		require.Equal(t, "192.0.2.1", first.Session.IPAddress)
+
+		second := login("device-a")
+		require.False(t, second.NewDevice)
+
+		third := login("device-b")
+		require.True(t, third.NewDevice)
+
+		authCtx, err := authorize(first.Token)
+		require.NoError(t, err)
+
+		infos, err := service.GetSessions(authCtx)
+		require.NoError(t, err)
+		require.Len(t, infos, 3)
+		for _, info := range infos {
+			require.Equal(t, info.ID == first.Session.ID, info.Current)
+		}
+
+		t.Run("revoke session", func(t *testing.T) {
+			require.NoError(t, service.RevokeSession(authCtx, second.Session.ID))
+
+			_, err := authorize(second.Token)
+			require.True(t, console.ErrUnauthorized.Has(err), err)
+
+			err = service.RevokeSession(authCtx, second.Session.ID)
+			require.True(t, console.ErrValidation.Has(err), err)
+		})
+
+		t.Run("idle session", func(t *testing.T) {
+			err := sessions.UpdateLastActive(ctx, third.Session.ID, time.Now().Add(-2*time.Hour))
+			require.NoError(t, err)
+
+			_, err = authorize(third.Token)
+			require.True(t, console.ErrUnauthorized.Has(err), err)
+
+			// idle sessions aren't listed, but the device is still known.
+			infos, err := service.GetSessions(authCtx)
+			require.NoError(t, err)
+			require.Len(t, infos, 1)
+			require.False(t, login("device-b").NewDevice)
+		})
+
+		t.Run("revoke all sessions", func(t *testing.T) {
+			tokens := []string{first.Token, login("device-a").Token, login("device-c").Token}
+			for _, token := range tokens {
+				_, err := authorize(token)
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, service.RevokeAllSessions(authCtx))
+
+			tokens = append(tokens, legacyToken(time.Now().Add(time.Hour)))
+			for _, token := range tokens {
+				_, err := authorize(token)
+				require.True(t, console.ErrUnauthorized.Has(err), err)
+			}
+
+			all, err := sessions.GetAllByUserID(ctx, user.ID)
+			require.NoError(t, err)
+			require.Empty(t, all)
+		})
+
+		t.Run("logout", func(t *testing.T) {
+			info := login("device-a")
+			authCtx, err := authorize(info.Token)
+			require.NoError(t, err)
+
+			require.NoError(t, service.Logout(authCtx))
+
+			_, err = authorize(info.Token)
+			require.True(t, console.ErrUnauthorized.Has(err), err)
+		})
+
+		t.Run("change password", func(t *testing.T) {
+			current, other := login("device-a"), login("device-b")
+			authCtx, err := authorize(current.Token)
+			require.NoError(t, err)
+
+			require.NoError(t, service.ChangePassword(authCtx, user.FullName, "new password"))
+
+			_, err = authorize(current.Token)
+			require.NoError(t, err)
+			_, err = authorize(other.Token)
+			require.True(t, console.ErrUnauthorized.Has(err), err)
+
+			require.NoError(t, service.ChangePassword(authCtx, "new password", user.FullName))
+		})
+
+		t.Run("legacy token", func(t *testing.T) {
+			// tokens without a session can't be revoked, they are rejected.
+			_, err := authorize(legacyToken(time.Now().Add(time.Hour)))
+			require.True(t, console.ErrUnauthorized.Has(err), err)
+
+			_, err = authorize(legacyToken(time.Now().Add(-time.Hour)))
+			require.True(t, console.ErrTokenExpiration.Has(err), err)
+		})
+
+		t.Run("token without session", func(t *testing.T) {
+			token, err := service.GenerateActivationToken(ctx, user.ID, user.Email)
+			require.NoError(t, err)
+
+			_, err = authorize(token)
+			require.True(t, console.ErrUnauthorized.Has(err), err)
+		})
+	})
+}
@@ -0,0 +1,326 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/oidc"
+)
+
+const (
+	ssoDisabledErrMsg         = "Single sign-on is not enabled on this satellite"
+	ssoRequiredErrMsg         = "Your organization requires you to log in through single sign-on"
+	ssoInvalidStateErrMsg     = "Your single sign-on login has expired or is invalid, please try again"
+	ssoEmailNotVerifiedErrMsg = "Your identity provider did not verify your email address"
+	ssoNoAccountErrMsg        = "There is no account on this satellite for your email address"
+	ssoInactiveAccountErrMsg  = "Your account is not active"
+)
+
+var (
+	// ErrSSO is error type of single sign-on failures.
+	ErrSSO = errs.Class("single sign-on")
+
+	// ErrSSORequired is error type of password logins of users that have to log in through single sign-on.
+	ErrSSORequired = errs.Class("single sign-on required")
+)
+
+// ssoStateExpiration is the time a user has to log in at the identity provider.
+const ssoStateExpiration = 10 * time.Minute
+
+// SSOConfig contains configurations for OpenID Connect single sign-on.
+type SSOConfig struct {
+	Enabled         bool   `help:"whether or not OpenID Connect single sign-on is enabled" default:"false"`
+	ProvisionUsers  bool   `help:"create accounts for users logging in through single sign-on for the first time" default:"true"`
+	EnforcedDomains string `help:"comma separated email domains whose users can only log in through single sign-on" default:""`
+	OIDC            oidc.Config
+}
+
+// OIDCIdentities exposes methods to manage the identity provider accounts linked to users.
+//
+// architecture: Database
+type OIDCIdentities interface {
+	// Get is a method for querying a linked account by the issuer and the subject of the identity provider.
+	Get(ctx context.Context, issuer, subject string) (*OIDCIdentity, error)
+	// Insert is a method for linking an identity provider account to a user.
+	Insert(ctx context.Context, identity OIDCIdentity) error
+}
+
+// OIDCIdentity is a database object that links an identity provider account to a user.
+type OIDCIdentity struct {
+	Issuer  string
+	Subject string
+	UserID  uuid.UUID
+
+	CreatedAt time.Time
+}
+
+// ssoState is the login attempt kept by the user agent between BeginSSOLogin and FinishSSOLogin.
+type ssoState struct {
+	oidc.AuthRequest
+	Expiration time.Time `json:"expiration"`
+}
+
+// SSOEnabled returns whether users can log in through single sign-on.
+func (s *Service) SSOEnabled() bool {
+	return s.sso != nil
+}
+
+// ssoRequired returns whether the user with the email has to log in through single sign-on.
+func (s *Service) ssoRequired(email string) bool {
+	if s.sso == nil {
+		return false
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	return s.ssoDomains[strings.ToLower(email[at+1:])]
+}
+
+// BeginSSOLogin starts a single sign-on login. It returns the URL of the
+// identity provider where the user has to be redirected and the signed state
+// of the login, which has to be kept by the user agent and passed to
+// FinishSSOLogin. redirectURL is the URL of the callback.
+func (s *Service) BeginSSOLogin(ctx context.Context, redirectURL string) (authURL, state string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.sso == nil {
+		return "", "", ErrSSO.New(ssoDisabledErrMsg)
+	}
+
+	request, err := oidc.NewAuthRequest(redirectURL)
+	if err != nil {
+		return "", "", ErrSSO.Wrap(err)
+	}
+
+	authURL, err = s.sso.AuthCodeURL(ctx, request)
+	if err != nil {
+		return "", "", ErrSSO.Wrap(err)
+	}
+
+	payload, err := json.Marshal(ssoState{
+		AuthRequest: request,
+		Expiration:  time.Now().Add(ssoStateExpiration),
+	})
+	if err != nil {
+		return "", "", Error.Wrap(err)
+	}
+
+	token := consoleauth.Token{Payload: payload}
+	err = signToken(&token, s.Signer)
+	if err != nil {
+		return "", "", Error.Wrap(err)
+	}
+
+	return authURL, token.String(), nil
+}
+
+// FinishSSOLogin completes the single sign-on login started with BeginSSOLogin
+// and returns the auth token of the user. state is the signed state returned by
+// BeginSSOLogin, callbackState and code are the parameters of the callback.
+//
+// The user is found by the linked identity provider account, otherwise by the
+// verified email. Users without an account are provisioned when enabled.
+func (s *Service) FinishSSOLogin(ctx context.Context, state, callbackState, code string) (token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.sso == nil {
+		return "", ErrSSO.New(ssoDisabledErrMsg)
+	}
+
+	login, err := s.verifySSOState(ctx, state)
+	if err != nil {
+		return "", err
+	}
+	if callbackState == "" || subtle.ConstantTimeCompare([]byte(login.State), []byte(callbackState)) != 1 {
+		return "", ErrUnauthorized.New(ssoInvalidStateErrMsg)
+	}
+
+	identity, err := s.sso.Exchange(ctx, login.AuthRequest, code)
+	if err != nil {
+		if oidc.ErrInvalidToken.Has(err) {
+			return "", ErrUnauthorized.Wrap(err)
+		}
+		return "", ErrSSO.Wrap(err)
+	}
+
+	user, err := s.ssoUser(ctx, identity)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.createSession(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	s.auditLog(ctx, "sso login", &user.ID, user.Email,
+		zap.String("issuer", identity.Issuer),
+		zap.String("sessionID", session.Session.ID.String()))
+
+	s.analytics.TrackSignedIn(user.ID, user.Email)
+
+	return session.Token, nil
+}
+
+// verifySSOState checks the signature and the expiration of the login state.
+func (s *Service) verifySSOState(ctx context.Context, state string) (_ *ssoState, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	token, err := consoleauth.FromBase64URLString(state)
+	if err != nil {
+		return nil, ErrUnauthorized.New(ssoInvalidStateErrMsg)
+	}
+
+	signature := token.Signature
+	err = signToken(&token, s.Signer)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if subtle.ConstantTimeCompare(signature, token.Signature) != 1 {
+		return nil, ErrUnauthorized.New(ssoInvalidStateErrMsg)
+	}
+
+	var login ssoState
+	if err := json.Unmarshal(token.Payload, &login); err != nil {
+		return nil, ErrUnauthorized.New(ssoInvalidStateErrMsg)
+	}
+	if login.State == "" || time.Now().After(login.Expiration) {
+		return nil, ErrUnauthorized.New(ssoInvalidStateErrMsg)
+	}
+
+	return &login, nil
+}
+
+// ssoUser returns the user of the identity provider account, linking or
+// provisioning the account when it's used for the first time.
+func (s *Service) ssoUser(ctx context.Context, identity *oidc.Identity) (_ *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	link, err := s.store.OIDCIdentities().Get(ctx, identity.Issuer, identity.Subject)
+	switch {
+	case err == nil:
+		user, err := s.store.Users().Get(ctx, link.UserID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if user.Status != Active {
+			return nil, ErrUnauthorized.New(ssoInactiveAccountErrMsg)
+		}
+		return user, nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, Error.Wrap(err)
+	}
+
+	// accounts are linked by email only when the identity provider vouches
+	// for the address, otherwise anyone could take over an account.
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, ErrUnauthorized.New(ssoEmailNotVerifiedErrMsg)
+	}
+
+	user, err := s.store.Users().GetByEmail(ctx, identity.Email)
+	switch {
+	case err == nil:
+		if user.Status != Active {
+			return nil, ErrUnauthorized.New(ssoInactiveAccountErrMsg)
+		}
+	case errors.Is(err, sql.ErrNoRows):
+		if !s.config.SSO.ProvisionUsers {
+			return nil, ErrUnauthorized.New(ssoNoAccountErrMsg)
+		}
+		user, err = s.provisionSSOUser(ctx, identity)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, Error.Wrap(err)
+	}
+
+	err = s.store.OIDCIdentities().Insert(ctx, OIDCIdentity{
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+		UserID:  user.ID,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	s.auditLog(ctx, "link sso identity", &user.ID, user.Email, zap.String("issuer", identity.Issuer), zap.String("subject", identity.Subject))
+
+	return user, nil
+}
+
+// provisionSSOUser creates an active account for the identity provider account.
+func (s *Service) provisionSSOUser(ctx context.Context, identity *oidc.Identity) (u *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the user doesn't know the random password, it can be set with the
+	// password reset, unless the email domain requires single sign-on.
+	password, err := oidc.RandomString()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), s.config.PasswordCost)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	fullName := identity.Name
+	if fullName == "" {
+		fullName = identity.Email
+	}
+
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		userID, err := uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		u, err = tx.Users().Insert(ctx, &User{
+			ID:           userID,
+			Email:        identity.Email,
+			FullName:     fullName,
+			PasswordHash: hash,
+			ProjectLimit: s.config.DefaultProjectLimit,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		// the identity provider has verified the email, so the account
+		// doesn't need an activation.
+		u.Status = Active
+		return tx.Users().Update(ctx, u)
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	s.auditLog(ctx, "create user", &u.ID, u.Email, zap.String("issuer", identity.Issuer))
+
+	return u, nil
+}
+
+// parseSSODomains parses the comma separated list of email domains.
+func parseSSODomains(domains string) map[string]bool {
+	parsed := map[string]bool{}
+	for _, domain := range strings.Split(domains, ",") {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if domain != "" {
+			parsed[domain] = true
+		}
+	}
+	return parsed
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/oidc/oidctest"
+)
+
+func TestSSOLogin(t *testing.T) {
+	idp, err := oidctest.New("satellite", "secret")
+	require.NoError(t, err)
+	defer idp.Close()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.SSO.Enabled = true
+				config.Console.SSO.ProvisionUsers = true
+				config.Console.SSO.EnforcedDomains = "Enforced.Test"
+				config.Console.SSO.OIDC = idp.Config()
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		users := sat.DB.Console().Users()
+
+		require.True(t, service.SSOEnabled())
+
+		// login logs in the user through the identity provider and returns the
+		// signed state and the callback parameters.
+		login := func(user oidctest.User) (state, callbackState, code string) {
+			idp.SetUser(user)
+
+			authURL, state, err := service.BeginSSOLogin(ctx, "https://satellite.test/callback")
+			require.NoError(t, err)
+
+			client := &http.Client{
+				CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, authURL, nil)
+			require.NoError(t, err)
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			require.Equal(t, http.StatusFound, resp.StatusCode)
+
+			location, err := url.Parse(resp.Header.Get("Location"))
+			require.NoError(t, err)
+			return state, location.Query().Get("state"), location.Query().Get("code")
+		}
+
+		// finish logs in the user through the identity provider and completes the login.
+		finish := func(user oidctest.User) (string, error) {
+			state, callbackState, code := login(user)
+			return service.FinishSSOLogin(ctx, state, callbackState, code)
+		}
+
+		t.Run("provision user", func(t *testing.T) {
+			token, err := finish(oidctest.User{
+				Subject:       "new-user",
+				Email:         "new@sso.test",
+				EmailVerified: true,
+				Name:          "New User",
+			})
+			require.NoError(t, err)
+			require.NotEmpty(t, token)
+
+			user, err := users.GetByEmail(ctx, "new@sso.test")
+			require.NoError(t, err)
+			require.Equal(t, console.Active, user.Status)
+			require.Equal(t, "New User", user.FullName)
+
+			// the account is found by the subject, even when the email changes.
+			_, err = finish(oidctest.User{
+				Subject:       "new-user",
+				Email:         "changed@sso.test",
+				EmailVerified: true,
+			})
+			require.NoError(t, err)
+
+			_, err = users.GetByEmail(ctx, "changed@sso.test")
+			require.Error(t, err)
+		})
+
+		t.Run("link existing user", func(t *testing.T) {
+			existing, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "Existing User",
+				Email:    "existing@sso.test",
+			}, 1)
+			require.NoError(t, err)
+
+			_, err = finish(oidctest.User{
+				Subject:       "existing-user",
+				Email:         "existing@sso.test",
+				EmailVerified: true,
+			})
+			require.NoError(t, err)
+
+			link, err := sat.DB.Console().OIDCIdentities().Get(ctx, idp.Issuer(), "existing-user")
+			require.NoError(t, err)
+			require.Equal(t, existing.ID, link.UserID)
+		})
+
+		t.Run("unverified email", func(t *testing.T) {
+			_, err := finish(oidctest.User{
+				Subject:       "unverified-user",
+				Email:         "existing@sso.test",
+				EmailVerified: false,
+			})
+			require.True(t, console.ErrUnauthorized.Has(err), err)
+		})
+
+		t.Run("invalid state", func(t *testing.T) {
+			state, _, code := login(oidctest.User{Subject: "state-user", Email: "state@sso.test", EmailVerified: true})
+			_, err := service.FinishSSOLogin(ctx, state, "other-state", code)
+			require.True(t, console.ErrUnauthorized.Has(err), err)
+
+			state, callbackState, code := login(oidctest.User{Subject: "state-user", Email: "state@sso.test", EmailVerified: true})
+			forged, err := consoleauth.FromBase64URLString(state)
+			require.NoError(t, err)
+			forged.Signature = []byte("forged")
+			_, err = service.FinishSSOLogin(ctx, forged.String(), callbackState, code)
+			require.True(t, console.ErrUnauthorized.Has(err), err)
+		})
+
+		t.Run("enforced domain", func(t *testing.T) {
+			_, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "Enforced User",
+				Email:    "user@enforced.test",
+			}, 1)
+			require.NoError(t, err)
+
+			_, err = service.Token(ctx, console.AuthUser{Email: "user@enforced.test", Password: "Enforced User"})
+			require.True(t, console.ErrSSORequired.Has(err), err)
+
+			_, err = finish(oidctest.User{
+				Subject:       "enforced-user",
+				Email:         "user@enforced.test",
+				EmailVerified: true,
+			})
+			require.NoError(t, err)
+		})
+	})
+}
@@ -29,6 +29,25 @@ type Users interface {
 	UpdatePaidTier(ctx context.Context, id uuid.UUID, paidTier bool) error
 	// GetProjectLimit is a method to get the users project limit
 	GetProjectLimit(ctx context.Context, id uuid.UUID) (limit int, err error)
+	// Search is a method for querying the users matching all of the given criteria,
+	// newest first.
+	Search(ctx context.Context, search UserSearch) ([]User, error)
+}
+
+// UserSearch holds the criteria for searching users. Empty criteria are ignored.
+type UserSearch struct {
+	// Email matches any part of the email, case insensitively.
+	Email string
+	// Name matches any part of the full name, case insensitively.
+	Name          string
+	PartnerID     *uuid.UUID
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	PaidTier      *bool
+	Status        *UserStatus
+
+	Limit  int
+	Offset int64
 }
 
 // UserInfo holds User updatable data.
@@ -105,6 +124,9 @@ const (
 	Active UserStatus = 1
 	// Deleted is a user status that he receives after deleting account.
 	Deleted UserStatus = 2
+	// Suspended is a user status that he receives when an admin suspends the account.
+	// Suspended users can not log in and their projects are frozen, but their data is kept.
+	Suspended UserStatus = 3
 )
 
 // User is a database object that describes User entity.
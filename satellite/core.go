@@ -30,7 +30,11 @@ import (
 	"storj.io/storj/satellite/accounting/rolluparchive"
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/consistency"
+	"storj.io/storj/satellite/console/auditretention"
+	"storj.io/storj/satellite/console/sessioncleanup"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/inventory"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metainfo"
@@ -40,6 +44,8 @@ import (
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/overlay/straynodes"
 	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/invoiceonly"
+	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/reputation"
@@ -108,6 +114,14 @@ type Core struct {
 		Chore *expireddeletion.Chore
 	}
 
+	Inventory struct {
+		Chore *inventory.Chore
+	}
+
+	Consistency struct {
+		Chore *consistency.Chore
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		NodeTally             *nodetally.Service
@@ -132,6 +146,14 @@ type Core struct {
 	Metrics struct {
 		Chore *metrics.Chore
 	}
+
+	AuditRetention struct {
+		Chore *auditretention.Chore
+	}
+
+	SessionCleanup struct {
+		Chore *sessioncleanup.Chore
+	}
 }
 
 // New creates a new satellite.
@@ -363,6 +385,80 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Expired Segments Chore", peer.ExpiredDeletion.Chore.Loop))
 	}
 
+	{ // setup bucket inventory reports
+		peer.Inventory.Chore = inventory.NewChore(
+			peer.Log.Named("core-inventory"),
+			config.Inventory,
+			peer.DB.BucketInventories(),
+			peer.Metainfo.Metabase,
+			inventory.UplinkUploader{},
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "inventory:chore",
+			Run:   peer.Inventory.Chore.Run,
+			Close: peer.Inventory.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Inventory Chore", peer.Inventory.Chore.Loop))
+	}
+
+	{ // setup metabase consistency checker
+		peer.Consistency.Chore = consistency.NewChore(
+			peer.Log.Named("core-consistency"),
+			config.Consistency,
+			peer.DB.ConsistencyFixes(),
+			peer.Metainfo.Metabase,
+			peer.Metainfo.SegmentLoop,
+			config.Metainfo.SegmentLoop,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "consistency:chore",
+			Run:   peer.Consistency.Chore.Run,
+			Close: peer.Consistency.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Metabase Consistency Chore", peer.Consistency.Chore.Loop))
+	}
+
+	{ // setup console audit log retention
+		if config.AuditRetention.Enabled {
+			peer.AuditRetention.Chore = auditretention.NewChore(
+				peer.Log.Named("console:audit-retention"),
+				peer.DB.Console().AuditEvents(),
+				config.AuditRetention,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "console:audit-retention",
+				Run:   peer.AuditRetention.Chore.Run,
+				Close: peer.AuditRetention.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Console Audit Retention", peer.AuditRetention.Chore.Loop))
+		} else {
+			peer.Log.Named("console:audit-retention").Info("disabled")
+		}
+	}
+
+	{ // setup console session cleanup
+		if config.SessionCleanup.Enabled {
+			peer.SessionCleanup.Chore = sessioncleanup.NewChore(
+				peer.Log.Named("console:session-cleanup"),
+				peer.DB.Console().WebappSessions(),
+				peer.DB.Console().LoginLockouts(),
+				config.SessionCleanup,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "console:session-cleanup",
+				Run:   peer.SessionCleanup.Chore.Run,
+				Close: peer.SessionCleanup.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Console Session Cleanup", peer.SessionCleanup.Chore.Loop))
+		} else {
+			peer.Log.Named("console:session-cleanup").Info("disabled")
+		}
+	}
+
 	{ // setup accounting
 		peer.Accounting.Tally = tally.New(peer.Log.Named("accounting:tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Cache, peer.Metainfo.Metabase, config.Tally)
 		peer.Services.Add(lifecycle.Item{
@@ -419,53 +515,72 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 	{ // setup payments
 		pc := config.Payments
 
-		var stripeClient stripecoinpayments.StripeClient
-		switch pc.Provider {
-		default:
-			stripeClient = stripecoinpayments.NewStripeMock(
-				peer.ID(),
-				peer.DB.StripeCoinPayments().Customers(),
+		if pc.Provider == paymentsconfig.ProviderInvoiceOnly {
+			priceModel, err := payments.NewProjectUsagePriceModel(pc.StorageTBPrice, pc.EgressTBPrice, pc.ObjectPrice)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+
+			service := invoiceonly.NewService(
+				peer.Log.Named("payments.invoiceonly:service"),
+				peer.DB.InvoiceOnly(),
+				peer.DB.Console().Projects(),
 				peer.DB.Console().Users(),
-			)
-		case "stripecoinpayments":
-			stripeClient = stripecoinpayments.NewStripeClient(log, pc.StripeCoinPayments)
-		}
+				peer.DB.ProjectAccounting(),
+				peer.DB.PricePlans(),
+				priceModel,
+				pc.InvoiceOnly)
+			peer.Payments.Accounts = service.Accounts()
+		} else {
+			var stripeClient stripecoinpayments.StripeClient
+			switch pc.Provider {
+			default:
+				stripeClient = stripecoinpayments.NewStripeMock(
+					peer.ID(),
+					peer.DB.StripeCoinPayments().Customers(),
+					peer.DB.Console().Users(),
+				)
+			case paymentsconfig.ProviderStripeCoinPayments:
+				stripeClient = stripecoinpayments.NewStripeClient(log, pc.StripeCoinPayments)
+			}
 
-		service, err := stripecoinpayments.NewService(
-			peer.Log.Named("payments.stripe:service"),
-			stripeClient,
-			pc.StripeCoinPayments,
-			peer.DB.StripeCoinPayments(),
-			peer.DB.Console().Projects(),
-			peer.DB.ProjectAccounting(),
-			pc.StorageTBPrice,
-			pc.EgressTBPrice,
-			pc.ObjectPrice,
-			pc.BonusRate,
-			pc.CouponValue,
-			pc.CouponDuration.IntPointer(),
-			pc.CouponProjectLimit,
-			pc.MinCoinPayment)
-		if err != nil {
-			return nil, errs.Combine(err, peer.Close())
-		}
+			service, err := stripecoinpayments.NewService(
+				peer.Log.Named("payments.stripe:service"),
+				stripeClient,
+				pc.StripeCoinPayments,
+				peer.DB.StripeCoinPayments(),
+				peer.DB.Console().Projects(),
+				peer.DB.ProjectAccounting(),
+				peer.DB.PricePlans(),
+				pc.StorageTBPrice,
+				pc.EgressTBPrice,
+				pc.ObjectPrice,
+				pc.BonusRate,
+				pc.CouponValue,
+				pc.CouponDuration.IntPointer(),
+				pc.CouponProjectLimit,
+				pc.MinCoinPayment)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
 
-		peer.Payments.Accounts = service.Accounts()
+			peer.Payments.Accounts = service.Accounts()
 
-		peer.Payments.Chore = stripecoinpayments.NewChore(
-			peer.Log.Named("payments.stripe:clearing"),
-			service,
-			pc.StripeCoinPayments.TransactionUpdateInterval,
-			pc.StripeCoinPayments.AccountBalanceUpdateInterval,
-		)
-		peer.Services.Add(lifecycle.Item{
-			Name: "payments.stripe:service",
-			Run:  peer.Payments.Chore.Run,
-		})
-		peer.Debug.Server.Panel.Add(
-			debug.Cycle("Payments Stripe Transactions", peer.Payments.Chore.TransactionCycle),
-			debug.Cycle("Payments Stripe Account Balance", peer.Payments.Chore.AccountBalanceCycle),
-		)
+			peer.Payments.Chore = stripecoinpayments.NewChore(
+				peer.Log.Named("payments.stripe:clearing"),
+				service,
+				pc.StripeCoinPayments.TransactionUpdateInterval,
+				pc.StripeCoinPayments.AccountBalanceUpdateInterval,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name: "payments.stripe:service",
+				Run:  peer.Payments.Chore.Run,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Payments Stripe Transactions", peer.Payments.Chore.TransactionCycle),
+				debug.Cycle("Payments Stripe Account Balance", peer.Payments.Chore.AccountBalanceCycle),
+			)
+		}
 	}
 
 	{ // setup graceful exit
@@ -5,6 +5,7 @@ package gc
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -16,7 +17,7 @@ import (
 	"storj.io/storj/satellite/metabase/segmentloop"
 )
 
-var _ segmentloop.Observer = (*PieceTracker)(nil)
+var _ segmentloop.ParallelObserver = (*PieceTracker)(nil)
 
 // PieceTracker implements the metainfo loop observer interface for garbage collection.
 //
@@ -28,7 +29,11 @@ type PieceTracker struct {
 	// TODO: should we use int or int64 consistently for piece count (db type is int64)?
 	pieceCounts map[storj.NodeID]int
 
+	// mu protects RetainInfos and forkedMemory while ranges are iterated.
+	mu          sync.Mutex
 	RetainInfos map[storj.NodeID]*RetainInfo
+	// forkedMemory is the size of the filters created for the ranges.
+	forkedMemory int64
 }
 
 // NewPieceTracker instantiates a new gc piece tracker to be subscribed to the metainfo loop.
@@ -70,6 +75,13 @@ func (pieceTracker *PieceTracker) InlineSegment(ctx context.Context, segment *se
 
 // adds a pieceID to the relevant node's RetainInfo.
 func (pieceTracker *PieceTracker) add(nodeID storj.NodeID, pieceID storj.PieceID) {
+	info := pieceTracker.retainInfo(nodeID)
+	info.Filter.Add(pieceID)
+	info.Count++
+}
+
+// retainInfo returns the RetainInfo of the node, creating it when necessary.
+func (pieceTracker *PieceTracker) retainInfo(nodeID storj.NodeID) *RetainInfo {
 	if _, ok := pieceTracker.RetainInfos[nodeID]; !ok {
 		// If we know how many pieces a node should be storing, use that number. Otherwise use default.
 		numPieces := pieceTracker.config.InitialPieces
@@ -83,7 +95,131 @@ func (pieceTracker *PieceTracker) add(nodeID storj.NodeID, pieceID storj.PieceID
 			CreationDate: pieceTracker.creationDate,
 		}
 	}
+	return pieceTracker.RetainInfos[nodeID]
+}
+
+// filterHeaderSize is the size of version, seed and hash count in the encoded bloom filter.
+const filterHeaderSize = 3
+
+// Fork creates a piece tracker for a single range of the segments loop.
+func (pieceTracker *PieceTracker) Fork(ctx context.Context) (segmentloop.Partial, error) {
+	return &pieceTrackerPartial{
+		parent:      pieceTracker,
+		retainInfos: make(map[storj.NodeID]*RetainInfo),
+		shared:      make(map[storj.NodeID]bool),
+	}, nil
+}
+
+// Join merges the bloom filters of a single range into the piece tracker.
+func (pieceTracker *PieceTracker) Join(ctx context.Context, partial segmentloop.Partial) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	forked, ok := partial.(*pieceTrackerPartial)
+	if !ok {
+		return errs.New("expected partial type %T but got %T", forked, partial)
+	}
+
+	pieceTracker.mu.Lock()
+	defer pieceTracker.mu.Unlock()
+
+	for nodeID, info := range forked.retainInfos {
+		target := pieceTracker.retainInfo(nodeID)
+
+		merged, bits := target.Filter.Bytes(), info.Filter.Bytes()
+		if len(merged) != len(bits) || merged[1] != bits[1] {
+			return errs.New("bloom filter parameters differ for node %s", nodeID)
+		}
+		for i := filterHeaderSize; i < len(merged); i++ {
+			merged[i] |= bits[i]
+		}
+
+		target.Filter, err = bloomfilter.NewFromBytes(merged)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		target.Count += info.Count
+		pieceTracker.forkedMemory -= info.Filter.Size()
+	}
+	return nil
+}
+
+// forkFilter returns an empty bloom filter with the same parameters as the
+// filter of the node. It returns nil when the filter would exceed
+// Config.ParallelFilterMemory, the range adds the pieces to the filter of the
+// piece tracker instead.
+func (pieceTracker *PieceTracker) forkFilter(nodeID storj.NodeID) (*bloomfilter.Filter, error) {
+	pieceTracker.mu.Lock()
+	defer pieceTracker.mu.Unlock()
+
+	info := pieceTracker.retainInfo(nodeID)
+	if pieceTracker.forkedMemory+info.Filter.Size() > pieceTracker.config.ParallelFilterMemory.Int64() {
+		return nil, nil
+	}
+	pieceTracker.forkedMemory += info.Filter.Size()
+
+	data := info.Filter.Bytes()
+	for i := filterHeaderSize; i < len(data); i++ {
+		data[i] = 0
+	}
+	return bloomfilter.NewFromBytes(data)
+}
+
+// addShared adds a piece directly to the filter of the piece tracker.
+func (pieceTracker *PieceTracker) addShared(nodeID storj.NodeID, pieceID storj.PieceID) {
+	pieceTracker.mu.Lock()
+	defer pieceTracker.mu.Unlock()
+
+	pieceTracker.add(nodeID, pieceID)
+}
+
+// pieceTrackerPartial collects the pieces of a single range of the segments loop.
+//
+// Its filters use the same parameters as the filters of the parent, which
+// allows merging them. Nodes without a filter of their own, because of the
+// memory limit, share the filter of the parent.
+type pieceTrackerPartial struct {
+	parent      *PieceTracker
+	retainInfos map[storj.NodeID]*RetainInfo
+	shared      map[storj.NodeID]bool
+}
+
+// RemoteSegment adds the pieces of the segment to the bloom filters of the range.
+func (partial *pieceTrackerPartial) RemoteSegment(ctx context.Context, segment *segmentloop.Segment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, piece := range segment.Pieces {
+		pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+
+		if partial.shared[piece.StorageNode] {
+			partial.parent.addShared(piece.StorageNode, pieceID)
+			continue
+		}
+
+		info, ok := partial.retainInfos[piece.StorageNode]
+		if !ok {
+			filter, err := partial.parent.forkFilter(piece.StorageNode)
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			if filter == nil {
+				partial.shared[piece.StorageNode] = true
+				partial.parent.addShared(piece.StorageNode, pieceID)
+				continue
+			}
+			info = &RetainInfo{
+				Filter:       filter,
+				CreationDate: partial.parent.creationDate,
+			}
+			partial.retainInfos[piece.StorageNode] = info
+		}
+
+		info.Filter.Add(pieceID)
+		info.Count++
+	}
+	return nil
+}
 
-	pieceTracker.RetainInfos[nodeID].Filter.Add(pieceID)
-	pieceTracker.RetainInfos[nodeID].Count++
+// InlineSegment returns nil because we're only doing gc for storage nodes for now.
+func (partial *pieceTrackerPartial) InlineSegment(ctx context.Context, segment *segmentloop.Segment) (err error) {
+	return nil
 }
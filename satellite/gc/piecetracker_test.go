@@ -0,0 +1,87 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+func TestPieceTrackerForkJoin(t *testing.T) {
+	for _, limit := range []memory.Size{memory.MiB, 0} {
+		limit := limit
+		t.Run(limit.String(), func(t *testing.T) {
+			// with a zero limit the ranges share the filters of the piece tracker.
+			testPieceTrackerForkJoin(t, limit)
+		})
+	}
+}
+
+func testPieceTrackerForkJoin(t *testing.T, limit memory.Size) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	nodes := []storj.NodeID{testrand.NodeID(), testrand.NodeID()}
+	tracker := gc.NewPieceTracker(zaptest.NewLogger(t), gc.Config{
+		InitialPieces:     100,
+		FalsePositiveRate: 0.000000001,
+
+		ParallelFilterMemory: limit,
+	}, nil)
+
+	var segments []segmentloop.Segment
+	for i := 0; i < 10; i++ {
+		segments = append(segments, segmentloop.Segment{
+			StreamID:    testrand.UUID(),
+			RootPieceID: testrand.PieceID(),
+			Redundancy:  storj.RedundancyScheme{RequiredShares: 1, OptimalShares: 2, TotalShares: 2},
+			Pieces: metabase.Pieces{
+				{Number: 0, StorageNode: nodes[0]},
+				{Number: 1, StorageNode: nodes[i%2]},
+			},
+		})
+	}
+
+	// the first segment is added without forking.
+	require.NoError(t, tracker.RemoteSegment(ctx, &segments[0]))
+
+	first, err := tracker.Fork(ctx)
+	require.NoError(t, err)
+	second, err := tracker.Fork(ctx)
+	require.NoError(t, err)
+
+	for i := 1; i < len(segments); i++ {
+		partial := first
+		if i%2 == 0 {
+			partial = second
+		}
+		require.NoError(t, partial.RemoteSegment(ctx, &segments[i]))
+	}
+
+	require.NoError(t, tracker.Join(ctx, first))
+	require.NoError(t, tracker.Join(ctx, second))
+
+	require.Len(t, tracker.RetainInfos, 2)
+	require.Equal(t, 15, tracker.RetainInfos[nodes[0]].Count)
+	require.Equal(t, 5, tracker.RetainInfos[nodes[1]].Count)
+
+	for _, segment := range segments {
+		for _, piece := range segment.Pieces {
+			pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+			require.True(t, tracker.RetainInfos[piece.StorageNode].Filter.Contains(pieceID))
+		}
+	}
+
+	require.Error(t, tracker.Join(ctx, segmentloop.NullObserver{}))
+}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/bloomfilter"
+	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
@@ -37,6 +38,8 @@ type Config struct {
 	FalsePositiveRate float64       `help:"the false positive rate used for creating a garbage collection bloom filter" releaseDefault:"0.1" devDefault:"0.1"`
 	ConcurrentSends   int           `help:"the number of nodes to concurrently send garbage collection bloom filters to" releaseDefault:"1" devDefault:"1"`
 	RetainSendTimeout time.Duration `help:"the amount of time to allow a node to handle a retain request" default:"1m"`
+
+	ParallelFilterMemory memory.Size `help:"the memory used by the bloom filters of the ranges iterated in parallel, the ranges share the filters above the limit" default:"1GiB"`
 }
 
 // Service implements the garbage collection service.
@@ -79,6 +82,12 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		return nil
 	}
 
+	// the bloom filters of a partition don't contain the pieces of the other
+	// partitions, sending them would delete live pieces.
+	if service.segmentLoop.Partitioned() {
+		return Error.New("garbage collection requires the segment loop to iterate all partitions, disable it on partitioned processes")
+	}
+
 	// load last piece counts from overlay db
 	lastPieceCounts, err := service.overlay.AllPieceCounts(ctx)
 	if err != nil {
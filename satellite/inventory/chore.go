@@ -0,0 +1,177 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"context"
+	"io"
+	"path"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/uplink"
+)
+
+// Uploader uploads generated reports to the destination bucket configured by the customer.
+type Uploader interface {
+	Upload(ctx context.Context, access, bucket, key string, data io.Reader) error
+}
+
+// UplinkUploader uploads reports using the access grant stored in the configuration.
+type UplinkUploader struct{}
+
+// Upload uploads data to the bucket using the serialized access grant.
+func (UplinkUploader) Upload(ctx context.Context, access, bucket, key string, data io.Reader) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	parsed, err := uplink.ParseAccess(access)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	project, err := uplink.OpenProject(ctx, parsed)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(project.Close())) }()
+
+	upload, err := project.UploadObject(ctx, bucket, key, nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if _, err := io.Copy(upload, data); err != nil {
+		return Error.Wrap(errs.Combine(err, upload.Abort()))
+	}
+
+	return Error.Wrap(upload.Commit())
+}
+
+// Chore generates inventory reports for every configured bucket and
+// uploads them to the destination bucket.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	db       DB
+	metabase *metabase.DB
+	uploader Uploader
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new inventory chore.
+func NewChore(log *zap.Logger, config Config, db DB, metabase *metabase.DB, uploader Uploader) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		db:       db,
+		metabase: metabase,
+		uploader: uploader,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the inventory chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.RunOnce)
+}
+
+// RunOnce generates reports for all configured buckets.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	configs, err := chore.db.List(ctx)
+	if err != nil {
+		chore.log.Error("listing inventory configurations failed", zap.Error(err))
+		return nil
+	}
+
+	for _, config := range configs {
+		if config.DestinationBucket == "" {
+			continue
+		}
+
+		if err := chore.generate(ctx, config); err != nil {
+			chore.log.Error("generating inventory report failed",
+				zap.Stringer("Project ID", config.ProjectID),
+				zap.String("Bucket", config.BucketName),
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func (chore *Chore) generate(ctx context.Context, config Configuration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	access, err := decryptAccess(chore.config.EncryptionKeys, config.DestinationAccess)
+	if err != nil {
+		return err
+	}
+
+	now := chore.nowFn()
+	key := ReportKey(config, now)
+
+	reader, writer := io.Pipe()
+
+	var group errgroup.Group
+	group.Go(func() error {
+		w, err := NewWriter(config.Format, writer)
+		if err == nil {
+			var count int64
+			count, err = Generate(ctx, chore.metabase, metabase.BucketLocation{
+				ProjectID:  config.ProjectID,
+				BucketName: config.BucketName,
+			}, chore.config.BatchSize, w)
+			mon.IntVal("inventory_report_entries").Observe(count)
+		}
+		// closing with a nil error signals the end of the report to the uploader
+		_ = writer.CloseWithError(err)
+		return err
+	})
+	group.Go(func() error {
+		err := chore.uploader.Upload(ctx, access, config.DestinationBucket, key, reader)
+		_ = reader.CloseWithError(err)
+		return err
+	})
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	return chore.db.SetLastReport(ctx, config.ProjectID, config.BucketName, now)
+}
+
+// ReportKey returns the object key a report generated at the given time is uploaded to.
+func ReportKey(config Configuration, now time.Time) string {
+	name := now.UTC().Format("2006-01-02T15-04-05Z") + config.Format.Extension()
+	return config.DestinationPrefix + path.Join(config.BucketName, name)
+}
+
+// Close stops the inventory chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/inventory"
+)
+
+func TestChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+
+		require.NoError(t, uplink.Upload(ctx, satellite, "source", "inline", testrand.Bytes(1*memory.KiB)))
+		require.NoError(t, uplink.Upload(ctx, satellite, "source", "remote", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, uplink.CreateBucket(ctx, satellite, "destination"))
+
+		access, err := uplink.Access[satellite.ID()].Serialize()
+		require.NoError(t, err)
+
+		service := inventory.NewService(zaptest.NewLogger(t), satellite.DB.BucketInventories(), satellite.DB.Buckets(), satellite.Config.Inventory.EncryptionKeys)
+		err = service.Set(ctx, inventory.Configuration{
+			ProjectID:         projectID,
+			BucketName:        "source",
+			Format:            inventory.FormatJSON,
+			DestinationAccess: access,
+			DestinationBucket: "destination",
+			DestinationPrefix: "reports/",
+		})
+		require.NoError(t, err)
+
+		now := time.Now()
+		chore := satellite.Inventory.Chore
+		chore.SetNow(func() time.Time { return now })
+		require.NoError(t, chore.RunOnce(ctx))
+
+		config, err := satellite.DB.BucketInventories().Get(ctx, projectID, "source")
+		require.NoError(t, err)
+		require.NotNil(t, config.LastReportAt)
+
+		objects, err := satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "source")
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		data, err := uplink.Download(ctx, satellite, "destination", inventory.ReportKey(config, now))
+		require.NoError(t, err)
+
+		var entries []map[string]interface{}
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+			entries = append(entries, entry)
+		}
+		require.NoError(t, scanner.Err())
+		require.Len(t, entries, len(objects))
+
+		_, err = service.Get(ctx, projectID, "missing")
+		require.True(t, inventory.ErrNotFound.Has(err))
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package inventory implements scheduled bucket inventory reports.
+//
+// An inventory report is a manifest listing every committed object version in
+// a bucket with its encrypted key, size, segment count, creation and
+// expiration time.
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/orders"
+)
+
+var (
+	// Error is the default error class for inventory reports.
+	Error = errs.Class("inventory")
+	// ErrNotFound is returned when a bucket has no inventory configured.
+	ErrNotFound = errs.Class("inventory not found")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the inventory chore.
+type Config struct {
+	Enabled   bool          `help:"set if bucket inventory reports are generated" default:"false"`
+	Interval  time.Duration `help:"how often to generate bucket inventory reports" releaseDefault:"24h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
+	BatchSize int           `help:"how many objects to query in a batch while generating a report" default:"1000"`
+
+	EncryptionKeys orders.EncryptionKeys `help:"encryption keys to encrypt the destination access grants, the first key is used for new configurations" default:""`
+}
+
+// Configuration describes the inventory configured by the customer for a bucket.
+type Configuration struct {
+	ProjectID  uuid.UUID `json:"projectId"`
+	BucketName string    `json:"bucketName"`
+	Format     Format    `json:"format"`
+
+	// DestinationAccess is the serialized access grant used for uploading
+	// reports into the destination bucket. It is stored encrypted with
+	// Config.EncryptionKeys.
+	DestinationAccess string `json:"-"`
+	DestinationBucket string `json:"destinationBucket"`
+	DestinationPrefix string `json:"destinationPrefix"`
+
+	CreatedAt    time.Time  `json:"createdAt"`
+	LastReportAt *time.Time `json:"lastReportAt"`
+}
+
+// DB stores bucket inventory configurations.
+//
+// architecture: Database
+type DB interface {
+	// Get returns the inventory configuration for the bucket.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName string) (Configuration, error)
+	// List returns all inventory configurations.
+	List(ctx context.Context) ([]Configuration, error)
+	// Set creates or replaces the inventory configuration for the bucket.
+	Set(ctx context.Context, config Configuration) error
+	// Delete removes the inventory configuration for the bucket.
+	Delete(ctx context.Context, projectID uuid.UUID, bucketName string) error
+	// SetLastReport updates when the last report for the bucket was generated.
+	SetLastReport(ctx context.Context, projectID uuid.UUID, bucketName string, at time.Time) error
+}
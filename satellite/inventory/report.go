@@ -0,0 +1,176 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+// Format is the encoding used for an inventory report.
+type Format string
+
+const (
+	// FormatJSON encodes one JSON object per line.
+	FormatJSON = Format("ndjson")
+	// FormatCSV encodes entries as comma separated values with a header.
+	FormatCSV = Format("csv")
+)
+
+// Extension returns the file extension used for reports in this format.
+func (format Format) Extension() string { return "." + string(format) }
+
+// ContentType returns the MIME type of reports in this format.
+func (format Format) ContentType() string {
+	if format == FormatCSV {
+		return "text/csv"
+	}
+	return "application/x-ndjson"
+}
+
+// Verify checks whether the format is supported.
+func (format Format) Verify() error {
+	switch format {
+	case FormatJSON, FormatCSV:
+		return nil
+	default:
+		return Error.New("unsupported format %q", format)
+	}
+}
+
+// Entry is a single object version in an inventory report.
+type Entry struct {
+	// ObjectKey is the encrypted object key, as stored.
+	ObjectKey    metabase.ObjectKey `json:"-"`
+	Version      metabase.Version   `json:"version"`
+	Size         int64              `json:"size"`
+	SegmentCount int32              `json:"segmentCount"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	ExpiresAt    *time.Time         `json:"expiresAt,omitempty"`
+}
+
+// Writer writes entries of an inventory report.
+type Writer interface {
+	// Write appends an entry to the report.
+	Write(entry Entry) error
+	// Flush writes any buffered data to the underlying writer.
+	Flush() error
+}
+
+// NewWriter returns a writer for the specified format.
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatJSON:
+		return &jsonWriter{enc: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	default:
+		return nil, Error.New("unsupported format %q", format)
+	}
+}
+
+// encodeKey encodes the encrypted object key so it is safe to use in text formats.
+func encodeKey(key metabase.ObjectKey) string {
+	return base64.StdEncoding.EncodeToString([]byte(key))
+}
+
+type jsonWriter struct {
+	enc *json.Encoder
+}
+
+func (writer *jsonWriter) Write(entry Entry) error {
+	type jsonEntry struct {
+		Key string `json:"key"`
+		Entry
+	}
+	return Error.Wrap(writer.enc.Encode(jsonEntry{
+		Key:   encodeKey(entry.ObjectKey),
+		Entry: entry,
+	}))
+}
+
+func (writer *jsonWriter) Flush() error { return nil }
+
+type csvWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+var csvHeader = []string{"key", "version", "size", "segment_count", "created_at", "expires_at"}
+
+func (writer *csvWriter) Write(entry Entry) error {
+	if !writer.headerWritten {
+		if err := writer.w.Write(csvHeader); err != nil {
+			return Error.Wrap(err)
+		}
+		writer.headerWritten = true
+	}
+
+	var expiresAt string
+	if entry.ExpiresAt != nil {
+		expiresAt = entry.ExpiresAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	return Error.Wrap(writer.w.Write([]string{
+		encodeKey(entry.ObjectKey),
+		strconv.FormatInt(int64(entry.Version), 10),
+		strconv.FormatInt(entry.Size, 10),
+		strconv.FormatInt(int64(entry.SegmentCount), 10),
+		entry.CreatedAt.UTC().Format(time.RFC3339Nano),
+		expiresAt,
+	}))
+}
+
+func (writer *csvWriter) Flush() error {
+	if !writer.headerWritten {
+		if err := writer.w.Write(csvHeader); err != nil {
+			return Error.Wrap(err)
+		}
+		writer.headerWritten = true
+	}
+	writer.w.Flush()
+	return Error.Wrap(writer.w.Error())
+}
+
+// Generate writes all committed object versions in the bucket to w.
+func Generate(ctx context.Context, db *metabase.DB, bucket metabase.BucketLocation, batchSize int, w Writer) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+		ProjectID:  bucket.ProjectID,
+		BucketName: bucket.BucketName,
+		Recursive:  true,
+		BatchSize:  batchSize,
+		Status:     metabase.Committed,
+	}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		var item metabase.ObjectEntry
+		for it.Next(ctx, &item) {
+			err := w.Write(Entry{
+				ObjectKey:    item.ObjectKey,
+				Version:      item.Version,
+				Size:         item.TotalEncryptedSize,
+				SegmentCount: item.SegmentCount,
+				CreatedAt:    item.CreatedAt,
+				ExpiresAt:    item.ExpiresAt,
+			})
+			if err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return count, Error.Wrap(err)
+	}
+
+	return count, w.Flush()
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/inventory"
+)
+
+func TestWriter(t *testing.T) {
+	createdAt := time.Date(2021, 8, 10, 12, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.Add(24 * time.Hour)
+
+	entries := []inventory.Entry{
+		{
+			ObjectKey:    "\x00\x01encrypted",
+			Version:      1,
+			Size:         1024,
+			SegmentCount: 2,
+			CreatedAt:    createdAt,
+		},
+		{
+			ObjectKey:    "plain",
+			Version:      3,
+			Size:         10,
+			SegmentCount: 1,
+			CreatedAt:    createdAt,
+			ExpiresAt:    &expiresAt,
+		},
+	}
+
+	for _, tc := range []struct {
+		format   inventory.Format
+		expected string
+	}{
+		{
+			format: inventory.FormatJSON,
+			expected: `{"key":"AAFlbmNyeXB0ZWQ=","version":1,"size":1024,"segmentCount":2,"createdAt":"2021-08-10T12:00:00Z"}` + "\n" +
+				`{"key":"cGxhaW4=","version":3,"size":10,"segmentCount":1,"createdAt":"2021-08-10T12:00:00Z","expiresAt":"2021-08-11T12:00:00Z"}` + "\n",
+		},
+		{
+			format: inventory.FormatCSV,
+			expected: "key,version,size,segment_count,created_at,expires_at\n" +
+				"AAFlbmNyeXB0ZWQ=,1,1024,2,2021-08-10T12:00:00Z,\n" +
+				"cGxhaW4=,3,10,1,2021-08-10T12:00:00Z,2021-08-11T12:00:00Z\n",
+		},
+	} {
+		var buf bytes.Buffer
+		w, err := inventory.NewWriter(tc.format, &buf)
+		require.NoError(t, err)
+
+		for _, entry := range entries {
+			require.NoError(t, w.Write(entry))
+		}
+		require.NoError(t, w.Flush())
+
+		require.Equal(t, tc.expected, buf.String(), tc.format)
+	}
+
+	_, err := inventory.NewWriter("xml", &bytes.Buffer{})
+	require.Error(t, err)
+}
+
+func TestWriter_EmptyCSV(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := inventory.NewWriter(inventory.FormatCSV, &buf)
+	require.NoError(t, err)
+	require.NoError(t, w.Flush())
+
+	require.Equal(t, "key,version,size,segment_count,created_at,expires_at\n", buf.String())
+}
+
+func TestReportKey(t *testing.T) {
+	now := time.Date(2021, 8, 10, 12, 30, 15, 0, time.UTC)
+
+	key := inventory.ReportKey(inventory.Configuration{
+		BucketName:        "photos",
+		Format:            inventory.FormatCSV,
+		DestinationPrefix: "inventory/",
+	}, now)
+	require.Equal(t, "inventory/photos/2021-08-10T12-30-15Z.csv", key)
+}
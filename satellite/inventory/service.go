@@ -0,0 +1,161 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/nacl/secretbox"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/orders"
+)
+
+// ErrInvalid is returned when an inventory configuration is not valid.
+var ErrInvalid = errs.Class("invalid inventory")
+
+// Buckets is the subset of the buckets database used for verifying that the
+// inventory is configured for an existing bucket.
+type Buckets interface {
+	// GetBucket returns an existing bucket.
+	GetBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (storj.Bucket, error)
+}
+
+// Service manages the inventory configurations of the buckets and keeps
+// the destination access grants encrypted at rest.
+//
+// architecture: Service
+type Service struct {
+	log     *zap.Logger
+	db      DB
+	buckets Buckets
+	keys    orders.EncryptionKeys
+}
+
+// NewService creates a new inventory service.
+func NewService(log *zap.Logger, db DB, buckets Buckets, keys orders.EncryptionKeys) *Service {
+	return &Service{
+		log:     log,
+		db:      db,
+		buckets: buckets,
+		keys:    keys,
+	}
+}
+
+// Get returns the inventory configuration for the bucket. The destination
+// access grant is never returned.
+func (service *Service) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ Configuration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	config, err := service.db.Get(ctx, projectID, bucketName)
+	if err != nil {
+		return Configuration{}, err
+	}
+	config.DestinationAccess = ""
+	return config, nil
+}
+
+// Set validates and stores the inventory configuration for the bucket. It
+// returns storj.ErrBucketNotFound when the bucket does not exist.
+func (service *Service) Set(ctx context.Context, config Configuration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if config.Format == "" {
+		config.Format = FormatJSON
+	}
+	if err := config.Format.Verify(); err != nil {
+		return ErrInvalid.Wrap(err)
+	}
+	if (config.DestinationBucket == "") != (config.DestinationAccess == "") {
+		return ErrInvalid.New("destination access and destination bucket must be set together")
+	}
+
+	_, err = service.buckets.GetBucket(ctx, []byte(config.BucketName), config.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	config.DestinationAccess, err = encryptAccess(service.keys, config.DestinationAccess)
+	if err != nil {
+		return err
+	}
+
+	return service.db.Set(ctx, config)
+}
+
+// Delete removes the inventory configuration for the bucket.
+func (service *Service) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.Delete(ctx, projectID, bucketName)
+}
+
+// encryptAccess encrypts the serialized access grant with the default key.
+// The result is formatted as "hex(key id):base64(nonce + ciphertext)", so
+// older keys can still be used for decryption after a key rotation.
+func encryptAccess(keys orders.EncryptionKeys, access string) (string, error) {
+	if access == "" {
+		return "", nil
+	}
+	if keys.Default.IsZero() {
+		return "", Error.New("encryption keys are not configured")
+	}
+
+	var nonce storj.SerialNumber
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	sealed := append(nonce[:], keys.Default.Encrypt([]byte(access), nonce)...)
+	return hex.EncodeToString(keys.Default.ID[:]) + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptAccess decrypts the access grant encrypted by encryptAccess.
+func decryptAccess(keys orders.EncryptionKeys, encrypted string) (string, error) {
+	if encrypted == "" {
+		return "", nil
+	}
+
+	tokens := strings.SplitN(encrypted, ":", 2)
+	if len(tokens) != 2 {
+		return "", Error.New("invalid encrypted access")
+	}
+
+	var id orders.EncryptionKeyID
+	if len(tokens[0]) != hex.EncodedLen(len(id)) {
+		return "", Error.New("invalid encryption key id %q", tokens[0])
+	}
+	if _, err := hex.Decode(id[:], []byte(tokens[0])); err != nil {
+		return "", Error.New("invalid encryption key id %q", tokens[0])
+	}
+	key, ok := keys.KeyByID[id]
+	if !ok {
+		return "", Error.New("unknown encryption key id %q", tokens[0])
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(tokens[1])
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	var nonce storj.SerialNumber
+	if len(sealed) < len(nonce)+secretbox.Overhead {
+		return "", Error.New("invalid encrypted access")
+	}
+	copy(nonce[:], sealed)
+
+	ekey := orders.EncryptionKey{ID: id, Key: key}
+	access, err := ekey.Decrypt(sealed[len(nonce):], nonce)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return string(access), nil
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/orders"
+)
+
+func TestEncryptAccess(t *testing.T) {
+	first := orders.EncryptionKey{ID: orders.EncryptionKeyID{1}, Key: storj.Key{1}}
+	second := orders.EncryptionKey{ID: orders.EncryptionKeyID{2}, Key: storj.Key{2}}
+
+	keys, err := orders.NewEncryptionKeys(first)
+	require.NoError(t, err)
+
+	encrypted, err := encryptAccess(*keys, "access")
+	require.NoError(t, err)
+	require.NotContains(t, encrypted, "access")
+
+	decrypted, err := decryptAccess(*keys, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, "access", decrypted)
+
+	// after a rotation the old key is still used for decrypting
+	rotated, err := orders.NewEncryptionKeys(second, first)
+	require.NoError(t, err)
+	decrypted, err = decryptAccess(*rotated, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, "access", decrypted)
+
+	unknown, err := orders.NewEncryptionKeys(second)
+	require.NoError(t, err)
+	_, err = decryptAccess(*unknown, encrypted)
+	require.Error(t, err)
+
+	_, err = decryptAccess(*keys, encrypted[:len(encrypted)-4]+"AAAA")
+	require.Error(t, err)
+	_, err = decryptAccess(*keys, "01:AA==")
+	require.Error(t, err)
+
+	_, err = encryptAccess(orders.EncryptionKeys{}, "access")
+	require.Error(t, err)
+
+	empty, err := encryptAccess(orders.EncryptionKeys{}, "")
+	require.NoError(t, err)
+	require.Empty(t, empty)
+}
@@ -257,6 +257,14 @@ type CommitSegment struct {
 func (db *DB) CommitSegment(ctx context.Context, opts CommitSegment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return db.commitSegment(ctx, opts, false)
+}
+
+// commitSegment commits segment to the database, allowDegraded allows
+// committing fewer pieces than the redundancy optimal shares.
+func (db *DB) commitSegment(ctx context.Context, opts CommitSegment, allowDegraded bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	if err := opts.ObjectStream.Verify(); err != nil {
 		return err
 	}
@@ -282,7 +290,7 @@ func (db *DB) CommitSegment(ctx context.Context, opts CommitSegment) (err error)
 		return ErrInvalidRequest.New("Redundancy zero")
 	}
 
-	if len(opts.Pieces) < int(opts.Redundancy.OptimalShares) {
+	if len(opts.Pieces) < int(opts.Redundancy.OptimalShares) && !allowDegraded {
 		return ErrInvalidRequest.New("number of pieces is less than redundancy optimal shares value")
 	}
 
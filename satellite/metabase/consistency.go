@@ -0,0 +1,214 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// consistencyBatchSizeLimit limits the batch size used by consistency queries.
+const consistencyBatchSizeLimit = intLimitRange(2500)
+
+// IterateSegmentAliasPieces contains arguments necessary for listing raw segment alias pieces.
+type IterateSegmentAliasPieces struct {
+	BatchSize          int
+	AsOfSystemInterval time.Duration
+
+	// StartStreamID is the first stream included in the iteration.
+	StartStreamID uuid.UUID
+}
+
+// SegmentAliasPieces contains the alias pieces of a segment without converting them to node IDs.
+type SegmentAliasPieces struct {
+	StreamID    uuid.UUID
+	Position    SegmentPosition
+	AliasPieces AliasPieces
+}
+
+// IterateSegmentAliasPieces iterates over the alias pieces of all remote segments.
+//
+// Unlike IterateLoopSegments it does not resolve aliases, which makes it possible
+// to find segments that refer to aliases missing from node_aliases.
+func (db *DB) IterateSegmentAliasPieces(ctx context.Context, opts IterateSegmentAliasPieces, fn func(context.Context, []SegmentAliasPieces) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.BatchSize < 0 {
+		return ErrInvalidRequest.New("BatchSize is negative")
+	}
+	batchSize := opts.BatchSize
+	consistencyBatchSizeLimit.Ensure(&batchSize)
+
+	var cursorStreamID uuid.UUID
+	var cursorPosition SegmentPosition
+
+	for {
+		batch := make([]SegmentAliasPieces, 0, batchSize)
+		var count int
+
+		err := withRows(db.db.QueryContext(ctx, `
+			SELECT stream_id, position, remote_alias_pieces
+			FROM segments
+			`+db.asOfTime(time.Time{}, opts.AsOfSystemInterval)+`
+			WHERE
+				(stream_id, position) > ($1, $2)
+				AND stream_id >= $4
+			ORDER BY (stream_id, position) ASC
+			LIMIT $3
+		`, cursorStreamID, cursorPosition, batchSize, opts.StartStreamID))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var segment SegmentAliasPieces
+				if err := rows.Scan(&segment.StreamID, &segment.Position, &segment.AliasPieces); err != nil {
+					return err
+				}
+				count++
+				cursorStreamID, cursorPosition = segment.StreamID, segment.Position
+
+				if len(segment.AliasPieces) > 0 {
+					batch = append(batch, segment)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return Error.New("unable to iterate segment alias pieces: %w", err)
+		}
+
+		if len(batch) > 0 {
+			if err := fn(ctx, batch); err != nil {
+				return err
+			}
+		}
+
+		if count < batchSize {
+			return nil
+		}
+	}
+}
+
+// IterateZombieObjects contains arguments necessary for listing zombie objects.
+type IterateZombieObjects struct {
+	DeadlineBefore     time.Time
+	BatchSize          int
+	AsOfSystemInterval time.Duration
+}
+
+// ZombieObject is a pending object whose zombie deletion deadline has passed.
+type ZombieObject struct {
+	ObjectStream
+	ZombieDeletionDeadline time.Time
+}
+
+// IterateZombieObjects iterates over pending objects whose zombie deletion deadline is before opts.DeadlineBefore.
+func (db *DB) IterateZombieObjects(ctx context.Context, opts IterateZombieObjects, fn func(context.Context, []ZombieObject) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.BatchSize < 0 {
+		return ErrInvalidRequest.New("BatchSize is negative")
+	}
+	batchSize := opts.BatchSize
+	consistencyBatchSizeLimit.Ensure(&batchSize)
+
+	var cursor ObjectStream
+	for {
+		batch := make([]ZombieObject, 0, batchSize)
+
+		err := withRows(db.db.QueryContext(ctx, `
+			SELECT
+				project_id, bucket_name, object_key, version, stream_id,
+				zombie_deletion_deadline
+			FROM objects
+			`+db.asOfTime(time.Time{}, opts.AsOfSystemInterval)+`
+			WHERE
+				(project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
+				AND status = `+pendingStatus+`
+				AND zombie_deletion_deadline < $5
+			ORDER BY project_id, bucket_name, object_key, version
+			LIMIT $6
+		`, cursor.ProjectID, []byte(cursor.BucketName), []byte(cursor.ObjectKey), cursor.Version,
+			opts.DeadlineBefore, batchSize))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var object ZombieObject
+				err := rows.Scan(
+					&object.ProjectID, &object.BucketName, &object.ObjectKey, &object.Version, &object.StreamID,
+					&object.ZombieDeletionDeadline,
+				)
+				if err != nil {
+					return err
+				}
+				batch = append(batch, object)
+			}
+			return nil
+		})
+		if err != nil {
+			return Error.New("unable to iterate zombie objects: %w", err)
+		}
+
+		if len(batch) > 0 {
+			if err := fn(ctx, batch); err != nil {
+				return err
+			}
+			cursor = batch[len(batch)-1].ObjectStream
+		}
+
+		if len(batch) < batchSize {
+			return nil
+		}
+	}
+}
+
+// DeleteOrphanedSegments contains arguments necessary for deleting segments without an object.
+type DeleteOrphanedSegments struct {
+	StreamIDs []uuid.UUID
+}
+
+// DeleteOrphanedSegments deletes all segments of the given streams, which do
+// not belong to any object.
+//
+// The objects are checked again while deleting, using objects_stream_id_index,
+// because the streams may have been reported from a stale snapshot. A segment
+// is always created after its object, so a stream without an object stays
+// orphaned.
+func (db *DB) DeleteOrphanedSegments(ctx context.Context, opts DeleteOrphanedSegments) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(opts.StreamIDs) == 0 {
+		return 0, nil
+	}
+
+	ids := make([][]byte, len(opts.StreamIDs))
+	for i, streamID := range opts.StreamIDs {
+		if streamID.IsZero() {
+			return 0, ErrInvalidRequest.New("StreamID missing: index %d", i)
+		}
+		id := streamID
+		ids[i] = id[:]
+	}
+
+	result, err := db.db.ExecContext(ctx, `
+		DELETE FROM segments
+		WHERE
+			stream_id = ANY ($1::BYTEA[])
+			AND NOT EXISTS (
+				SELECT 1 FROM objects
+				WHERE objects.stream_id = segments.stream_id
+			)
+	`, pgutil.ByteaArray(ids))
+	if err != nil {
+		return 0, Error.New("unable to delete orphaned segments: %w", err)
+	}
+
+	deleted, err = result.RowsAffected()
+	if err != nil {
+		return 0, Error.New("unable to delete orphaned segments: %w", err)
+	}
+
+	mon.Meter("orphaned_segment_delete").Mark64(deleted)
+
+	return deleted, nil
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestIterateSegmentAliasPieces(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("empty", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var count int
+			err := db.IterateSegmentAliasPieces(ctx, metabase.IterateSegmentAliasPieces{}, func(ctx context.Context, segments []metabase.SegmentAliasPieces) error {
+				count += len(segments)
+				return nil
+			})
+			require.NoError(t, err)
+			require.Zero(t, count)
+		})
+
+		t.Run("batches", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			for i := 0; i < 3; i++ {
+				metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+			}
+
+			var segments []metabase.SegmentAliasPieces
+			err := db.IterateSegmentAliasPieces(ctx, metabase.IterateSegmentAliasPieces{
+				BatchSize: 4,
+			}, func(ctx context.Context, batch []metabase.SegmentAliasPieces) error {
+				segments = append(segments, batch...)
+				return nil
+			})
+			require.NoError(t, err)
+			require.Len(t, segments, 6)
+			for _, segment := range segments {
+				require.NotEmpty(t, segment.AliasPieces)
+			}
+		})
+
+		t.Run("start stream", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var streams []uuid.UUID
+			for i := 0; i < 3; i++ {
+				obj := metabasetest.RandObjectStream()
+				metabasetest.CreateObject(ctx, t, db, obj, 2)
+				streams = append(streams, obj.StreamID)
+			}
+			sort.Slice(streams, func(i, k int) bool { return streams[i].Less(streams[k]) })
+
+			var segments []metabase.SegmentAliasPieces
+			err := db.IterateSegmentAliasPieces(ctx, metabase.IterateSegmentAliasPieces{
+				BatchSize:     1,
+				StartStreamID: streams[1],
+			}, func(ctx context.Context, batch []metabase.SegmentAliasPieces) error {
+				segments = append(segments, batch...)
+				return nil
+			})
+			require.NoError(t, err)
+			require.Len(t, segments, 4)
+			require.Equal(t, streams[1], segments[0].StreamID)
+			require.Equal(t, streams[2], segments[3].StreamID)
+		})
+	})
+}
+
+func TestIterateZombieObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		now := time.Now()
+
+		zombie := metabasetest.RandObjectStream()
+		deadline := now.Add(-time.Hour)
+		metabasetest.BeginObjectExactVersion{
+			Opts: metabase.BeginObjectExactVersion{
+				ObjectStream:           zombie,
+				Encryption:             metabasetest.DefaultEncryption,
+				ZombieDeletionDeadline: &deadline,
+			},
+			Version: zombie.Version,
+		}.Check(ctx, t, db)
+
+		metabasetest.CreatePendingObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+		metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+		var zombies []metabase.ZombieObject
+		err := db.IterateZombieObjects(ctx, metabase.IterateZombieObjects{
+			DeadlineBefore: now,
+			BatchSize:      1,
+		}, func(ctx context.Context, batch []metabase.ZombieObject) error {
+			zombies = append(zombies, batch...)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, zombies, 1)
+		require.Equal(t, zombie, zombies[0].ObjectStream)
+	})
+}
+
+func TestDeleteOrphanedSegments(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid", func(t *testing.T) {
+			_, err := db.DeleteOrphanedSegments(ctx, metabase.DeleteOrphanedSegments{
+				StreamIDs: []uuid.UUID{{}},
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			orphan := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, db, orphan, 2)
+
+			_, err := db.UnderlyingTagSQL().ExecContext(ctx, `DELETE FROM objects WHERE stream_id = $1`, orphan.StreamID)
+			require.NoError(t, err)
+
+			object := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+			deleted, err := db.DeleteOrphanedSegments(ctx, metabase.DeleteOrphanedSegments{
+				StreamIDs: []uuid.UUID{orphan.StreamID},
+			})
+			require.NoError(t, err)
+			require.EqualValues(t, 2, deleted)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 1)
+			require.Equal(t, object.StreamID, segments[0].StreamID)
+		})
+
+		t.Run("keep segments with object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+
+			deleted, err := db.DeleteOrphanedSegments(ctx, metabase.DeleteOrphanedSegments{
+				StreamIDs: []uuid.UUID{object.StreamID},
+			})
+			require.NoError(t, err)
+			require.Zero(t, deleted)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 2)
+		})
+	})
+}
@@ -310,6 +310,14 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`ALTER TABLE segments ALTER COLUMN created_at SET NOT NULL`,
 				},
 			},
+			{
+				DB:          &db.db,
+				Description: "add index on stream_id to objects table",
+				Version:     14,
+				Action: migrate.SQL{
+					`CREATE INDEX IF NOT EXISTS objects_stream_id_index ON objects (stream_id)`,
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,274 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package dump implements a logical, portable export and import of metabase.
+//
+// A dump is a directory with a manifest and a sequence of gzip compressed
+// newline delimited JSON chunks. Every chunk contains whole objects, each
+// followed by its segments, and its SHA-256 checksum is stored in the
+// manifest. Pieces refer to node IDs, so a dump doesn't depend on the node
+// aliases of the source database; the aliases are exported separately to keep
+// their order on import.
+//
+// Both export and import are resumable: the manifest is updated after every
+// chunk written and the import progress is stored next to the manifest.
+package dump
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error is the default error class for metabase dumps.
+	Error = errs.Class("metabase dump")
+	// ErrChecksum is returned when a file doesn't match the checksum in the manifest.
+	ErrChecksum = errs.Class("metabase dump checksum mismatch")
+	// ErrVerify is returned when the imported data doesn't match the manifest.
+	ErrVerify = errs.Class("metabase dump verification failed")
+
+	mon = monkit.Package()
+)
+
+// FormatVersion is the version of the dump format written by Export.
+const FormatVersion = 1
+
+const (
+	manifestName    = "manifest.json"
+	progressName    = "import-progress.json"
+	nodeAliasesName = "node-aliases.ndjson.gz"
+)
+
+// Scope selects the objects included in a dump.
+// A zero scope includes the whole metabase.
+type Scope struct {
+	ProjectID  uuid.UUID `json:"projectId"`
+	BucketName string    `json:"bucketName"`
+}
+
+// Verify verifies the scope fields.
+func (scope Scope) Verify() error {
+	if scope.BucketName != "" && scope.ProjectID.IsZero() {
+		return Error.New("bucket requires a project")
+	}
+	return nil
+}
+
+// Contains returns whether the object belongs to the scope.
+func (scope Scope) Contains(object metabase.ObjectStream) bool {
+	if !scope.ProjectID.IsZero() && scope.ProjectID != object.ProjectID {
+		return false
+	}
+	if scope.BucketName != "" && scope.BucketName != object.BucketName {
+		return false
+	}
+	return true
+}
+
+// File describes a file of the dump.
+type File struct {
+	Name   string `json:"name"`
+	SHA256 string `json:"sha256"`
+}
+
+// Chunk describes a single chunk of objects.
+type Chunk struct {
+	File
+
+	Objects  int64 `json:"objects"`
+	Segments int64 `json:"segments"`
+
+	// Last is the last object in the chunk, export continues after it.
+	Last metabase.ObjectStream `json:"last"`
+}
+
+// Manifest describes the contents of a dump.
+type Manifest struct {
+	FormatVersion int       `json:"formatVersion"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Scope         Scope     `json:"scope"`
+
+	// AsOfSystemTime is the snapshot time of the export, a resumed export
+	// reads from the same snapshot.
+	AsOfSystemTime time.Time `json:"asOfSystemTime"`
+
+	NodeAliases *File   `json:"nodeAliases"`
+	Chunks      []Chunk `json:"chunks"`
+
+	// Complete is set when the export has finished.
+	Complete bool  `json:"complete"`
+	Objects  int64 `json:"objects"`
+	Segments int64 `json:"segments"`
+}
+
+// Record is a single line in a chunk, exactly one of the fields is set.
+type Record struct {
+	Object  *metabase.RawObject  `json:"object,omitempty"`
+	Segment *metabase.RawSegment `json:"segment,omitempty"`
+}
+
+// NodeAlias is a single line in the node aliases file.
+type NodeAlias struct {
+	NodeID storj.NodeID       `json:"nodeId"`
+	Alias  metabase.NodeAlias `json:"alias"`
+}
+
+// ReadManifest reads the manifest from the dump directory.
+func ReadManifest(dir string) (manifest Manifest, err error) {
+	err = readJSON(filepath.Join(dir, manifestName), &manifest)
+	return manifest, err
+}
+
+// chunkName returns the file name of the chunk with the specified index.
+func chunkName(index int) string {
+	return fmt.Sprintf("chunk-%06d.ndjson.gz", index)
+}
+
+// readJSON decodes a json file, it returns an error satisfying os.ErrNotExist when it's missing.
+func readJSON(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(json.Unmarshal(data, v))
+}
+
+// writeJSON atomically replaces the file with the encoded value.
+func writeJSON(path string, v interface{}) (err error) {
+	data, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	tmp := path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if _, err := file.Write(data); err != nil {
+		return Error.Wrap(errs.Combine(err, file.Close()))
+	}
+	if err := file.Sync(); err != nil {
+		return Error.Wrap(errs.Combine(err, file.Close()))
+	}
+	if err := file.Close(); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(os.Rename(tmp, path))
+}
+
+// fileWriter writes a compressed newline delimited json file and computes its checksum.
+type fileWriter struct {
+	path    string
+	file    *os.File
+	hash    hash.Hash
+	buf     *bufio.Writer
+	gzip    *gzip.Writer
+	encoder *json.Encoder
+	closed  bool
+}
+
+// createFile starts writing the file, it's only visible under name after Close.
+func createFile(dir, name string) (*fileWriter, error) {
+	path := filepath.Join(dir, name)
+	file, err := os.Create(path + ".tmp")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	w := &fileWriter{path: path, file: file}
+	w.buf = bufio.NewWriter(file)
+	w.hash = sha256.New()
+	w.gzip = gzip.NewWriter(io.MultiWriter(w.buf, w.hash))
+	w.encoder = json.NewEncoder(w.gzip)
+	return w, nil
+}
+
+// Encode writes a single line.
+func (w *fileWriter) Encode(v interface{}) error {
+	return Error.Wrap(w.encoder.Encode(v))
+}
+
+// Close flushes and syncs the file and returns its description.
+func (w *fileWriter) Close() (_ File, err error) {
+	w.closed = true
+	err = errs.Combine(w.gzip.Close(), w.buf.Flush())
+	if err == nil {
+		err = w.file.Sync()
+	}
+	err = errs.Combine(err, w.file.Close())
+	if err != nil {
+		return File{}, Error.Wrap(err)
+	}
+
+	if err := os.Rename(w.path+".tmp", w.path); err != nil {
+		return File{}, Error.Wrap(err)
+	}
+
+	return File{
+		Name:   filepath.Base(w.path),
+		SHA256: hex.EncodeToString(w.hash.Sum(nil)),
+	}, nil
+}
+
+// Abort removes the partially written file.
+func (w *fileWriter) Abort() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	return Error.Wrap(errs.Combine(w.file.Close(), os.Remove(w.path+".tmp")))
+}
+
+// verifyFile checks the checksum of the file.
+func verifyFile(dir string, file File) (err error) {
+	f, err := os.Open(filepath.Join(dir, file.Name))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(f.Close())) }()
+
+	sha := sha256.New()
+	if _, err := io.Copy(sha, f); err != nil {
+		return Error.Wrap(err)
+	}
+
+	if sum := hex.EncodeToString(sha.Sum(nil)); sum != file.SHA256 {
+		return ErrChecksum.New("%s: expected %s, got %s", file.Name, file.SHA256, sum)
+	}
+	return nil
+}
+
+// readFile decodes the lines of the file with fn.
+func readFile(dir string, file File, fn func(decoder *json.Decoder) error) (err error) {
+	f, err := os.Open(filepath.Join(dir, file.Name))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(f.Close())) }()
+
+	r, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(r.Close())) }()
+
+	return fn(json.NewDecoder(r))
+}
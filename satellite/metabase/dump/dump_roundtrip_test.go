@@ -0,0 +1,178 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package dump_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/dump"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestExportImport(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+		log := zaptest.NewLogger(t)
+
+		projectID := testrand.UUID()
+		for i := 0; i < 4; i++ {
+			obj := metabasetest.RandObjectStream()
+			obj.ProjectID = projectID
+			metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream:                  obj,
+					EncryptedMetadata:             testrand.Bytes(32),
+					EncryptedMetadataNonce:        testrand.Nonce().Bytes(),
+					EncryptedMetadataEncryptedKey: testrand.Bytes(32),
+				},
+			}.Run(ctx, t, db, obj, byte(i))
+		}
+		metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+
+		inline := metabasetest.RandObjectStream()
+		metabasetest.BeginObjectExactVersion{
+			Opts:    metabase.BeginObjectExactVersion{ObjectStream: inline, Encryption: metabasetest.DefaultEncryption},
+			Version: inline.Version,
+		}.Check(ctx, t, db)
+		metabasetest.CommitInlineSegment{
+			Opts: metabase.CommitInlineSegment{
+				ObjectStream:      inline,
+				EncryptedKey:      testrand.Bytes(32),
+				EncryptedKeyNonce: testrand.Bytes(32),
+				PlainSize:         512,
+				InlineData:        testrand.Bytes(256),
+			},
+		}.Check(ctx, t, db)
+		metabasetest.CommitObject{
+			Opts: metabase.CommitObject{ObjectStream: inline},
+		}.Check(ctx, t, db)
+
+		// pending object with a segment that has lost some of its pieces.
+		pending := metabasetest.RandObjectStream()
+		deadline := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+		metabasetest.BeginObjectExactVersion{
+			Opts: metabase.BeginObjectExactVersion{
+				ObjectStream:           pending,
+				ZombieDeletionDeadline: &deadline,
+				Encryption:             metabasetest.DefaultEncryption,
+			},
+			Version: pending.Version,
+		}.Check(ctx, t, db)
+		degraded := metabasetest.DefaultRedundancy
+		degraded.OptimalShares, degraded.TotalShares = 2, 2
+		require.NoError(t, db.CommitCopiedSegment(ctx, metabase.CommitSegment{
+			ObjectStream:      pending,
+			RootPieceID:       testrand.PieceID(),
+			Pieces:            metabase.Pieces{{Number: 1, StorageNode: testrand.NodeID()}},
+			EncryptedKey:      testrand.Bytes(32),
+			EncryptedKeyNonce: testrand.Bytes(32),
+			EncryptedSize:     1024,
+			PlainSize:         512,
+			Redundancy:        degraded,
+		}))
+
+		expected, err := db.TestingGetState(ctx)
+		require.NoError(t, err)
+
+		dir := ctx.Dir("full")
+		manifest, err := dump.Export(ctx, log, db, dir, dump.ExportOptions{ChunkSize: 2, BatchSize: 1})
+		require.NoError(t, err)
+		require.True(t, manifest.Complete)
+		require.Len(t, manifest.Chunks, 4)
+		require.EqualValues(t, len(expected.Objects), manifest.Objects)
+		require.EqualValues(t, len(expected.Segments), manifest.Segments)
+
+		// exporting again doesn't change a complete dump.
+		again, err := dump.Export(ctx, log, db, dir, dump.ExportOptions{ChunkSize: 2})
+		require.NoError(t, err)
+		require.Equal(t, manifest, again)
+
+		// a resumed export reads from the snapshot of the interrupted export.
+		interrupted := manifest
+		interrupted.Chunks = manifest.Chunks[:2]
+		interrupted.Complete, interrupted.Objects, interrupted.Segments = false, 0, 0
+		data, err := json.Marshal(interrupted)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "manifest.json"), data, 0600))
+
+		resumed, err := dump.Export(ctx, log, db, dir, dump.ExportOptions{ChunkSize: 2, AsOfSystemInterval: time.Hour})
+		require.NoError(t, err)
+		require.Equal(t, manifest, resumed)
+
+		_, err = dump.Export(ctx, log, db, dir, dump.ExportOptions{Scope: dump.Scope{ProjectID: projectID}})
+		require.Error(t, err)
+
+		projectManifest, err := dump.Export(ctx, log, db, ctx.Dir("project"), dump.ExportOptions{
+			Scope: dump.Scope{ProjectID: projectID},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 4, projectManifest.Objects)
+		require.EqualValues(t, 6, projectManifest.Segments)
+
+		require.NoError(t, db.TestingDeleteAll(ctx))
+
+		progress, err := dump.Import(ctx, log, db, dir, dump.ImportOptions{})
+		require.NoError(t, err)
+		require.Equal(t, dump.Progress{
+			NodeAliases: true,
+			Chunks:      4,
+			Objects:     manifest.Objects,
+			Segments:    manifest.Segments,
+		}, progress)
+
+		imported, err := db.TestingGetState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, withoutCreationTimes(expected), withoutCreationTimes(imported))
+
+		// an interrupted import skips the objects it has already committed.
+		require.NoError(t, os.Remove(filepath.Join(dir, "import-progress.json")))
+		progress, err = dump.Import(ctx, log, db, dir, dump.ImportOptions{})
+		require.NoError(t, err)
+		require.EqualValues(t, len(expected.Objects)-1, progress.Skipped)
+		require.EqualValues(t, 1, progress.Objects)
+
+		imported, err = db.TestingGetState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, withoutCreationTimes(expected), withoutCreationTimes(imported))
+
+		// corrupted chunks are not imported.
+		require.NoError(t, db.TestingDeleteAll(ctx))
+		require.NoError(t, os.Remove(filepath.Join(dir, "import-progress.json")))
+
+		path := filepath.Join(dir, manifest.Chunks[1].Name)
+		data, err = ioutil.ReadFile(path)
+		require.NoError(t, err)
+		data[len(data)/2] ^= 0xFF
+		require.NoError(t, ioutil.WriteFile(path, data, 0600))
+
+		progress, err = dump.Import(ctx, log, db, dir, dump.ImportOptions{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "checksum mismatch")
+		require.Equal(t, 1, progress.Chunks)
+	})
+}
+
+func withoutCreationTimes(state *metabase.RawState) *metabase.RawState {
+	result := &metabase.RawState{}
+	for _, object := range state.Objects {
+		object.CreatedAt = time.Time{}
+		result.Objects = append(result.Objects, object)
+	}
+	for _, segment := range state.Segments {
+		segment.CreatedAt = time.Time{}
+		result.Segments = append(result.Segments, segment)
+	}
+	return result
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package dump
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestFileChecksum(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir := ctx.Dir("dump")
+
+	w, err := createFile(dir, chunkName(0))
+	require.NoError(t, err)
+
+	expected := []NodeAlias{
+		{NodeID: testrand.NodeID(), Alias: 1},
+		{NodeID: testrand.NodeID(), Alias: 2},
+	}
+	for _, alias := range expected {
+		require.NoError(t, w.Encode(alias))
+	}
+	file, err := w.Close()
+	require.NoError(t, err)
+	require.Equal(t, "chunk-000000.ndjson.gz", file.Name)
+	require.NoError(t, w.Abort())
+
+	require.NoError(t, verifyFile(dir, file))
+
+	var decoded []NodeAlias
+	require.NoError(t, readFile(dir, file, func(decoder *json.Decoder) error {
+		for decoder.More() {
+			var alias NodeAlias
+			if err := decoder.Decode(&alias); err != nil {
+				return err
+			}
+			decoded = append(decoded, alias)
+		}
+		return nil
+	}))
+	require.Equal(t, expected, decoded)
+
+	path := filepath.Join(dir, file.Name)
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xFF
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+
+	err = verifyFile(dir, file)
+	require.True(t, ErrChecksum.Has(err))
+}
+
+func TestAbortFile(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir := ctx.Dir("dump")
+
+	w, err := createFile(dir, nodeAliasesName)
+	require.NoError(t, err)
+	require.NoError(t, w.Encode(NodeAlias{NodeID: testrand.NodeID(), Alias: 1}))
+	require.NoError(t, w.Abort())
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestManifest(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir := ctx.Dir("dump")
+
+	_, err := ReadManifest(dir)
+	require.Error(t, err)
+
+	manifest := Manifest{
+		FormatVersion: FormatVersion,
+		Scope:         Scope{ProjectID: testrand.UUID(), BucketName: "bucket"},
+		NodeAliases:   &File{Name: nodeAliasesName, SHA256: "00"},
+		Chunks: []Chunk{{
+			File:     File{Name: chunkName(0), SHA256: "01"},
+			Objects:  1,
+			Segments: 2,
+			Last: metabase.ObjectStream{
+				ProjectID:  testrand.UUID(),
+				BucketName: "bucket",
+				ObjectKey:  "key",
+				Version:    1,
+				StreamID:   testrand.UUID(),
+			},
+		}},
+		Complete: true,
+		Objects:  1,
+		Segments: 2,
+	}
+	require.NoError(t, writeJSON(filepath.Join(dir, manifestName), manifest))
+
+	read, err := ReadManifest(dir)
+	require.NoError(t, err)
+	require.Equal(t, manifest, read)
+}
+
+func TestScope(t *testing.T) {
+	projectID := testrand.UUID()
+	object := metabase.ObjectStream{ProjectID: projectID, BucketName: "bucket"}
+
+	require.NoError(t, Scope{}.Verify())
+	require.Error(t, Scope{BucketName: "bucket"}.Verify())
+
+	require.True(t, Scope{}.Contains(object))
+	require.True(t, Scope{ProjectID: projectID}.Contains(object))
+	require.True(t, Scope{ProjectID: projectID, BucketName: "bucket"}.Contains(object))
+	require.False(t, Scope{ProjectID: projectID, BucketName: "other"}.Contains(object))
+	require.False(t, Scope{ProjectID: testrand.UUID()}.Contains(object))
+}
@@ -0,0 +1,234 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package dump
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ExportOptions contains options for Export.
+type ExportOptions struct {
+	Scope Scope
+	// ChunkSize is the number of objects in a single chunk.
+	ChunkSize int
+	// BatchSize is the number of objects read from the database at once.
+	BatchSize int
+	// AsOfSystemInterval is used for reads on CockroachDB.
+	AsOfSystemInterval time.Duration
+}
+
+// Export writes the objects and segments in the scope to dir.
+//
+// When dir already contains an unfinished export with the same scope, the
+// export continues after the last written chunk and reads from the same
+// snapshot. On CockroachDB the snapshot must still be within the garbage
+// collection window of the database.
+func Export(ctx context.Context, log *zap.Logger, db *metabase.DB, dir string, opts ExportOptions) (manifest Manifest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Scope.Verify(); err != nil {
+		return Manifest{}, err
+	}
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = 100000
+	}
+	metabase.ListLimit.Ensure(&opts.BatchSize)
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return Manifest{}, Error.Wrap(err)
+	}
+
+	manifest, err = ReadManifest(dir)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		now := time.Now()
+		manifest = Manifest{
+			FormatVersion:  FormatVersion,
+			CreatedAt:      now.UTC(),
+			Scope:          opts.Scope,
+			AsOfSystemTime: now.Add(opts.AsOfSystemInterval).UTC(),
+		}
+	case err != nil:
+		return Manifest{}, err
+	case manifest.FormatVersion != FormatVersion:
+		return Manifest{}, Error.New("unsupported format version %d", manifest.FormatVersion)
+	case manifest.Scope != opts.Scope:
+		return Manifest{}, Error.New("dump directory contains a different scope: %+v", manifest.Scope)
+	case manifest.Complete:
+		log.Info("export already complete", zap.Int64("objects", manifest.Objects), zap.Int64("segments", manifest.Segments))
+		return manifest, nil
+	case manifest.AsOfSystemTime.IsZero():
+		return Manifest{}, Error.New("dump directory contains an export without a snapshot time")
+	default:
+		log.Info("resuming export", zap.Int("chunks", len(manifest.Chunks)), zap.Time("as of system time", manifest.AsOfSystemTime))
+	}
+
+	if manifest.NodeAliases == nil {
+		file, err := exportNodeAliases(ctx, db, dir)
+		if err != nil {
+			return Manifest{}, err
+		}
+		manifest.NodeAliases = &file
+		if err := writeJSON(filepath.Join(dir, manifestName), manifest); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	cursor := metabase.ObjectStream{
+		ProjectID:  opts.Scope.ProjectID,
+		BucketName: opts.Scope.BucketName,
+	}
+	if n := len(manifest.Chunks); n > 0 {
+		cursor = manifest.Chunks[n-1].Last
+	}
+
+	for {
+		chunk, done, err := exportChunk(ctx, db, dir, len(manifest.Chunks), cursor, manifest.AsOfSystemTime, opts)
+		if err != nil {
+			return Manifest{}, err
+		}
+		if chunk.Objects > 0 {
+			manifest.Chunks = append(manifest.Chunks, chunk)
+			cursor = chunk.Last
+
+			log.Info("exported chunk", zap.String("name", chunk.Name),
+				zap.Int64("objects", chunk.Objects), zap.Int64("segments", chunk.Segments))
+		}
+		if done {
+			break
+		}
+		if err := writeJSON(filepath.Join(dir, manifestName), manifest); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	manifest.Objects, manifest.Segments = 0, 0
+	for _, chunk := range manifest.Chunks {
+		manifest.Objects += chunk.Objects
+		manifest.Segments += chunk.Segments
+	}
+	manifest.Complete = true
+
+	if err := writeJSON(filepath.Join(dir, manifestName), manifest); err != nil {
+		return Manifest{}, err
+	}
+	return manifest, nil
+}
+
+// exportNodeAliases writes all node aliases ordered by alias.
+func exportNodeAliases(ctx context.Context, db *metabase.DB, dir string) (_ File, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	entries, err := db.ListNodeAliases(ctx)
+	if err != nil {
+		return File{}, Error.Wrap(err)
+	}
+	sort.Slice(entries, func(i, k int) bool {
+		return entries[i].Alias < entries[k].Alias
+	})
+
+	w, err := createFile(dir, nodeAliasesName)
+	if err != nil {
+		return File{}, err
+	}
+	for _, entry := range entries {
+		if err := w.Encode(NodeAlias{NodeID: entry.ID, Alias: entry.Alias}); err != nil {
+			return File{}, errs.Combine(err, w.Abort())
+		}
+	}
+	return w.Close()
+}
+
+// exportChunk writes up to opts.ChunkSize objects after cursor into a new chunk.
+// done is set when there are no more objects to export.
+func exportChunk(ctx context.Context, db *metabase.DB, dir string, index int, cursor metabase.ObjectStream, asOfSystemTime time.Time, opts ExportOptions) (chunk Chunk, done bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	w, err := createFile(dir, chunkName(index))
+	if err != nil {
+		return Chunk{}, false, err
+	}
+	defer func() {
+		if err != nil || chunk.Objects == 0 {
+			err = errs.Combine(err, w.Abort())
+		}
+	}()
+
+	for chunk.Objects < int64(opts.ChunkSize) {
+		limit := opts.BatchSize
+		if remaining := int64(opts.ChunkSize) - chunk.Objects; remaining < int64(limit) {
+			limit = int(remaining)
+		}
+
+		objects, err := db.ListRawObjects(ctx, metabase.ListRawObjects{
+			ProjectID:      opts.Scope.ProjectID,
+			BucketName:     opts.Scope.BucketName,
+			Cursor:         cursor,
+			Limit:          limit,
+			AsOfSystemTime: asOfSystemTime,
+		})
+		if err != nil {
+			return Chunk{}, false, Error.Wrap(err)
+		}
+
+		streamIDs := make([]uuid.UUID, len(objects))
+		for i := range objects {
+			streamIDs[i] = objects[i].StreamID
+		}
+		segments, err := db.ListRawSegments(ctx, metabase.ListRawSegments{
+			StreamIDs:      streamIDs,
+			AsOfSystemTime: asOfSystemTime,
+		})
+		if err != nil {
+			return Chunk{}, false, Error.Wrap(err)
+		}
+
+		streamSegments := make(map[uuid.UUID][]metabase.RawSegment, len(objects))
+		for _, segment := range segments {
+			streamSegments[segment.StreamID] = append(streamSegments[segment.StreamID], segment)
+		}
+
+		for i := range objects {
+			object := &objects[i]
+			if err := w.Encode(Record{Object: object}); err != nil {
+				return Chunk{}, false, err
+			}
+			for k := range streamSegments[object.StreamID] {
+				if err := w.Encode(Record{Segment: &streamSegments[object.StreamID][k]}); err != nil {
+					return Chunk{}, false, err
+				}
+				chunk.Segments++
+			}
+			chunk.Objects++
+			chunk.Last = object.ObjectStream
+			cursor = object.ObjectStream
+		}
+
+		if len(objects) < limit {
+			done = true
+			break
+		}
+	}
+
+	if chunk.Objects == 0 {
+		return Chunk{}, true, nil
+	}
+
+	chunk.File, err = w.Close()
+	if err != nil {
+		return Chunk{}, false, err
+	}
+	return chunk, done, nil
+}
@@ -0,0 +1,360 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package dump
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// nodeAliasBatchSize is the number of node aliases inserted at once.
+const nodeAliasBatchSize = 1000
+
+// ImportOptions contains options for Import.
+type ImportOptions struct {
+	// SkipVerify skips counting the objects and segments in the scope after the import.
+	// It should be used when the destination already contained objects in the scope.
+	SkipVerify bool
+}
+
+// Progress is the state of an import, it's stored in the dump directory.
+type Progress struct {
+	NodeAliases bool `json:"nodeAliases"`
+	// Chunks is the number of fully imported chunks.
+	Chunks int `json:"chunks"`
+
+	Objects  int64 `json:"objects"`
+	Segments int64 `json:"segments"`
+	// Skipped is the number of objects that were already committed.
+	Skipped int64 `json:"skipped"`
+}
+
+// Import imports the dump in dir into db.
+//
+// Objects and segments are inserted using the same methods as uploads, so
+// their creation times are set at import time and segment repair times are
+// not preserved. Chunk checksums are verified before a chunk is imported.
+// An interrupted import continues with the first chunk that wasn't finished.
+func Import(ctx context.Context, log *zap.Logger, db *metabase.DB, dir string, opts ImportOptions) (progress Progress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		return Progress{}, err
+	}
+	switch {
+	case manifest.FormatVersion != FormatVersion:
+		return Progress{}, Error.New("unsupported format version %d", manifest.FormatVersion)
+	case !manifest.Complete:
+		return Progress{}, Error.New("export is not complete")
+	case manifest.NodeAliases == nil:
+		return Progress{}, Error.New("node aliases missing from manifest")
+	}
+
+	progressPath := filepath.Join(dir, progressName)
+	err = readJSON(progressPath, &progress)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return Progress{}, err
+	default:
+		log.Info("resuming import", zap.Int("chunks", progress.Chunks))
+	}
+
+	if !progress.NodeAliases {
+		if err := importNodeAliases(ctx, db, dir, *manifest.NodeAliases); err != nil {
+			return progress, err
+		}
+		progress.NodeAliases = true
+		if err := writeJSON(progressPath, progress); err != nil {
+			return progress, err
+		}
+	}
+
+	for progress.Chunks < len(manifest.Chunks) {
+		chunk := manifest.Chunks[progress.Chunks]
+
+		imported, err := importChunk(ctx, db, dir, manifest.Scope, chunk)
+		if err != nil {
+			return progress, Error.New("%s: %w", chunk.Name, err)
+		}
+
+		progress.Chunks++
+		progress.Objects += imported.Objects
+		progress.Segments += imported.Segments
+		progress.Skipped += imported.Skipped
+		if err := writeJSON(progressPath, progress); err != nil {
+			return progress, err
+		}
+
+		log.Info("imported chunk", zap.String("name", chunk.Name),
+			zap.Int64("objects", imported.Objects), zap.Int64("segments", imported.Segments),
+			zap.Int64("skipped", imported.Skipped))
+	}
+
+	if opts.SkipVerify {
+		return progress, nil
+	}
+	return progress, verifyCounts(ctx, db, manifest)
+}
+
+// importNodeAliases creates the node aliases in the same order as the source database.
+func importNodeAliases(ctx context.Context, db *metabase.DB, dir string, file File) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := verifyFile(dir, file); err != nil {
+		return err
+	}
+
+	var nodes []storj.NodeID
+	err = readFile(dir, file, func(decoder *json.Decoder) error {
+		var last metabase.NodeAlias
+		for decoder.More() {
+			var alias NodeAlias
+			if err := decoder.Decode(&alias); err != nil {
+				return Error.Wrap(err)
+			}
+			if alias.Alias <= last {
+				return Error.New("node aliases are not ordered")
+			}
+			last = alias.Alias
+			nodes = append(nodes, alias.NodeID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for len(nodes) > 0 {
+		batch := nodes
+		if len(batch) > nodeAliasBatchSize {
+			batch = batch[:nodeAliasBatchSize]
+		}
+		nodes = nodes[len(batch):]
+
+		if err := db.EnsureNodeAliases(ctx, metabase.EnsureNodeAliases{Nodes: batch}); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// importChunk imports all objects in a single chunk.
+func importChunk(ctx context.Context, db *metabase.DB, dir string, scope Scope, chunk Chunk) (imported Progress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := verifyFile(dir, chunk.File); err != nil {
+		return Progress{}, err
+	}
+
+	var object *metabase.RawObject
+	var segments []metabase.RawSegment
+
+	flush := func() error {
+		if object == nil {
+			return nil
+		}
+		skipped, err := importObject(ctx, db, *object, segments)
+		if err != nil {
+			return Error.New("object %s: %w", object.StreamID, err)
+		}
+		if skipped {
+			imported.Skipped++
+		} else {
+			imported.Objects++
+			imported.Segments += int64(len(segments))
+		}
+		object, segments = nil, nil
+		return nil
+	}
+
+	err = readFile(dir, chunk.File, func(decoder *json.Decoder) error {
+		for decoder.More() {
+			var record Record
+			if err := decoder.Decode(&record); err != nil {
+				return Error.Wrap(err)
+			}
+
+			switch {
+			case record.Object != nil:
+				if err := flush(); err != nil {
+					return err
+				}
+				if !scope.Contains(record.Object.ObjectStream) {
+					return Error.New("object %s is outside of the dump scope", record.Object.StreamID)
+				}
+				object = record.Object
+			case record.Segment != nil:
+				if object == nil || record.Segment.StreamID != object.StreamID {
+					return Error.New("segment %s/%d doesn't follow its object", record.Segment.StreamID, record.Segment.Position.Encode())
+				}
+				segments = append(segments, *record.Segment)
+			default:
+				return Error.New("empty record")
+			}
+		}
+		return flush()
+	})
+	if err != nil {
+		return Progress{}, err
+	}
+
+	if imported.Objects+imported.Skipped != chunk.Objects {
+		return Progress{}, ErrVerify.New("expected %d objects, got %d", chunk.Objects, imported.Objects+imported.Skipped)
+	}
+	return imported, nil
+}
+
+// importObject inserts a single object with its segments.
+//
+// skipped is set when the object was already committed by an earlier
+// attempt. A pending object left by an earlier attempt is replaced.
+func importObject(ctx context.Context, db *metabase.DB, object metabase.RawObject, segments []metabase.RawSegment) (skipped bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	begin := metabase.BeginObjectExactVersion{
+		ObjectStream:           object.ObjectStream,
+		ExpiresAt:              object.ExpiresAt,
+		ZombieDeletionDeadline: object.ZombieDeletionDeadline,
+		Encryption:             object.Encryption,
+	}
+
+	_, err = db.BeginObjectExactVersion(ctx, begin)
+	if metabase.ErrConflict.Has(err) {
+		existing, getErr := db.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+			ObjectLocation: object.Location(),
+			Version:        object.Version,
+		})
+		switch {
+		case getErr == nil && existing.StreamID == object.StreamID:
+			return true, nil
+		case getErr != nil && !storj.ErrObjectNotFound.Has(getErr):
+			return false, getErr
+		}
+
+		_, deleteErr := db.DeletePendingObject(ctx, metabase.DeletePendingObject{
+			ObjectStream: object.ObjectStream,
+		})
+		if deleteErr != nil {
+			if storj.ErrObjectNotFound.Has(deleteErr) {
+				return false, err
+			}
+			return false, deleteErr
+		}
+
+		_, err = db.BeginObjectExactVersion(ctx, begin)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, segment := range segments {
+		if metabase.Segment(segment).Inline() {
+			err = db.CommitInlineSegment(ctx, metabase.CommitInlineSegment{
+				ObjectStream:      object.ObjectStream,
+				Position:          segment.Position,
+				ExpiresAt:         segment.ExpiresAt,
+				EncryptedKeyNonce: segment.EncryptedKeyNonce,
+				EncryptedKey:      segment.EncryptedKey,
+				PlainOffset:       segment.PlainOffset,
+				PlainSize:         segment.PlainSize,
+				EncryptedETag:     segment.EncryptedETag,
+				InlineData:        segment.InlineData,
+			})
+		} else {
+			err = db.CommitCopiedSegment(ctx, metabase.CommitSegment{
+				ObjectStream:      object.ObjectStream,
+				Position:          segment.Position,
+				RootPieceID:       segment.RootPieceID,
+				ExpiresAt:         segment.ExpiresAt,
+				EncryptedKeyNonce: segment.EncryptedKeyNonce,
+				EncryptedKey:      segment.EncryptedKey,
+				PlainOffset:       segment.PlainOffset,
+				PlainSize:         segment.PlainSize,
+				EncryptedSize:     segment.EncryptedSize,
+				EncryptedETag:     segment.EncryptedETag,
+				Redundancy:        segment.Redundancy,
+				Pieces:            segment.Pieces,
+			})
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if object.Status != metabase.Committed {
+		return false, nil
+	}
+
+	committed, err := db.CommitObject(ctx, metabase.CommitObject{
+		ObjectStream:                  object.ObjectStream,
+		Encryption:                    object.Encryption,
+		EncryptedMetadata:             object.EncryptedMetadata,
+		EncryptedMetadataNonce:        object.EncryptedMetadataNonce,
+		EncryptedMetadataEncryptedKey: object.EncryptedMetadataEncryptedKey,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if committed.SegmentCount != object.SegmentCount || committed.TotalEncryptedSize != object.TotalEncryptedSize {
+		return false, ErrVerify.New("expected %d segments of %d bytes, got %d segments of %d bytes",
+			object.SegmentCount, object.TotalEncryptedSize, committed.SegmentCount, committed.TotalEncryptedSize)
+	}
+	return false, nil
+}
+
+// verifyCounts checks that the scope contains as many objects and segments as the manifest.
+func verifyCounts(ctx context.Context, db *metabase.DB, manifest Manifest) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var objects, segments int64
+	cursor := metabase.ObjectStream{
+		ProjectID:  manifest.Scope.ProjectID,
+		BucketName: manifest.Scope.BucketName,
+	}
+	for {
+		batch, err := db.ListRawObjects(ctx, metabase.ListRawObjects{
+			ProjectID:  manifest.Scope.ProjectID,
+			BucketName: manifest.Scope.BucketName,
+			Cursor:     cursor,
+			Limit:      metabase.ListLimit.Max(),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		streamIDs := make([]uuid.UUID, len(batch))
+		for i := range batch {
+			streamIDs[i] = batch[i].StreamID
+		}
+		rawSegments, err := db.ListRawSegments(ctx, metabase.ListRawSegments{StreamIDs: streamIDs})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		objects += int64(len(batch))
+		segments += int64(len(rawSegments))
+		cursor = batch[len(batch)-1].ObjectStream
+	}
+
+	if objects != manifest.Objects || segments != manifest.Segments {
+		return ErrVerify.New("expected %d objects and %d segments, found %d objects and %d segments",
+			manifest.Objects, manifest.Segments, objects, segments)
+	}
+	return nil
+}
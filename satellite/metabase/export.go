@@ -0,0 +1,203 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// ListRawObjects contains arguments necessary for listing raw objects.
+type ListRawObjects struct {
+	// ProjectID limits the listing to a single project when set.
+	ProjectID uuid.UUID
+	// BucketName limits the listing to a single bucket, it requires ProjectID.
+	BucketName string
+
+	// Cursor is exclusive, objects are listed after it. A cursor before
+	// the requested project or bucket starts the listing at it.
+	Cursor ObjectStream
+	Limit  int
+
+	AsOfSystemTime time.Time
+}
+
+// Verify verifies list raw objects request fields.
+func (opts *ListRawObjects) Verify() error {
+	switch {
+	case opts.BucketName != "" && opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.Limit < 0:
+		return ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+	return nil
+}
+
+// ListRawObjects lists objects with all their fields, ordered by location and version.
+func (db *DB) ListRawObjects(ctx context.Context, opts ListRawObjects) (objects []RawObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+	ListLimit.Ensure(&opts.Limit)
+
+	// start the listing at the requested project or bucket, so that it doesn't
+	// scan the objects before it.
+	cursor := opts.Cursor
+	if !opts.ProjectID.IsZero() {
+		first := ObjectStream{ProjectID: opts.ProjectID, BucketName: opts.BucketName}
+		switch cmp := cursor.ProjectID.Compare(opts.ProjectID); {
+		case cmp < 0:
+			cursor = first
+		case cmp > 0:
+			return nil, nil
+		case opts.BucketName != "" && cursor.BucketName < opts.BucketName:
+			cursor = first
+		case opts.BucketName != "" && cursor.BucketName > opts.BucketName:
+			return nil, nil
+		}
+	}
+
+	args := []interface{}{
+		cursor.ProjectID, []byte(cursor.BucketName), []byte(cursor.ObjectKey), cursor.Version,
+		opts.Limit,
+	}
+
+	// the listing stops once it passes the requested project or bucket.
+	var scope string
+	switch {
+	case opts.BucketName != "":
+		scope = `AND project_id = $6 AND bucket_name = $7`
+		args = append(args, opts.ProjectID, []byte(opts.BucketName))
+	case !opts.ProjectID.IsZero():
+		scope = `AND project_id = $6`
+		args = append(args, opts.ProjectID)
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			project_id, bucket_name, object_key, version, stream_id,
+			created_at, expires_at,
+			status, segment_count,
+			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+			total_plain_size, total_encrypted_size, fixed_segment_size,
+			encryption,
+			zombie_deletion_deadline
+		FROM objects
+		`+db.impl.AsOfSystemTime(opts.AsOfSystemTime)+`
+		WHERE
+			(project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
+			`+scope+`
+		ORDER BY project_id ASC, bucket_name ASC, object_key ASC, version ASC
+		LIMIT $5
+	`, args...))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var object RawObject
+			err := rows.Scan(
+				&object.ProjectID, &object.BucketName, &object.ObjectKey, &object.Version, &object.StreamID,
+				&object.CreatedAt, &object.ExpiresAt,
+				&object.Status, &object.SegmentCount,
+				&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
+				&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+				encryptionParameters{&object.Encryption},
+				&object.ZombieDeletionDeadline,
+			)
+			if err != nil {
+				return err
+			}
+			objects = append(objects, object)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list raw objects: %w", err)
+	}
+
+	return objects, nil
+}
+
+// ListRawSegments contains arguments necessary for listing raw segments.
+type ListRawSegments struct {
+	StreamIDs []uuid.UUID
+
+	AsOfSystemTime time.Time
+}
+
+// ListRawSegments lists the segments of the streams with all their fields, ordered by stream and position.
+func (db *DB) ListRawSegments(ctx context.Context, opts ListRawSegments) (segments []RawSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(opts.StreamIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([][]byte, len(opts.StreamIDs))
+	for i, streamID := range opts.StreamIDs {
+		if streamID.IsZero() {
+			return nil, ErrInvalidRequest.New("StreamID missing: index %d", i)
+		}
+		id := streamID
+		ids[i] = id[:]
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			stream_id, position,
+			created_at, repaired_at, expires_at,
+			root_piece_id, encrypted_key_nonce, encrypted_key,
+			encrypted_size,
+			plain_offset, plain_size,
+			encrypted_etag,
+			redundancy,
+			inline_data, remote_alias_pieces
+		FROM segments
+		`+db.impl.AsOfSystemTime(opts.AsOfSystemTime)+`
+		WHERE stream_id = ANY ($1::BYTEA[])
+		ORDER BY stream_id ASC, position ASC
+	`, pgutil.ByteaArray(ids)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var segment RawSegment
+			var aliasPieces AliasPieces
+			err := rows.Scan(
+				&segment.StreamID, &segment.Position,
+				&segment.CreatedAt, &segment.RepairedAt, &segment.ExpiresAt,
+				&segment.RootPieceID, &segment.EncryptedKeyNonce, &segment.EncryptedKey,
+				&segment.EncryptedSize,
+				&segment.PlainOffset, &segment.PlainSize,
+				&segment.EncryptedETag,
+				redundancyScheme{&segment.Redundancy},
+				&segment.InlineData, &aliasPieces,
+			)
+			if err != nil {
+				return err
+			}
+
+			segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+			if err != nil {
+				return Error.New("failed to convert aliases to pieces: %w", err)
+			}
+
+			segments = append(segments, segment)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list raw segments: %w", err)
+	}
+
+	return segments, nil
+}
+
+// CommitCopiedSegment commits a segment imported from a metabase dump, it must be used only by the import.
+// Unlike CommitSegment, it accepts fewer pieces than the optimal shares, the segment may have lost pieces.
+func (db *DB) CommitCopiedSegment(ctx context.Context, opts CommitSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.commitSegment(ctx, opts, true)
+}
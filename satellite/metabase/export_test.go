@@ -0,0 +1,169 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestListRawObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_, err := db.ListRawObjects(ctx, metabase.ListRawObjects{BucketName: "bucket"})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+			_, err = db.ListRawObjects(ctx, metabase.ListRawObjects{Limit: -1})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("scope and cursor", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			projectID := testrand.UUID()
+			var expected []metabase.ObjectStream
+			for _, bucketName := range []string{"alpha", "beta"} {
+				for i := 0; i < 3; i++ {
+					obj := metabasetest.RandObjectStream()
+					obj.ProjectID = projectID
+					obj.BucketName = bucketName
+					metabasetest.CreateObject(ctx, t, db, obj, 1)
+					if bucketName == "beta" {
+						expected = append(expected, obj)
+					}
+				}
+			}
+			// object in another project must not be listed.
+			metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+			sort.Slice(expected, func(i, k int) bool {
+				return expected[i].ObjectKey < expected[k].ObjectKey
+			})
+
+			var listed []metabase.ObjectStream
+			var cursor metabase.ObjectStream
+			for {
+				objects, err := db.ListRawObjects(ctx, metabase.ListRawObjects{
+					ProjectID:  projectID,
+					BucketName: "beta",
+					Cursor:     cursor,
+					Limit:      2,
+				})
+				require.NoError(t, err)
+				if len(objects) == 0 {
+					break
+				}
+				for _, object := range objects {
+					require.Equal(t, metabase.Committed, object.Status)
+					require.EqualValues(t, 1, object.SegmentCount)
+					listed = append(listed, object.ObjectStream)
+				}
+				cursor = objects[len(objects)-1].ObjectStream
+			}
+			require.Equal(t, expected, listed)
+
+			// cursor past the bucket.
+			objects, err := db.ListRawObjects(ctx, metabase.ListRawObjects{
+				ProjectID:  projectID,
+				BucketName: "beta",
+				Cursor:     metabase.ObjectStream{ProjectID: projectID, BucketName: "gamma"},
+			})
+			require.NoError(t, err)
+			require.Empty(t, objects)
+
+			project, err := db.ListRawObjects(ctx, metabase.ListRawObjects{ProjectID: projectID})
+			require.NoError(t, err)
+			require.Len(t, project, 6)
+
+			all, err := db.ListRawObjects(ctx, metabase.ListRawObjects{})
+			require.NoError(t, err)
+			require.Len(t, all, 7)
+		})
+	})
+}
+
+func TestListRawSegments(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+		segments, err := db.ListRawSegments(ctx, metabase.ListRawSegments{})
+		require.NoError(t, err)
+		require.Empty(t, segments)
+
+		_, err = db.ListRawSegments(ctx, metabase.ListRawSegments{StreamIDs: []uuid.UUID{{}}})
+		require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+		first := metabasetest.RandObjectStream()
+		second := metabasetest.RandObjectStream()
+		metabasetest.CreateObject(ctx, t, db, first, 3)
+		metabasetest.CreateObject(ctx, t, db, second, 2)
+		metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+		segments, err = db.ListRawSegments(ctx, metabase.ListRawSegments{
+			StreamIDs: []uuid.UUID{first.StreamID, second.StreamID},
+		})
+		require.NoError(t, err)
+		require.Len(t, segments, 5)
+
+		state, err := db.TestingGetState(ctx)
+		require.NoError(t, err)
+
+		var expected []metabase.RawSegment
+		for _, segment := range state.Segments {
+			if segment.StreamID == first.StreamID || segment.StreamID == second.StreamID {
+				expected = append(expected, segment)
+			}
+		}
+		require.Equal(t, expected, segments)
+	})
+}
+
+func TestCommitCopiedSegment(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+		obj := metabasetest.RandObjectStream()
+		metabasetest.BeginObjectExactVersion{
+			Opts: metabase.BeginObjectExactVersion{
+				ObjectStream: obj,
+				Encryption:   metabasetest.DefaultEncryption,
+			},
+			Version: obj.Version,
+		}.Check(ctx, t, db)
+
+		redundancy := metabasetest.DefaultRedundancy
+		redundancy.OptimalShares, redundancy.TotalShares = 2, 2
+
+		opts := metabase.CommitSegment{
+			ObjectStream:      obj,
+			RootPieceID:       testrand.PieceID(),
+			Pieces:            metabase.Pieces{{Number: 1, StorageNode: testrand.NodeID()}},
+			EncryptedKey:      testrand.Bytes(32),
+			EncryptedKeyNonce: testrand.Bytes(32),
+			EncryptedSize:     1024,
+			PlainSize:         512,
+			Redundancy:        redundancy,
+		}
+
+		err := db.CommitSegment(ctx, opts)
+		require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+		require.NoError(t, db.CommitCopiedSegment(ctx, opts))
+
+		segments, err := db.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Len(t, segments[0].Pieces, 1)
+	})
+}
@@ -347,6 +347,12 @@ type IterateLoopSegments struct {
 	BatchSize          int
 	AsOfSystemTime     time.Time
 	AsOfSystemInterval time.Duration
+
+	// StartStreamID is the first stream included in the iteration.
+	StartStreamID uuid.UUID
+	// EndStreamID is the stream where the iteration stops, it's not included.
+	// Zero value means iterating until the last stream.
+	EndStreamID uuid.UUID
 }
 
 // Verify verifies segments request fields.
@@ -354,6 +360,9 @@ func (opts *IterateLoopSegments) Verify() error {
 	if opts.BatchSize < 0 {
 		return ErrInvalidRequest.New("BatchSize is negative")
 	}
+	if !opts.EndStreamID.IsZero() && opts.EndStreamID.Compare(opts.StartStreamID) <= 0 {
+		return ErrInvalidRequest.New("EndStreamID must be after StartStreamID")
+	}
 	return nil
 }
 
@@ -372,6 +381,9 @@ func (db *DB) IterateLoopSegments(ctx context.Context, opts IterateLoopSegments,
 		asOfSystemInterval: opts.AsOfSystemInterval,
 		batchSize:          opts.BatchSize,
 
+		startStreamID: opts.StartStreamID,
+		endStreamID:   opts.EndStreamID,
+
 		curIndex: 0,
 		cursor:   loopSegmentIteratorCursor{},
 	}
@@ -404,6 +416,9 @@ type loopSegmentIterator struct {
 	asOfSystemTime     time.Time
 	asOfSystemInterval time.Duration
 
+	startStreamID uuid.UUID
+	endStreamID   uuid.UUID
+
 	curIndex int
 	curRows  tagsql.Rows
 	cursor   loopSegmentIteratorCursor
@@ -463,6 +478,11 @@ func (it *loopSegmentIterator) Next(ctx context.Context, item *LoopSegmentEntry)
 func (it *loopSegmentIterator) doNextQuery(ctx context.Context) (_ tagsql.Rows, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	var endStreamID []byte
+	if !it.endStreamID.IsZero() {
+		endStreamID = it.endStreamID[:]
+	}
+
 	return it.db.db.QueryContext(ctx, `
 		SELECT
 			stream_id, position,
@@ -476,10 +496,13 @@ func (it *loopSegmentIterator) doNextQuery(ctx context.Context) (_ tagsql.Rows,
 		`+it.db.asOfTime(it.asOfSystemTime, it.asOfSystemInterval)+`
 		WHERE
 			(stream_id, position) > ($1, $2)
+			AND stream_id >= $4
+			AND ($5::BYTEA IS NULL OR stream_id < $5::BYTEA)
 		ORDER BY (stream_id, position) ASC
 		LIMIT $3
 		`, it.cursor.StreamID, it.cursor.Position,
 		it.batchSize,
+		it.startStreamID, endStreamID,
 	)
 }
 
@@ -0,0 +1,74 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentloop
+
+import (
+	"fmt"
+	"math/big"
+
+	"storj.io/common/uuid"
+)
+
+// Range is a range of stream IDs iterated by the segments loop.
+//
+// Start is inclusive and End is exclusive. Zero End means the range
+// continues until the last stream ID.
+type Range struct {
+	Start uuid.UUID
+	End   uuid.UUID
+}
+
+// FullRange returns the range containing all stream IDs.
+func FullRange() Range { return Range{} }
+
+// IsFull returns whether the range contains all stream IDs.
+func (r Range) IsFull() bool { return r.Start.IsZero() && r.End.IsZero() }
+
+// String returns a human readable form of the range.
+func (r Range) String() string {
+	end := "end"
+	if !r.End.IsZero() {
+		end = r.End.String()
+	}
+	return fmt.Sprintf("[%s, %s)", r.Start, end)
+}
+
+// Split splits the range into n ranges of approximately equal size.
+func (r Range) Split(n int) []Range {
+	if n <= 1 {
+		return []Range{r}
+	}
+
+	start := new(big.Int).SetBytes(r.Start[:])
+	end := new(big.Int).SetBytes(r.End[:])
+	if r.End.IsZero() {
+		end.Lsh(big.NewInt(1), uint(8*len(r.End)))
+	}
+
+	width := new(big.Int).Sub(end, start)
+	if width.Cmp(big.NewInt(int64(n))) < 0 {
+		n = int(width.Int64())
+	}
+	step := width.Div(width, big.NewInt(int64(n)))
+
+	ranges := make([]Range, n)
+	position := start
+	for i := range ranges {
+		ranges[i].Start = bigToUUID(position)
+		position = new(big.Int).Add(position, step)
+		if i < n-1 {
+			ranges[i].End = bigToUUID(position)
+		} else {
+			ranges[i].End = r.End
+		}
+	}
+	return ranges
+}
+
+// bigToUUID converts the integer into a big-endian stream ID.
+func bigToUUID(v *big.Int) (id uuid.UUID) {
+	b := v.Bytes()
+	copy(id[len(id)-len(b):], b)
+	return id
+}
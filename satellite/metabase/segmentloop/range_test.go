@@ -0,0 +1,70 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentloop_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+func TestRangeSplit(t *testing.T) {
+	full := segmentloop.FullRange()
+	require.True(t, full.IsFull())
+	require.Equal(t, []segmentloop.Range{full}, full.Split(1))
+
+	ranges := full.Split(4)
+	require.Equal(t, []segmentloop.Range{
+		{Start: uuid.UUID{}, End: uuid.UUID{0x40}},
+		{Start: uuid.UUID{0x40}, End: uuid.UUID{0x80}},
+		{Start: uuid.UUID{0x80}, End: uuid.UUID{0xC0}},
+		{Start: uuid.UUID{0xC0}, End: uuid.UUID{}},
+	}, ranges)
+
+	sub := ranges[1].Split(2)
+	require.Equal(t, []segmentloop.Range{
+		{Start: uuid.UUID{0x40}, End: uuid.UUID{0x60}},
+		{Start: uuid.UUID{0x60}, End: uuid.UUID{0x80}},
+	}, sub)
+
+	// every stream ID belongs to exactly one range.
+	for _, n := range []int{2, 3, 7, 16} {
+		ranges := full.Split(n)
+		require.Len(t, ranges, n)
+		for i := 0; i < 100; i++ {
+			streamID := testrand.UUID()
+			matches := 0
+			for _, r := range ranges {
+				if streamID.Compare(r.Start) >= 0 && (r.End.IsZero() || streamID.Compare(r.End) < 0) {
+					matches++
+				}
+			}
+			require.Equal(t, 1, matches)
+		}
+	}
+
+	// a range can't be split into more ranges than it contains stream IDs.
+	small := segmentloop.Range{Start: uuid.UUID{15: 1}, End: uuid.UUID{15: 3}}
+	require.Len(t, small.Split(5), 2)
+}
+
+func TestConfigRanges(t *testing.T) {
+	ranges, err := segmentloop.Config{}.Ranges()
+	require.NoError(t, err)
+	require.Equal(t, []segmentloop.Range{segmentloop.FullRange()}, ranges)
+
+	ranges, err = segmentloop.Config{Parallelism: 2, PartitionCount: 2, PartitionIndex: 1}.Ranges()
+	require.NoError(t, err)
+	require.Equal(t, []segmentloop.Range{
+		{Start: uuid.UUID{0x80}, End: uuid.UUID{0xC0}},
+		{Start: uuid.UUID{0xC0}, End: uuid.UUID{}},
+	}, ranges)
+
+	_, err = segmentloop.Config{PartitionCount: 2, PartitionIndex: 2}.Ranges()
+	require.Error(t, err)
+}
@@ -7,11 +7,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
 
 	"storj.io/common/errs2"
@@ -51,6 +54,28 @@ type Observer interface {
 	InlineSegment(context.Context, *Segment) error
 }
 
+// Partial is an observer of a single range of stream IDs.
+type Partial interface {
+	RemoteSegment(context.Context, *Segment) error
+	InlineSegment(context.Context, *Segment) error
+}
+
+// ParallelObserver is an observer, which can process ranges of stream IDs concurrently.
+//
+// When the loop iterates multiple ranges, Fork is called once for every range
+// after LoopStarted and the returned partial receives the segments of that
+// range. After all ranges have been iterated, every partial is passed to Join.
+// Fork and Join are never called concurrently.
+//
+// Observers, which don't implement this interface, receive segments of all
+// ranges serially. Segments of a single stream are still ordered by position,
+// however segments of different ranges are interleaved.
+type ParallelObserver interface {
+	Observer
+	Fork(context.Context) (Partial, error)
+	Join(context.Context, Partial) error
+}
+
 // LoopInfo contains information about the current loop.
 type LoopInfo struct {
 	Started time.Time
@@ -82,6 +107,11 @@ type observerContext struct {
 	ctx  context.Context
 	done chan error
 
+	finishOnce sync.Once
+	finished   int32
+
+	// mu protects the distributions when ranges are iterated concurrently.
+	mu     sync.Mutex
 	remote *monkit.DurationDist
 	inline *monkit.DurationDist
 }
@@ -117,14 +147,29 @@ func (observer *observerContext) InlineSegment(ctx context.Context, segment *Seg
 
 func (observer *observerContext) HandleError(err error) bool {
 	if err != nil {
-		observer.done <- err
-		observer.Finish()
+		observer.finishOnce.Do(func() {
+			atomic.StoreInt32(&observer.finished, 1)
+			observer.done <- err
+			observer.finish()
+		})
 		return true
 	}
 	return false
 }
 
 func (observer *observerContext) Finish() {
+	observer.finishOnce.Do(func() {
+		atomic.StoreInt32(&observer.finished, 1)
+		observer.finish()
+	})
+}
+
+// Finished returns whether the observer has already finished, e.g. due to an error.
+func (observer *observerContext) Finished() bool {
+	return atomic.LoadInt32(&observer.finished) != 0
+}
+
+func (observer *observerContext) finish() {
 	close(observer.done)
 
 	name := fmt.Sprintf("%T", observer.observer)
@@ -145,6 +190,31 @@ type Config struct {
 	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
 
 	SuspiciousProcessedRatio float64 `help:"ratio where to consider processed count as supicious" default:"0.03"`
+
+	Parallelism    int `help:"number of stream ID ranges iterated concurrently" default:"1"`
+	PartitionCount int `help:"number of processes splitting the stream ID keyspace between them, garbage collection, node tally and consistency checks require a single partition" default:"1"`
+	PartitionIndex int `help:"index of the stream ID keyspace partition iterated by this process" default:"0"`
+}
+
+// Partition returns the stream ID range of the partition iterated by this process.
+func (config Config) Partition() (Range, error) {
+	partitionCount := config.PartitionCount
+	if partitionCount <= 0 {
+		partitionCount = 1
+	}
+	if config.PartitionIndex < 0 || config.PartitionIndex >= partitionCount {
+		return Range{}, Error.New("partition index %d out of range [0, %d)", config.PartitionIndex, partitionCount)
+	}
+	return FullRange().Split(partitionCount)[config.PartitionIndex], nil
+}
+
+// Ranges returns the stream ID ranges iterated by this process.
+func (config Config) Ranges() ([]Range, error) {
+	partition, err := config.Partition()
+	if err != nil {
+		return nil, err
+	}
+	return partition.Split(config.Parallelism), nil
 }
 
 // MetabaseDB contains iterators for the metabase data.
@@ -180,6 +250,13 @@ func New(log *zap.Logger, config Config, metabaseDB MetabaseDB) *Service {
 	}
 }
 
+// Partitioned returns whether the loop iterates only a partition of the
+// stream ID keyspace. Observers, which need all segments, must not join a
+// partitioned loop.
+func (loop *Service) Partitioned() bool {
+	return loop.config.PartitionCount > 1
+}
+
 // Join will join the looper for one full cycle until completion and then returns.
 // Joining will trigger a new iteration after coalesce duration.
 // On ctx cancel the observer will return without completely finishing.
@@ -349,9 +426,18 @@ func (loop *Service) iterateDatabase(ctx context.Context, observers []*observerC
 		finishObservers(observers)
 	}()
 
-	before, err := loop.metabaseDB.GetTableStats(ctx, metabase.GetTableStats{
+	// only the segments of the partition are verified.
+	partition, err := loop.config.Partition()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	stats := metabase.GetTableStats{
 		AsOfSystemInterval: loop.config.AsOfSystemInterval,
-	})
+		StartStreamID:      partition.Start,
+		EndStreamID:        partition.End,
+	}
+
+	before, err := loop.metabaseDB.GetTableStats(ctx, stats)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -365,9 +451,7 @@ func (loop *Service) iterateDatabase(ctx context.Context, observers []*observerC
 		return Error.Wrap(err)
 	}
 
-	after, err := loop.metabaseDB.GetTableStats(ctx, metabase.GetTableStats{
-		AsOfSystemInterval: loop.config.AsOfSystemInterval,
-	})
+	after, err := loop.metabaseDB.GetTableStats(ctx, stats)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -448,6 +532,14 @@ func (loop *Service) iterateSegments(ctx context.Context, observers []*observerC
 		return processed, observers, errNoObservers
 	}
 
+	ranges, err := loop.config.Ranges()
+	if err != nil {
+		return processed, observers, err
+	}
+	if len(ranges) > 1 || !ranges[0].IsFull() {
+		return loop.iterateRanges(ctx, observers, ranges, startingTime, limit, rateLimiter)
+	}
+
 	err = loop.metabaseDB.IterateLoopSegments(ctx, metabase.IterateLoopSegments{
 		BatchSize:          limit,
 		AsOfSystemTime:     startingTime,
@@ -488,6 +580,175 @@ func (loop *Service) iterateSegments(ctx context.Context, observers []*observerC
 	return processed, observers, err
 }
 
+// rangeObserver is the partial of an observer for a single range.
+type rangeObserver struct {
+	observer *observerContext
+	partial  Partial
+}
+
+// lockedPartial serializes calls to an observer, which can't be forked.
+type lockedPartial struct {
+	mu       *sync.Mutex
+	observer Observer
+}
+
+func (partial lockedPartial) RemoteSegment(ctx context.Context, segment *Segment) error {
+	partial.mu.Lock()
+	defer partial.mu.Unlock()
+	return partial.observer.RemoteSegment(ctx, segment)
+}
+
+func (partial lockedPartial) InlineSegment(ctx context.Context, segment *Segment) error {
+	partial.mu.Lock()
+	defer partial.mu.Unlock()
+	return partial.observer.InlineSegment(ctx, segment)
+}
+
+// iterateRanges iterates the ranges concurrently and joins the partials afterwards.
+func (loop *Service) iterateRanges(ctx context.Context, observers []*observerContext, ranges []Range, startingTime time.Time, limit int, rateLimiter *rate.Limiter) (processed processedStats, _ []*observerContext, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	partials := make([][]rangeObserver, len(ranges))
+	observers = withObservers(ctx, observers, func(ctx context.Context, observer *observerContext) bool {
+		parallel, ok := observer.observer.(ParallelObserver)
+		if !ok {
+			locked := lockedPartial{mu: new(sync.Mutex), observer: observer.observer}
+			for i := range ranges {
+				partials[i] = append(partials[i], rangeObserver{observer: observer, partial: locked})
+			}
+			return true
+		}
+
+		forked := make([]rangeObserver, len(ranges))
+		for i := range ranges {
+			partial, err := parallel.Fork(ctx)
+			if observer.HandleError(err) {
+				return false
+			}
+			forked[i] = rangeObserver{observer: observer, partial: partial}
+		}
+		for i := range ranges {
+			partials[i] = append(partials[i], forked[i])
+		}
+		return true
+	})
+	if len(observers) == 0 {
+		return processed, observers, errNoObservers
+	}
+
+	var segments int64
+	var group errgroup.Group
+	for i, r := range ranges {
+		i, r := i, r
+		group.Go(func() error {
+			err := loop.iterateRange(ctx, r, partials[i], startingTime, limit, rateLimiter, &segments)
+			if err != nil {
+				return Error.New("range %s: %w", r, err)
+			}
+			return nil
+		})
+	}
+	err = group.Wait()
+	processed.segments = atomic.LoadInt64(&segments)
+	if err != nil {
+		return processed, observers, err
+	}
+
+	observers = withObservers(ctx, observers, func(ctx context.Context, observer *observerContext) bool {
+		if observer.Finished() {
+			return false
+		}
+		parallel, ok := observer.observer.(ParallelObserver)
+		if !ok {
+			return true
+		}
+		for i := range ranges {
+			for _, ro := range partials[i] {
+				if ro.observer != observer {
+					continue
+				}
+				if observer.HandleError(parallel.Join(ctx, ro.partial)) {
+					return false
+				}
+			}
+		}
+		return true
+	})
+	if len(observers) == 0 {
+		return processed, observers, errNoObservers
+	}
+
+	return processed, observers, nil
+}
+
+// iterateRange sends the segments of a single range to the partials.
+func (loop *Service) iterateRange(ctx context.Context, r Range, partials []rangeObserver, startingTime time.Time, limit int, rateLimiter *rate.Limiter, processed *int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return loop.metabaseDB.IterateLoopSegments(ctx, metabase.IterateLoopSegments{
+		BatchSize:          limit,
+		AsOfSystemTime:     startingTime,
+		AsOfSystemInterval: loop.config.AsOfSystemInterval,
+		StartStreamID:      r.Start,
+		EndStreamID:        r.End,
+	}, func(ctx context.Context, iterator metabase.LoopSegmentsIterator) error {
+		var entry metabase.LoopSegmentEntry
+		for iterator.Next(ctx, &entry) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return err
+			}
+
+			active := 0
+			for _, ro := range partials {
+				if ro.observer.Finished() {
+					continue
+				}
+				segment := Segment(entry)
+				if ro.observer.HandleError(handlePartialSegment(ctx, ro, &segment)) {
+					continue
+				}
+				active++
+			}
+			if active == 0 {
+				return nil
+			}
+
+			count := atomic.AddInt64(processed, 1)
+			mon.IntVal("segmentsProcessed").Observe(count) //mon:locked
+		}
+		return nil
+	})
+}
+
+func handlePartialSegment(ctx context.Context, ro rangeObserver, segment *Segment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	start := time.Now()
+	if segment.Inline() {
+		err = ro.partial.InlineSegment(ctx, segment)
+	} else {
+		err = ro.partial.RemoteSegment(ctx, segment)
+	}
+	duration := time.Since(start)
+
+	ro.observer.mu.Lock()
+	if segment.Inline() {
+		ro.observer.inline.Insert(duration)
+	} else {
+		ro.observer.remote.Insert(duration)
+	}
+	ro.observer.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+	return ro.observer.ctx.Err()
+}
+
 func withObservers(ctx context.Context, observers []*observerContext, handleObserver func(ctx context.Context, observer *observerContext) bool) []*observerContext {
 	defer mon.Task()(&ctx)(nil)
 	nextObservers := observers[:0]
@@ -135,6 +135,83 @@ func TestSegmentsLoop_AllData(t *testing.T) {
 	})
 }
 
+func TestSegmentsLoop_Parallel(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 4,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.SegmentLoop.CoalesceDuration = 1 * time.Second
+				config.Metainfo.SegmentLoop.ListLimit = 2
+				config.Metainfo.SegmentLoop.Parallelism = 4
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		for i := 0; i < 10; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "bucket", "remote/"+strconv.Itoa(i), testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+		for i := 0; i < 3; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "bucket", "inline/"+strconv.Itoa(i), testrand.Bytes(1*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		serial := newTestObserver(nil)
+		parallel := newParallelTestObserver(nil)
+		failing := newParallelTestObserver(func(ctx context.Context) error {
+			return errors.New("test error")
+		})
+
+		var group errgroup.Group
+		group.Go(func() error { return sat.Metainfo.SegmentLoop.Join(ctx, serial) })
+		group.Go(func() error { return sat.Metainfo.SegmentLoop.Join(ctx, parallel) })
+		failingErr := make(chan error, 1)
+		group.Go(func() error {
+			failingErr <- sat.Metainfo.SegmentLoop.Join(ctx, failing)
+			return nil
+		})
+		require.NoError(t, group.Wait())
+		require.Error(t, <-failingErr)
+
+		for _, obs := range []*testObserver{serial, &parallel.testObserver} {
+			assert.EqualValues(t, 10, obs.remoteSegCount)
+			assert.EqualValues(t, 3, obs.inlineSegCount)
+			assert.EqualValues(t, 13, len(obs.uniqueKeys))
+		}
+		assert.Equal(t, 4, parallel.joined)
+
+		// two processes iterating half of the keyspace each see every segment once.
+		seen := map[testKey]struct{}{}
+		for index := 0; index < 2; index++ {
+			loop := segmentloop.New(zaptest.NewLogger(t), segmentloop.Config{
+				CoalesceDuration:   time.Millisecond,
+				ListLimit:          2,
+				AsOfSystemInterval: -time.Microsecond,
+				Parallelism:        2,
+				PartitionCount:     2,
+				PartitionIndex:     index,
+			}, sat.Metainfo.Metabase)
+
+			obs := newParallelTestObserver(nil)
+			var group errgroup.Group
+			group.Go(func() error { return loop.RunOnce(ctx) })
+			group.Go(func() error { return loop.Join(ctx, obs) })
+			require.NoError(t, group.Wait())
+			require.NoError(t, loop.Close())
+
+			for key := range obs.uniqueKeys {
+				_, ok := seen[key]
+				require.False(t, ok)
+				seen[key] = struct{}{}
+			}
+		}
+		require.Len(t, seen, 13)
+	})
+}
+
 // TestsegmentsLoopObserverCancel does the following:
 // * upload 3 remote segments
 // * hook three observers up to segments loop
@@ -424,3 +501,30 @@ func (obs *testObserver) InlineSegment(ctx context.Context, segment *segmentloop
 	obs.uniqueKeys[key] = struct{}{}
 	return nil
 }
+
+type parallelTestObserver struct {
+	testObserver
+	joined int
+}
+
+func newParallelTestObserver(onSegment func(context.Context) error) *parallelTestObserver {
+	return &parallelTestObserver{testObserver: *newTestObserver(onSegment)}
+}
+
+func (obs *parallelTestObserver) Fork(ctx context.Context) (segmentloop.Partial, error) {
+	return newTestObserver(obs.onSegment), nil
+}
+
+func (obs *parallelTestObserver) Join(ctx context.Context, partial segmentloop.Partial) error {
+	forked := partial.(*testObserver)
+	obs.remoteSegCount += forked.remoteSegCount
+	obs.inlineSegCount += forked.inlineSegCount
+	for key := range forked.uniqueKeys {
+		if _, ok := obs.uniqueKeys[key]; ok {
+			return errors.New("segment seen by multiple ranges")
+		}
+		obs.uniqueKeys[key] = struct{}{}
+	}
+	obs.joined++
+	return nil
+}
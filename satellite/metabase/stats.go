@@ -10,11 +10,18 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/errs2"
+	"storj.io/common/uuid"
 )
 
 // GetTableStats contains arguments necessary for getting table statistics.
 type GetTableStats struct {
 	AsOfSystemInterval time.Duration
+
+	// StartStreamID is the first stream whose segments are counted.
+	StartStreamID uuid.UUID
+	// EndStreamID is the stream where counting segments stops, it's not included.
+	// Zero value means counting until the last stream.
+	EndStreamID uuid.UUID
 }
 
 // TableStats contains information about the metabase status.
@@ -33,7 +40,20 @@ func (db *DB) GetTableStats(ctx context.Context, opts GetTableStats) (result Tab
 		return Error.Wrap(row.Scan(&result.ObjectCount))
 	})
 	group.Go(func() error {
-		row := db.db.QueryRowContext(ctx, `SELECT count(*) FROM segments `+db.impl.AsOfSystemInterval(opts.AsOfSystemInterval))
+		if opts.StartStreamID.IsZero() && opts.EndStreamID.IsZero() {
+			row := db.db.QueryRowContext(ctx, `SELECT count(*) FROM segments `+db.impl.AsOfSystemInterval(opts.AsOfSystemInterval))
+			return Error.Wrap(row.Scan(&result.SegmentCount))
+		}
+
+		var endStreamID []byte
+		if !opts.EndStreamID.IsZero() {
+			endStreamID = opts.EndStreamID[:]
+		}
+		row := db.db.QueryRowContext(ctx, `
+			SELECT count(*) FROM segments
+			`+db.impl.AsOfSystemInterval(opts.AsOfSystemInterval)+`
+			WHERE stream_id >= $1 AND ($2::BYTEA IS NULL OR stream_id < $2::BYTEA)
+		`, opts.StartStreamID, endStreamID)
 		return Error.Wrap(row.Scan(&result.SegmentCount))
 	})
 	err = errs.Combine(group.Wait()...)
@@ -47,6 +47,29 @@ func TestGetTableStats(t *testing.T) {
 					SegmentCount: 7,
 				},
 			}.Check(ctx, t, db)
+
+			first, second := obj1.StreamID, obj2.StreamID
+			firstCount, secondCount := int64(4), int64(3)
+			if second.Less(first) {
+				first, second = second, first
+				firstCount, secondCount = secondCount, firstCount
+			}
+
+			metabasetest.GetTableStats{
+				Opts: metabase.GetTableStats{EndStreamID: second},
+				Result: metabase.TableStats{
+					ObjectCount:  2,
+					SegmentCount: firstCount,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.GetTableStats{
+				Opts: metabase.GetTableStats{StartStreamID: second},
+				Result: metabase.TableStats{
+					ObjectCount:  2,
+					SegmentCount: secondCount,
+				},
+			}.Check(ctx, t, db)
 		})
 
 		if db.Implementation() == dbutil.Cockroach {
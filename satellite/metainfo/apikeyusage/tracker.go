@@ -0,0 +1,121 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package apikeyusage implements tracking of the last time api keys were used.
+package apikeyusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+)
+
+// Error is a standard error class for this package.
+var (
+	Error = errs.Class("apikeyusage")
+	mon   = monkit.Package()
+)
+
+// closeFlushTimeout is how long Close waits for writing the pending usages.
+const closeFlushTimeout = time.Minute
+
+// Config contains configurable values for the api key usage tracker.
+type Config struct {
+	FlushInterval time.Duration `help:"how frequently the last used times of api keys are written to the database" releaseDefault:"5m" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+}
+
+// DB stores the last used times of api keys.
+//
+// architecture: Database
+type DB interface {
+	// UpdateLastUsed updates the time the api keys were last used, it never moves the time backwards.
+	UpdateLastUsed(ctx context.Context, lastUsed map[uuid.UUID]time.Time) error
+}
+
+// Tracker collects the api key usages in memory and periodically writes
+// them to the database, so that requests don't wait for the update.
+//
+// architecture: Service
+type Tracker struct {
+	log  *zap.Logger
+	db   DB
+	Loop *sync2.Cycle
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]time.Time
+}
+
+// NewTracker creates a new api key usage tracker.
+func NewTracker(log *zap.Logger, db DB, config Config) *Tracker {
+	return &Tracker{
+		log:     log,
+		db:      db,
+		Loop:    sync2.NewCycle(config.FlushInterval),
+		pending: make(map[uuid.UUID]time.Time),
+	}
+}
+
+// Record records that the api key was used at the given time.
+func (tracker *Tracker) Record(id uuid.UUID, usedAt time.Time) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	if last, ok := tracker.pending[id]; !ok || last.Before(usedAt) {
+		tracker.pending[id] = usedAt
+	}
+}
+
+// Run starts writing the recorded usages periodically.
+func (tracker *Tracker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return tracker.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := tracker.Flush(ctx); err != nil {
+			tracker.log.Error("failed to update last used times of api keys", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Flush writes the recorded usages to the database. The usages are kept for
+// the next flush when the write fails.
+func (tracker *Tracker) Flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tracker.mu.Lock()
+	pending := tracker.pending
+	tracker.pending = make(map[uuid.UUID]time.Time)
+	tracker.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	err = tracker.db.UpdateLastUsed(ctx, pending)
+	if err != nil {
+		for id, usedAt := range pending {
+			tracker.Record(id, usedAt)
+		}
+		return Error.Wrap(err)
+	}
+
+	mon.IntVal("api_keys_last_used_updated").Observe(int64(len(pending)))
+	return nil
+}
+
+// Close stops the tracker and writes the pending usages to the database.
+func (tracker *Tracker) Close() error {
+	tracker.Loop.Close()
+
+	// the context of Run is already canceled when the peer is closed.
+	ctx, cancel := context.WithTimeout(context.Background(), closeFlushTimeout)
+	defer cancel()
+
+	return tracker.Flush(ctx)
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apikeyusage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/errs2"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metainfo/apikeyusage"
+)
+
+type mockDB struct {
+	fail     bool
+	lastUsed map[uuid.UUID]time.Time
+}
+
+func (db *mockDB) UpdateLastUsed(ctx context.Context, lastUsed map[uuid.UUID]time.Time) error {
+	if db.fail {
+		return errors.New("update failed")
+	}
+	for id, usedAt := range lastUsed {
+		db.lastUsed[id] = usedAt
+	}
+	return nil
+}
+
+func TestTracker(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db := &mockDB{lastUsed: make(map[uuid.UUID]time.Time)}
+	tracker := apikeyusage.NewTracker(zaptest.NewLogger(t), db, apikeyusage.Config{FlushInterval: time.Hour})
+	defer ctx.Check(tracker.Close)
+
+	now := time.Now()
+	first, second := testrand.UUID(), testrand.UUID()
+
+	// only the most recent usage is kept.
+	tracker.Record(first, now)
+	tracker.Record(first, now.Add(-time.Minute))
+	tracker.Record(second, now.Add(-time.Hour))
+	tracker.Record(second, now)
+
+	db.fail = true
+	require.Error(t, tracker.Flush(ctx))
+	require.Empty(t, db.lastUsed)
+
+	// failed usages are written by the next flush.
+	db.fail = false
+	tracker.Record(first, now.Add(time.Second))
+	require.NoError(t, tracker.Flush(ctx))
+	require.Equal(t, map[uuid.UUID]time.Time{
+		first:  now.Add(time.Second),
+		second: now,
+	}, db.lastUsed)
+
+	db.lastUsed = make(map[uuid.UUID]time.Time)
+	require.NoError(t, tracker.Flush(ctx))
+	require.Empty(t, db.lastUsed)
+}
+
+func TestTracker_Close(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db := &mockDB{lastUsed: make(map[uuid.UUID]time.Time)}
+	tracker := apikeyusage.NewTracker(zaptest.NewLogger(t), db, apikeyusage.Config{FlushInterval: time.Hour})
+
+	runCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error {
+		return errs2.IgnoreCanceled(tracker.Run(runCtx))
+	})
+
+	id, now := testrand.UUID(), time.Now()
+	tracker.Record(id, now)
+
+	// the pending usages are written when the tracker is closed.
+	cancel()
+	require.NoError(t, tracker.Close())
+	require.Equal(t, map[uuid.UUID]time.Time{id: now}, db.lastUsed)
+}
@@ -11,6 +11,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/metainfo/apikeyusage"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
 )
 
@@ -94,8 +95,22 @@ func (rs *RSConfig) Set(s string) error {
 type RateLimiterConfig struct {
 	Enabled         bool          `help:"whether rate limiting is enabled." releaseDefault:"true" devDefault:"true"`
 	Rate            float64       `help:"request rate per project per second." releaseDefault:"1000" devDefault:"100" testDefault:"1000"`
+	Burst           int           `help:"request burst per project, zero means the same as the rate." default:"0"`
 	CacheCapacity   int           `help:"number of projects to cache." releaseDefault:"10000" devDefault:"10" testDefault:"100"`
 	CacheExpiration time.Duration `help:"how long to cache the projects limiter." releaseDefault:"10m" devDefault:"10s"`
+
+	List     OperationRateLimiterConfig `help:"rate limiter for listing objects and buckets"`
+	Upload   OperationRateLimiterConfig `help:"rate limiter for beginning and committing objects and segments"`
+	Download OperationRateLimiterConfig `help:"rate limiter for getting and downloading objects and segments"`
+	Delete   OperationRateLimiterConfig `help:"rate limiter for deleting objects and buckets"`
+}
+
+// OperationRateLimiterConfig is a configuration struct for rate limiting a class of requests.
+//
+// Requests of a class are limited by both the rate of the class and the project rate limit.
+type OperationRateLimiterConfig struct {
+	Rate  float64 `help:"request rate per project per second in addition to the project rate limit, zero means only the project rate limit applies." default:"0"`
+	Burst int     `help:"request burst per project, zero means the same as the rate." default:"0"`
 }
 
 // ProjectLimitConfig is a configuration struct for default project limits.
@@ -117,4 +132,5 @@ type Config struct {
 	RateLimiter          RateLimiterConfig    `help:"rate limiter configuration"`
 	ProjectLimits        ProjectLimitConfig   `help:"project limit configuration"`
 	PieceDeletion        piecedeletion.Config `help:"piece deletion configuration"`
+	APIKeyUsage          apikeyusage.Config   `help:"api key usage tracking configuration"`
 }
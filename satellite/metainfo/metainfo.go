@@ -28,6 +28,7 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo/apikeyusage"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
 	"storj.io/storj/satellite/metainfo/pointerverification"
 	"storj.io/storj/satellite/orders"
@@ -74,7 +75,9 @@ type Endpoint struct {
 	pointerVerification  *pointerverification.Service
 	projectUsage         *accounting.Service
 	projects             console.Projects
+	projectFreezes       console.ProjectFreezes
 	apiKeys              APIKeys
+	apiKeyUsage          *apikeyusage.Tracker
 	satellite            signing.Signer
 	limiterCache         *lrucache.ExpiringLRU
 	encInlineSegmentSize int64 // max inline segment size + encryption overhead
@@ -88,8 +91,8 @@ type Endpoint struct {
 func NewEndpoint(log *zap.Logger, metainfo *Service, deletePieces *piecedeletion.Service,
 	orders *orders.Service, cache *overlay.Service, attributions attribution.DB,
 	partners *rewards.PartnersService, peerIdentities overlay.PeerIdentities,
-	apiKeys APIKeys, projectUsage *accounting.Service, projects console.Projects,
-	satellite signing.Signer, revocations revocation.DB, config Config) (*Endpoint, error) {
+	apiKeys APIKeys, apiKeyUsage *apikeyusage.Tracker, projectUsage *accounting.Service, projects console.Projects,
+	projectFreezes console.ProjectFreezes, satellite signing.Signer, revocations revocation.DB, config Config) (*Endpoint, error) {
 	// TODO do something with too many params
 
 	encInlineSegmentSize, err := encryption.CalcEncryptedSize(config.MaxInlineSegmentSize.Int64(), storj.EncryptionParameters{
@@ -119,8 +122,10 @@ func NewEndpoint(log *zap.Logger, metainfo *Service, deletePieces *piecedeletion
 		partners:            partners,
 		pointerVerification: pointerverification.NewService(peerIdentities),
 		apiKeys:             apiKeys,
+		apiKeyUsage:         apiKeyUsage,
 		projectUsage:        projectUsage,
 		projects:            projects,
+		projectFreezes:      projectFreezes,
 		satellite:           satellite,
 		limiterCache: lrucache.New(lrucache.Options{
 			Capacity:   config.RateLimiter.CacheCapacity,
@@ -310,19 +315,17 @@ func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDelete
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+	canRead := endpoint.hasPermission(ctx, req.Header, macaroon.Action{
 		Op:     macaroon.ActionRead,
 		Bucket: req.Name,
 		Time:   now,
 	})
-	canRead := err == nil
 
-	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+	canList := endpoint.hasPermission(ctx, req.Header, macaroon.Action{
 		Op:     macaroon.ActionList,
 		Bucket: req.Name,
 		Time:   now,
 	})
-	canList := err == nil
 
 	var (
 		bucket     storj.Bucket
@@ -598,13 +601,16 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: non-existing-bucket")
 	}
 
-	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+	if err := endpoint.checkFrozen(ctx, keyInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	canDelete := endpoint.hasPermission(ctx, req.Header, macaroon.Action{
 		Op:            macaroon.ActionDelete,
 		Bucket:        req.Bucket,
 		EncryptedPath: req.EncryptedPath,
 		Time:          time.Now(),
 	})
-	canDelete := err == nil
 
 	if canDelete {
 		_, err = endpoint.DeleteObjectAnyStatus(ctx, metabase.ObjectLocation{
@@ -874,6 +880,10 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	if err := endpoint.checkFrozen(ctx, keyInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
 	if exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID); err != nil {
 		endpoint.log.Error("Retrieving project bandwidth total failed; bandwidth limit won't be enforced", zap.Error(err))
 	} else if exceeded {
@@ -1412,21 +1422,19 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+	canRead := endpoint.hasPermission(ctx, req.Header, macaroon.Action{
 		Op:            macaroon.ActionRead,
 		Bucket:        req.Bucket,
 		EncryptedPath: req.EncryptedPath,
 		Time:          now,
 	})
-	canRead := err == nil
 
-	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+	canList := endpoint.hasPermission(ctx, req.Header, macaroon.Action{
 		Op:            macaroon.ActionList,
 		Bucket:        req.Bucket,
 		EncryptedPath: req.EncryptedPath,
 		Time:          now,
 	})
-	canList := err == nil
 
 	var deletedObjects []*pb.Object
 
@@ -1660,6 +1668,10 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "segment index must be greater then 0")
 	}
 
+	if err := endpoint.checkFrozen(ctx, keyInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
 	if err := endpoint.checkExceedsStorageUsage(ctx, keyInfo.ProjectID); err != nil {
 		return nil, err
 	}
@@ -1975,6 +1987,10 @@ func (endpoint *Endpoint) makeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, nil, rpcstatus.Error(rpcstatus.InvalidArgument, fmt.Sprintf("inline segment size cannot be larger than %s", endpoint.config.MaxInlineSegmentSize))
 	}
 
+	if err := endpoint.checkFrozen(ctx, keyInfo.ProjectID); err != nil {
+		return nil, nil, err
+	}
+
 	if err := endpoint.checkExceedsStorageUsage(ctx, keyInfo.ProjectID); err != nil {
 		return nil, nil, err
 	}
@@ -2152,6 +2168,10 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 
 	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
 
+	if err := endpoint.checkFrozen(ctx, keyInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
 	if exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID); err != nil {
 		endpoint.log.Error("Retrieving project bandwidth total failed; bandwidth limit won't be enforced", zap.Error(err))
 	} else if exceeded {
@@ -2770,6 +2790,22 @@ func (endpoint *Endpoint) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKey
 	return &pb.RevokeAPIKeyResponse{}, nil
 }
 
+// checkFrozen refuses the uploads and downloads of frozen projects. The
+// check fails closed, a project is only accessed when it's known not to be frozen.
+func (endpoint *Endpoint) checkFrozen(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	frozen, err := endpoint.projectFreezes.IsFrozen(ctx, projectID)
+	if err != nil {
+		endpoint.log.Error("Retrieving project freeze failed", zap.Stringer("Project ID", projectID), zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, "unable to check project status")
+	}
+	if frozen {
+		return rpcstatus.Error(rpcstatus.PermissionDenied, "project is frozen")
+	}
+	return nil
+}
+
 func (endpoint *Endpoint) checkExceedsStorageUsage(ctx context.Context, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -29,6 +29,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo"
@@ -306,6 +307,67 @@ func TestInvalidAPIKey(t *testing.T) {
 	})
 }
 
+func TestAPIKeyExpirationAndUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKeys := sat.DB.Console().APIKeys()
+		tracker := sat.API.Metainfo.APIKeyUsage
+		tracker.Loop.Pause()
+
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		createKey := func(name string, expiresAt time.Time) *macaroon.APIKey {
+			secret, err := macaroon.NewSecret()
+			require.NoError(t, err)
+
+			key, err := macaroon.NewAPIKey(secret)
+			require.NoError(t, err)
+
+			_, err = apiKeys.Create(ctx, key.Head(), console.APIKeyInfo{
+				Name:      name,
+				ProjectID: projectID,
+				Secret:    secret,
+				ExpiresAt: &expiresAt,
+			})
+			require.NoError(t, err)
+			return key
+		}
+
+		listBuckets := func(key *macaroon.APIKey) error {
+			client, err := planet.Uplinks[0].DialMetainfo(ctx, sat, key)
+			require.NoError(t, err)
+			defer ctx.Check(client.Close)
+
+			_, err = client.ListBuckets(ctx, metaclient.ListBucketsParams{})
+			return err
+		}
+
+		validKey := createKey("valid", time.Now().Add(time.Hour))
+		require.NoError(t, listBuckets(validKey))
+
+		expiredKey := createKey("expired", time.Now().Add(-time.Hour))
+		err := listBuckets(expiredKey)
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied), err)
+
+		require.NoError(t, tracker.Flush(ctx))
+
+		validInfo, err := apiKeys.GetByHead(ctx, validKey.Head())
+		require.NoError(t, err)
+		validInfo, err = apiKeys.Get(ctx, validInfo.ID)
+		require.NoError(t, err)
+		require.NotNil(t, validInfo.LastUsedAt)
+		require.WithinDuration(t, time.Now(), *validInfo.LastUsedAt, time.Minute)
+
+		expiredInfo, err := apiKeys.GetByHead(ctx, expiredKey.Head())
+		require.NoError(t, err)
+		expiredInfo, err = apiKeys.Get(ctx, expiredInfo.ID)
+		require.NoError(t, err)
+		require.Nil(t, expiredInfo.LastUsedAt)
+	})
+}
+
 func assertInvalidArgument(t *testing.T, err error, allowed bool) {
 	t.Helper()
 
@@ -1376,6 +1438,57 @@ func TestRateLimit_ProjectRateLimitOverrideCachedExpired(t *testing.T) {
 	})
 }
 
+func TestRateLimit_OperationLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.RateLimiter.Rate = 100
+				config.Metainfo.RateLimiter.CacheExpiration = 500 * time.Millisecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ul := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+
+		// TODO find a way to reset limiter before test is executed, currently
+		// testplanet is doing one additional request to get access
+		time.Sleep(1 * time.Second)
+
+		listRate, listBurst, deleteRate := 1, 1, 0
+		err := satellite.DB.Console().Projects().UpdateOperationLimits(ctx, ul.Projects[0].ID, console.OperationLimits{
+			List:   console.OperationLimit{Rate: &listRate, Burst: &listBurst},
+			Delete: console.OperationLimit{Rate: &deleteRate},
+		})
+		require.NoError(t, err)
+
+		// creating buckets uses the project rate limit.
+		var group errs2.Group
+		for i := 0; i < 5; i++ {
+			group.Go(func() error {
+				return ul.CreateBucket(ctx, satellite, testrand.BucketName())
+			})
+		}
+		require.Empty(t, group.Wait())
+
+		_, err = ul.ListBuckets(ctx, satellite)
+		require.NoError(t, err)
+
+		_, err = ul.ListBuckets(ctx, satellite)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Too Many Requests: list rate limit of 1 requests per second exceeded, retry after")
+
+		// other operations are not affected by the list rate limit.
+		bucketName := testrand.BucketName()
+		require.NoError(t, ul.CreateBucket(ctx, satellite, bucketName))
+
+		// a rate of zero blocks the operation.
+		err = ul.DeleteBucket(ctx, satellite, bucketName)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Too Many Requests: delete rate limit of 0 requests per second exceeded")
+	})
+}
+
 func TestBucketEmptinessBeforeDelete(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
@@ -2002,3 +2115,35 @@ func TestDeleteNonExistentObject(t *testing.T) {
 		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
 	})
 }
+
+func TestFrozenProject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ul := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		projectID := ul.Projects[0].ID
+
+		data := testrand.Bytes(10 * memory.KiB)
+		require.NoError(t, ul.Upload(ctx, satellite, "testbucket", "stored", data))
+
+		_, err := satellite.DB.Console().ProjectFreezes().Freeze(ctx, projectID, "admin")
+		require.NoError(t, err)
+
+		// the limits of a frozen project don't matter.
+		require.NoError(t, satellite.DB.ProjectAccounting().UpdateProjectUsageLimit(ctx, projectID, memory.TB))
+
+		err = ul.Upload(ctx, satellite, "testbucket", "refused", data)
+		require.Error(t, err)
+		_, err = ul.Download(ctx, satellite, "testbucket", "stored")
+		require.Error(t, err)
+
+		_, err = satellite.DB.Console().ProjectFreezes().Unfreeze(ctx, projectID)
+		require.NoError(t, err)
+
+		downloaded, err := ul.Download(ctx, satellite, "testbucket", "stored")
+		require.NoError(t, err)
+		require.Equal(t, data, downloaded)
+		require.NoError(t, ul.Upload(ctx, satellite, "testbucket", "accepted", data))
+	})
+}
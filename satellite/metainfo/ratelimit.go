@@ -0,0 +1,186 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"storj.io/common/macaroon"
+	"storj.io/storj/satellite/console"
+)
+
+// rateLimitOperation is a class of requests that can be rate limited separately.
+type rateLimitOperation int
+
+const (
+	// rateLimitProject is used for requests that are only limited by the project rate limit.
+	rateLimitProject rateLimitOperation = iota
+	rateLimitList
+	rateLimitUpload
+	rateLimitDownload
+	rateLimitDelete
+
+	rateLimitOperationCount
+)
+
+// rateLimitOperationForAction returns the class of requests authorized by the action.
+func rateLimitOperationForAction(op macaroon.ActionType) rateLimitOperation {
+	switch op {
+	case macaroon.ActionList:
+		return rateLimitList
+	case macaroon.ActionWrite:
+		return rateLimitUpload
+	case macaroon.ActionRead:
+		return rateLimitDownload
+	case macaroon.ActionDelete:
+		return rateLimitDelete
+	default:
+		return rateLimitProject
+	}
+}
+
+// String returns the name of the operation.
+func (op rateLimitOperation) String() string {
+	switch op {
+	case rateLimitProject:
+		return "project"
+	case rateLimitList:
+		return "list"
+	case rateLimitUpload:
+		return "upload"
+	case rateLimitDownload:
+		return "download"
+	case rateLimitDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("operation(%d)", int(op))
+	}
+}
+
+// RateLimitError is returned when a project exceeds one of its rate limits.
+type RateLimitError struct {
+	// Operation is the class of requests that was limited.
+	Operation string
+	// Limit is the number of requests allowed per second.
+	Limit float64
+	// RetryAfter is how long the client should wait before retrying the request.
+	// It's zero when the request would never be allowed.
+	RetryAfter time.Duration
+}
+
+// Error implements error.
+func (err *RateLimitError) Error() string {
+	if err.RetryAfter <= 0 {
+		return fmt.Sprintf("Too Many Requests: %s rate limit of %g requests per second exceeded", err.Operation, err.Limit)
+	}
+	return fmt.Sprintf("Too Many Requests: %s rate limit of %g requests per second exceeded, retry after %s", err.Operation, err.Limit, err.RetryAfter)
+}
+
+// projectLimiters contains the rate limiters of a single project.
+type projectLimiters struct {
+	project *rate.Limiter
+	// operations contains limiters for request classes that are limited
+	// separately in addition to the project limiter, nil means that the class
+	// is only limited by the project limiter.
+	operations [rateLimitOperationCount]*rate.Limiter
+}
+
+// newProjectLimiters creates the limiters for the project, project specific
+// limits override the configured ones.
+func newProjectLimiters(config RateLimiterConfig, project *console.Project) *projectLimiters {
+	limiters := &projectLimiters{
+		project: newLimiter(config.Rate, config.Burst, project.RateLimit, project.BurstLimit),
+	}
+
+	operations := []struct {
+		op      rateLimitOperation
+		config  OperationRateLimiterConfig
+		project console.OperationLimit
+	}{
+		{rateLimitList, config.List, project.OperationLimits.List},
+		{rateLimitUpload, config.Upload, project.OperationLimits.Upload},
+		{rateLimitDownload, config.Download, project.OperationLimits.Download},
+		{rateLimitDelete, config.Delete, project.OperationLimits.Delete},
+	}
+	for _, operation := range operations {
+		if operation.config.Rate <= 0 && operation.project.Rate == nil {
+			continue
+		}
+		limiters.operations[operation.op] = newLimiter(operation.config.Rate, operation.config.Burst, operation.project.Rate, operation.project.Burst)
+	}
+
+	return limiters
+}
+
+// allow checks whether a request of the class can be made now against both
+// the limiter of the class and the project limiter. When a limiter doesn't
+// allow the request now, it returns the limited class and how long to wait,
+// and neither limiter counts the request. ok is false when the request can
+// never be allowed.
+func (limiters *projectLimiters) allow(op rateLimitOperation, now time.Time) (limited rateLimitOperation, limit float64, delay time.Duration, ok bool) {
+	ops := []rateLimitOperation{rateLimitProject}
+	if op > rateLimitProject && op < rateLimitOperationCount && limiters.operations[op] != nil {
+		ops = []rateLimitOperation{op, rateLimitProject}
+	}
+
+	reservations := make([]*rate.Reservation, 0, len(ops))
+	cancel := func() {
+		for _, reservation := range reservations {
+			reservation.CancelAt(now)
+		}
+	}
+
+	for _, op := range ops {
+		limiter := limiters.project
+		if op != rateLimitProject {
+			limiter = limiters.operations[op]
+		}
+		if len(reservations) == 0 {
+			limited, limit = op, float64(limiter.Limit())
+		}
+
+		reservation := limiter.ReserveN(now, 1)
+		if !reservation.OK() {
+			cancel()
+			return op, float64(limiter.Limit()), 0, false
+		}
+		reservations = append(reservations, reservation)
+
+		if opDelay := reservation.DelayFrom(now); opDelay > delay {
+			limited, limit, delay = op, float64(limiter.Limit()), opDelay
+		}
+	}
+
+	if delay > 0 {
+		cancel()
+	}
+	return limited, limit, delay, true
+}
+
+// newLimiter creates a limiter using the project overrides when they are set.
+// Burst defaults to the rate, so calls aren't limited within the second.
+// A project specific rate of zero blocks all requests.
+func newLimiter(limit float64, burst int, projectLimit, projectBurst *int) *rate.Limiter {
+	if projectLimit != nil {
+		if *projectLimit == 0 {
+			return rate.NewLimiter(0, 0)
+		}
+		limit = float64(*projectLimit)
+		// the configured burst belongs to the configured rate.
+		burst = 0
+	}
+	if projectBurst != nil {
+		burst = *projectBurst
+	}
+	if burst <= 0 {
+		burst = int(limit)
+		if burst < 1 && limit > 0 {
+			burst = 1
+		}
+	}
+	return rate.NewLimiter(rate.Limit(limit), burst)
+}
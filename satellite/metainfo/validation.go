@@ -12,7 +12,6 @@ import (
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
-	"golang.org/x/time/rate"
 
 	"storj.io/common/encryption"
 	"storj.io/common/macaroon"
@@ -47,7 +46,7 @@ func getAPIKey(ctx context.Context, header *pb.RequestHeader) (key *macaroon.API
 func (endpoint *Endpoint) validateAuth(ctx context.Context, header *pb.RequestHeader, action macaroon.Action) (_ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	key, keyInfo, err := endpoint.validateBasic(ctx, header)
+	key, keyInfo, err := endpoint.validateBasic(ctx, header, rateLimitOperationForAction(action.Op))
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +60,33 @@ func (endpoint *Endpoint) validateAuth(ctx context.Context, header *pb.RequestHe
 	return keyInfo, nil
 }
 
-func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestHeader) (_ *macaroon.APIKey, _ *console.APIKeyInfo, err error) {
+// hasPermission checks whether the API key allows the action. It's used for
+// additional checks within a request, so it doesn't count towards the rate limit.
+func (endpoint *Endpoint) hasPermission(ctx context.Context, header *pb.RequestHeader, action macaroon.Action) bool {
+	key, keyInfo, err := endpoint.authenticate(ctx, header)
+	if err != nil {
+		return false
+	}
+	return key.Check(ctx, keyInfo.Secret, action, endpoint.revocations) == nil
+}
+
+func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestHeader, op rateLimitOperation) (_ *macaroon.APIKey, _ *console.APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, keyInfo, err := endpoint.authenticate(ctx, header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = endpoint.checkRate(ctx, keyInfo.ProjectID, op); err != nil {
+		endpoint.log.Debug("rate check failed", zap.Error(err))
+		return nil, nil, err
+	}
+
+	return key, keyInfo, nil
+}
+
+func (endpoint *Endpoint) authenticate(ctx context.Context, header *pb.RequestHeader) (_ *macaroon.APIKey, _ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	key, err := getAPIKey(ctx, header)
@@ -76,9 +101,14 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 		return nil, nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
 	}
 
-	if err = endpoint.checkRate(ctx, keyInfo.ProjectID); err != nil {
-		endpoint.log.Debug("rate check failed", zap.Error(err))
-		return nil, nil, err
+	now := time.Now()
+	if keyInfo.Expired(now) {
+		endpoint.log.Debug("expired api key", zap.Stringer("keyID", keyInfo.ID))
+		return nil, nil, rpcstatus.Error(rpcstatus.PermissionDenied, "API key has expired")
+	}
+
+	if endpoint.apiKeyUsage != nil {
+		endpoint.apiKeyUsage.Record(keyInfo.ID, now)
 	}
 
 	return key, keyInfo, nil
@@ -86,7 +116,7 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 
 func (endpoint *Endpoint) validateRevoke(ctx context.Context, header *pb.RequestHeader, macToRevoke *macaroon.Macaroon) (_ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
-	key, keyInfo, err := endpoint.validateBasic(ctx, header)
+	key, keyInfo, err := endpoint.validateBasic(ctx, header, rateLimitProject)
 	if err != nil {
 		return nil, err
 	}
@@ -110,38 +140,38 @@ func (endpoint *Endpoint) validateRevoke(ctx context.Context, header *pb.Request
 	return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized attempt to revoke macaroon")
 }
 
-func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (err error) {
+// checkRate checks the rate limits of the request class and of the project,
+// requests over a limit get an error telling the client how long to back off.
+func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID, op rateLimitOperation) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !endpoint.config.RateLimiter.Enabled {
 		return nil
 	}
-	limiter, err := endpoint.limiterCache.Get(projectID.String(), func() (interface{}, error) {
-		limit := rate.Limit(endpoint.config.RateLimiter.Rate)
-
+	limiters, err := endpoint.limiterCache.Get(projectID.String(), func() (interface{}, error) {
 		project, err := endpoint.projects.Get(ctx, projectID)
 		if err != nil {
 			return false, err
 		}
-		if project.RateLimit != nil {
-			limit = rate.Limit(*project.RateLimit)
-		}
-
-		// initialize the limiter with limit and burst the same so that we don't limit how quickly calls
-		// are made within the second.
-		return rate.NewLimiter(limit, int(limit)), nil
+		return newProjectLimiters(endpoint.config.RateLimiter, project), nil
 	})
 	if err != nil {
 		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
 	}
 
-	if !limiter.(*rate.Limiter).Allow() {
+	op, limit, delay, ok := limiters.(*projectLimiters).allow(op, time.Now())
+	if !ok || delay > 0 {
 		endpoint.log.Warn("too many requests for project",
 			zap.Stringer("projectID", projectID),
-			zap.Float64("limit", float64(limiter.(*rate.Limiter).Limit())))
+			zap.Stringer("operation", op),
+			zap.Float64("limit", limit))
 
 		mon.Event("metainfo_rate_limit_exceeded") //mon:locked
 
-		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
+		return rpcstatus.Wrap(rpcstatus.ResourceExhausted, &RateLimitError{
+			Operation:  op.String(),
+			Limit:      limit,
+			RetryAfter: delay,
+		})
 	}
 
 	return nil
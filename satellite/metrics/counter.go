@@ -10,6 +10,8 @@ import (
 	"storj.io/storj/satellite/metabase/segmentloop"
 )
 
+var _ segmentloop.ParallelObserver = (*Counter)(nil)
+
 // Counter implements the segment loop observer interface for data science metrics collection.
 //
 // architecture: Observer
@@ -80,3 +82,23 @@ func (counter *Counter) InlineSegment(ctx context.Context, segment *segmentloop.
 	}
 	return nil
 }
+
+// Fork creates a counter for a single range of the segments loop.
+func (counter *Counter) Fork(ctx context.Context) (segmentloop.Partial, error) {
+	return NewCounter(), nil
+}
+
+// Join adds the counts of a single range to the counter.
+func (counter *Counter) Join(ctx context.Context, partial segmentloop.Partial) error {
+	forked, ok := partial.(*Counter)
+	if !ok {
+		return Error.New("expected partial type %T but got %T", counter, partial)
+	}
+	counter.RemoteObjects += forked.RemoteObjects
+	counter.InlineObjects += forked.InlineObjects
+	counter.TotalInlineBytes += forked.TotalInlineBytes
+	counter.TotalRemoteBytes += forked.TotalRemoteBytes
+	counter.TotalInlineSegments += forked.TotalInlineSegments
+	counter.TotalRemoteSegments += forked.TotalRemoteSegments
+	return nil
+}
@@ -15,16 +15,16 @@ import (
 // ErrAccountNotSetup is an error type which indicates that payment account is not created.
 var ErrAccountNotSetup = errs.Class("payment account is not set up")
 
+// ErrUnsupported is an error type which indicates that the payment provider doesn't support the operation.
+var ErrUnsupported = errs.Class("not supported by the payment provider")
+
 // Accounts exposes all needed functionality to manage payment accounts.
+// It's implemented by every payment provider, the operations a provider
+// can't perform return ErrUnsupported.
 //
 // architecture: Service
 type Accounts interface {
-	// Setup creates a payment account for the user.
-	// If account is already set up it will return nil.
-	Setup(ctx context.Context, userID uuid.UUID, email string) error
-
-	// Balance returns an object that represents current free credits and coins balance in cents.
-	Balance(ctx context.Context, userID uuid.UUID) (Balance, error)
+	Customers
 
 	// ProjectCharges returns how much money current user will be charged for each project.
 	ProjectCharges(ctx context.Context, userID uuid.UUID, since, before time.Time) ([]ProjectCharge, error)
@@ -36,10 +36,10 @@ type Accounts interface {
 	// Charges returns list of all credit card charges related to account.
 	Charges(ctx context.Context, userID uuid.UUID) ([]Charge, error)
 
-	// CreditCards exposes all needed functionality to manage account credit cards.
+	// CreditCards exposes all needed functionality to manage account payment methods.
 	CreditCards() CreditCards
 
-	// StorjTokens exposes all storj token related functionality.
+	// StorjTokens exposes all storj token deposit functionality.
 	StorjTokens() StorjTokens
 
 	// Invoices exposes all needed functionality to manage account invoices.
@@ -0,0 +1,22 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+)
+
+// Customers exposes all needed functionality to manage the customers of a payment provider.
+//
+// architecture: Service
+type Customers interface {
+	// Setup creates a payment account for the user.
+	// If account is already set up it will return nil.
+	Setup(ctx context.Context, userID uuid.UUID, email string) error
+
+	// Balance returns an object that represents current free credits and coins balance in cents.
+	Balance(ctx context.Context, userID uuid.UUID) (Balance, error)
+}
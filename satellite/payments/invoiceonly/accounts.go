@@ -0,0 +1,286 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments"
+)
+
+// ensures that accounts implements payments.Accounts.
+var _ payments.Accounts = (*accounts)(nil)
+
+// accounts is an implementation of payments.Accounts.
+//
+// architecture: Service
+type accounts struct {
+	service *Service
+}
+
+// Setup creates a payment account for the user.
+// The invoices are issued to the console accounts, so there is nothing to set up.
+func (accounts *accounts) Setup(ctx context.Context, userID uuid.UUID, email string) (err error) {
+	defer mon.Task()(&ctx, userID, email)(&err)
+	return nil
+}
+
+// Balance returns an object that represents current free credits and coins balance in cents.
+// The free credits are the amount paid in excess of the invoices, there are no coins
+// without a payment processor.
+func (accounts *accounts) Balance(ctx context.Context, userID uuid.UUID) (_ payments.Balance, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	credit, err := accounts.service.accountCredit(ctx, userID)
+	if err != nil {
+		return payments.Balance{}, Error.Wrap(err)
+	}
+	return payments.Balance{FreeCredits: credit}, nil
+}
+
+// ProjectCharges returns how much money current user will be charged for each project.
+func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID, since, before time.Time) (charges []payments.ProjectCharge, err error) {
+	defer mon.Task()(&ctx, userID, since, before)(&err)
+
+	// to return empty slice instead of nil if there are no projects
+	charges = make([]payments.ProjectCharge, 0)
+
+	projects, err := accounts.service.projectsDB.GetOwn(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, project := range projects {
+		usage, err := accounts.service.usageDB.GetProjectTotal(ctx, project.ID, since, before)
+		if err != nil {
+			return charges, Error.Wrap(err)
+		}
+
+		plan, err := accounts.service.projectPricePlan(ctx, &project)
+		if err != nil {
+			return charges, Error.Wrap(err)
+		}
+
+		billed := payments.NewProjectUsage(usage.Egress, usage.Storage, usage.ObjectCount)
+		price := accounts.service.priceModel.Price(billed)
+		if plan != nil {
+			price = plan.UsagePrice(billed)
+		}
+
+		charges = append(charges, payments.ProjectCharge{
+			ProjectUsage: *usage,
+
+			ProjectID:    project.ID,
+			Egress:       price.Egress.IntPart(),
+			ObjectCount:  price.Objects.IntPart(),
+			StorageGbHrs: price.Storage.IntPart(),
+		})
+	}
+
+	return charges, nil
+}
+
+// CheckProjectInvoicingStatus returns true if for the given project there is usage
+// which has not been invoiced yet.
+func (accounts *accounts) CheckProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) (unpaidUsage bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service := accounts.service
+
+	// we do not want to delete projects that have usage for the current month.
+	firstOfMonth, _ := billingPeriod(service.nowFn())
+
+	currentUsage, err := service.usageDB.GetProjectTotal(ctx, projectID, firstOfMonth, service.nowFn())
+	if err != nil {
+		return false, err
+	}
+	if currentUsage.Storage > 0 || currentUsage.Egress > 0 || currentUsage.ObjectCount > 0 {
+		return true, errors.New("usage for current month exists")
+	}
+
+	// if usage of last month exists, make sure that it was invoiced.
+	lastMonth := firstOfMonth.AddDate(0, -1, 0)
+	lastMonthUsage, err := service.usageDB.GetProjectTotal(ctx, projectID, lastMonth, firstOfMonth)
+	if err != nil {
+		return false, err
+	}
+	if lastMonthUsage.Storage <= 0 && lastMonthUsage.Egress <= 0 && lastMonthUsage.ObjectCount <= 0 {
+		return false, nil
+	}
+
+	project, err := service.projectsDB.Get(ctx, projectID)
+	if err != nil {
+		return true, err
+	}
+
+	_, err = service.db.Invoices().GetByUserIDAndPeriod(ctx, project.OwnerID, lastMonth)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return true, errors.New("usage for last month exist, but is not invoiced yet")
+		}
+		return true, err
+	}
+	return false, nil
+}
+
+// Charges returns the payments recorded for the invoices of the account.
+func (accounts *accounts) Charges(ctx context.Context, userID uuid.UUID) (_ []payments.Charge, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	userPayments, err := accounts.service.db.Payments().ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	charges := make([]payments.Charge, 0, len(userPayments))
+	for _, payment := range userPayments {
+		charges = append(charges, payments.Charge{
+			ID:        payment.ID.String(),
+			Amount:    payment.Amount,
+			CreatedAt: payment.PaidAt,
+		})
+	}
+	return charges, nil
+}
+
+// CreditCards exposes all needed functionality to manage account payment methods.
+func (accounts *accounts) CreditCards() payments.CreditCards {
+	return &creditCards{}
+}
+
+// StorjTokens exposes all storj token deposit functionality.
+func (accounts *accounts) StorjTokens() payments.StorjTokens {
+	return &storjTokens{}
+}
+
+// Invoices exposes all needed functionality to manage account invoices.
+func (accounts *accounts) Invoices() payments.Invoices {
+	return &invoices{service: accounts.service}
+}
+
+// Coupons exposes all needed functionality to manage coupons.
+func (accounts *accounts) Coupons() payments.Coupons {
+	return &coupons{service: accounts.service}
+}
+
+// creditCards is an implementation of payments.CreditCards for accounts
+// without payment methods.
+//
+// architecture: Service
+type creditCards struct{}
+
+// List returns a list of credit cards for a given payment account.
+func (creditCards *creditCards) List(ctx context.Context, userID uuid.UUID) (_ []payments.CreditCard, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return []payments.CreditCard{}, nil
+}
+
+// Add is used to save new credit card and attach it to payment account.
+func (creditCards *creditCards) Add(ctx context.Context, userID uuid.UUID, cardToken string) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return payments.ErrUnsupported.New("invoices are paid manually")
+}
+
+// Remove is used to detach a credit card from payment account.
+func (creditCards *creditCards) Remove(ctx context.Context, userID uuid.UUID, cardID string) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return payments.ErrUnsupported.New("invoices are paid manually")
+}
+
+// RemoveAll is used to detach all credit cards from payment account.
+func (creditCards *creditCards) RemoveAll(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil
+}
+
+// MakeDefault makes a credit card default payment method.
+func (creditCards *creditCards) MakeDefault(ctx context.Context, userID uuid.UUID, cardID string) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return payments.ErrUnsupported.New("invoices are paid manually")
+}
+
+// storjTokens is an implementation of payments.StorjTokens for accounts
+// that can't deposit tokens.
+//
+// architecture: Service
+type storjTokens struct{}
+
+// Deposit creates deposit transaction for specified amount in cents.
+func (tokens *storjTokens) Deposit(ctx context.Context, userID uuid.UUID, amount int64) (_ *payments.Transaction, err error) {
+	defer mon.Task()(&ctx, userID, amount)(&err)
+	return nil, payments.ErrUnsupported.New("invoices are paid manually")
+}
+
+// ListTransactionInfos returns all transactions associated with user.
+func (tokens *storjTokens) ListTransactionInfos(ctx context.Context, userID uuid.UUID) (_ []payments.TransactionInfo, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, nil
+}
+
+// ListDepositBonuses returns all deposit bonuses associated with user.
+func (tokens *storjTokens) ListDepositBonuses(ctx context.Context, userID uuid.UUID) (_ []payments.DepositBonus, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, nil
+}
+
+// coupons is an implementation of payments.Coupons. The coupons are applied to
+// the invoices, but they can't be created from coupon codes or promotions.
+//
+// architecture: Service
+type coupons struct {
+	service *Service
+}
+
+// GetByUserID returns the coupon applied to the specified user.
+func (coupons *coupons) GetByUserID(ctx context.Context, userID uuid.UUID) (_ *payments.Coupon, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, nil
+}
+
+// ListByUserID return list of all coupons of specified payment account.
+func (coupons *coupons) ListByUserID(ctx context.Context, userID uuid.UUID) (_ []payments.CouponOld, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	list, err := coupons.service.db.Coupons().ListByUserID(ctx, userID)
+	return list, Error.Wrap(err)
+}
+
+// TotalUsage returns sum of all usage records for specified coupon.
+func (coupons *coupons) TotalUsage(ctx context.Context, couponID uuid.UUID) (_ int64, err error) {
+	defer mon.Task()(&ctx, couponID)(&err)
+
+	usage, err := coupons.service.db.Coupons().TotalUsage(ctx, couponID)
+	return usage, Error.Wrap(err)
+}
+
+// Create attaches a coupon for payment account.
+func (coupons *coupons) Create(ctx context.Context, coupon payments.CouponOld) (_ payments.CouponOld, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	coupon, err = coupons.service.db.Coupons().Insert(ctx, coupon)
+	return coupon, Error.Wrap(err)
+}
+
+// AddPromotionalCoupon is used to add a promotional coupon for specified users.
+func (coupons *coupons) AddPromotionalCoupon(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return payments.ErrUnsupported.New("coupons are not supported")
+}
+
+// PopulatePromotionalCoupons is used to populate promotional coupons through all active users.
+func (coupons *coupons) PopulatePromotionalCoupons(ctx context.Context, duration *int, amount int64, projectLimit memory.Size) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return payments.ErrUnsupported.New("coupons are not supported")
+}
+
+// ApplyCouponCode attempts to apply a coupon code to the user.
+func (coupons *coupons) ApplyCouponCode(ctx context.Context, userID uuid.UUID, couponCode string) (_ *payments.Coupon, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, payments.ErrUnsupported.New("coupons are not supported")
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments"
+)
+
+// ErrInvoiceExists is error type of invoices that were already created for a billing period.
+var ErrInvoiceExists = errs.Class("invoice already exists")
+
+// DB is invoiceonly DB interface.
+//
+// architecture: Database
+type DB interface {
+	// Invoices is getter for invoices db.
+	Invoices() InvoicesDB
+	// Payments is getter for manual payments db.
+	Payments() PaymentsDB
+	// Coupons is getter for coupons db.
+	Coupons() CouponsDB
+}
+
+// InvoicesDB stores the invoices of the users.
+//
+// architecture: Database
+type InvoicesDB interface {
+	// Insert inserts an invoice, it returns ErrInvoiceExists when the user
+	// already has an invoice for the billing period.
+	Insert(ctx context.Context, invoice Invoice) error
+	// Get returns the invoice with the given id.
+	Get(ctx context.Context, id uuid.UUID) (*Invoice, error)
+	// GetByUserIDAndPeriod returns the invoice of the user for the billing period starting at periodStart.
+	GetByUserIDAndPeriod(ctx context.Context, userID uuid.UUID, periodStart time.Time) (*Invoice, error)
+	// ListByUserID returns the invoices of the user, the most recent billing period first.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]Invoice, error)
+}
+
+// PaymentsDB stores the manually recorded payments of invoices.
+//
+// architecture: Database
+type PaymentsDB interface {
+	// Insert inserts a payment.
+	Insert(ctx context.Context, payment Payment) error
+	// ListByInvoiceID returns the payments of the invoice, the oldest first.
+	ListByInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]Payment, error)
+	// ListByUserID returns the payments of all invoices of the user, the most recent first.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]Payment, error)
+}
+
+// CouponsDB stores the promotional coupons of the users and how much of them
+// the invoices used.
+//
+// architecture: Database
+type CouponsDB interface {
+	// Insert inserts a coupon into the database.
+	Insert(ctx context.Context, coupon payments.CouponOld) (payments.CouponOld, error)
+	// Update updates coupon in database.
+	Update(ctx context.Context, couponID uuid.UUID, status payments.CouponStatus) (payments.CouponOld, error)
+	// ListByUserID returns all coupons of specified user.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]payments.CouponOld, error)
+	// ListByUserIDAndStatus returns all coupons of specified user and status. Results are ordered (asc) by expiration date.
+	ListByUserIDAndStatus(ctx context.Context, userID uuid.UUID, status payments.CouponStatus) ([]payments.CouponOld, error)
+	// TotalUsage gets sum of all usage records for specified coupon.
+	TotalUsage(ctx context.Context, couponID uuid.UUID) (int64, error)
+	// AddUsage records the amount of the coupon used by the invoice of the
+	// billing period starting at period.
+	AddUsage(ctx context.Context, couponID uuid.UUID, amount int64, period time.Time) error
+}
+
+// Invoice is an invoice of the usage of a user during a billing period.
+type Invoice struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	// Amount is the total of the line items in cents, after the coupons and
+	// the account credit were applied.
+	Amount    int64
+	LineItems []LineItem
+	DueAt     time.Time
+	CreatedAt time.Time
+}
+
+// LineItem is a billed item of an invoice.
+type LineItem struct {
+	Description string `json:"description"`
+	Quantity    int64  `json:"quantity"`
+	// Amount is the price of the item in cents, negative for discounts.
+	Amount int64 `json:"amount"`
+	// Credit is set for the item that pays a part of the invoice with the
+	// account credit.
+	Credit bool `json:"credit,omitempty"`
+}
+
+// creditUsed returns the amount of the account credit that paid a part of the invoice.
+func (invoice *Invoice) creditUsed() (amount int64) {
+	for _, item := range invoice.LineItems {
+		if item.Credit {
+			amount -= item.Amount
+		}
+	}
+	return amount
+}
+
+// Payment is a payment of an invoice, recorded by the satellite operator.
+type Payment struct {
+	ID        uuid.UUID
+	InvoiceID uuid.UUID
+	// Amount is the paid amount in cents.
+	Amount int64
+	// Reference identifies the payment, e.g. the bank transfer reference.
+	Reference string
+	PaidAt    time.Time
+	CreatedAt time.Time
+}
@@ -0,0 +1,178 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"context"
+	"fmt"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments"
+)
+
+// ensures that invoices implements payments.Invoices.
+var _ payments.Invoices = (*invoices)(nil)
+
+// invoices is an implementation of payments.Invoices.
+//
+// architecture: Service
+type invoices struct {
+	service *Service
+}
+
+// List returns a list of invoices for a given payment account.
+func (invoices *invoices) List(ctx context.Context, userID uuid.UUID) (_ []payments.Invoice, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	userInvoices, err := invoices.service.db.Invoices().ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	paid, err := invoices.service.paidAmounts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]payments.Invoice, 0, len(userInvoices))
+	for i := range userInvoices {
+		invoice := &userInvoices[i]
+		list = append(list, payments.Invoice{
+			ID:          invoice.ID.String(),
+			Description: fmt.Sprintf("Usage %s", invoice.PeriodStart.Format("January 2006")),
+			Amount:      invoice.Amount,
+			Status:      invoiceStatus(invoice, paid[invoice.ID]),
+			Link:        fmt.Sprintf("/api/v0/payments/invoices/%s/document", invoice.ID),
+			Start:       invoice.PeriodStart,
+			End:         invoice.PeriodEnd,
+		})
+	}
+	return list, nil
+}
+
+// CheckPendingItems returns if the payment account has invoices that aren't paid in full.
+func (invoices *invoices) CheckPendingItems(ctx context.Context, userID uuid.UUID) (existingItems bool, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	userInvoices, err := invoices.service.db.Invoices().ListByUserID(ctx, userID)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	paid, err := invoices.service.paidAmounts(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	for i := range userInvoices {
+		if invoiceStatus(&userInvoices[i], paid[userInvoices[i].ID]) != InvoiceStatusPaid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Document renders an invoice of the payment account in the given format.
+func (invoices *invoices) Document(ctx context.Context, userID uuid.UUID, invoiceID string, format payments.InvoiceFormat) (_ *payments.InvoiceDocument, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	id, err := uuid.FromString(invoiceID)
+	if err != nil {
+		return nil, payments.ErrInvoiceNotFound.New("%s", invoiceID)
+	}
+
+	invoice, err := invoices.service.getInvoice(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// don't reveal the invoices of other users.
+	if invoice.UserID != userID {
+		return nil, payments.ErrInvoiceNotFound.New("%s", invoiceID)
+	}
+
+	return invoices.service.Document(ctx, invoice, format)
+}
+
+// Preview estimates the invoice of the current billing period from the usage so far.
+// The line items, the coupons and the account credit are calculated the same way
+// as when the invoice is created.
+func (invoices *invoices) Preview(ctx context.Context, userID uuid.UUID) (_ *payments.InvoicePreview, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	service := invoices.service
+
+	now := service.nowFn().UTC()
+	start, end := billingPeriod(now)
+
+	preview := &payments.InvoicePreview{
+		PeriodStart: start,
+		PeriodEnd:   end,
+		UsageUntil:  now,
+		Projects:    []payments.ProjectInvoicePreview{},
+		Discounts:   []payments.InvoicePreviewItem{},
+	}
+
+	projects, err := service.projectsDB.GetOwn(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var projectedSubtotal int64
+	for _, project := range projects {
+		usage, err := service.usageDB.GetProjectTotal(ctx, project.ID, start, now)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		plan, err := service.projectPricePlan(ctx, &project)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		projectPreview := payments.ProjectInvoicePreview{
+			ProjectID:   project.ID,
+			ProjectName: project.Name,
+			Items:       []payments.InvoicePreviewItem{},
+		}
+		for _, item := range service.projectLineItems(project.Name, plan, usage) {
+			projectPreview.Items = append(projectPreview.Items, previewItem(item))
+			projectPreview.Total += item.Amount
+		}
+		preview.Projects = append(preview.Projects, projectPreview)
+		preview.Subtotal += projectPreview.Total
+
+		projected := payments.ProjectedUsage(*usage, start, now, end)
+		for _, item := range service.projectLineItems(project.Name, plan, &projected) {
+			projectedSubtotal += item.Amount
+		}
+	}
+
+	applied, total, err := service.applyDiscounts(ctx, userID, preview.Subtotal, end)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, item := range applied.items {
+		if !item.Credit {
+			preview.Discounts = append(preview.Discounts, previewItem(item))
+		}
+	}
+	preview.Credits = applied.credits
+	preview.Total = total
+
+	_, preview.ProjectedTotal, err = service.applyDiscounts(ctx, userID, projectedSubtotal, end)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return preview, nil
+}
+
+// previewItem converts the line item to a preview item.
+func previewItem(item LineItem) payments.InvoicePreviewItem {
+	return payments.InvoicePreviewItem{
+		Description: item.Description,
+		Quantity:    item.Quantity,
+		Amount:      item.Amount,
+	}
+}
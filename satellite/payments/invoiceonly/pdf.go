@@ -0,0 +1,199 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 page dimensions and margins in points.
+const (
+	pdfPageWidth  = 595
+	pdfPageHeight = 842
+	pdfMargin     = 50
+)
+
+// pdfCell is a text positioned on the current line of a page.
+type pdfCell struct {
+	x    float64
+	text string
+	bold bool
+}
+
+// pdfWriter lays out lines of text on the pages of a PDF document
+// which uses the standard Helvetica fonts only.
+type pdfWriter struct {
+	pages []*bytes.Buffer
+	y     float64
+}
+
+// line writes the cells on a new line, starting a new page when the current one is full.
+func (w *pdfWriter) line(size float64, cells ...pdfCell) {
+	height := size * 1.6
+	if len(w.pages) == 0 || w.y-height < pdfMargin {
+		w.pages = append(w.pages, new(bytes.Buffer))
+		w.y = pdfPageHeight - pdfMargin
+	}
+	w.y -= height
+
+	page := w.pages[len(w.pages)-1]
+	for _, cell := range cells {
+		font := "F1"
+		if cell.bold {
+			font = "F2"
+		}
+		fmt.Fprintf(page, "BT /%s %.1f Tf %.1f %.1f Td (%s) Tj ET\n", font, size, cell.x, w.y, pdfEscape(cell.text))
+	}
+}
+
+// space adds vertical space.
+func (w *pdfWriter) space(size float64) {
+	w.line(size)
+}
+
+// bytes returns the PDF document.
+func (w *pdfWriter) bytes() []byte {
+	if len(w.pages) == 0 {
+		w.pages = append(w.pages, new(bytes.Buffer))
+	}
+
+	// objects 1 and 2 are the catalog and the page tree, 3 and 4 are the fonts,
+	// each page is followed by its content stream.
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	}
+
+	kids := make([]string, 0, len(w.pages))
+	for _, page := range w.pages {
+		pageID := len(objects) + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageID))
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				pdfPageWidth, pdfPageHeight, pageID+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()),
+		)
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(w.pages))
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return buf.Bytes()
+}
+
+// pdfEscape escapes the text for a PDF string literal. Characters
+// outside of printable ASCII are replaced, since only the standard fonts are used.
+func pdfEscape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < ' ' || r > '~':
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// wrapText splits the text into lines of at most width characters,
+// unless a single word is longer than that.
+func wrapText(text string, width int) []string {
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// renderPDF renders the invoice as a PDF document.
+func renderPDF(view *invoiceView) []byte {
+	const (
+		left     = pdfMargin
+		quantity = 360
+		amount   = 460
+	)
+
+	w := &pdfWriter{}
+	w.line(20, pdfCell{x: left, text: "Invoice " + view.Number, bold: true})
+	w.space(10)
+
+	if view.IssuerName != "" {
+		w.line(10, pdfCell{x: left, text: view.IssuerName, bold: true})
+	}
+	for _, line := range view.IssuerAddress {
+		w.line(10, pdfCell{x: left, text: line})
+	}
+	w.space(10)
+
+	w.line(10, pdfCell{x: left, text: "Billed to:", bold: true})
+	w.line(10, pdfCell{x: left, text: view.CustomerName})
+	w.line(10, pdfCell{x: left, text: view.CustomerEmail})
+	w.space(10)
+
+	w.line(10, pdfCell{x: left, text: "Issued: " + view.IssuedAt})
+	w.line(10, pdfCell{x: left, text: "Billing period: " + view.PeriodStart + " - " + view.PeriodEnd})
+	w.line(10, pdfCell{x: left, text: "Due: " + view.DueAt})
+	w.line(10, pdfCell{x: left, text: "Status: " + view.Status})
+	w.space(10)
+
+	w.line(10,
+		pdfCell{x: left, text: "Description", bold: true},
+		pdfCell{x: quantity, text: "Quantity", bold: true},
+		pdfCell{x: amount, text: "Amount", bold: true},
+	)
+	for _, item := range view.LineItems {
+		w.line(10,
+			pdfCell{x: left, text: item.Description},
+			pdfCell{x: quantity, text: item.Quantity},
+			pdfCell{x: amount, text: item.Amount},
+		)
+	}
+	w.space(10)
+
+	w.line(10, pdfCell{x: quantity, text: "Total"}, pdfCell{x: amount, text: view.Total})
+	w.line(10, pdfCell{x: quantity, text: "Paid"}, pdfCell{x: amount, text: view.Paid})
+	w.line(10, pdfCell{x: quantity, text: "Amount due", bold: true}, pdfCell{x: amount, text: view.Due, bold: true})
+
+	if view.PaymentInstructions != "" {
+		w.space(10)
+		for _, line := range wrapText(view.PaymentInstructions, 95) {
+			w.line(10, pdfCell{x: left, text: line})
+		}
+	}
+
+	return w.bytes()
+}
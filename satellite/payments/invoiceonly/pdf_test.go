@@ -0,0 +1,65 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderPDF(t *testing.T) {
+	view := &invoiceView{
+		Number:              "ABCD1234",
+		IssuerName:          "Issuer (Ltd)",
+		IssuerAddress:       []string{"Main Street 1", "12345 Town"},
+		CustomerName:        "Jürgen \\ User",
+		CustomerEmail:       "user@mail.test",
+		Total:               "$1.00",
+		Paid:                "$0.00",
+		Due:                 "$1.00",
+		Status:              InvoiceStatusOpen,
+		PaymentInstructions: "Pay by bank transfer.",
+	}
+	// enough line items to need a second page.
+	for i := 0; i < 60; i++ {
+		view.LineItems = append(view.LineItems, lineItemView{
+			Description: fmt.Sprintf("Project %d - Egress Bandwidth (MB)", i),
+			Quantity:    "1",
+			Amount:      "$0.01",
+		})
+	}
+
+	data := renderPDF(view)
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(data, []byte("%%EOF\n")))
+
+	require.Contains(t, string(data), "(Issuer \\(Ltd\\)) Tj")
+	require.Contains(t, string(data), "(J?rgen \\\\ User) Tj")
+	require.Contains(t, string(data), "/Count 2")
+
+	// every object must start at the offset listed in the cross-reference table.
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(data)
+	require.NotNil(t, startxref)
+	xref, err := strconv.Atoi(string(startxref[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data[xref:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(data[xref:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(data[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d", i+1)
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	require.Equal(t, []string{"aa bb", "cc", "dddddddd"}, wrapText("aa bb cc  dddddddd", 5))
+	require.Empty(t, wrapText("  ", 5))
+}
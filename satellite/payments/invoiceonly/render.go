@@ -0,0 +1,196 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"storj.io/storj/satellite/payments"
+)
+
+// invoiceView is the data printed on an invoice.
+type invoiceView struct {
+	Number        string
+	IssuerName    string
+	IssuerAddress []string
+
+	CustomerName  string
+	CustomerEmail string
+
+	IssuedAt    string
+	PeriodStart string
+	PeriodEnd   string
+	DueAt       string
+
+	LineItems []lineItemView
+
+	Total  string
+	Paid   string
+	Due    string
+	Status string
+
+	PaymentInstructions string
+}
+
+// lineItemView is a line item printed on an invoice.
+type lineItemView struct {
+	Description string
+	Quantity    string
+	Amount      string
+}
+
+// Document renders the invoice in the given format.
+func (service *Service) Document(ctx context.Context, invoice *Invoice, format payments.InvoiceFormat) (_ *payments.InvoiceDocument, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	view, err := service.invoiceView(ctx, invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := fmt.Sprintf("invoice-%s-%s", invoice.PeriodStart.Format("2006-01"), view.Number)
+
+	switch format {
+	case payments.InvoiceFormatHTML:
+		var buf bytes.Buffer
+		if err := invoiceTemplate.Execute(&buf, view); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return &payments.InvoiceDocument{
+			FileName:    fileName + ".html",
+			ContentType: "text/html; charset=utf-8",
+			Data:        buf.Bytes(),
+		}, nil
+	case payments.InvoiceFormatPDF:
+		return &payments.InvoiceDocument{
+			FileName:    fileName + ".pdf",
+			ContentType: "application/pdf",
+			Data:        renderPDF(view),
+		}, nil
+	default:
+		return nil, Error.New("unknown invoice format %q", format)
+	}
+}
+
+// invoiceView collects the data printed on the invoice.
+func (service *Service) invoiceView(ctx context.Context, invoice *Invoice) (_ *invoiceView, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := service.usersDB.Get(ctx, invoice.UserID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	invoicePayments, err := service.db.Payments().ListByInvoiceID(ctx, invoice.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var paid int64
+	for _, payment := range invoicePayments {
+		paid += payment.Amount
+	}
+	due := invoice.Amount - paid
+	if due < 0 {
+		due = 0
+	}
+
+	const dateFormat = "January 2, 2006"
+
+	view := &invoiceView{
+		Number:     strings.ToUpper(invoice.ID.String()[:8]),
+		IssuerName: service.config.IssuerName,
+
+		CustomerName:  user.FullName,
+		CustomerEmail: user.Email,
+
+		IssuedAt:    invoice.CreatedAt.UTC().Format(dateFormat),
+		PeriodStart: invoice.PeriodStart.UTC().Format(dateFormat),
+		// the period end is exclusive.
+		PeriodEnd: invoice.PeriodEnd.UTC().AddDate(0, 0, -1).Format(dateFormat),
+		DueAt:     invoice.DueAt.UTC().Format(dateFormat),
+
+		Total:  formatCents(invoice.Amount),
+		Paid:   formatCents(paid),
+		Due:    formatCents(due),
+		Status: invoiceStatus(invoice, paid),
+
+		PaymentInstructions: service.config.PaymentInstructions,
+	}
+
+	for _, line := range strings.Split(service.config.IssuerAddress, ";") {
+		if line = strings.TrimSpace(line); line != "" {
+			view.IssuerAddress = append(view.IssuerAddress, line)
+		}
+	}
+
+	for _, item := range invoice.LineItems {
+		view.LineItems = append(view.LineItems, lineItemView{
+			Description: item.Description,
+			Quantity:    fmt.Sprintf("%d", item.Quantity),
+			Amount:      formatCents(item.Amount),
+		})
+	}
+
+	return view, nil
+}
+
+// formatCents formats an amount in cents as dollars.
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s$%d.%02d", sign, cents/100, cents%100)
+}
+
+var invoiceTemplate = template.Must(template.New("invoice").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Invoice {{.Number}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; font-size: 14px; margin: 40px; color: #1b2533; }
+table { border-collapse: collapse; width: 100%; margin: 24px 0; }
+th, td { border-bottom: 1px solid #dadde5; padding: 8px; text-align: left; }
+td.number, th.number { text-align: right; }
+.summary td { border: none; }
+</style>
+</head>
+<body>
+<h1>Invoice {{.Number}}</h1>
+<p>
+{{- if .IssuerName}}<strong>{{.IssuerName}}</strong><br>{{end}}
+{{- range .IssuerAddress}}{{.}}<br>{{end}}
+</p>
+<p>Billed to:<br>{{.CustomerName}}<br>{{.CustomerEmail}}</p>
+<p>
+Issued: {{.IssuedAt}}<br>
+Billing period: {{.PeriodStart}} - {{.PeriodEnd}}<br>
+Due: {{.DueAt}}<br>
+Status: {{.Status}}
+</p>
+<table>
+<thead><tr><th>Description</th><th class="number">Quantity</th><th class="number">Amount</th></tr></thead>
+<tbody>
+{{- range .LineItems}}
+<tr><td>{{.Description}}</td><td class="number">{{.Quantity}}</td><td class="number">{{.Amount}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+<table class="summary">
+<tr><td class="number">Total</td><td class="number">{{.Total}}</td></tr>
+<tr><td class="number">Paid</td><td class="number">{{.Paid}}</td></tr>
+<tr><td class="number"><strong>Amount due</strong></td><td class="number"><strong>{{.Due}}</strong></td></tr>
+</table>
+{{- if .PaymentInstructions}}
+<p>{{.PaymentInstructions}}</p>
+{{- end}}
+</body>
+</html>
+`))
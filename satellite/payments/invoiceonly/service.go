@@ -0,0 +1,526 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package invoiceonly implements a payment provider that doesn't process
+// payments: it issues invoices for the project usage and keeps track of the
+// payments the satellite operator records manually.
+package invoiceonly
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/priceplans"
+)
+
+var (
+	// Error defines invoiceonly service error.
+	Error = errs.Class("invoiceonly service")
+
+	mon = monkit.Package()
+)
+
+// Config stores the configuration of the invoice only payment provider.
+type Config struct {
+	IssuerName          string        `help:"name of the business issuing the invoices" default:""`
+	IssuerAddress       string        `help:"postal address of the business issuing the invoices, lines are separated by semicolons" default:""`
+	PaymentInstructions string        `help:"instructions on how to pay the invoices, printed on every invoice" default:""`
+	DueAfter            time.Duration `help:"how long after the end of the billing period an invoice is due" default:"720h"`
+	ListingLimit        int           `help:"sets the maximum amount of items before we start paging on requests" default:"100" hidden:"true"`
+}
+
+const (
+	// InvoiceStatusPaid is the status of invoices that are paid in full.
+	InvoiceStatusPaid = "paid"
+	// InvoiceStatusOpen is the status of invoices that aren't paid in full.
+	InvoiceStatusOpen = "open"
+)
+
+// Service is an implementation of a payment provider that only issues invoices.
+//
+// architecture: Service
+type Service struct {
+	log        *zap.Logger
+	db         DB
+	projectsDB console.Projects
+	usersDB    console.Users
+	usageDB    accounting.ProjectAccounting
+	pricePlans priceplans.DB
+	priceModel payments.ProjectUsagePriceModel
+	config     Config
+
+	nowFn func() time.Time
+}
+
+// NewService creates a Service instance.
+func NewService(log *zap.Logger, db DB, projectsDB console.Projects, usersDB console.Users, usageDB accounting.ProjectAccounting, pricePlans priceplans.DB, priceModel payments.ProjectUsagePriceModel, config Config) *Service {
+	return &Service{
+		log:        log,
+		db:         db,
+		projectsDB: projectsDB,
+		usersDB:    usersDB,
+		usageDB:    usageDB,
+		pricePlans: pricePlans,
+		priceModel: priceModel,
+		config:     config,
+		nowFn:      time.Now,
+	}
+}
+
+// Accounts exposes all needed functionality to manage payment accounts.
+func (service *Service) Accounts() payments.Accounts {
+	return &accounts{service: service}
+}
+
+// SetNow allows tests to have the Service act as if the current time is whatever
+// they want. This avoids races and sleeping, making tests more reliable and efficient.
+func (service *Service) SetNow(now func() time.Time) {
+	service.nowFn = now
+}
+
+// billingPeriod returns the first day of the month of the period and the first day of the next month.
+func billingPeriod(period time.Time) (start, end time.Time) {
+	utc := period.UTC()
+	start = time.Date(utc.Year(), utc.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// CreateInvoices creates the invoices of all project owners for the billing
+// period of the month of period. Invoices that already exist are left as they are,
+// so that the creation can be retried.
+func (service *Service) CreateInvoices(ctx context.Context, period time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	start, end := billingPeriod(period)
+	if end.After(service.nowFn()) {
+		return Error.New("allowed for past periods only")
+	}
+
+	owners := make(map[uuid.UUID][]console.Project)
+	var ownerIDs []uuid.UUID
+
+	page := console.ProjectsPage{Next: true}
+	for page.Next {
+		if err = ctx.Err(); err != nil {
+			return Error.Wrap(err)
+		}
+
+		page, err = service.projectsDB.List(ctx, page.NextOffset, service.config.ListingLimit, end)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, project := range page.Projects {
+			if _, ok := owners[project.OwnerID]; !ok {
+				ownerIDs = append(ownerIDs, project.OwnerID)
+			}
+			owners[project.OwnerID] = append(owners[project.OwnerID], project)
+		}
+	}
+
+	var created, existing int
+	for _, ownerID := range ownerIDs {
+		// the coupons and the account credit must not be applied twice when
+		// the creation is retried.
+		_, err := service.db.Invoices().GetByUserIDAndPeriod(ctx, ownerID, start)
+		switch {
+		case err == nil:
+			existing++
+			continue
+		case !errors.Is(err, sql.ErrNoRows):
+			return Error.Wrap(err)
+		}
+
+		invoice, discounts, err := service.newInvoice(ctx, ownerID, owners[ownerID], start, end)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		if err = service.expireCoupons(ctx, discounts.expired); err != nil {
+			return Error.Wrap(err)
+		}
+		if len(invoice.LineItems) == 0 {
+			continue
+		}
+
+		err = service.db.Invoices().Insert(ctx, invoice)
+		switch {
+		case ErrInvoiceExists.Has(err):
+			existing++
+			continue
+		case err != nil:
+			return Error.Wrap(err)
+		}
+
+		if err = service.useCoupons(ctx, discounts.usages, start); err != nil {
+			return Error.Wrap(err)
+		}
+		created++
+	}
+
+	service.log.Info("Invoices created.",
+		zap.Time("period", start),
+		zap.Int("Created", created),
+		zap.Int("Existing", existing))
+	return nil
+}
+
+// newInvoice creates the invoice of the project usage of a user during the billing period.
+// The projects are priced with their price plans, then the coupons and the account
+// credit of the user are applied the same way as for Stripe invoices.
+func (service *Service) newInvoice(ctx context.Context, userID uuid.UUID, projects []console.Project, start, end time.Time) (_ Invoice, _ discounts, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return Invoice{}, discounts{}, err
+	}
+
+	invoice := Invoice{
+		ID:          id,
+		UserID:      userID,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		DueAt:       end.Add(service.config.DueAfter),
+		CreatedAt:   service.nowFn(),
+	}
+
+	var subtotal int64
+	for _, project := range projects {
+		usage, err := service.usageDB.GetProjectTotal(ctx, project.ID, start, end)
+		if err != nil {
+			return Invoice{}, discounts{}, err
+		}
+
+		plan, err := service.projectPricePlan(ctx, &project)
+		if err != nil {
+			return Invoice{}, discounts{}, err
+		}
+
+		for _, item := range service.projectLineItems(project.Name, plan, usage) {
+			if item.Amount == 0 {
+				continue
+			}
+			invoice.LineItems = append(invoice.LineItems, item)
+			subtotal += item.Amount
+		}
+	}
+	applied, leftToCharge, err := service.applyDiscounts(ctx, userID, subtotal, end)
+	if err != nil {
+		return Invoice{}, discounts{}, err
+	}
+	invoice.LineItems = append(invoice.LineItems, applied.items...)
+	invoice.Amount = leftToCharge
+
+	return invoice, applied, nil
+}
+
+// projectLineItems returns the line items of the project usage, priced with
+// the price plan of the project when there is one.
+func (service *Service) projectLineItems(projectName string, plan *priceplans.Plan, usage *accounting.ProjectUsage) []LineItem {
+	billed := payments.NewProjectUsage(usage.Egress, usage.Storage, usage.ObjectCount)
+	if plan != nil {
+		return planLineItems(projectName, plan, billed)
+	}
+
+	price := service.priceModel.Price(billed)
+
+	return []LineItem{
+		{
+			Description: fmt.Sprintf("Project %s - Object Storage (MB-Month)", projectName),
+			Quantity:    billed.StorageMBMonths.IntPart(),
+			Amount:      price.Storage.IntPart(),
+		},
+		{
+			Description: fmt.Sprintf("Project %s - Egress Bandwidth (MB)", projectName),
+			Quantity:    billed.EgressMB.IntPart(),
+			Amount:      price.Egress.IntPart(),
+		},
+		{
+			Description: fmt.Sprintf("Project %s - Object Fee (Object-Month)", projectName),
+			Quantity:    billed.ObjectMonths.IntPart(),
+			Amount:      price.Objects.IntPart(),
+		},
+	}
+}
+
+// planLineItems returns the line items of the project usage priced with the price plan.
+func planLineItems(projectName string, plan *priceplans.Plan, billed payments.ProjectUsage) []LineItem {
+	charge := plan.Price(billed)
+
+	items := []LineItem{
+		{
+			Description: fmt.Sprintf("Project %s - Object Storage (MB-Month, %s plan)", projectName, plan.Name),
+			Quantity:    billed.StorageMBMonths.IntPart(),
+			Amount:      charge.Storage.IntPart(),
+		},
+		{
+			Description: fmt.Sprintf("Project %s - Egress Bandwidth (MB, %s plan)", projectName, plan.Name),
+			Quantity:    billed.EgressMB.IntPart(),
+			Amount:      charge.Egress.IntPart(),
+		},
+		{
+			Description: fmt.Sprintf("Project %s - Object Fee (Object-Month, %s plan)", projectName, plan.Name),
+			Quantity:    billed.ObjectMonths.IntPart(),
+			Amount:      charge.Objects.IntPart(),
+		},
+	}
+	if charge.Minimum.IsPositive() {
+		items = append(items, LineItem{
+			Description: fmt.Sprintf("Project %s - Minimum Monthly Charge (%s plan)", projectName, plan.Name),
+			Quantity:    1,
+			Amount:      charge.Minimum.IntPart(),
+		})
+	}
+	return items
+}
+
+// projectPricePlan returns the price plan of the project, or nil when the
+// satellite-wide prices apply.
+func (service *Service) projectPricePlan(ctx context.Context, project *console.Project) (_ *priceplans.Plan, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	plan, err := service.pricePlans.GetForProject(ctx, project.ID, project.PartnerID)
+	if err != nil {
+		if priceplans.ErrNotFound.Has(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return plan, nil
+}
+
+// couponUsage is the amount of a coupon that an invoice uses.
+type couponUsage struct {
+	coupon payments.CouponOld
+	amount int64
+}
+
+// discounts are the coupons and the account credit applied to an invoice.
+type discounts struct {
+	// items are the line items of the discounts, with negative amounts.
+	items  []LineItem
+	usages []couponUsage
+	// expired are the coupons that expire with the billing period.
+	expired []uuid.UUID
+	credits int64
+}
+
+// applyDiscounts applies the active coupons and then the account credit of the
+// user to the subtotal of the invoice of the billing period ending at end, the
+// same way as for Stripe invoices. It doesn't modify the coupons, it returns
+// what has to be recorded when the invoice is created.
+func (service *Service) applyDiscounts(ctx context.Context, userID uuid.UUID, subtotal int64, end time.Time) (_ discounts, leftToCharge int64, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	var applied discounts
+	leftToCharge = subtotal
+
+	coupons, err := service.db.Coupons().ListByUserIDAndStatus(ctx, userID, payments.CouponActive)
+	if err != nil {
+		return discounts{}, 0, err
+	}
+
+	for _, coupon := range coupons {
+		expirationDate := coupon.ExpirationDate()
+		if expirationDate != nil && end.After(*expirationDate) {
+			applied.expired = append(applied.expired, coupon.ID)
+			continue
+		}
+
+		alreadyChargedAmount, err := service.db.Coupons().TotalUsage(ctx, coupon.ID)
+		if err != nil {
+			return discounts{}, 0, err
+		}
+		remaining := coupon.Amount - alreadyChargedAmount
+
+		amount := leftToCharge
+		if amount >= remaining {
+			amount = remaining
+		}
+
+		if amount > 0 {
+			applied.usages = append(applied.usages, couponUsage{coupon: coupon, amount: amount})
+			applied.items = append(applied.items, LineItem{
+				Description: coupon.Description,
+				Quantity:    1,
+				Amount:      -amount,
+			})
+			leftToCharge -= amount
+		}
+
+		if amount < remaining && expirationDate != nil && end.Equal(*expirationDate) {
+			// the coupon was not fully spent, but this is the last month it is valid for.
+			applied.expired = append(applied.expired, coupon.ID)
+		}
+	}
+
+	credit, err := service.accountCredit(ctx, userID)
+	if err != nil {
+		return discounts{}, 0, err
+	}
+	if credit > leftToCharge {
+		credit = leftToCharge
+	}
+	if credit > 0 {
+		applied.credits = credit
+		applied.items = append(applied.items, LineItem{
+			Description: "Account credit",
+			Quantity:    1,
+			Amount:      -credit,
+			Credit:      true,
+		})
+		leftToCharge -= credit
+	}
+
+	return applied, leftToCharge, nil
+}
+
+// expireCoupons marks the coupons as expired.
+func (service *Service) expireCoupons(ctx context.Context, couponIDs []uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, couponID := range couponIDs {
+		if _, err = service.db.Coupons().Update(ctx, couponID, payments.CouponExpired); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// useCoupons records the coupon usages of the invoice of the billing period
+// starting at period, and marks the coupons that are spent as used.
+func (service *Service) useCoupons(ctx context.Context, usages []couponUsage, period time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, usage := range usages {
+		if err = service.db.Coupons().AddUsage(ctx, usage.coupon.ID, usage.amount, period); err != nil {
+			return err
+		}
+
+		totalUsage, err := service.db.Coupons().TotalUsage(ctx, usage.coupon.ID)
+		if err != nil {
+			return err
+		}
+		if totalUsage == usage.coupon.Amount {
+			if _, err = service.db.Coupons().Update(ctx, usage.coupon.ID, payments.CouponUsed); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// accountCredit returns the amount the user paid in excess of their invoices,
+// which is credited to the next invoices.
+func (service *Service) accountCredit(ctx context.Context, userID uuid.UUID) (_ int64, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	userInvoices, err := service.db.Invoices().ListByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	paid, err := service.paidAmounts(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	var credit int64
+	for i := range userInvoices {
+		invoice := &userInvoices[i]
+		credit += paid[invoice.ID] - invoice.Amount - invoice.creditUsed()
+	}
+	if credit < 0 {
+		return 0, nil
+	}
+	return credit, nil
+}
+
+// RecordPayment records a payment of an invoice that was received outside of the satellite.
+func (service *Service) RecordPayment(ctx context.Context, invoiceID uuid.UUID, amount int64, reference string, paidAt time.Time) (_ *Payment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if amount <= 0 {
+		return nil, Error.New("payment amount must be positive")
+	}
+
+	_, err = service.getInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	payment := Payment{
+		ID:        id,
+		InvoiceID: invoiceID,
+		Amount:    amount,
+		Reference: reference,
+		PaidAt:    paidAt,
+		CreatedAt: service.nowFn(),
+	}
+
+	err = service.db.Payments().Insert(ctx, payment)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	service.log.Info("Payment recorded.",
+		zap.Stringer("Invoice", invoiceID),
+		zap.Int64("Amount", amount),
+		zap.String("Reference", reference))
+	return &payment, nil
+}
+
+// getInvoice returns the invoice, or payments.ErrInvoiceNotFound when it doesn't exist.
+func (service *Service) getInvoice(ctx context.Context, invoiceID uuid.UUID) (_ *Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invoice, err := service.db.Invoices().Get(ctx, invoiceID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, payments.ErrInvoiceNotFound.New("%s", invoiceID)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return invoice, nil
+}
+
+// paidAmounts returns the total paid amount of each invoice of the user.
+func (service *Service) paidAmounts(ctx context.Context, userID uuid.UUID) (_ map[uuid.UUID]int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	userPayments, err := service.db.Payments().ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	paid := make(map[uuid.UUID]int64)
+	for _, payment := range userPayments {
+		paid[payment.InvoiceID] += payment.Amount
+	}
+	return paid, nil
+}
+
+// invoiceStatus returns the status of the invoice given the amount paid so far.
+func invoiceStatus(invoice *Invoice, paid int64) string {
+	if paid >= invoice.Amount {
+		return InvoiceStatusPaid
+	}
+	return InvoiceStatusOpen
+}
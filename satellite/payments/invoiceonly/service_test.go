@@ -0,0 +1,255 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/invoiceonly"
+	"storj.io/storj/satellite/payments/paymentsconfig"
+	"storj.io/storj/satellite/payments/priceplans"
+)
+
+func TestService_InvoicesAndPayments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Payments.Provider = paymentsconfig.ProviderInvoiceOnly
+				config.Payments.InvoiceOnly.IssuerName = "Test Satellite (Operator)"
+				config.Payments.InvoiceOnly.IssuerAddress = "Main Street 1; 12345 Town"
+				config.Payments.InvoiceOnly.PaymentInstructions = "Pay by bank transfer."
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Payments.InvoiceOnly
+		accounts := sat.API.Payments.Accounts
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Invoiced User",
+			Email:    "invoiced@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "invoiced")
+		require.NoError(t, err)
+
+		// a user without usage doesn't receive an invoice.
+		idle, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Idle User",
+			Email:    "idle@mail.test",
+		}, 1)
+		require.NoError(t, err)
+		_, err = sat.AddProject(ctx, idle.ID, "idle")
+		require.NoError(t, err)
+
+		// pick a specific date so that it doesn't fail if it's the last day of the month
+		// keep month + 1 because users need to be created before the billing period.
+		period := time.Date(time.Now().Year(), time.Now().Month()+1, 20, 0, 0, 0, 0, time.UTC)
+		err = sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte("testbucket"),
+			pb.PieceAction_GET, 10*memory.GiB.Int64(), period)
+		require.NoError(t, err)
+
+		// the current billing period can't be invoiced.
+		service.SetNow(func() time.Time { return period })
+		require.Error(t, service.CreateInvoices(ctx, period))
+
+		// the preview of the current billing period contains the usage so far.
+		service.SetNow(func() time.Time { return period.Add(12 * time.Hour) })
+		preview, err := accounts.Invoices().Preview(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, preview.Projects, 1)
+		require.Equal(t, project.ID, preview.Projects[0].ProjectID)
+		require.Positive(t, preview.Total)
+		require.Greater(t, preview.ProjectedTotal, preview.Total)
+
+		service.SetNow(func() time.Time {
+			return time.Date(period.Year(), period.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		})
+		require.NoError(t, service.CreateInvoices(ctx, period))
+		// creating the invoices again doesn't duplicate them.
+		require.NoError(t, service.CreateInvoices(ctx, period))
+
+		invoices, err := accounts.Invoices().List(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, invoices, 1)
+		invoice := invoices[0]
+		require.Equal(t, invoiceonly.InvoiceStatusOpen, invoice.Status)
+		require.Positive(t, invoice.Amount)
+		require.Equal(t, preview.Total, invoice.Amount)
+		require.Equal(t, time.Date(period.Year(), period.Month(), 1, 0, 0, 0, 0, time.UTC), invoice.Start.UTC())
+
+		idleInvoices, err := accounts.Invoices().List(ctx, idle.ID)
+		require.NoError(t, err)
+		require.Empty(t, idleInvoices)
+
+		pending, err := accounts.Invoices().CheckPendingItems(ctx, user.ID)
+		require.NoError(t, err)
+		require.True(t, pending)
+
+		document, err := accounts.Invoices().Document(ctx, user.ID, invoice.ID, payments.InvoiceFormatPDF)
+		require.NoError(t, err)
+		require.Equal(t, "application/pdf", document.ContentType)
+		require.True(t, bytes.HasPrefix(document.Data, []byte("%PDF-")))
+		require.Contains(t, string(document.Data), "Test Satellite \\(Operator\\)")
+
+		document, err = accounts.Invoices().Document(ctx, user.ID, invoice.ID, payments.InvoiceFormatHTML)
+		require.NoError(t, err)
+		require.Contains(t, string(document.Data), "Invoiced User")
+		require.Contains(t, string(document.Data), "Main Street 1")
+
+		// the invoices of other users can't be rendered.
+		_, err = accounts.Invoices().Document(ctx, idle.ID, invoice.ID, payments.InvoiceFormatHTML)
+		require.True(t, payments.ErrInvoiceNotFound.Has(err))
+		_, err = accounts.Invoices().Document(ctx, user.ID, testrand.UUID().String(), payments.InvoiceFormatHTML)
+		require.True(t, payments.ErrInvoiceNotFound.Has(err))
+
+		invoiceID := invoices[0].ID
+		id, err := uuid.FromString(invoiceID)
+		require.NoError(t, err)
+
+		_, err = service.RecordPayment(ctx, id, 0, "none", time.Now())
+		require.Error(t, err)
+		_, err = service.RecordPayment(ctx, testrand.UUID(), 100, "unknown", time.Now())
+		require.True(t, payments.ErrInvoiceNotFound.Has(err))
+
+		// a partial payment leaves the invoice open.
+		_, err = service.RecordPayment(ctx, id, invoice.Amount-1, "transfer-1", time.Now())
+		require.NoError(t, err)
+
+		invoices, err = accounts.Invoices().List(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, invoiceonly.InvoiceStatusOpen, invoices[0].Status)
+
+		_, err = service.RecordPayment(ctx, id, 1, "transfer-2", time.Now())
+		require.NoError(t, err)
+
+		invoices, err = accounts.Invoices().List(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, invoiceonly.InvoiceStatusPaid, invoices[0].Status)
+
+		pending, err = accounts.Invoices().CheckPendingItems(ctx, user.ID)
+		require.NoError(t, err)
+		require.False(t, pending)
+
+		charges, err := accounts.Charges(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, charges, 2)
+
+		// payment methods are not supported.
+		err = accounts.CreditCards().Add(ctx, user.ID, "token")
+		require.True(t, payments.ErrUnsupported.Has(err))
+		_, err = accounts.StorjTokens().Deposit(ctx, user.ID, 1000)
+		require.True(t, payments.ErrUnsupported.Has(err))
+	})
+}
+
+func TestService_PricePlansCouponsAndCredit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Payments.Provider = paymentsconfig.ProviderInvoiceOnly
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Payments.InvoiceOnly
+		accounts := sat.API.Payments.Accounts
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Plan User",
+			Email:    "plan@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "plan")
+		require.NoError(t, err)
+
+		// the project without usage is charged the minimum of its price plan.
+		free := priceplans.Rate{Tiers: []priceplans.Tier{{UpTo: 0, Price: decimal.Zero}}}
+		plan := priceplans.Plan{
+			ID:            testrand.UUID(),
+			Name:          "minimum",
+			Storage:       free,
+			Egress:        free,
+			Objects:       free,
+			MinimumCharge: 500,
+		}
+		require.NoError(t, sat.DB.PricePlans().Insert(ctx, plan))
+		require.NoError(t, sat.DB.PricePlans().AssignProject(ctx, project.ID, plan.ID))
+
+		_, err = accounts.Coupons().Create(ctx, payments.CouponOld{
+			ID:          testrand.UUID(),
+			UserID:      user.ID,
+			Amount:      200,
+			Description: "promotional credit",
+			Type:        payments.CouponTypePromotional,
+			Status:      payments.CouponActive,
+		})
+		require.NoError(t, err)
+
+		firstPeriod := time.Date(time.Now().Year(), time.Now().Month()+1, 20, 0, 0, 0, 0, time.UTC)
+		secondPeriod := firstPeriod.AddDate(0, 1, 0)
+
+		service.SetNow(func() time.Time { return firstPeriod })
+		preview, err := accounts.Invoices().Preview(ctx, user.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 500, preview.Subtotal)
+		require.Len(t, preview.Discounts, 1)
+		require.EqualValues(t, 300, preview.Total)
+
+		service.SetNow(func() time.Time { return secondPeriod })
+		require.NoError(t, service.CreateInvoices(ctx, firstPeriod))
+		// retrying doesn't use the coupon again.
+		require.NoError(t, service.CreateInvoices(ctx, firstPeriod))
+
+		invoices, err := accounts.Invoices().List(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, invoices, 1)
+		require.Equal(t, preview.Total, invoices[0].Amount)
+
+		usage, err := sat.DB.InvoiceOnly().Coupons().ListByUserIDAndStatus(ctx, user.ID, payments.CouponUsed)
+		require.NoError(t, err)
+		require.Len(t, usage, 1)
+
+		// paying more than the invoice credits the rest to the next invoice.
+		id, err := uuid.FromString(invoices[0].ID)
+		require.NoError(t, err)
+		_, err = service.RecordPayment(ctx, id, 400, "transfer", time.Now())
+		require.NoError(t, err)
+
+		balance, err := accounts.Balance(ctx, user.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, balance.FreeCredits)
+
+		service.SetNow(func() time.Time { return secondPeriod.AddDate(0, 1, 0) })
+		require.NoError(t, service.CreateInvoices(ctx, secondPeriod))
+
+		invoices, err = accounts.Invoices().List(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, invoices, 2)
+		require.EqualValues(t, 400, invoices[0].Amount)
+
+		balance, err = accounts.Balance(ctx, user.ID)
+		require.NoError(t, err)
+		require.Zero(t, balance.FreeCredits)
+	})
+}
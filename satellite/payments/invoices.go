@@ -7,6 +7,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/common/uuid"
 )
 
@@ -18,8 +20,16 @@ type Invoices interface {
 	List(ctx context.Context, userID uuid.UUID) ([]Invoice, error)
 	// CheckPendingItems returns if pending invoice items for a given payment account exist.
 	CheckPendingItems(ctx context.Context, userID uuid.UUID) (existingItems bool, err error)
+	// Document renders an invoice of the payment account in the given format.
+	// Providers that host the invoices themselves return ErrUnsupported.
+	Document(ctx context.Context, userID uuid.UUID, invoiceID string, format InvoiceFormat) (*InvoiceDocument, error)
+	// Preview estimates the invoice of the current billing period from the usage so far.
+	Preview(ctx context.Context, userID uuid.UUID) (*InvoicePreview, error)
 }
 
+// ErrInvoiceNotFound is an error type which indicates that the invoice doesn't exist.
+var ErrInvoiceNotFound = errs.Class("invoice not found")
+
 // Invoice holds all public information about invoice.
 type Invoice struct {
 	ID          string    `json:"id"`
@@ -30,3 +40,59 @@ type Invoice struct {
 	Start       time.Time `json:"start"`
 	End         time.Time `json:"end"`
 }
+
+// InvoiceFormat is the file format of a rendered invoice.
+type InvoiceFormat string
+
+const (
+	// InvoiceFormatHTML renders the invoice as an HTML page.
+	InvoiceFormatHTML InvoiceFormat = "html"
+	// InvoiceFormatPDF renders the invoice as a PDF document.
+	InvoiceFormatPDF InvoiceFormat = "pdf"
+)
+
+// InvoiceDocument is a rendered invoice.
+type InvoiceDocument struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// InvoicePreview is an estimate of the invoice of the current billing period.
+// All amounts are in cents.
+type InvoicePreview struct {
+	PeriodStart time.Time `json:"periodStart"`
+	PeriodEnd   time.Time `json:"periodEnd"`
+	// UsageUntil is the time up to which the usage is included.
+	UsageUntil time.Time `json:"usageUntil"`
+
+	Projects []ProjectInvoicePreview `json:"projects"`
+	// Discounts are the coupons applied to the usage, with negative amounts.
+	Discounts []InvoicePreviewItem `json:"discounts"`
+	// Credits is the part of the total paid with the account balance.
+	Credits int64 `json:"credits"`
+
+	// Subtotal is the price of the usage of all projects.
+	Subtotal int64 `json:"subtotal"`
+	// Total is the amount due when the invoice was created now.
+	Total int64 `json:"total"`
+	// ProjectedTotal is the amount due at the end of the period when the usage
+	// continues at the same rate.
+	ProjectedTotal int64 `json:"projectedTotal"`
+}
+
+// ProjectInvoicePreview contains the invoice line items of a project.
+type ProjectInvoicePreview struct {
+	ProjectID   uuid.UUID            `json:"projectId"`
+	ProjectName string               `json:"projectName"`
+	Items       []InvoicePreviewItem `json:"items"`
+	Total       int64                `json:"total"`
+}
+
+// InvoicePreviewItem is a line item of an invoice preview.
+type InvoicePreviewItem struct {
+	Description string `json:"description"`
+	// Quantity is zero for items that are priced as a whole, e.g. tiered prices.
+	Quantity int64 `json:"quantity"`
+	Amount   int64 `json:"amount"`
+}
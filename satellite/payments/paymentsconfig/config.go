@@ -7,13 +7,22 @@ import (
 	"strconv"
 
 	"storj.io/common/memory"
+	"storj.io/storj/satellite/payments/invoiceonly"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 )
 
+const (
+	// ProviderStripeCoinPayments processes the payments with Stripe and CoinPayments.
+	ProviderStripeCoinPayments = "stripecoinpayments"
+	// ProviderInvoiceOnly only issues invoices, the payments are recorded manually.
+	ProviderInvoiceOnly = "invoiceonly"
+)
+
 // Config defines global payments config.
 type Config struct {
-	Provider                 string `help:"payments provider to use" default:""`
+	Provider                 string `help:"payments provider to use: stripecoinpayments, invoiceonly or empty for the stripe mock" default:""`
 	StripeCoinPayments       stripecoinpayments.Config
+	InvoiceOnly              invoiceonly.Config
 	StorageTBPrice           string         `help:"price user should pay for storing TB per month" default:"4" testDefault:"10"`
 	EgressTBPrice            string         `help:"price user should pay for each TB of egress" default:"7" testDefault:"45"`
 	ObjectPrice              string         `help:"price user should pay for each object stored in network per month" default:"0" testDefault:"0.0000022"`
@@ -0,0 +1,43 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package priceplans
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+)
+
+// DB stores the price plans and their assignments.
+//
+// architecture: Database
+type DB interface {
+	// Insert inserts a price plan, it returns ErrNameTaken when a plan with
+	// the same name exists.
+	Insert(ctx context.Context, plan Plan) error
+	// Get returns the price plan with the given id.
+	Get(ctx context.Context, id uuid.UUID) (*Plan, error)
+	// List returns all price plans ordered by name.
+	List(ctx context.Context) ([]Plan, error)
+	// Update updates the name, rates and minimum charge of the price plan.
+	Update(ctx context.Context, plan Plan) error
+	// Delete deletes the price plan, it returns ErrInUse when the plan is assigned.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// AssignProject assigns the price plan to the project, replacing the current assignment.
+	AssignProject(ctx context.Context, projectID, planID uuid.UUID) error
+	// UnassignProject removes the price plan assignment of the project.
+	UnassignProject(ctx context.Context, projectID uuid.UUID) error
+	// AssignPartner assigns the price plan to the partner, replacing the current assignment.
+	AssignPartner(ctx context.Context, partnerID, planID uuid.UUID) error
+	// UnassignPartner removes the price plan assignment of the partner.
+	UnassignPartner(ctx context.Context, partnerID uuid.UUID) error
+	// GetAssignments returns the projects and partners the price plan is assigned to.
+	GetAssignments(ctx context.Context, planID uuid.UUID) (Assignments, error)
+
+	// GetForProject returns the price plan that applies to the project: the plan
+	// assigned to the project, otherwise the plan assigned to its partner.
+	// It returns ErrNotFound when no plan applies.
+	GetForProject(ctx context.Context, projectID, partnerID uuid.UUID) (*Plan, error)
+}
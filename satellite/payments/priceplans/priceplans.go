@@ -0,0 +1,178 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package priceplans implements usage based price plans with tiered rates,
+// included free allowances and minimum monthly charges. A plan is assigned to
+// a project or to a partner, and replaces the satellite-wide prices for the
+// projects it applies to.
+package priceplans
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments"
+)
+
+var (
+	// Error is the default price plans error class.
+	Error = errs.Class("price plans")
+	// ErrNotFound is returned when the price plan doesn't exist, or no plan
+	// is assigned to the project.
+	ErrNotFound = errs.Class("price plan not found")
+	// ErrNameTaken is returned when a price plan with the same name exists.
+	ErrNameTaken = errs.Class("price plan name taken")
+	// ErrInUse is returned when deleting a price plan that is still assigned.
+	ErrInUse = errs.Class("price plan in use")
+	// ErrInvalid is returned when the price plan definition is invalid.
+	ErrInvalid = errs.Class("invalid price plan")
+)
+
+// Plan is a price plan for the usage of a project.
+//
+// Storage is billed in MB-months, egress in MB and objects in object-months,
+// the same units the invoice line items use.
+type Plan struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Storage Rate      `json:"storage"`
+	Egress  Rate      `json:"egress"`
+	Objects Rate      `json:"objects"`
+	// MinimumCharge is the minimum monthly charge of a project in cents.
+	MinimumCharge int64 `json:"minimumCharge"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Rate is the price of a kind of usage.
+type Rate struct {
+	// Included is the amount of usage that is free of charge every month.
+	Included int64 `json:"included"`
+	// Tiers are the prices of the usage, ordered by the upper bound of the usage
+	// they apply to.
+	Tiers []Tier `json:"tiers"`
+}
+
+// Tier is the price of the usage up to a bound.
+type Tier struct {
+	// UpTo is the upper bound of the monthly usage the tier applies to,
+	// 0 means that the tier is not bounded. Only the last tier is unbounded.
+	UpTo int64 `json:"upTo"`
+	// Price is the price of a unit of usage in cents.
+	Price decimal.Decimal `json:"price"`
+}
+
+// Assignments are the projects and partners a price plan is assigned to.
+type Assignments struct {
+	Projects []uuid.UUID `json:"projects"`
+	Partners []uuid.UUID `json:"partners"`
+}
+
+// Validate checks that the price plan prices all usage.
+func (plan *Plan) Validate() error {
+	var group errs.Group
+	if plan.Name == "" {
+		group.Add(ErrInvalid.New("name is required"))
+	}
+	if plan.MinimumCharge < 0 {
+		group.Add(ErrInvalid.New("minimum charge can't be negative"))
+	}
+	group.Add(plan.Storage.validate("storage"))
+	group.Add(plan.Egress.validate("egress"))
+	group.Add(plan.Objects.validate("objects"))
+	return group.Err()
+}
+
+// validate checks that the tiers of the rate are ordered and the last one is unbounded.
+func (rate *Rate) validate(name string) error {
+	if rate.Included < 0 {
+		return ErrInvalid.New("%s: included usage can't be negative", name)
+	}
+	if len(rate.Tiers) == 0 {
+		return ErrInvalid.New("%s: at least one tier is required", name)
+	}
+
+	var previous int64
+	for i, tier := range rate.Tiers {
+		if tier.Price.IsNegative() {
+			return ErrInvalid.New("%s: tier %d: price can't be negative", name, i)
+		}
+		last := i == len(rate.Tiers)-1
+		switch {
+		case last && tier.UpTo != 0:
+			return ErrInvalid.New("%s: the last tier must be unbounded", name)
+		case !last && tier.UpTo <= previous:
+			return ErrInvalid.New("%s: tier %d: bounds must be positive and increasing", name, i)
+		}
+		previous = tier.UpTo
+	}
+	return nil
+}
+
+// Amount returns the price of the monthly usage in cents. The included usage
+// is free of charge, the rest is charged at the price of the tier it falls into.
+func (rate *Rate) Amount(quantity decimal.Decimal) decimal.Decimal {
+	included := decimal.NewFromInt(rate.Included)
+
+	amount := decimal.Zero
+	lower := decimal.Zero
+	for _, tier := range rate.Tiers {
+		upper := quantity
+		if tier.UpTo > 0 {
+			upper = decimal.Min(upper, decimal.NewFromInt(tier.UpTo))
+		}
+
+		from := decimal.Max(lower, included)
+		if upper.GreaterThan(from) {
+			amount = amount.Add(upper.Sub(from).Mul(tier.Price))
+		}
+
+		if tier.UpTo == 0 {
+			break
+		}
+		lower = decimal.NewFromInt(tier.UpTo)
+		if !quantity.GreaterThan(lower) {
+			break
+		}
+	}
+	return amount.Round(0)
+}
+
+// Charge is the price of the monthly usage of a project on a price plan in cents.
+type Charge struct {
+	payments.ProjectUsagePrice
+	// Minimum is the amount added to the usage price to reach the
+	// minimum monthly charge.
+	Minimum decimal.Decimal
+}
+
+// Total returns the total charge.
+func (charge Charge) Total() decimal.Decimal {
+	return charge.ProjectUsagePrice.Total().Add(charge.Minimum)
+}
+
+// Price calculates the price of the monthly usage of a project.
+func (plan *Plan) Price(usage payments.ProjectUsage) Charge {
+	charge := Charge{
+		ProjectUsagePrice: plan.UsagePrice(usage),
+		Minimum:           decimal.Zero,
+	}
+
+	minimum := decimal.NewFromInt(plan.MinimumCharge)
+	if total := charge.ProjectUsagePrice.Total(); total.LessThan(minimum) {
+		charge.Minimum = minimum.Sub(total)
+	}
+	return charge
+}
+
+// UsagePrice calculates the price of the usage, without the minimum monthly charge.
+func (plan *Plan) UsagePrice(usage payments.ProjectUsage) payments.ProjectUsagePrice {
+	return payments.ProjectUsagePrice{
+		Storage: plan.Storage.Amount(usage.StorageMBMonths),
+		Egress:  plan.Egress.Amount(usage.EgressMB),
+		Objects: plan.Objects.Amount(usage.ObjectMonths),
+	}
+}
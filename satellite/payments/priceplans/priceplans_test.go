@@ -0,0 +1,119 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package priceplans_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/priceplans"
+)
+
+func TestRateAmount(t *testing.T) {
+	rate := priceplans.Rate{
+		Included: 100,
+		Tiers: []priceplans.Tier{
+			{UpTo: 1000, Price: decimal.NewFromInt(2)},
+			{UpTo: 5000, Price: decimal.NewFromInt(1)},
+			{UpTo: 0, Price: decimal.RequireFromString("0.5")},
+		},
+	}
+
+	for _, tt := range []struct {
+		quantity int64
+		amount   int64
+	}{
+		{quantity: 0, amount: 0},
+		{quantity: 100, amount: 0},
+		{quantity: 101, amount: 2},
+		{quantity: 1000, amount: 900 * 2},
+		{quantity: 3000, amount: 900*2 + 2000},
+		{quantity: 5000, amount: 900*2 + 4000},
+		{quantity: 7000, amount: 900*2 + 4000 + 1000},
+	} {
+		amount := rate.Amount(decimal.NewFromInt(tt.quantity))
+		require.Equal(t, tt.amount, amount.IntPart(), "quantity %d", tt.quantity)
+	}
+
+	// the allowance can span several tiers.
+	rate.Included = 2000
+	require.Equal(t, int64(3000), rate.Amount(decimal.NewFromInt(5000)).IntPart())
+	require.Equal(t, int64(0), rate.Amount(decimal.NewFromInt(2000)).IntPart())
+}
+
+func TestPlanPrice(t *testing.T) {
+	flat := func(price string) priceplans.Rate {
+		return priceplans.Rate{Tiers: []priceplans.Tier{{Price: decimal.RequireFromString(price)}}}
+	}
+
+	plan := priceplans.Plan{
+		Name:          "small business",
+		Storage:       flat("0.0004"),
+		Egress:        flat("0.0007"),
+		Objects:       flat("0"),
+		MinimumCharge: 500,
+	}
+	require.NoError(t, plan.Validate())
+
+	usage := payments.ProjectUsage{
+		StorageMBMonths: decimal.NewFromInt(1000000),
+		EgressMB:        decimal.NewFromInt(1000000),
+		ObjectMonths:    decimal.NewFromInt(10),
+	}
+
+	charge := plan.Price(usage)
+	require.Equal(t, int64(400), charge.Storage.IntPart())
+	require.Equal(t, int64(700), charge.Egress.IntPart())
+	require.True(t, charge.Minimum.IsZero())
+	require.Equal(t, int64(1100), charge.Total().IntPart())
+
+	// the minimum charge is topped up.
+	usage.EgressMB = decimal.Zero
+	charge = plan.Price(usage)
+	require.Equal(t, int64(100), charge.Minimum.IntPart())
+	require.Equal(t, int64(500), charge.Total().IntPart())
+
+	charge = plan.Price(payments.ProjectUsage{})
+	require.Equal(t, int64(500), charge.Total().IntPart())
+}
+
+func TestPlanValidate(t *testing.T) {
+	valid := func() priceplans.Plan {
+		rate := priceplans.Rate{Tiers: []priceplans.Tier{
+			{UpTo: 10, Price: decimal.NewFromInt(1)},
+			{UpTo: 0, Price: decimal.Zero},
+		}}
+		return priceplans.Plan{Name: "plan", Storage: rate, Egress: rate, Objects: rate}
+	}
+
+	plan := valid()
+	require.NoError(t, plan.Validate())
+
+	for _, modify := range []func(plan *priceplans.Plan){
+		func(plan *priceplans.Plan) { plan.Name = "" },
+		func(plan *priceplans.Plan) { plan.MinimumCharge = -1 },
+		func(plan *priceplans.Plan) { plan.Storage.Included = -1 },
+		func(plan *priceplans.Plan) { plan.Egress.Tiers = nil },
+		func(plan *priceplans.Plan) {
+			plan.Objects.Tiers = []priceplans.Tier{{UpTo: 10, Price: decimal.NewFromInt(1)}}
+		},
+		func(plan *priceplans.Plan) {
+			plan.Objects.Tiers = []priceplans.Tier{{UpTo: 0, Price: decimal.NewFromInt(-1)}}
+		},
+		func(plan *priceplans.Plan) {
+			plan.Storage.Tiers = []priceplans.Tier{
+				{UpTo: 10, Price: decimal.NewFromInt(1)},
+				{UpTo: 10, Price: decimal.NewFromInt(1)},
+				{UpTo: 0, Price: decimal.NewFromInt(1)},
+			}
+		},
+	} {
+		plan := valid()
+		modify(&plan)
+		require.True(t, priceplans.ErrInvalid.Has(plan.Validate()))
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// hoursPerMonth is the number of hours in a billing month.
+const hoursPerMonth = 24 * 30
+
+// ProjectUsagePriceModel is the price model for project usage in cents.
+type ProjectUsagePriceModel struct {
+	StorageMBMonthCents decimal.Decimal
+	EgressMBCents       decimal.Decimal
+	ObjectMonthCents    decimal.Decimal
+}
+
+// NewProjectUsagePriceModel creates a price model from the configured
+// dollar prices of a TB-month of storage, a TB of egress and an object-month.
+func NewProjectUsagePriceModel(storageTBPrice, egressTBPrice, objectPrice string) (ProjectUsagePriceModel, error) {
+	storageTBMonthDollars, err := decimal.NewFromString(storageTBPrice)
+	if err != nil {
+		return ProjectUsagePriceModel{}, err
+	}
+	egressTBDollars, err := decimal.NewFromString(egressTBPrice)
+	if err != nil {
+		return ProjectUsagePriceModel{}, err
+	}
+	objectMonthDollars, err := decimal.NewFromString(objectPrice)
+	if err != nil {
+		return ProjectUsagePriceModel{}, err
+	}
+
+	// change the precision from TB dollars to MB cents
+	return ProjectUsagePriceModel{
+		StorageMBMonthCents: storageTBMonthDollars.Shift(-6).Shift(2),
+		EgressMBCents:       egressTBDollars.Shift(-6).Shift(2),
+		ObjectMonthCents:    objectMonthDollars.Shift(2),
+	}, nil
+}
+
+// ProjectUsage is the billed usage of a project, rounded to whole units.
+type ProjectUsage struct {
+	StorageMBMonths decimal.Decimal
+	EgressMB        decimal.Decimal
+	ObjectMonths    decimal.Decimal
+}
+
+// NewProjectUsage converts the raw usage totals, storage in byte-hours, egress
+// in bytes and objects in object-hours, to the units the usage is billed in.
+func NewProjectUsage(egress int64, storage, objects float64) ProjectUsage {
+	return ProjectUsage{
+		StorageMBMonths: decimal.NewFromFloat(storage).Shift(-6).Div(decimal.NewFromInt(hoursPerMonth)).Round(0),
+		EgressMB:        decimal.NewFromInt(egress).Shift(-6).Round(0),
+		ObjectMonths:    decimal.NewFromFloat(objects).Div(decimal.NewFromInt(hoursPerMonth)).Round(0),
+	}
+}
+
+// ProjectUsagePrice is the price of project usage in cents.
+type ProjectUsagePrice struct {
+	Storage decimal.Decimal
+	Egress  decimal.Decimal
+	Objects decimal.Decimal
+}
+
+// Total returns project usage price total.
+func (price ProjectUsagePrice) Total() decimal.Decimal {
+	return price.Storage.Add(price.Egress).Add(price.Objects)
+}
+
+// Price calculates the price of the project usage.
+func (model ProjectUsagePriceModel) Price(usage ProjectUsage) ProjectUsagePrice {
+	return ProjectUsagePrice{
+		Storage: model.StorageMBMonthCents.Mul(usage.StorageMBMonths).Round(0),
+		Egress:  model.EgressMBCents.Mul(usage.EgressMB).Round(0),
+		Objects: model.ObjectMonthCents.Mul(usage.ObjectMonths).Round(0),
+	}
+}
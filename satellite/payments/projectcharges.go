@@ -4,6 +4,8 @@
 package payments
 
 import (
+	"time"
+
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 )
@@ -20,3 +22,19 @@ type ProjectCharge struct {
 	// ObjectCount shows how many cents we should pay for objects count.
 	ObjectCount int64 `json:"objectPrice"`
 }
+
+// ProjectedUsage extrapolates the usage accounted from the start of the billing
+// period until now to the whole period, assuming the usage continues at the same rate.
+func ProjectedUsage(usage accounting.ProjectUsage, start, now, end time.Time) accounting.ProjectUsage {
+	if !now.After(start) || !now.Before(end) {
+		return usage
+	}
+
+	scale := float64(end.Sub(start)) / float64(now.Sub(start))
+
+	usage.Storage *= scale
+	usage.Egress = int64(float64(usage.Egress) * scale)
+	usage.ObjectCount *= scale
+	usage.Before = end
+	return usage
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/payments"
+)
+
+func TestProjectedUsage(t *testing.T) {
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC)
+	usage := accounting.ProjectUsage{
+		Storage:     100,
+		Egress:      100,
+		ObjectCount: 100,
+		Since:       start,
+	}
+
+	require.Equal(t, usage, payments.ProjectedUsage(usage, start, start, end))
+	require.Equal(t, usage, payments.ProjectedUsage(usage, start, end, end))
+
+	projected := payments.ProjectedUsage(usage, start, start.Add(end.Sub(start)/4), end)
+	require.Equal(t, int64(400), projected.Egress)
+	require.Equal(t, 400.0, projected.Storage)
+	require.Equal(t, 400.0, projected.ObjectCount)
+	require.Equal(t, end, projected.Before)
+}
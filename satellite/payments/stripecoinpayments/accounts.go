@@ -117,7 +117,19 @@ func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID,
 			return charges, Error.Wrap(err)
 		}
 
-		projectPrice := accounts.service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.ObjectCount)
+		plan, err := accounts.service.projectPricePlan(ctx, &project)
+		if err != nil {
+			return charges, Error.Wrap(err)
+		}
+
+		// the minimum monthly charge of a price plan is only known at the end of the month.
+		var projectPrice payments.ProjectUsagePrice
+		if plan != nil {
+			projectPrice = plan.UsagePrice(payments.NewProjectUsage(usage.Egress, usage.Storage, usage.ObjectCount))
+		} else {
+			price := accounts.service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.ObjectCount)
+			projectPrice = payments.ProjectUsagePrice(price)
+		}
 
 		charges = append(charges, payments.ProjectCharge{
 			ProjectUsage: *usage,
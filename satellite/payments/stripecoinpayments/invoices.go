@@ -7,9 +7,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stripe/stripe-go/v72"
 
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/payments"
 )
 
@@ -91,3 +93,226 @@ func (invoices *invoices) CheckPendingItems(ctx context.Context, userID uuid.UUI
 
 	return false, nil
 }
+
+// Document renders an invoice of the payment account in the given format.
+// Stripe hosts the invoices itself, they are linked from the invoice list instead.
+func (invoices *invoices) Document(ctx context.Context, userID uuid.UUID, invoiceID string, format payments.InvoiceFormat) (_ *payments.InvoiceDocument, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, payments.ErrUnsupported.New("stripe invoices are hosted by stripe")
+}
+
+// Preview estimates the invoice of the current billing period from the usage so far.
+// The line items are calculated the same way as when the invoice is created and
+// the discounts, coupons and account balance are applied in the same order.
+func (invoices *invoices) Preview(ctx context.Context, userID uuid.UUID) (_ *payments.InvoicePreview, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	service := invoices.service
+
+	customerID, err := service.db.Customers().GetCustomerID(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	customer, err := service.stripeClient.Customers().Get(customerID, nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the invoices are created for the same period.
+	now := service.nowFn().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(now.Year(), now.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+
+	until := now
+	if until.After(end) {
+		until = end
+	}
+
+	preview := &payments.InvoicePreview{
+		PeriodStart: start,
+		PeriodEnd:   end,
+		UsageUntil:  until,
+		Projects:    []payments.ProjectInvoicePreview{},
+	}
+
+	projects, err := service.projectsDB.GetOwn(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the Stripe discount is applied to the price of every project, the same
+	// way as when the project records are created.
+	var charge, projectedCharge previewCharge
+	var projectedSubtotal int64
+	for _, project := range projects {
+		usage, err := service.usageDB.GetProjectTotal(ctx, project.ID, start, until)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		plan, err := service.projectPricePlan(ctx, &project)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		projectPreview := payments.ProjectInvoicePreview{
+			ProjectID:   project.ID,
+			ProjectName: project.Name,
+			Items:       []payments.InvoicePreviewItem{},
+		}
+		for _, item := range service.invoiceItems(project.Name, plan, previewProjectRecord(project.ID, *usage)) {
+			previewItem := invoicePreviewItem(item)
+			projectPreview.Items = append(projectPreview.Items, previewItem)
+			projectPreview.Total += previewItem.Amount
+		}
+		preview.Projects = append(preview.Projects, projectPreview)
+		preview.Subtotal += projectPreview.Total
+		charge.add(customer, service.projectChargeTotal(plan, *usage))
+
+		projected := payments.ProjectedUsage(*usage, start, until, end)
+		for _, item := range service.invoiceItems(project.Name, plan, previewProjectRecord(project.ID, projected)) {
+			projectedSubtotal += invoicePreviewItem(item).Amount
+		}
+		projectedCharge.add(customer, service.projectChargeTotal(plan, projected))
+	}
+
+	coupons, err := invoices.previewCoupons(ctx, userID, end)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	preview.Discounts, preview.Credits, preview.Total = previewTotal(customer, coupons, preview.Subtotal, charge)
+	_, _, preview.ProjectedTotal = previewTotal(customer, coupons, projectedSubtotal, projectedCharge)
+
+	return preview, nil
+}
+
+// previewCoupon is an active coupon with the amount that is left of it.
+type previewCoupon struct {
+	description string
+	remaining   int64
+}
+
+// previewCoupons returns the coupons of the user that will be applied to the
+// invoice of the period ending at end.
+func (invoices *invoices) previewCoupons(ctx context.Context, userID uuid.UUID, end time.Time) (_ []previewCoupon, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	coupons, err := invoices.service.db.Coupons().ListByUserIDAndStatus(ctx, userID, payments.CouponActive)
+	if err != nil {
+		return nil, err
+	}
+
+	var active []previewCoupon
+	for _, coupon := range coupons {
+		expirationDate := coupon.ExpirationDate()
+		if expirationDate != nil && end.After(*expirationDate) {
+			continue
+		}
+
+		alreadyChargedAmount, err := invoices.service.db.Coupons().TotalUsage(ctx, coupon.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		active = append(active, previewCoupon{
+			description: coupon.Description,
+			remaining:   coupon.Amount - alreadyChargedAmount,
+		})
+	}
+	return active, nil
+}
+
+// previewCharge is the price of the usage of the projects before and after the
+// Stripe discount of the customer was applied to each of them.
+type previewCharge struct {
+	price      int64
+	discounted int64
+}
+
+// add adds the price of the usage of a project.
+func (charge *previewCharge) add(customer *stripe.Customer, price int64) {
+	charge.price += price
+	charge.discounted += discountedPrice(customer, price)
+}
+
+// previewTotal applies the Stripe discount, the coupons and the account balance
+// of the customer to the charge of the projects, and returns the total of the
+// subtotal with them.
+func previewTotal(customer *stripe.Customer, coupons []previewCoupon, subtotal int64, charge previewCharge) (discounts []payments.InvoicePreviewItem, credits, total int64) {
+	discounts = []payments.InvoicePreviewItem{}
+
+	total = subtotal
+	leftToCharge := charge.discounted
+	if leftToCharge < charge.price {
+		description := customer.Discount.Coupon.Name
+		if description == "" {
+			description = "Discount"
+		}
+		discounts = append(discounts, payments.InvoicePreviewItem{
+			Description: description,
+			Amount:      leftToCharge - charge.price,
+		})
+		total += leftToCharge - charge.price
+	}
+
+	for _, coupon := range coupons {
+		amount := leftToCharge
+		if amount >= coupon.remaining {
+			amount = coupon.remaining
+		}
+		if amount <= 0 {
+			continue
+		}
+
+		discounts = append(discounts, payments.InvoicePreviewItem{
+			Description: coupon.description,
+			Amount:      -amount,
+		})
+		leftToCharge -= amount
+		total -= amount
+	}
+
+	// a negative balance of the customer is credited to the invoice.
+	if balance := -customer.Balance; balance > 0 {
+		credits = balance
+		if credits > leftToCharge {
+			credits = leftToCharge
+		}
+		total -= credits
+	}
+
+	return discounts, credits, total
+}
+
+// previewProjectRecord returns the project record the usage would be invoiced with.
+func previewProjectRecord(projectID uuid.UUID, usage accounting.ProjectUsage) ProjectRecord {
+	return ProjectRecord{
+		ProjectID: projectID,
+		Storage:   usage.Storage,
+		Egress:    usage.Egress,
+		Objects:   usage.ObjectCount,
+	}
+}
+
+// invoicePreviewItem converts the Stripe invoice item to a preview item, with
+// the amount Stripe calculates for it.
+func invoicePreviewItem(item *stripe.InvoiceItemParams) payments.InvoicePreviewItem {
+	previewItem := payments.InvoicePreviewItem{
+		Description: stripe.StringValue(item.Description),
+	}
+
+	if item.Quantity != nil {
+		previewItem.Quantity = *item.Quantity
+	}
+
+	switch {
+	case item.Amount != nil:
+		previewItem.Amount = *item.Amount
+	case item.UnitAmountDecimal != nil:
+		previewItem.Amount = decimal.NewFromFloat(*item.UnitAmountDecimal).Mul(decimal.NewFromInt(previewItem.Quantity)).Round(0).IntPart()
+	}
+
+	return previewItem
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripecoinpayments_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
+)
+
+func TestInvoices_Preview(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.API.Payments.Service
+
+		// the middle of next month, so that the user exists before the period.
+		year, month, _ := time.Now().Date()
+		now := time.Date(year, month+1, 15, 12, 0, 0, 0, time.UTC)
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(now.Year(), now.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+		service.SetNow(func() time.Time { return now })
+
+		user, err := satellite.AddUser(ctx, console.CreateUser{
+			FullName: "testuser",
+			Email:    "user@test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := satellite.AddProject(ctx, user.ID, "testproject")
+		require.NoError(t, err)
+
+		// more than the promotional coupons cover.
+		egress := 100 * memory.GB.Int64()
+		err = satellite.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte("testbucket"),
+			pb.PieceAction_GET, egress, start.Add(time.Hour))
+		require.NoError(t, err)
+
+		preview, err := satellite.API.Payments.Accounts.Invoices().Preview(ctx, user.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, start, preview.PeriodStart)
+		require.Equal(t, end, preview.PeriodEnd)
+		require.Equal(t, now, preview.UsageUntil)
+
+		require.Len(t, preview.Projects, 1)
+		projectPreview := preview.Projects[0]
+		require.Equal(t, project.ID, projectPreview.ProjectID)
+		require.Len(t, projectPreview.Items, 3)
+
+		egressItem := projectPreview.Items[1]
+		require.Equal(t, int64(100000), egressItem.Quantity)
+		require.Equal(t, service.EgressMBPriceCents.Mul(payments.NewProjectUsage(egress, 0, 0).EgressMB).Round(0).IntPart(), egressItem.Amount)
+		require.Equal(t, egressItem.Amount, projectPreview.Total)
+		require.Equal(t, projectPreview.Total, preview.Subtotal)
+
+		total := preview.Subtotal - preview.Credits
+		for _, discount := range preview.Discounts {
+			require.Negative(t, discount.Amount)
+			total += discount.Amount
+		}
+		require.Equal(t, total, preview.Total)
+
+		// the egress so far is projected to the whole period.
+		require.Greater(t, preview.ProjectedTotal, preview.Total)
+	})
+}
+
+func TestInvoices_PreviewMatchesInvoiceRecords(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.API.Payments.Service
+
+		year, month, _ := time.Now().Date()
+		now := time.Date(year, month+1, 15, 12, 0, 0, 0, time.UTC)
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(now.Year(), now.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+
+		user, err := satellite.AddUser(ctx, console.CreateUser{
+			FullName: "testuser",
+			Email:    "user@test",
+		}, 2)
+		require.NoError(t, err)
+
+		// the Stripe discount applies to every project, not once to the subtotal.
+		customerID, err := satellite.DB.StripeCoinPayments().Customers().GetCustomerID(ctx, user.ID)
+		require.NoError(t, err)
+		customer, err := satellite.API.Payments.Stripe.Customers().Get(customerID, nil)
+		require.NoError(t, err)
+		customer.Discount = &stripe.Discount{Coupon: &stripe.Coupon{Name: "amount off", AmountOff: 10, Valid: true}}
+
+		_, err = satellite.DB.StripeCoinPayments().Coupons().Insert(ctx, payments.CouponOld{
+			ID:          testrand.UUID(),
+			UserID:      user.ID,
+			Amount:      100000,
+			Description: "promotional credit",
+			Type:        payments.CouponTypePromotional,
+			Status:      payments.CouponActive,
+		})
+		require.NoError(t, err)
+
+		for _, name := range []string{"first", "second"} {
+			project, err := satellite.AddProject(ctx, user.ID, name)
+			require.NoError(t, err)
+
+			err = satellite.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte("testbucket"),
+				pb.PieceAction_GET, 100*memory.GB.Int64(), start.Add(time.Hour))
+			require.NoError(t, err)
+		}
+
+		// the whole usage of the period is in the preview.
+		service.SetNow(func() time.Time { return end })
+		preview, err := satellite.API.Payments.Accounts.Invoices().Preview(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, preview.Projects, 2)
+
+		var discount, previewCoupons int64
+		for _, item := range preview.Discounts {
+			if item.Description == "amount off" {
+				discount += item.Amount
+			} else {
+				previewCoupons -= item.Amount
+			}
+		}
+		require.EqualValues(t, -20, discount)
+
+		service.SetNow(func() time.Time { return end.AddDate(0, 0, 1) })
+		require.NoError(t, service.PrepareInvoiceProjectRecords(ctx, start))
+
+		usages, err := satellite.DB.StripeCoinPayments().Coupons().ListUnapplied(ctx, 0, 100, start)
+		require.NoError(t, err)
+
+		var invoicedCoupons int64
+		for _, usage := range usages.Usages {
+			invoicedCoupons += usage.Amount
+		}
+		require.Positive(t, invoicedCoupons)
+		require.Equal(t, invoicedCoupons, previewCoupons)
+	})
+}
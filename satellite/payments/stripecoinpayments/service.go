@@ -25,6 +25,7 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/coinpayments"
+	"storj.io/storj/satellite/payments/priceplans"
 )
 
 var (
@@ -61,6 +62,7 @@ type Service struct {
 	db           DB
 	projectsDB   console.Projects
 	usageDB      accounting.ProjectAccounting
+	pricePlans   priceplans.DB
 	stripeClient StripeClient
 	coinPayments *coinpayments.Client
 
@@ -89,7 +91,7 @@ type Service struct {
 }
 
 // NewService creates a Service instance.
-func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB, projectsDB console.Projects, usageDB accounting.ProjectAccounting, storageTBPrice, egressTBPrice, objectPrice string, bonusRate, couponValue int64, couponDuration *int64, couponProjectLimit memory.Size, minCoinPayment int64) (*Service, error) {
+func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB, projectsDB console.Projects, usageDB accounting.ProjectAccounting, pricePlans priceplans.DB, storageTBPrice, egressTBPrice, objectPrice string, bonusRate, couponValue int64, couponDuration *int64, couponProjectLimit memory.Size, minCoinPayment int64) (*Service, error) {
 
 	coinPaymentsClient := coinpayments.NewClient(
 		coinpayments.Credentials{
@@ -98,34 +100,22 @@ func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB
 		},
 	)
 
-	storageTBMonthDollars, err := decimal.NewFromString(storageTBPrice)
+	priceModel, err := payments.NewProjectUsagePriceModel(storageTBPrice, egressTBPrice, objectPrice)
 	if err != nil {
 		return nil, err
 	}
-	egressTBDollars, err := decimal.NewFromString(egressTBPrice)
-	if err != nil {
-		return nil, err
-	}
-	objectMonthDollars, err := decimal.NewFromString(objectPrice)
-	if err != nil {
-		return nil, err
-	}
-
-	// change the precision from TB dollars to MB cents
-	storageMBMonthPriceCents := storageTBMonthDollars.Shift(-6).Shift(2)
-	egressMBPriceCents := egressTBDollars.Shift(-6).Shift(2)
-	objectMonthPriceCents := objectMonthDollars.Shift(2)
 
 	return &Service{
 		log:                      log,
 		db:                       db,
 		projectsDB:               projectsDB,
 		usageDB:                  usageDB,
+		pricePlans:               pricePlans,
 		stripeClient:             stripeClient,
 		coinPayments:             coinPaymentsClient,
-		StorageMBMonthPriceCents: storageMBMonthPriceCents,
-		EgressMBPriceCents:       egressMBPriceCents,
-		ObjectMonthPriceCents:    objectMonthPriceCents,
+		StorageMBMonthPriceCents: priceModel.StorageMBMonthCents,
+		EgressMBPriceCents:       priceModel.EgressMBCents,
+		ObjectMonthPriceCents:    priceModel.ObjectMonthCents,
 		BonusRate:                bonusRate,
 		StripeFreeTierCouponID:   config.StripeFreeTierCouponID,
 		CouponValue:              couponValue,
@@ -543,6 +533,11 @@ func (service *Service) createProjectRecords(ctx context.Context, customerID str
 			return 0, nil, err
 		}
 
+		plan, err := service.projectPricePlan(ctx, &project)
+		if err != nil {
+			return 0, nil, err
+		}
+
 		// TODO: account for usage data.
 		records = append(records,
 			CreateProjectRecord{
@@ -553,7 +548,7 @@ func (service *Service) createProjectRecords(ctx context.Context, customerID str
 			},
 		)
 
-		leftToCharge := service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.ObjectCount).TotalInt64()
+		leftToCharge := service.projectChargeTotal(plan, *usage)
 		if leftToCharge == 0 {
 			continue
 		}
@@ -645,7 +640,12 @@ func (service *Service) applyProjectRecords(ctx context.Context, records []Proje
 			return err
 		}
 
-		if err = service.createInvoiceItems(ctx, cusID, proj.Name, record); err != nil {
+		plan, err := service.projectPricePlan(ctx, proj)
+		if err != nil {
+			return err
+		}
+
+		if err = service.createInvoiceItems(ctx, cusID, proj.Name, plan, record); err != nil {
 			return err
 		}
 	}
@@ -654,15 +654,15 @@ func (service *Service) applyProjectRecords(ctx context.Context, records []Proje
 }
 
 // createInvoiceItems consumes invoice project record and creates invoice line items for stripe customer.
-func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName string, record ProjectRecord) (err error) {
+// The items are priced with the price plan of the project, when there is one.
+func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName string, plan *priceplans.Plan, record ProjectRecord) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if err = service.db.ProjectRecords().Consume(ctx, record.ID); err != nil {
 		return err
 	}
 
-	items := service.InvoiceItemsFromProjectRecord(projName, record)
-	for _, item := range items {
+	for _, item := range service.invoiceItems(projName, plan, record) {
 		item.Currency = stripe.String(string(stripe.CurrencyUSD))
 		item.Customer = stripe.String(cusID)
 		item.AddMetadata("projectID", record.ProjectID.String())
@@ -676,6 +676,15 @@ func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName
 	return nil
 }
 
+// invoiceItems calculates the Stripe invoice items from project record, priced
+// with the price plan of the project when there is one.
+func (service *Service) invoiceItems(projName string, plan *priceplans.Plan, record ProjectRecord) []*stripe.InvoiceItemParams {
+	if plan != nil {
+		return service.InvoiceItemsFromPricePlan(projName, plan, record)
+	}
+	return service.InvoiceItemsFromProjectRecord(projName, record)
+}
+
 // InvoiceItemsFromProjectRecord calculates Stripe invoice item from project record.
 func (service *Service) InvoiceItemsFromProjectRecord(projName string, record ProjectRecord) (result []*stripe.InvoiceItemParams) {
 	projectItem := &stripe.InvoiceItemParams{}
@@ -702,6 +711,38 @@ func (service *Service) InvoiceItemsFromProjectRecord(projName string, record Pr
 	return result
 }
 
+// InvoiceItemsFromPricePlan calculates Stripe invoice items from project record
+// priced with the price plan. Tiered prices can't be expressed as a unit price,
+// so the items have the total amount and the quantity is in the description.
+func (service *Service) InvoiceItemsFromPricePlan(projName string, plan *priceplans.Plan, record ProjectRecord) (result []*stripe.InvoiceItemParams) {
+	usage := payments.NewProjectUsage(record.Egress, record.Storage, record.Objects)
+	charge := plan.Price(usage)
+
+	projectItem := &stripe.InvoiceItemParams{}
+	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Object Storage (%s MB-Month, %s plan)", projName, usage.StorageMBMonths, plan.Name))
+	projectItem.Amount = stripe.Int64(charge.Storage.IntPart())
+	result = append(result, projectItem)
+
+	projectItem = &stripe.InvoiceItemParams{}
+	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Egress Bandwidth (%s MB, %s plan)", projName, usage.EgressMB, plan.Name))
+	projectItem.Amount = stripe.Int64(charge.Egress.IntPart())
+	result = append(result, projectItem)
+
+	projectItem = &stripe.InvoiceItemParams{}
+	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Object Fee (%s Object-Month, %s plan)", projName, usage.ObjectMonths, plan.Name))
+	projectItem.Amount = stripe.Int64(charge.Objects.IntPart())
+	result = append(result, projectItem)
+
+	if charge.Minimum.IsPositive() {
+		projectItem = &stripe.InvoiceItemParams{}
+		projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Minimum Monthly Charge (%s plan)", projName, plan.Name))
+		projectItem.Amount = stripe.Int64(charge.Minimum.IntPart())
+		result = append(result, projectItem)
+	}
+
+	return result
+}
+
 // ApplyFreeTierCoupons iterates through all customers in Stripe. For each customer,
 // if that customer does not currently have a Stripe coupon, the free tier Stripe coupon
 // is applied.
@@ -1013,6 +1054,16 @@ func (service *Service) calculateProjectUsagePrice(egress int64, storage, object
 	}
 }
 
+// projectChargeTotal returns the price of the project usage that the discounts
+// and credits are applied to. It's calculated from the unrounded prices, so it
+// can differ slightly from the sum of the invoice items.
+func (service *Service) projectChargeTotal(plan *priceplans.Plan, usage accounting.ProjectUsage) int64 {
+	if plan != nil {
+		return plan.Price(payments.NewProjectUsage(usage.Egress, usage.Storage, usage.ObjectCount)).Total().IntPart()
+	}
+	return service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.ObjectCount).TotalInt64()
+}
+
 // discountedProjectUsagePrice reduces the project usage price with the discount applied for the Stripe customer.
 // The promotional coupons and bonus credits are not applied yet.
 func (service *Service) discountedProjectUsagePrice(ctx context.Context, customerID string, projectUsagePrice int64) (int64, error) {
@@ -1021,38 +1072,57 @@ func (service *Service) discountedProjectUsagePrice(ctx context.Context, custome
 		return 0, Error.Wrap(err)
 	}
 
-	if customer.Discount == nil {
-		return projectUsagePrice, nil
+	if coupon := validStripeCoupon(customer); coupon != nil {
+		service.log.Info("Applying Stripe discount.", zap.String("Customer ID", customerID), zap.Int64("AmountOff", coupon.AmountOff), zap.Float64("PercentOff", coupon.PercentOff))
 	}
 
-	coupon := customer.Discount.Coupon
+	return discountedPrice(customer, projectUsagePrice), nil
+}
 
-	if coupon == nil {
-		return projectUsagePrice, nil
+// validStripeCoupon returns the coupon of the Stripe customer, if it's valid.
+func validStripeCoupon(customer *stripe.Customer) *stripe.Coupon {
+	if customer.Discount == nil || customer.Discount.Coupon == nil || !customer.Discount.Coupon.Valid {
+		return nil
 	}
+	return customer.Discount.Coupon
+}
 
-	if !coupon.Valid {
-		return projectUsagePrice, nil
+// discountedPrice reduces the price with the discount of the Stripe customer.
+func discountedPrice(customer *stripe.Customer, price int64) int64 {
+	coupon := validStripeCoupon(customer)
+	if coupon == nil {
+		return price
 	}
 
 	if coupon.AmountOff > 0 {
-		service.log.Info("Applying Stripe discount.", zap.String("Customer ID", customerID), zap.Int64("AmountOff", coupon.AmountOff))
-
-		discounted := projectUsagePrice - coupon.AmountOff
+		discounted := price - coupon.AmountOff
 		if discounted < 0 {
-			return 0, nil
+			return 0
 		}
-		return discounted, nil
+		return discounted
 	}
 
 	if coupon.PercentOff > 0 {
-		service.log.Info("Applying Stripe discount.", zap.String("Customer ID", customerID), zap.Float64("PercentOff", coupon.PercentOff))
-
-		discount := int64(math.Round(float64(projectUsagePrice) * coupon.PercentOff / 100))
-		return projectUsagePrice - discount, nil
+		discount := int64(math.Round(float64(price) * coupon.PercentOff / 100))
+		return price - discount
 	}
 
-	return projectUsagePrice, nil
+	return price
+}
+
+// projectPricePlan returns the price plan of the project, or nil when the
+// satellite-wide prices apply.
+func (service *Service) projectPricePlan(ctx context.Context, project *console.Project) (_ *priceplans.Plan, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	plan, err := service.pricePlans.GetForProject(ctx, project.ID, project.PartnerID)
+	if err != nil {
+		if priceplans.ErrNotFound.Has(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return plan, nil
 }
 
 // SetNow allows tests to have the Service act as if the current time is whatever
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -23,6 +24,7 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/priceplans"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 )
 
@@ -555,7 +557,7 @@ func TestService_ProjectsWithMembers(t *testing.T) {
 		for _, project := range projects {
 			for _, user := range users {
 				if project.OwnerID != user.ID {
-					_, err := satellite.DB.Console().ProjectMembers().Insert(ctx, user.ID, project.ID)
+					_, err := satellite.DB.Console().ProjectMembers().Insert(ctx, user.ID, project.ID, console.DefaultProjectMemberRole)
 					require.NoError(t, err)
 				}
 			}
@@ -640,3 +642,46 @@ func TestService_InvoiceItemsFromProjectRecord(t *testing.T) {
 		}
 	})
 }
+
+func TestService_InvoiceItemsFromPricePlan(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		flat := func(price int64) priceplans.Rate {
+			return priceplans.Rate{Tiers: []priceplans.Tier{{Price: decimal.NewFromInt(price)}}}
+		}
+		plan := &priceplans.Plan{
+			Name:    "business",
+			Storage: flat(1),
+			Egress: priceplans.Rate{
+				Included: 1000,
+				Tiers: []priceplans.Tier{
+					{UpTo: 100000, Price: decimal.RequireFromString("0.01")},
+					{UpTo: 0, Price: decimal.RequireFromString("0.005")},
+				},
+			},
+			Objects:       flat(0),
+			MinimumCharge: 2000,
+		}
+
+		record := stripecoinpayments.ProjectRecord{
+			Storage: 10000000000,             // 14 Megabyte-Months
+			Egress:  134 * memory.GB.Int64(), // 134000 Megabytes
+		}
+
+		items := satellite.API.Payments.Service.InvoiceItemsFromPricePlan("project name", plan, record)
+		require.Len(t, items, 4)
+		require.Equal(t, int64(14), *items[0].Amount)
+		// 99000 MB in the first tier and 34000 MB in the second one.
+		require.Equal(t, int64(990+170), *items[1].Amount)
+		require.Equal(t, int64(0), *items[2].Amount)
+		require.Equal(t, int64(2000-14-1160), *items[3].Amount)
+		require.Contains(t, *items[3].Description, "Minimum Monthly Charge")
+
+		plan.MinimumCharge = 1000
+		items = satellite.API.Payments.Service.InvoiceItemsFromPricePlan("project name", plan, record)
+		require.Len(t, items, 3)
+	})
+}
@@ -20,15 +20,20 @@ import (
 	"storj.io/storj/satellite/accounting/rolluparchive"
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/admin/credentials"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/consistency"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/auditretention"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/console/sessioncleanup"
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/gc"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/inventory"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
@@ -37,7 +42,9 @@ import (
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/overlay/straynodes"
+	"storj.io/storj/satellite/payments/invoiceonly"
 	"storj.io/storj/satellite/payments/paymentsconfig"
+	"storj.io/storj/satellite/payments/priceplans"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
@@ -93,6 +100,12 @@ type DB interface {
 	GracefulExit() gracefulexit.DB
 	// StripeCoinPayments returns stripecoinpayments database.
 	StripeCoinPayments() stripecoinpayments.DB
+	// InvoiceOnly returns invoiceonly database.
+	InvoiceOnly() invoiceonly.DB
+	// PricePlans returns database for price plans.
+	PricePlans() priceplans.DB
+	// AdminCredentials returns database for the admin API credentials.
+	AdminCredentials() credentials.DB
 	// SnoPayout returns database for payouts.
 	SNOPayouts() snopayouts.DB
 	// Compoensation tracks storage node compensation
@@ -101,6 +114,10 @@ type DB interface {
 	Revocation() revocation.DB
 	// NodeAPIVersion tracks nodes observed api usage
 	NodeAPIVersion() nodeapiversion.DB
+	// BucketInventories returns database for bucket inventory configurations.
+	BucketInventories() inventory.DB
+	// ConsistencyFixes returns database for fixes queued by the metabase consistency checker.
+	ConsistencyFixes() consistency.DB
 }
 
 // Config is the global config satellite.
@@ -128,6 +145,14 @@ type Config struct {
 
 	ExpiredDeletion expireddeletion.Config
 
+	Inventory inventory.Config
+
+	Consistency consistency.Config
+
+	AuditRetention auditretention.Config
+
+	SessionCleanup sessioncleanup.Config
+
 	Tally            tally.Config
 	Rollup           rollup.Config
 	RollupArchive    rolluparchive.Config
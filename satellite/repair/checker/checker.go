@@ -156,7 +156,7 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 	return nil
 }
 
-var _ segmentloop.Observer = (*checkerObserver)(nil)
+var _ segmentloop.ParallelObserver = (*checkerObserver)(nil)
 
 // checkerObserver implements the metainfo loop Observer interface.
 //
@@ -184,6 +184,31 @@ func containsStreamID(a []uuid.UUID, x uuid.UUID) bool {
 	return false
 }
 
+// Fork creates an observer for a single range of the segments loop.
+func (obs *checkerObserver) Fork(ctx context.Context) (segmentloop.Partial, error) {
+	return &checkerObserver{
+		repairQueue:      obs.repairQueue,
+		nodestate:        obs.nodestate,
+		statsCollector:   obs.statsCollector.fork(),
+		monStats:         aggregateStats{},
+		repairOverrides:  obs.repairOverrides,
+		nodeFailureRate:  obs.nodeFailureRate,
+		getNodesEstimate: obs.getNodesEstimate,
+		log:              obs.log,
+	}, nil
+}
+
+// Join adds the statistics of a single range to the observer.
+func (obs *checkerObserver) Join(ctx context.Context, partial segmentloop.Partial) error {
+	forked, ok := partial.(*checkerObserver)
+	if !ok {
+		return Error.New("expected partial type %T but got %T", obs, partial)
+	}
+	obs.monStats.add(&forked.monStats)
+	obs.statsCollector.join(forked.statsCollector)
+	return nil
+}
+
 func (obs *checkerObserver) getStatsByRS(redundancy storj.RedundancyScheme) *stats {
 	rsString := getRSString(obs.loadRedundancy(redundancy))
 	return obs.statsCollector.getStatsByRS(rsString)
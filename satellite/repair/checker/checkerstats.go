@@ -5,6 +5,7 @@ package checker
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/spacemonkeygo/monkit/v3"
 
@@ -15,6 +16,10 @@ import (
 // seen by the checker. These are chained into the monkit scope for
 // monitoring as they are initialized.
 type statsCollector struct {
+	// parent is set for collectors of a single range of the segments loop.
+	parent *statsCollector
+
+	mu    sync.Mutex
 	stats map[string]*stats
 }
 
@@ -25,15 +30,40 @@ func newStatsCollector() *statsCollector {
 }
 
 func (collector *statsCollector) getStatsByRS(rs string) *stats {
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
 	stats, ok := collector.stats[rs]
 	if !ok {
-		stats = newStats(rs)
-		mon.Chain(stats)
+		if collector.parent != nil {
+			shared := *collector.parent.getStatsByRS(rs)
+			shared.iterationAggregates = new(aggregateStats)
+			stats = &shared
+		} else {
+			stats = newStats(rs)
+			mon.Chain(stats)
+		}
 		collector.stats[rs] = stats
 	}
 	return stats
 }
 
+// fork returns a collector, which shares the metrics with this collector,
+// but keeps separate iteration aggregates until they're joined.
+func (collector *statsCollector) fork() *statsCollector {
+	return &statsCollector{
+		parent: collector,
+		stats:  make(map[string]*stats),
+	}
+}
+
+// join adds the iteration aggregates of a forked collector to this collector.
+func (collector *statsCollector) join(forked *statsCollector) {
+	for rs, stats := range forked.stats {
+		collector.getStatsByRS(rs).iterationAggregates.add(stats.iterationAggregates)
+	}
+}
+
 // collectAggregates transfers the iteration aggregates into the
 // respective stats monkit metrics at the end of each checker iteration.
 // iterationAggregates is then cleared.
@@ -90,6 +120,21 @@ type aggregateStats struct {
 	remoteSegmentsOverThreshold [5]int64
 }
 
+// add adds the values of other to the aggregates.
+func (aggregates *aggregateStats) add(other *aggregateStats) {
+	aggregates.objectsChecked += other.objectsChecked
+	aggregates.remoteSegmentsChecked += other.remoteSegmentsChecked
+	aggregates.remoteSegmentsNeedingRepair += other.remoteSegmentsNeedingRepair
+	aggregates.newRemoteSegmentsNeedingRepair += other.newRemoteSegmentsNeedingRepair
+	aggregates.remoteSegmentsLost += other.remoteSegmentsLost
+	aggregates.remoteSegmentsFailedToCheck += other.remoteSegmentsFailedToCheck
+	// ranges don't share streams, so there are no duplicates.
+	aggregates.objectsLost = append(aggregates.objectsLost, other.objectsLost...)
+	for i := range aggregates.remoteSegmentsOverThreshold {
+		aggregates.remoteSegmentsOverThreshold[i] += other.remoteSegmentsOverThreshold[i]
+	}
+}
+
 func newStats(rs string) *stats {
 	return &stats{
 		iterationAggregates:             new(aggregateStats),
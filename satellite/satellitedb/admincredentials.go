@@ -0,0 +1,175 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
+	"storj.io/storj/satellite/admin/credentials"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that *adminCredentials implements credentials.DB.
+var _ credentials.DB = (*adminCredentials)(nil)
+
+// adminCredentials is an implementation of credentials.DB.
+//
+// architecture: Database
+type adminCredentials struct {
+	db *satelliteDB
+}
+
+// Insert inserts a credential, it returns ErrNameTaken when a credential
+// with the same name exists.
+func (creds *adminCredentials) Insert(ctx context.Context, credential credentials.Credential) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = creds.db.CreateNoReturn_AdminCredential(ctx,
+		dbx.AdminCredential_Id(credential.ID[:]),
+		dbx.AdminCredential_Name(credential.Name),
+		dbx.AdminCredential_Scopes(credentials.FormatScopes(credential.Scopes)),
+		dbx.AdminCredential_SecretHash(credential.SecretHash),
+		dbx.AdminCredential_CreatedAt(credential.CreatedAt),
+		dbx.AdminCredential_Create_Fields{
+			RotatedAt: dbx.AdminCredential_RotatedAt_Raw(credential.RotatedAt),
+		},
+	)
+	if pgerrcode.FromError(err) == pgxerrcode.UniqueViolation {
+		return credentials.ErrNameTaken.New("%s", credential.Name)
+	}
+	return errs.Wrap(err)
+}
+
+// GetByName returns the credential with the given name.
+func (creds *adminCredentials) GetByName(ctx context.Context, name string) (_ *credentials.Credential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxCredential, err := creds.db.Get_AdminCredential_By_Name(ctx, dbx.AdminCredential_Name(name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, credentials.ErrNotFound.New("%s", name)
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return adminCredentialFromDBX(dbxCredential)
+}
+
+// GetBySecretHash returns the credential with the given secret hash.
+func (creds *adminCredentials) GetBySecretHash(ctx context.Context, hash []byte) (_ *credentials.Credential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxCredential, err := creds.db.Get_AdminCredential_By_SecretHash(ctx, dbx.AdminCredential_SecretHash(hash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, credentials.ErrNotFound.New("secret")
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return adminCredentialFromDBX(dbxCredential)
+}
+
+// List returns all credentials ordered by name.
+func (creds *adminCredentials) List(ctx context.Context) (_ []credentials.Credential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxCredentials, err := creds.db.All_AdminCredential_OrderBy_Asc_Name(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	var list []credentials.Credential
+	for _, dbxCredential := range dbxCredentials {
+		credential, err := adminCredentialFromDBX(dbxCredential)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *credential)
+	}
+	return list, nil
+}
+
+// UpdateScopes replaces the scopes of a credential.
+func (creds *adminCredentials) UpdateScopes(ctx context.Context, id uuid.UUID, scopes []credentials.Scope) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := creds.db.Update_AdminCredential_By_Id(ctx,
+		dbx.AdminCredential_Id(id[:]),
+		dbx.AdminCredential_Update_Fields{
+			Scopes: dbx.AdminCredential_Scopes(credentials.FormatScopes(scopes)),
+		},
+	)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if updated == nil {
+		return credentials.ErrNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// Rotate replaces the secret hash of a credential.
+func (creds *adminCredentials) Rotate(ctx context.Context, id uuid.UUID, hash []byte, rotatedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := creds.db.Update_AdminCredential_By_Id(ctx,
+		dbx.AdminCredential_Id(id[:]),
+		dbx.AdminCredential_Update_Fields{
+			SecretHash: dbx.AdminCredential_SecretHash(hash),
+			RotatedAt:  dbx.AdminCredential_RotatedAt(rotatedAt),
+		},
+	)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if updated == nil {
+		return credentials.ErrNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// Delete deletes a credential.
+func (creds *adminCredentials) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := creds.db.Delete_AdminCredential_By_Id(ctx, dbx.AdminCredential_Id(id[:]))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if !deleted {
+		return credentials.ErrNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// adminCredentialFromDBX converts the dbx admin credential into a credential.
+func adminCredentialFromDBX(dbxCredential *dbx.AdminCredential) (*credentials.Credential, error) {
+	id, err := uuid.FromBytes(dbxCredential.Id)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	scopes, err := credentials.ParseScopes(dbxCredential.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentials.Credential{
+		ID:         id,
+		Name:       dbxCredential.Name,
+		Scopes:     scopes,
+		SecretHash: dbxCredential.SecretHash,
+		CreatedAt:  dbxCredential.CreatedAt,
+		RotatedAt:  dbxCredential.RotatedAt,
+	}, nil
+}
@@ -6,11 +6,13 @@ package satellitedb
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/common/lrucache"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -70,7 +72,7 @@ func (keys *apikeys) GetPagedByProjectID(ctx context.Context, projectID uuid.UUI
 	}
 
 	repoundQuery := keys.db.Rebind(`
-		SELECT ak.id, ak.project_id, ak.name, ak.partner_id, ak.created_at
+		SELECT ak.id, ak.project_id, ak.name, ak.partner_id, ak.created_at, ak.expires_at, ak.last_used_at
 		FROM api_keys ak
 		WHERE ak.project_id = ?
 		AND lower(ak.name) LIKE ?
@@ -95,7 +97,7 @@ func (keys *apikeys) GetPagedByProjectID(ctx context.Context, projectID uuid.UUI
 		ak := console.APIKeyInfo{}
 		var partnerID uuid.NullUUID
 
-		err = rows.Scan(&ak.ID, &ak.ProjectID, &ak.Name, &partnerID, &ak.CreatedAt)
+		err = rows.Scan(&ak.ID, &ak.ProjectID, &ak.Name, &partnerID, &ak.CreatedAt, &ak.ExpiresAt, &ak.LastUsedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -175,6 +177,9 @@ func (keys *apikeys) Create(ctx context.Context, head []byte, info console.APIKe
 	if !info.PartnerID.IsZero() {
 		optional.PartnerId = dbx.ApiKey_PartnerId(info.PartnerID[:])
 	}
+	if info.ExpiresAt != nil {
+		optional.ExpiresAt = dbx.ApiKey_ExpiresAt(*info.ExpiresAt)
+	}
 
 	dbKey, err := keys.methods.Create_ApiKey(
 		ctx,
@@ -212,6 +217,33 @@ func (keys *apikeys) Delete(ctx context.Context, id uuid.UUID) (err error) {
 	return err
 }
 
+// UpdateLastUsed implements satellite.APIKeys.
+func (keys *apikeys) UpdateLastUsed(ctx context.Context, lastUsed map[uuid.UUID]time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(lastUsed) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(lastUsed))
+	times := make([]time.Time, 0, len(lastUsed))
+	for id, usedAt := range lastUsed {
+		ids = append(ids, id)
+		times = append(times, usedAt)
+	}
+
+	_, err = keys.db.ExecContext(ctx, `
+		UPDATE api_keys
+		SET last_used_at = used.last_used_at
+		FROM (
+			SELECT unnest($1::bytea[]) AS id, unnest($2::timestamptz[]) AS last_used_at
+		) AS used
+		WHERE api_keys.id = used.id
+			AND (api_keys.last_used_at IS NULL OR api_keys.last_used_at < used.last_used_at)
+	`, pgutil.UUIDArray(ids), pgutil.TimestampTZArray(times))
+	return Error.Wrap(err)
+}
+
 // fromDBXAPIKey converts dbx.ApiKey to satellite.APIKeyInfo.
 func fromDBXAPIKey(ctx context.Context, key *dbx.ApiKey) (_ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -226,11 +258,13 @@ func fromDBXAPIKey(ctx context.Context, key *dbx.ApiKey) (_ *console.APIKeyInfo,
 	}
 
 	result := &console.APIKeyInfo{
-		ID:        id,
-		ProjectID: projectID,
-		Name:      key.Name,
-		CreatedAt: key.CreatedAt,
-		Secret:    key.Secret,
+		ID:         id,
+		ProjectID:  projectID,
+		Name:       key.Name,
+		CreatedAt:  key.CreatedAt,
+		Secret:     key.Secret,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
 	}
 
 	if key.PartnerId != nil {
@@ -0,0 +1,174 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// auditEventsMaxLimit is the maximum number of audit events returned in a single page.
+const auditEventsMaxLimit = 1000
+
+// ensures that auditEvents implements console.AuditEvents.
+var _ console.AuditEvents = (*auditEvents)(nil)
+
+// auditEvents is an implementation of console.AuditEvents.
+type auditEvents struct {
+	db *satelliteDB
+}
+
+// Insert is a method for inserting an audit event into the database.
+func (events *auditEvents) Insert(ctx context.Context, event console.AuditEvent) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	details := string(event.Details)
+	if details == "" {
+		details = "{}"
+	}
+
+	_, err = events.db.ExecContext(ctx, events.db.Rebind(`
+		INSERT INTO audit_events (
+			id, user_id, email, project_id, source, operation, details,
+			source_ip, forwarded_for_ip, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`), event.ID, event.UserID, event.Email, event.ProjectID, string(event.Source), event.Operation, details,
+		event.SourceIP, event.ForwardedForIP, event.CreatedAt)
+	return Error.Wrap(err)
+}
+
+// GetPagedByProjectID is a method for querying the audit events of a project, newest first.
+func (events *auditEvents) GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor console.AuditEventsCursor) (_ *console.AuditEventsPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return events.getPaged(ctx, "project_id", projectID, cursor)
+}
+
+// GetPagedByUserID is a method for querying the audit events of a user, newest first.
+func (events *auditEvents) GetPagedByUserID(ctx context.Context, userID uuid.UUID, cursor console.AuditEventsCursor) (_ *console.AuditEventsPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return events.getPaged(ctx, "user_id", userID, cursor)
+}
+
+// getPaged returns a page of the events where column matches id.
+func (events *auditEvents) getPaged(ctx context.Context, column string, id uuid.UUID, cursor console.AuditEventsCursor) (_ *console.AuditEventsPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cursor.Limit == 0 {
+		return nil, errs.New("limit cannot be 0")
+	}
+	if cursor.Limit > auditEventsMaxLimit {
+		cursor.Limit = auditEventsMaxLimit
+	}
+	if cursor.Page == 0 {
+		return nil, errs.New("page cannot be 0")
+	}
+
+	page := &console.AuditEventsPage{
+		Limit:  cursor.Limit,
+		Offset: uint64((cursor.Page - 1) * cursor.Limit),
+	}
+
+	condition := column + ` = ?`
+	args := []interface{}{id}
+	if !cursor.Before.IsZero() {
+		condition += ` AND created_at < ?`
+		args = append(args, cursor.Before)
+	}
+
+	countRow := events.db.QueryRowContext(ctx, events.db.Rebind(`
+		SELECT COUNT(*) FROM audit_events WHERE `+condition), args...)
+	err = countRow.Scan(&page.TotalCount)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if page.TotalCount == 0 {
+		return page, nil
+	}
+	if page.Offset > page.TotalCount-1 {
+		return nil, errs.New("page is out of range")
+	}
+
+	rows, err := events.db.QueryContext(ctx, events.db.Rebind(`
+		SELECT id, user_id, email, project_id, source, operation, details,
+			source_ip, forwarded_for_ip, created_at
+		FROM audit_events
+		WHERE `+condition+`
+		ORDER BY created_at DESC, id
+		LIMIT ? OFFSET ?
+	`), append(args, page.Limit, page.Offset)...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var event console.AuditEvent
+		var userID, projectID uuid.NullUUID
+		var source, details string
+		err = rows.Scan(&event.ID, &userID, &event.Email, &projectID, &source, &event.Operation, &details,
+			&event.SourceIP, &event.ForwardedForIP, &event.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if userID.Valid {
+			event.UserID = &userID.UUID
+		}
+		if projectID.Valid {
+			event.ProjectID = &projectID.UUID
+		}
+		event.Source = console.AuditEventSource(source)
+		event.Details = []byte(details)
+
+		page.Events = append(page.Events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	page.PageCount = uint(page.TotalCount / uint64(cursor.Limit))
+	if page.TotalCount%uint64(cursor.Limit) != 0 {
+		page.PageCount++
+	}
+	page.CurrentPage = cursor.Page
+
+	return page, nil
+}
+
+// DeleteBefore is a method for deleting audit events created before the given time.
+func (events *auditEvents) DeleteBefore(ctx context.Context, before time.Time, batchSize int) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if batchSize <= 0 {
+		return 0, nil
+	}
+
+	for {
+		result, err := events.db.ExecContext(ctx, events.db.Rebind(`
+			DELETE FROM audit_events
+			WHERE id IN (
+				SELECT id FROM audit_events
+				WHERE created_at < ?
+				LIMIT ?
+			)
+		`), before, batchSize)
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+		deleted += affected
+
+		if affected < int64(batchSize) {
+			return deleted, nil
+		}
+	}
+}
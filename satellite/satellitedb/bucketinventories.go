@@ -0,0 +1,144 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/inventory"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that bucketInventories implements inventory.DB.
+var _ inventory.DB = (*bucketInventories)(nil)
+
+// bucketInventories implements inventory.DB.
+type bucketInventories struct {
+	db *satelliteDB
+}
+
+// Get returns the inventory configuration for the bucket.
+func (db *bucketInventories) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ inventory.Configuration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxInventory, err := db.db.Get_BucketInventory_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketInventory_ProjectId(projectID[:]),
+		dbx.BucketInventory_BucketName([]byte(bucketName)),
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return inventory.Configuration{}, inventory.ErrNotFound.New("%s/%s", projectID, bucketName)
+	}
+	if err != nil {
+		return inventory.Configuration{}, Error.Wrap(err)
+	}
+
+	return bucketInventoryFromDBX(dbxInventory)
+}
+
+// List returns all inventory configurations ordered by project and bucket.
+func (db *bucketInventories) List(ctx context.Context) (_ []inventory.Configuration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxInventories, err := db.db.All_BucketInventory(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	sort.Slice(dbxInventories, func(i, k int) bool {
+		if c := bytes.Compare(dbxInventories[i].ProjectId, dbxInventories[k].ProjectId); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(dbxInventories[i].BucketName, dbxInventories[k].BucketName) < 0
+	})
+
+	var configs []inventory.Configuration
+	for _, dbxInventory := range dbxInventories {
+		config, err := bucketInventoryFromDBX(dbxInventory)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, config)
+	}
+	return configs, nil
+}
+
+// Set creates or replaces the inventory configuration for the bucket.
+// Replacing keeps the creation and the last report time.
+func (db *bucketInventories) Set(ctx context.Context, config inventory.Configuration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID := dbx.BucketInventory_ProjectId(config.ProjectID[:])
+	bucketName := dbx.BucketInventory_BucketName([]byte(config.BucketName))
+	format := dbx.BucketInventory_Format(string(config.Format))
+	destinationAccess := dbx.BucketInventory_DestinationAccess(config.DestinationAccess)
+	destinationBucket := dbx.BucketInventory_DestinationBucket(config.DestinationBucket)
+	destinationPrefix := dbx.BucketInventory_DestinationPrefix(config.DestinationPrefix)
+
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		updated, err := tx.Update_BucketInventory_By_ProjectId_And_BucketName(ctx, projectID, bucketName,
+			dbx.BucketInventory_Update_Fields{
+				Format:            format,
+				DestinationAccess: destinationAccess,
+				DestinationBucket: destinationBucket,
+				DestinationPrefix: destinationPrefix,
+			})
+		if err != nil || updated != nil {
+			return err
+		}
+
+		return tx.CreateNoReturn_BucketInventory(ctx, projectID, bucketName,
+			format, destinationAccess, destinationBucket, destinationPrefix,
+			dbx.BucketInventory_Create_Fields{})
+	}))
+}
+
+// Delete removes the inventory configuration for the bucket.
+func (db *bucketInventories) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Delete_BucketInventory_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketInventory_ProjectId(projectID[:]),
+		dbx.BucketInventory_BucketName([]byte(bucketName)),
+	)
+	return Error.Wrap(err)
+}
+
+// SetLastReport updates when the last report for the bucket was generated.
+func (db *bucketInventories) SetLastReport(ctx context.Context, projectID uuid.UUID, bucketName string, at time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Update_BucketInventory_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketInventory_ProjectId(projectID[:]),
+		dbx.BucketInventory_BucketName([]byte(bucketName)),
+		dbx.BucketInventory_Update_Fields{
+			LastReportAt: dbx.BucketInventory_LastReportAt(at.UTC()),
+		},
+	)
+	return Error.Wrap(err)
+}
+
+// bucketInventoryFromDBX converts the dbx bucket inventory into an inventory configuration.
+func bucketInventoryFromDBX(dbxInventory *dbx.BucketInventory) (inventory.Configuration, error) {
+	projectID, err := uuid.FromBytes(dbxInventory.ProjectId)
+	if err != nil {
+		return inventory.Configuration{}, Error.Wrap(err)
+	}
+
+	return inventory.Configuration{
+		ProjectID:         projectID,
+		BucketName:        string(dbxInventory.BucketName),
+		Format:            inventory.Format(dbxInventory.Format),
+		DestinationAccess: dbxInventory.DestinationAccess,
+		DestinationBucket: dbxInventory.DestinationBucket,
+		DestinationPrefix: dbxInventory.DestinationPrefix,
+		CreatedAt:         dbxInventory.CreatedAt,
+		LastReportAt:      dbxInventory.LastReportAt,
+	}, nil
+}
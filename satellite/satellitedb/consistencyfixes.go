@@ -0,0 +1,168 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
+	"storj.io/storj/satellite/consistency"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that consistencyFixes implements consistency.DB.
+var _ consistency.DB = (*consistencyFixes)(nil)
+
+// consistencyFixes implements consistency.DB.
+type consistencyFixes struct {
+	db *satelliteDB
+}
+
+// Queue inserts fixes, skipping the ones already queued for the same stream and kind.
+func (db *consistencyFixes) Queue(ctx context.Context, fixes []consistency.Fix) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, fix := range fixes {
+		queued, err := db.db.Has_ConsistencyFix_By_StreamId_And_Kind(ctx,
+			dbx.ConsistencyFix_StreamId(fix.Object.StreamID[:]),
+			dbx.ConsistencyFix_Kind(string(fix.Kind)),
+		)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if queued {
+			continue
+		}
+
+		err = db.db.CreateNoReturn_ConsistencyFix(ctx,
+			dbx.ConsistencyFix_Id(fix.ID[:]),
+			dbx.ConsistencyFix_Kind(string(fix.Kind)),
+			dbx.ConsistencyFix_StreamId(fix.Object.StreamID[:]),
+			dbx.ConsistencyFix_ProjectId(fix.Object.ProjectID[:]),
+			dbx.ConsistencyFix_BucketName([]byte(fix.Object.BucketName)),
+			dbx.ConsistencyFix_ObjectKey([]byte(fix.Object.ObjectKey)),
+			dbx.ConsistencyFix_Version(int64(fix.Object.Version)),
+			dbx.ConsistencyFix_Description(fix.Description),
+			dbx.ConsistencyFix_Status(string(fix.Status)),
+			dbx.ConsistencyFix_CreatedAt(fix.CreatedAt.UTC()),
+			dbx.ConsistencyFix_Create_Fields{},
+		)
+		// the fix may have been queued concurrently since the check.
+		if pgerrcode.FromError(err) == pgxerrcode.UniqueViolation {
+			continue
+		}
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// Get returns the fix with the specified id.
+func (db *consistencyFixes) Get(ctx context.Context, id uuid.UUID) (_ consistency.Fix, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxFix, err := db.db.Get_ConsistencyFix_By_Id(ctx, dbx.ConsistencyFix_Id(id[:]))
+	if errors.Is(err, sql.ErrNoRows) {
+		return consistency.Fix{}, consistency.ErrNotFound.New("%s", id)
+	}
+	if err != nil {
+		return consistency.Fix{}, Error.Wrap(err)
+	}
+
+	return consistencyFixFromDBX(dbxFix)
+}
+
+// List returns at most limit fixes with the specified status, oldest first.
+func (db *consistencyFixes) List(ctx context.Context, status consistency.FixStatus, limit int) (_ []consistency.Fix, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT id, kind, stream_id,
+			project_id, bucket_name, object_key, version,
+			description, status, created_at, resolved_at
+		FROM consistency_fixes
+		WHERE status = ?
+		ORDER BY created_at, id
+		LIMIT ?
+	`), string(status), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var fixes []consistency.Fix
+	for rows.Next() {
+		var dbxFix dbx.ConsistencyFix
+		err = rows.Scan(
+			&dbxFix.Id, &dbxFix.Kind, &dbxFix.StreamId,
+			&dbxFix.ProjectId, &dbxFix.BucketName, &dbxFix.ObjectKey, &dbxFix.Version,
+			&dbxFix.Description, &dbxFix.Status, &dbxFix.CreatedAt, &dbxFix.ResolvedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		fix, err := consistencyFixFromDBX(&dbxFix)
+		if err != nil {
+			return nil, err
+		}
+		fixes = append(fixes, fix)
+	}
+	return fixes, Error.Wrap(rows.Err())
+}
+
+// Resolve changes the status of a pending fix.
+func (db *consistencyFixes) Resolve(ctx context.Context, id uuid.UUID, status consistency.FixStatus, resolvedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	resolved, err := db.db.Update_ConsistencyFix_By_Id_And_Status(ctx,
+		dbx.ConsistencyFix_Id(id[:]),
+		dbx.ConsistencyFix_Status(string(consistency.FixPending)),
+		dbx.ConsistencyFix_Update_Fields{
+			Status:     dbx.ConsistencyFix_Status(string(status)),
+			ResolvedAt: dbx.ConsistencyFix_ResolvedAt(resolvedAt.UTC()),
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if resolved == nil {
+		return consistency.ErrNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// consistencyFixFromDBX converts the dbx consistency fix into a fix.
+func consistencyFixFromDBX(dbxFix *dbx.ConsistencyFix) (fix consistency.Fix, err error) {
+	fix.ID, err = uuid.FromBytes(dbxFix.Id)
+	if err != nil {
+		return consistency.Fix{}, Error.Wrap(err)
+	}
+	fix.Object.StreamID, err = uuid.FromBytes(dbxFix.StreamId)
+	if err != nil {
+		return consistency.Fix{}, Error.Wrap(err)
+	}
+	fix.Object.ProjectID, err = uuid.FromBytes(dbxFix.ProjectId)
+	if err != nil {
+		return consistency.Fix{}, Error.Wrap(err)
+	}
+
+	fix.Kind = consistency.IssueKind(dbxFix.Kind)
+	fix.Object.BucketName = string(dbxFix.BucketName)
+	fix.Object.ObjectKey = metabase.ObjectKey(dbxFix.ObjectKey)
+	fix.Object.Version = metabase.Version(dbxFix.Version)
+	fix.Description = dbxFix.Description
+	fix.Status = consistency.FixStatus(dbxFix.Status)
+	fix.CreatedAt = dbxFix.CreatedAt
+	fix.ResolvedAt = dbxFix.ResolvedAt
+	return fix, nil
+}
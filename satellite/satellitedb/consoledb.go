@@ -32,7 +32,7 @@ type ConsoleDB struct {
 
 // Users is getter a for Users repository.
 func (db *ConsoleDB) Users() console.Users {
-	return &users{db.methods}
+	return &users{db: db.methods, sdb: db.db}
 }
 
 // Projects is a getter for Projects repository.
@@ -68,6 +68,31 @@ func (db *ConsoleDB) ResetPasswordTokens() console.ResetPasswordTokens {
 	return &resetPasswordTokens{db.methods}
 }
 
+// AuditEvents is a getter for AuditEvents repository.
+func (db *ConsoleDB) AuditEvents() console.AuditEvents {
+	return &auditEvents{db.db}
+}
+
+// OIDCIdentities is a getter for OIDCIdentities repository.
+func (db *ConsoleDB) OIDCIdentities() console.OIDCIdentities {
+	return &oidcIdentities{db.methods}
+}
+
+// WebappSessions is a getter for WebappSessions repository.
+func (db *ConsoleDB) WebappSessions() console.WebappSessions {
+	return &webappSessions{db.db}
+}
+
+// LoginLockouts is a getter for LoginLockouts repository.
+func (db *ConsoleDB) LoginLockouts() console.LoginLockouts {
+	return &loginLockouts{db.db}
+}
+
+// ProjectFreezes is a getter for ProjectFreezes repository.
+func (db *ConsoleDB) ProjectFreezes() console.ProjectFreezes {
+	return &projectFreezes{db.methods}
+}
+
 // WithTx is a method for executing and retrying transaction.
 func (db *ConsoleDB) WithTx(ctx context.Context, fn func(context.Context, console.DBTx) error) error {
 	if db.db == nil {
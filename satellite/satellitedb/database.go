@@ -17,15 +17,20 @@ import (
 	"storj.io/storj/private/migrate"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/admin/credentials"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/consistency"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/inventory"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/payments/invoiceonly"
+	"storj.io/storj/satellite/payments/priceplans"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/reputation"
@@ -257,6 +262,21 @@ func (dbc *satelliteDBCollection) StripeCoinPayments() stripecoinpayments.DB {
 	return &stripeCoinPaymentsDB{db: dbc.getByName("stripecoinpayments")}
 }
 
+// InvoiceOnly returns database for invoiceonly.
+func (dbc *satelliteDBCollection) InvoiceOnly() invoiceonly.DB {
+	return &invoiceOnlyDB{db: dbc.getByName("invoiceonly")}
+}
+
+// PricePlans returns database for price plans.
+func (dbc *satelliteDBCollection) PricePlans() priceplans.DB {
+	return &pricePlans{db: dbc.getByName("priceplans")}
+}
+
+// AdminCredentials returns database for the admin API credentials.
+func (dbc *satelliteDBCollection) AdminCredentials() credentials.DB {
+	return &adminCredentials{db: dbc.getByName("admincredentials")}
+}
+
 // SNOPayouts returns database for storagenode payStubs and payments info.
 func (dbc *satelliteDBCollection) SNOPayouts() snopayouts.DB {
 	return &snopayoutsDB{db: dbc.getByName("snopayouts")}
@@ -277,6 +297,16 @@ func (dbc *satelliteDBCollection) Buckets() metainfo.BucketsDB {
 	return &bucketsDB{db: dbc.getByName("buckets")}
 }
 
+// BucketInventories returns database for bucket inventory configurations.
+func (dbc *satelliteDBCollection) BucketInventories() inventory.DB {
+	return &bucketInventories{db: dbc.getByName("bucketinventories")}
+}
+
+// ConsistencyFixes returns database for fixes queued by the metabase consistency checker.
+func (dbc *satelliteDBCollection) ConsistencyFixes() consistency.DB {
+	return &consistencyFixes{db: dbc.getByName("consistencyfixes")}
+}
+
 // CheckVersion confirms all databases are at the desired version.
 func (dbc *satelliteDBCollection) CheckVersion(ctx context.Context) error {
 	var eg errs.Group
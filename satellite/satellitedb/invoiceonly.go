@@ -0,0 +1,265 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/payments/invoiceonly"
+	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that *invoiceOnlyDB implements invoiceonly.DB.
+var _ invoiceonly.DB = (*invoiceOnlyDB)(nil)
+
+// invoiceOnlyDB is invoiceonly DB.
+//
+// architecture: Database
+type invoiceOnlyDB struct {
+	db *satelliteDB
+}
+
+// Invoices is getter for invoices db.
+func (db *invoiceOnlyDB) Invoices() invoiceonly.InvoicesDB {
+	return &invoiceOnlyInvoices{db: db.db}
+}
+
+// Payments is getter for manual payments db.
+func (db *invoiceOnlyDB) Payments() invoiceonly.PaymentsDB {
+	return &invoiceOnlyPayments{db: db.db}
+}
+
+// Coupons is getter for coupons db.
+func (db *invoiceOnlyDB) Coupons() invoiceonly.CouponsDB {
+	return &invoiceOnlyCoupons{coupons: &coupons{db: db.db}}
+}
+
+// ensures that *invoiceOnlyInvoices implements invoiceonly.InvoicesDB.
+var _ invoiceonly.InvoicesDB = (*invoiceOnlyInvoices)(nil)
+
+// invoiceOnlyInvoices is an implementation of invoiceonly.InvoicesDB.
+//
+// architecture: Database
+type invoiceOnlyInvoices struct {
+	db *satelliteDB
+}
+
+// Insert inserts an invoice, it returns ErrInvoiceExists when the user
+// already has an invoice for the billing period.
+func (invoices *invoiceOnlyInvoices) Insert(ctx context.Context, invoice invoiceonly.Invoice) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lineItems, err := json.Marshal(invoice.LineItems)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	err = invoices.db.CreateNoReturn_InvoiceonlyInvoice(ctx,
+		dbx.InvoiceonlyInvoice_Id(invoice.ID[:]),
+		dbx.InvoiceonlyInvoice_UserId(invoice.UserID[:]),
+		dbx.InvoiceonlyInvoice_PeriodStart(invoice.PeriodStart),
+		dbx.InvoiceonlyInvoice_PeriodEnd(invoice.PeriodEnd),
+		dbx.InvoiceonlyInvoice_Amount(invoice.Amount),
+		dbx.InvoiceonlyInvoice_LineItems(string(lineItems)),
+		dbx.InvoiceonlyInvoice_DueAt(invoice.DueAt),
+		dbx.InvoiceonlyInvoice_CreatedAt(invoice.CreatedAt),
+	)
+	if pgerrcode.FromError(err) == pgxerrcode.UniqueViolation {
+		return invoiceonly.ErrInvoiceExists.New("user %s, period %s", invoice.UserID, invoice.PeriodStart.Format("2006-01"))
+	}
+	return errs.Wrap(err)
+}
+
+// Get returns the invoice with the given id.
+func (invoices *invoiceOnlyInvoices) Get(ctx context.Context, id uuid.UUID) (_ *invoiceonly.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxInvoice, err := invoices.db.Get_InvoiceonlyInvoice_By_Id(ctx, dbx.InvoiceonlyInvoice_Id(id[:]))
+	if err != nil {
+		// sql.ErrNoRows is returned as is.
+		return nil, err
+	}
+	return invoiceOnlyInvoiceFromDBX(dbxInvoice)
+}
+
+// GetByUserIDAndPeriod returns the invoice of the user for the billing period starting at periodStart.
+func (invoices *invoiceOnlyInvoices) GetByUserIDAndPeriod(ctx context.Context, userID uuid.UUID, periodStart time.Time) (_ *invoiceonly.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxInvoice, err := invoices.db.Get_InvoiceonlyInvoice_By_UserId_And_PeriodStart(ctx,
+		dbx.InvoiceonlyInvoice_UserId(userID[:]),
+		dbx.InvoiceonlyInvoice_PeriodStart(periodStart),
+	)
+	if err != nil {
+		// sql.ErrNoRows is returned as is.
+		return nil, err
+	}
+	return invoiceOnlyInvoiceFromDBX(dbxInvoice)
+}
+
+// ListByUserID returns the invoices of the user, the most recent billing period first.
+func (invoices *invoiceOnlyInvoices) ListByUserID(ctx context.Context, userID uuid.UUID) (_ []invoiceonly.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxInvoices, err := invoices.db.All_InvoiceonlyInvoice_By_UserId_OrderBy_Desc_PeriodStart(ctx, dbx.InvoiceonlyInvoice_UserId(userID[:]))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	var list []invoiceonly.Invoice
+	for _, dbxInvoice := range dbxInvoices {
+		invoice, err := invoiceOnlyInvoiceFromDBX(dbxInvoice)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *invoice)
+	}
+	return list, nil
+}
+
+// invoiceOnlyInvoiceFromDBX converts the dbx invoice into an invoice.
+func invoiceOnlyInvoiceFromDBX(dbxInvoice *dbx.InvoiceonlyInvoice) (_ *invoiceonly.Invoice, err error) {
+	invoice := invoiceonly.Invoice{
+		PeriodStart: dbxInvoice.PeriodStart,
+		PeriodEnd:   dbxInvoice.PeriodEnd,
+		Amount:      dbxInvoice.Amount,
+		DueAt:       dbxInvoice.DueAt,
+		CreatedAt:   dbxInvoice.CreatedAt,
+	}
+
+	invoice.ID, err = uuid.FromBytes(dbxInvoice.Id)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	invoice.UserID, err = uuid.FromBytes(dbxInvoice.UserId)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	if err := json.Unmarshal([]byte(dbxInvoice.LineItems), &invoice.LineItems); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &invoice, nil
+}
+
+// ensures that *invoiceOnlyPayments implements invoiceonly.PaymentsDB.
+var _ invoiceonly.PaymentsDB = (*invoiceOnlyPayments)(nil)
+
+// invoiceOnlyPayments is an implementation of invoiceonly.PaymentsDB.
+//
+// architecture: Database
+type invoiceOnlyPayments struct {
+	db *satelliteDB
+}
+
+// Insert inserts a payment.
+func (payments *invoiceOnlyPayments) Insert(ctx context.Context, payment invoiceonly.Payment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = payments.db.CreateNoReturn_InvoiceonlyPayment(ctx,
+		dbx.InvoiceonlyPayment_Id(payment.ID[:]),
+		dbx.InvoiceonlyPayment_InvoiceId(payment.InvoiceID[:]),
+		dbx.InvoiceonlyPayment_Amount(payment.Amount),
+		dbx.InvoiceonlyPayment_Reference(payment.Reference),
+		dbx.InvoiceonlyPayment_PaidAt(payment.PaidAt),
+		dbx.InvoiceonlyPayment_CreatedAt(payment.CreatedAt),
+	)
+	return errs.Wrap(err)
+}
+
+// ListByInvoiceID returns the payments of the invoice, the oldest first.
+func (payments *invoiceOnlyPayments) ListByInvoiceID(ctx context.Context, invoiceID uuid.UUID) (_ []invoiceonly.Payment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxPayments, err := payments.db.All_InvoiceonlyPayment_By_InvoiceId_OrderBy_Asc_PaidAt(ctx, dbx.InvoiceonlyPayment_InvoiceId(invoiceID[:]))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	var list []invoiceonly.Payment
+	for _, dbxPayment := range dbxPayments {
+		payment := invoiceonly.Payment{
+			Amount:    dbxPayment.Amount,
+			Reference: dbxPayment.Reference,
+			PaidAt:    dbxPayment.PaidAt,
+			CreatedAt: dbxPayment.CreatedAt,
+		}
+		payment.ID, err = uuid.FromBytes(dbxPayment.Id)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		payment.InvoiceID, err = uuid.FromBytes(dbxPayment.InvoiceId)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		list = append(list, payment)
+	}
+	return list, nil
+}
+
+// ListByUserID returns the payments of all invoices of the user, the most recent first.
+func (payments *invoiceOnlyPayments) ListByUserID(ctx context.Context, userID uuid.UUID) (_ []invoiceonly.Payment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := payments.db.QueryContext(ctx, `
+		SELECT p.id, p.invoice_id, p.amount, p.reference, p.paid_at, p.created_at
+		FROM invoiceonly_payments AS p
+		JOIN invoiceonly_invoices AS i ON i.id = p.invoice_id
+		WHERE i.user_id = $1
+		ORDER BY p.paid_at DESC
+	`, userID)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return scanInvoiceOnlyPayments(rows)
+}
+
+// scanInvoiceOnlyPayments scans and closes the rows.
+func scanInvoiceOnlyPayments(rows tagsql.Rows) (_ []invoiceonly.Payment, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []invoiceonly.Payment
+	for rows.Next() {
+		var payment invoiceonly.Payment
+		err := rows.Scan(&payment.ID, &payment.InvoiceID, &payment.Amount, &payment.Reference, &payment.PaidAt, &payment.CreatedAt)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		list = append(list, payment)
+	}
+	return list, errs.Wrap(rows.Err())
+}
+
+// ensures that *invoiceOnlyCoupons implements invoiceonly.CouponsDB.
+var _ invoiceonly.CouponsDB = (*invoiceOnlyCoupons)(nil)
+
+// invoiceOnlyCoupons is an implementation of invoiceonly.CouponsDB on the
+// coupons of the Stripe payment provider.
+//
+// architecture: Database
+type invoiceOnlyCoupons struct {
+	*coupons
+}
+
+// AddUsage records the amount of the coupon used by the invoice of the
+// billing period starting at period.
+func (coupons *invoiceOnlyCoupons) AddUsage(ctx context.Context, couponID uuid.UUID, amount int64, period time.Time) (err error) {
+	defer mon.Task()(&ctx, couponID)(&err)
+
+	return coupons.coupons.AddUsage(ctx, stripecoinpayments.CouponUsage{
+		CouponID: couponID,
+		Amount:   amount,
+		Status:   stripecoinpayments.CouponUsageStatusApplied,
+		Period:   period,
+	})
+}
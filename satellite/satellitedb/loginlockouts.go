@@ -0,0 +1,119 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that loginLockouts implements console.LoginLockouts.
+var _ console.LoginLockouts = (*loginLockouts)(nil)
+
+// loginLockouts is an implementation of console.LoginLockouts.
+type loginLockouts struct {
+	db *satelliteDB
+}
+
+// Get is a method for querying the failed attempts of a key.
+func (lockouts *loginLockouts) Get(ctx context.Context, key string) (_ *console.LoginLockout, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lockout, err := lockouts.db.Get_LoginLockout_By_Key(ctx, dbx.LoginLockout_Key(key))
+	if err != nil {
+		return nil, err
+	}
+
+	return &console.LoginLockout{
+		Key:          lockout.Key,
+		FailedCount:  lockout.FailedCount,
+		LastFailedAt: lockout.LastFailedAt,
+		LockedUntil:  lockout.LockedUntil,
+	}, nil
+}
+
+// RecordFailure is a method for counting a failed attempt of a key. The count
+// starts over when the previous failure happened before resetBefore.
+//
+// The conditional increment isn't expressible in dbx, it's done with a single
+// upsert so that concurrent failures are all counted.
+func (lockouts *loginLockouts) RecordFailure(ctx context.Context, key string, now, resetBefore time.Time) (_ *console.LoginLockout, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lockout := &console.LoginLockout{}
+	err = lockouts.db.QueryRowContext(ctx, lockouts.db.Rebind(`
+		INSERT INTO login_lockouts (key, failed_count, last_failed_at)
+		VALUES (?, 1, ?)
+		ON CONFLICT (key) DO UPDATE SET
+			failed_count = CASE
+				WHEN login_lockouts.last_failed_at < ? THEN 1
+				ELSE login_lockouts.failed_count + 1
+			END,
+			last_failed_at = EXCLUDED.last_failed_at
+		RETURNING key, failed_count, last_failed_at, locked_until
+	`), key, now, resetBefore).Scan(&lockout.Key, &lockout.FailedCount, &lockout.LastFailedAt, &lockout.LockedUntil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return lockout, nil
+}
+
+// Lock is a method for refusing the logins of a key until the given time.
+func (lockouts *loginLockouts) Lock(ctx context.Context, key string, until time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = lockouts.db.UpdateNoReturn_LoginLockout_By_Key(ctx,
+		dbx.LoginLockout_Key(key),
+		dbx.LoginLockout_Update_Fields{
+			LockedUntil: dbx.LoginLockout_LockedUntil(until),
+		},
+	)
+	return Error.Wrap(err)
+}
+
+// Delete is a method for forgetting the failed attempts of a key.
+func (lockouts *loginLockouts) Delete(ctx context.Context, key string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = lockouts.db.Delete_LoginLockout_By_Key(ctx, dbx.LoginLockout_Key(key))
+	return Error.Wrap(err)
+}
+
+// DeleteBefore is a method for deleting the records whose last failed attempt
+// happened before the given time and that are not locked anymore.
+func (lockouts *loginLockouts) DeleteBefore(ctx context.Context, before time.Time, batchSize int) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if batchSize <= 0 {
+		return 0, nil
+	}
+
+	for {
+		result, err := lockouts.db.ExecContext(ctx, lockouts.db.Rebind(`
+			DELETE FROM login_lockouts
+			WHERE key IN (
+				SELECT key FROM login_lockouts
+				WHERE last_failed_at < ? AND (locked_until IS NULL OR locked_until < ?)
+				LIMIT ?
+			)
+		`), before, before, batchSize)
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+		deleted += affected
+
+		if affected < int64(batchSize) {
+			return deleted, nil
+		}
+	}
+}
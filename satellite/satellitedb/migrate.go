@@ -1613,6 +1613,253 @@ func (db *satelliteDB) PostgresMigration() *migrate.Migration {
 					`DROP TABLE audit_histories`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_inventories table",
+				Version:     171,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_inventories (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						format text NOT NULL,
+						destination_access text NOT NULL,
+						destination_bucket text NOT NULL,
+						destination_prefix text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						last_report_at timestamp with time zone,
+						PRIMARY KEY ( project_id, bucket_name )
+					)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add consistency_fixes table",
+				Version:     172,
+				Action: migrate.SQL{
+					`CREATE TABLE consistency_fixes (
+						id bytea NOT NULL,
+						kind text NOT NULL,
+						stream_id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						object_key bytea NOT NULL,
+						version bigint NOT NULL,
+						description text NOT NULL,
+						status text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						resolved_at timestamp with time zone,
+						PRIMARY KEY ( id ),
+						UNIQUE ( stream_id, kind )
+					)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add burst and per operation rate limits to projects",
+				Version:     173,
+				Action: migrate.SQL{
+					`ALTER TABLE projects
+						ADD COLUMN burst_limit integer,
+						ADD COLUMN rate_limit_list integer,
+						ADD COLUMN burst_limit_list integer,
+						ADD COLUMN rate_limit_upload integer,
+						ADD COLUMN burst_limit_upload integer,
+						ADD COLUMN rate_limit_download integer,
+						ADD COLUMN burst_limit_download integer,
+						ADD COLUMN rate_limit_delete integer,
+						ADD COLUMN burst_limit_delete integer;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add role column to project members (step 1)",
+				Version:     174,
+				Action: migrate.SQL{
+					`ALTER TABLE project_members ADD COLUMN role integer NOT NULL DEFAULT 2;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add role column to project members (step 2)",
+				Version:     175,
+				SeparateTx:  true,
+				Action: migrate.SQL{
+					`UPDATE project_members SET role = 1 WHERE (project_id, member_id) IN (SELECT id, owner_id FROM projects);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add role column to project members (step 3)",
+				Version:     176,
+				SeparateTx:  true,
+				Action: migrate.SQL{
+					`ALTER TABLE project_members ALTER COLUMN role DROP DEFAULT;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add audit_events table",
+				Version:     177,
+				Action: migrate.SQL{
+					`CREATE TABLE audit_events (
+						id bytea NOT NULL,
+						user_id bytea,
+						email text NOT NULL,
+						project_id bytea,
+						source text NOT NULL,
+						operation text NOT NULL,
+						details text NOT NULL,
+						source_ip text NOT NULL,
+						forwarded_for_ip text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX audit_events_user_id_created_at_index ON audit_events ( user_id, created_at );`,
+					`CREATE INDEX audit_events_project_id_created_at_index ON audit_events ( project_id, created_at );`,
+					`CREATE INDEX audit_events_created_at_index ON audit_events ( created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add oidc_identities table",
+				Version:     178,
+				Action: migrate.SQL{
+					`CREATE TABLE oidc_identities (
+						issuer text NOT NULL,
+						subject text NOT NULL,
+						user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( issuer, subject )
+					);`,
+					`CREATE INDEX oidc_identities_user_id_index ON oidc_identities ( user_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add webapp_sessions and login_lockouts tables",
+				Version:     179,
+				Action: migrate.SQL{
+					`CREATE TABLE webapp_sessions (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						ip_address text NOT NULL,
+						user_agent text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						last_active_at timestamp with time zone NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id );`,
+					`CREATE TABLE login_lockouts (
+						key text NOT NULL,
+						failed_count integer NOT NULL,
+						last_failed_at timestamp with time zone NOT NULL,
+						locked_until timestamp with time zone,
+						PRIMARY KEY ( key )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add expires_at and last_used_at to api_keys",
+				Version:     180,
+				Action: migrate.SQL{
+					`ALTER TABLE api_keys ADD COLUMN expires_at timestamp with time zone;`,
+					`ALTER TABLE api_keys ADD COLUMN last_used_at timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add invoiceonly_invoices and invoiceonly_payments tables",
+				Version:     181,
+				Action: migrate.SQL{
+					`CREATE TABLE invoiceonly_invoices (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL,
+						period_start timestamp with time zone NOT NULL,
+						period_end timestamp with time zone NOT NULL,
+						amount bigint NOT NULL,
+						line_items text NOT NULL,
+						due_at timestamp with time zone NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( user_id, period_start )
+					);`,
+					`CREATE TABLE invoiceonly_payments (
+						id bytea NOT NULL,
+						invoice_id bytea NOT NULL REFERENCES invoiceonly_invoices( id ) ON DELETE CASCADE,
+						amount bigint NOT NULL,
+						reference text NOT NULL,
+						paid_at timestamp with time zone NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX invoiceonly_payments_invoice_id_index ON invoiceonly_payments ( invoice_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add price_plans, partner_price_plans and project_price_plans tables",
+				Version:     182,
+				Action: migrate.SQL{
+					`CREATE TABLE price_plans (
+						id bytea NOT NULL,
+						name text NOT NULL,
+						storage text NOT NULL,
+						egress text NOT NULL,
+						objects text NOT NULL,
+						minimum_charge bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( name )
+					);`,
+					`CREATE TABLE partner_price_plans (
+						partner_id bytea NOT NULL,
+						price_plan_id bytea NOT NULL REFERENCES price_plans( id ),
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( partner_id )
+					);`,
+					`CREATE TABLE project_price_plans (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						price_plan_id bytea NOT NULL REFERENCES price_plans( id ),
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+					`CREATE INDEX partner_price_plans_price_plan_id_index ON partner_price_plans ( price_plan_id );`,
+					`CREATE INDEX project_price_plans_price_plan_id_index ON project_price_plans ( price_plan_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_freezes table",
+				Version:     183,
+				Action: migrate.SQL{
+					`CREATE TABLE project_freezes (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						reason text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add admin_credentials table",
+				Version:     184,
+				Action: migrate.SQL{
+					`CREATE TABLE admin_credentials (
+						id bytea NOT NULL,
+						name text NOT NULL,
+						scopes text NOT NULL,
+						secret_hash bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						rotated_at timestamp with time zone,
+						PRIMARY KEY ( id ),
+						UNIQUE ( name ),
+						UNIQUE ( secret_hash )
+					);`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -13,7 +13,7 @@ func (db *satelliteDB) testMigration() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     170,
+				Version:     184,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
 CREATE TABLE accounting_rollups (
@@ -32,6 +32,30 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE admin_credentials (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	scopes text NOT NULL,
+	secret_hash bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	rotated_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( name ),
+	UNIQUE ( secret_hash )
+);
+CREATE TABLE audit_events (
+	id bytea NOT NULL,
+	user_id bytea,
+	email text NOT NULL,
+	project_id bytea,
+	source text NOT NULL,
+	operation text NOT NULL,
+	details text NOT NULL,
+	source_ip text NOT NULL,
+	forwarded_for_ip text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bucket_bandwidth_rollups (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -54,6 +78,17 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_inventories (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	format text NOT NULL,
+	destination_access text NOT NULL,
+	destination_bucket text NOT NULL,
+	destination_prefix text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_report_at timestamp with time zone,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -80,6 +115,21 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE consistency_fixes (
+	id bytea NOT NULL,
+	kind text NOT NULL,
+	stream_id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	version bigint NOT NULL,
+	description text NOT NULL,
+	status text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( stream_id, kind )
+);
 CREATE TABLE coupons (
 	id bytea NOT NULL,
 	user_id bytea NOT NULL,
@@ -151,6 +201,25 @@ CREATE TABLE graceful_exit_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, path, piece_num )
 );
+CREATE TABLE invoiceonly_invoices (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	amount bigint NOT NULL,
+	line_items text NOT NULL,
+	due_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, period_start )
+);
+CREATE TABLE login_lockouts (
+	key text NOT NULL,
+	failed_count integer NOT NULL,
+	last_failed_at timestamp with time zone NOT NULL,
+	locked_until timestamp with time zone,
+	PRIMARY KEY ( key )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -223,6 +292,17 @@ CREATE TABLE peer_identities (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE price_plans (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	storage text NOT NULL,
+	egress text NOT NULL,
+	objects text NOT NULL,
+	minimum_charge bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( name )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -230,6 +310,15 @@ CREATE TABLE projects (
 	usage_limit bigint,
 	bandwidth_limit bigint,
 	rate_limit integer,
+	burst_limit integer,
+	rate_limit_list integer,
+	burst_limit_list integer,
+	rate_limit_upload integer,
+	burst_limit_upload integer,
+	rate_limit_download integer,
+	burst_limit_download integer,
+	rate_limit_delete integer,
+	burst_limit_delete integer,
 	max_buckets integer,
 	partner_id bytea,
 	owner_id bytea NOT NULL,
@@ -444,6 +533,8 @@ CREATE TABLE api_keys (
 	secret bytea NOT NULL,
 	partner_id bytea,
 	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone,
+	last_used_at timestamp with time zone,
 	PRIMARY KEY ( id ),
 	UNIQUE ( head ),
 	UNIQUE ( name, project_id )
@@ -467,12 +558,47 @@ CREATE TABLE bucket_metainfos (
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
+CREATE TABLE invoiceonly_payments (
+	id bytea NOT NULL,
+	invoice_id bytea NOT NULL REFERENCES invoiceonly_invoices( id ) ON DELETE CASCADE,
+	amount bigint NOT NULL,
+	reference text NOT NULL,
+	paid_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE oidc_identities (
+	issuer text NOT NULL,
+	subject text NOT NULL,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( issuer, subject )
+);
+CREATE TABLE partner_price_plans (
+	partner_id bytea NOT NULL,
+	price_plan_id bytea NOT NULL REFERENCES price_plans( id ),
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( partner_id )
+);
+CREATE TABLE project_freezes (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	reason text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role integer NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE project_price_plans (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	price_plan_id bytea NOT NULL REFERENCES price_plans( id ),
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
@@ -492,7 +618,20 @@ CREATE TABLE user_credits (
 	PRIMARY KEY ( id ),
 	UNIQUE ( id, offer_id )
 );
+CREATE TABLE webapp_sessions (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	ip_address text NOT NULL,
+	user_agent text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_active_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX audit_events_user_id_created_at_index ON audit_events ( user_id, created_at ) ;
+CREATE INDEX audit_events_project_id_created_at_index ON audit_events ( project_id, created_at ) ;
+CREATE INDEX audit_events_created_at_index ON audit_events ( created_at ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
@@ -511,6 +650,11 @@ CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON stora
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
 CREATE INDEX storagenode_paystubs_node_id_index ON storagenode_paystubs ( node_id ) ;
 CREATE INDEX storagenode_storage_tallies_node_id_index ON storagenode_storage_tallies ( node_id ) ;
+CREATE INDEX invoiceonly_payments_invoice_id_index ON invoiceonly_payments ( invoice_id ) ;
+CREATE INDEX oidc_identities_user_id_index ON oidc_identities ( user_id ) ;
+CREATE INDEX partner_price_plans_price_plan_id_index ON partner_price_plans ( price_plan_id ) ;
+CREATE INDEX project_price_plans_price_plan_id_index ON project_price_plans ( price_plan_id ) ;
+CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
 CREATE UNIQUE INDEX credits_earned_user_id_offer_id ON user_credits ( id, offer_id ) ;
 
 INSERT INTO "offers" ("id", "name", "description", "award_credit_in_cents", "invitee_credit_in_cents", "expires_at", "created_at", "status", "type", "award_credit_duration_days", "invitee_credit_duration_days") VALUES (1, 'Default referral offer', 'Is active when no other active referral offer', 300, 600, '2119-03-14 08:28:24.636949+00', '2019-07-14 08:28:24.636949+00', 1, 2, 365, 14);
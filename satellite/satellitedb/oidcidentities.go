@@ -0,0 +1,63 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that oidcIdentities implements console.OIDCIdentities.
+var _ console.OIDCIdentities = (*oidcIdentities)(nil)
+
+// oidcIdentities is an implementation of console.OIDCIdentities.
+type oidcIdentities struct {
+	db dbx.Methods
+}
+
+// Get is a method for querying a linked account by the issuer and the subject of the identity provider.
+func (identities *oidcIdentities) Get(ctx context.Context, issuer, subject string) (_ *console.OIDCIdentity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	identity, err := identities.db.Get_OidcIdentity_By_Issuer_And_Subject(ctx,
+		dbx.OidcIdentity_Issuer(issuer),
+		dbx.OidcIdentity_Subject(subject),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.FromBytes(identity.UserId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &console.OIDCIdentity{
+		Issuer:    identity.Issuer,
+		Subject:   identity.Subject,
+		UserID:    userID,
+		CreatedAt: identity.CreatedAt,
+	}, nil
+}
+
+// Insert is a method for linking an identity provider account to a user.
+func (identities *oidcIdentities) Insert(ctx context.Context, identity console.OIDCIdentity) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if identity.CreatedAt.IsZero() {
+		identity.CreatedAt = time.Now()
+	}
+
+	err = identities.db.CreateNoReturn_OidcIdentity(ctx,
+		dbx.OidcIdentity_Issuer(identity.Issuer),
+		dbx.OidcIdentity_Subject(identity.Subject),
+		dbx.OidcIdentity_UserId(identity.UserID[:]),
+		dbx.OidcIdentity_CreatedAt(identity.CreatedAt),
+	)
+	return Error.Wrap(err)
+}
@@ -0,0 +1,293 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
+	"storj.io/storj/satellite/payments/priceplans"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that *pricePlans implements priceplans.DB.
+var _ priceplans.DB = (*pricePlans)(nil)
+
+// pricePlans is an implementation of priceplans.DB.
+//
+// architecture: Database
+type pricePlans struct {
+	db *satelliteDB
+}
+
+// Insert inserts a price plan, it returns ErrNameTaken when a plan with
+// the same name exists.
+func (plans *pricePlans) Insert(ctx context.Context, plan priceplans.Plan) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storage, egress, objects, err := encodePricePlanRates(plan)
+	if err != nil {
+		return err
+	}
+
+	err = plans.db.CreateNoReturn_PricePlan(ctx,
+		dbx.PricePlan_Id(plan.ID[:]),
+		dbx.PricePlan_Name(plan.Name),
+		dbx.PricePlan_Storage(storage),
+		dbx.PricePlan_Egress(egress),
+		dbx.PricePlan_Objects(objects),
+		dbx.PricePlan_MinimumCharge(plan.MinimumCharge),
+		dbx.PricePlan_CreatedAt(plan.CreatedAt),
+	)
+	if pgerrcode.FromError(err) == pgxerrcode.UniqueViolation {
+		return priceplans.ErrNameTaken.New("%s", plan.Name)
+	}
+	return errs.Wrap(err)
+}
+
+// Get returns the price plan with the given id.
+func (plans *pricePlans) Get(ctx context.Context, id uuid.UUID) (_ *priceplans.Plan, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxPlan, err := plans.db.Get_PricePlan_By_Id(ctx, dbx.PricePlan_Id(id[:]))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, priceplans.ErrNotFound.New("%s", id)
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return pricePlanFromDBX(dbxPlan)
+}
+
+// List returns all price plans ordered by name.
+func (plans *pricePlans) List(ctx context.Context) (_ []priceplans.Plan, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxPlans, err := plans.db.All_PricePlan_OrderBy_Asc_Name(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	var list []priceplans.Plan
+	for _, dbxPlan := range dbxPlans {
+		plan, err := pricePlanFromDBX(dbxPlan)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *plan)
+	}
+	return list, nil
+}
+
+// Update updates the name, rates and minimum charge of the price plan.
+func (plans *pricePlans) Update(ctx context.Context, plan priceplans.Plan) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storage, egress, objects, err := encodePricePlanRates(plan)
+	if err != nil {
+		return err
+	}
+
+	updated, err := plans.db.Update_PricePlan_By_Id(ctx,
+		dbx.PricePlan_Id(plan.ID[:]),
+		dbx.PricePlan_Update_Fields{
+			Name:          dbx.PricePlan_Name(plan.Name),
+			Storage:       dbx.PricePlan_Storage(storage),
+			Egress:        dbx.PricePlan_Egress(egress),
+			Objects:       dbx.PricePlan_Objects(objects),
+			MinimumCharge: dbx.PricePlan_MinimumCharge(plan.MinimumCharge),
+		},
+	)
+	if pgerrcode.FromError(err) == pgxerrcode.UniqueViolation {
+		return priceplans.ErrNameTaken.New("%s", plan.Name)
+	}
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if updated == nil {
+		return priceplans.ErrNotFound.New("%s", plan.ID)
+	}
+	return nil
+}
+
+// Delete deletes the price plan, it returns ErrInUse when the plan is assigned.
+func (plans *pricePlans) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := plans.db.Delete_PricePlan_By_Id(ctx, dbx.PricePlan_Id(id[:]))
+	if pgerrcode.FromError(err) == pgxerrcode.ForeignKeyViolation {
+		return priceplans.ErrInUse.New("%s", id)
+	}
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if !deleted {
+		return priceplans.ErrNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// AssignProject assigns the price plan to the project, replacing the current assignment.
+func (plans *pricePlans) AssignProject(ctx context.Context, projectID, planID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = plans.db.ReplaceNoReturn_ProjectPricePlan(ctx,
+		dbx.ProjectPricePlan_ProjectId(projectID[:]),
+		dbx.ProjectPricePlan_PricePlanId(planID[:]),
+	)
+	if pgerrcode.FromError(err) == pgxerrcode.ForeignKeyViolation {
+		return priceplans.ErrNotFound.New("%s", planID)
+	}
+	return errs.Wrap(err)
+}
+
+// UnassignProject removes the price plan assignment of the project.
+func (plans *pricePlans) UnassignProject(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = plans.db.Delete_ProjectPricePlan_By_ProjectId(ctx, dbx.ProjectPricePlan_ProjectId(projectID[:]))
+	return errs.Wrap(err)
+}
+
+// AssignPartner assigns the price plan to the partner, replacing the current assignment.
+func (plans *pricePlans) AssignPartner(ctx context.Context, partnerID, planID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = plans.db.ReplaceNoReturn_PartnerPricePlan(ctx,
+		dbx.PartnerPricePlan_PartnerId(partnerID[:]),
+		dbx.PartnerPricePlan_PricePlanId(planID[:]),
+	)
+	if pgerrcode.FromError(err) == pgxerrcode.ForeignKeyViolation {
+		return priceplans.ErrNotFound.New("%s", planID)
+	}
+	return errs.Wrap(err)
+}
+
+// UnassignPartner removes the price plan assignment of the partner.
+func (plans *pricePlans) UnassignPartner(ctx context.Context, partnerID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = plans.db.Delete_PartnerPricePlan_By_PartnerId(ctx, dbx.PartnerPricePlan_PartnerId(partnerID[:]))
+	return errs.Wrap(err)
+}
+
+// GetAssignments returns the projects and partners the price plan is assigned to.
+func (plans *pricePlans) GetAssignments(ctx context.Context, planID uuid.UUID) (_ priceplans.Assignments, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	assignments := priceplans.Assignments{
+		Projects: []uuid.UUID{},
+		Partners: []uuid.UUID{},
+	}
+
+	projectPlans, err := plans.db.All_ProjectPricePlan_By_PricePlanId_OrderBy_Asc_ProjectId(ctx, dbx.ProjectPricePlan_PricePlanId(planID[:]))
+	if err != nil {
+		return priceplans.Assignments{}, errs.Wrap(err)
+	}
+	for _, projectPlan := range projectPlans {
+		projectID, err := uuid.FromBytes(projectPlan.ProjectId)
+		if err != nil {
+			return priceplans.Assignments{}, errs.Wrap(err)
+		}
+		assignments.Projects = append(assignments.Projects, projectID)
+	}
+
+	partnerPlans, err := plans.db.All_PartnerPricePlan_By_PricePlanId_OrderBy_Asc_PartnerId(ctx, dbx.PartnerPricePlan_PricePlanId(planID[:]))
+	if err != nil {
+		return priceplans.Assignments{}, errs.Wrap(err)
+	}
+	for _, partnerPlan := range partnerPlans {
+		partnerID, err := uuid.FromBytes(partnerPlan.PartnerId)
+		if err != nil {
+			return priceplans.Assignments{}, errs.Wrap(err)
+		}
+		assignments.Partners = append(assignments.Partners, partnerID)
+	}
+
+	return assignments, nil
+}
+
+// GetForProject returns the price plan that applies to the project: the plan
+// assigned to the project, otherwise the plan assigned to its partner.
+func (plans *pricePlans) GetForProject(ctx context.Context, projectID, partnerID uuid.UUID) (_ *priceplans.Plan, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var planID []byte
+
+	projectPlan, err := plans.db.Find_ProjectPricePlan_By_ProjectId(ctx, dbx.ProjectPricePlan_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if projectPlan != nil {
+		planID = projectPlan.PricePlanId
+	} else {
+		partnerPlan, err := plans.db.Find_PartnerPricePlan_By_PartnerId(ctx, dbx.PartnerPricePlan_PartnerId(partnerID[:]))
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if partnerPlan == nil {
+			return nil, priceplans.ErrNotFound.New("project %s", projectID)
+		}
+		planID = partnerPlan.PricePlanId
+	}
+
+	dbxPlan, err := plans.db.Get_PricePlan_By_Id(ctx, dbx.PricePlan_Id(planID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, priceplans.ErrNotFound.New("project %s", projectID)
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return pricePlanFromDBX(dbxPlan)
+}
+
+// encodePricePlanRates encodes the rates of the price plan as JSON.
+func encodePricePlanRates(plan priceplans.Plan) (storage, egress, objects string, err error) {
+	var encoded [3][]byte
+	for i, rate := range []priceplans.Rate{plan.Storage, plan.Egress, plan.Objects} {
+		encoded[i], err = json.Marshal(rate)
+		if err != nil {
+			return "", "", "", errs.Wrap(err)
+		}
+	}
+	return string(encoded[0]), string(encoded[1]), string(encoded[2]), nil
+}
+
+// pricePlanFromDBX converts the dbx price plan into a plan.
+func pricePlanFromDBX(dbxPlan *dbx.PricePlan) (*priceplans.Plan, error) {
+	id, err := uuid.FromBytes(dbxPlan.Id)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	plan := priceplans.Plan{
+		ID:            id,
+		Name:          dbxPlan.Name,
+		MinimumCharge: dbxPlan.MinimumCharge,
+		CreatedAt:     dbxPlan.CreatedAt,
+	}
+
+	for _, rate := range []struct {
+		data string
+		into *priceplans.Rate
+	}{
+		{dbxPlan.Storage, &plan.Storage},
+		{dbxPlan.Egress, &plan.Egress},
+		{dbxPlan.Objects, &plan.Objects},
+	} {
+		if err := json.Unmarshal([]byte(rate.data), rate.into); err != nil {
+			return nil, errs.Wrap(err)
+		}
+	}
+	return &plan, nil
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that projectFreezes implements console.ProjectFreezes.
+var _ console.ProjectFreezes = (*projectFreezes)(nil)
+
+// projectFreezes is an implementation of console.ProjectFreezes.
+type projectFreezes struct {
+	db dbx.Methods
+}
+
+// Freeze is a method for freezing a project. It returns false when the
+// project was already frozen.
+func (freezes *projectFreezes) Freeze(ctx context.Context, projectID uuid.UUID, reason string) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	frozen, err := freezes.db.Has_ProjectFreeze_By_ProjectId(ctx, dbx.ProjectFreeze_ProjectId(projectID[:]))
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	if frozen {
+		return false, nil
+	}
+
+	err = freezes.db.CreateNoReturn_ProjectFreeze(ctx,
+		dbx.ProjectFreeze_ProjectId(projectID[:]),
+		dbx.ProjectFreeze_Reason(reason),
+	)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return true, nil
+}
+
+// Unfreeze is a method for unfreezing a project. It returns false when the
+// project was not frozen.
+func (freezes *projectFreezes) Unfreeze(ctx context.Context, projectID uuid.UUID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := freezes.db.Delete_ProjectFreeze_By_ProjectId(ctx, dbx.ProjectFreeze_ProjectId(projectID[:]))
+	return deleted, Error.Wrap(err)
+}
+
+// Get is a method for querying the freeze of a project.
+func (freezes *projectFreezes) Get(ctx context.Context, projectID uuid.UUID) (_ *console.ProjectFreeze, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	freeze, err := freezes.db.Get_ProjectFreeze_By_ProjectId(ctx, dbx.ProjectFreeze_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.FromBytes(freeze.ProjectId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &console.ProjectFreeze{
+		ProjectID: id,
+		Reason:    freeze.Reason,
+		CreatedAt: freeze.CreatedAt,
+	}, nil
+}
+
+// IsFrozen is a method for checking whether a project is frozen.
+func (freezes *projectFreezes) IsFrozen(ctx context.Context, projectID uuid.UUID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	frozen, err := freezes.db.Has_ProjectFreeze_By_ProjectId(ctx, dbx.ProjectFreeze_ProjectId(projectID[:]))
+	return frozen, Error.Wrap(err)
+}
@@ -6,6 +6,7 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -104,6 +105,15 @@ func (projects *projects) Insert(ctx context.Context, project *console.Project)
 		createFields.BandwidthLimit = dbx.Project_BandwidthLimit(project.BandwidthLimit.Int64())
 	}
 	createFields.RateLimit = dbx.Project_RateLimit_Raw(project.RateLimit)
+	createFields.BurstLimit = dbx.Project_BurstLimit_Raw(project.BurstLimit)
+	createFields.RateLimitList = dbx.Project_RateLimitList_Raw(project.OperationLimits.List.Rate)
+	createFields.BurstLimitList = dbx.Project_BurstLimitList_Raw(project.OperationLimits.List.Burst)
+	createFields.RateLimitUpload = dbx.Project_RateLimitUpload_Raw(project.OperationLimits.Upload.Rate)
+	createFields.BurstLimitUpload = dbx.Project_BurstLimitUpload_Raw(project.OperationLimits.Upload.Burst)
+	createFields.RateLimitDownload = dbx.Project_RateLimitDownload_Raw(project.OperationLimits.Download.Rate)
+	createFields.BurstLimitDownload = dbx.Project_BurstLimitDownload_Raw(project.OperationLimits.Download.Burst)
+	createFields.RateLimitDelete = dbx.Project_RateLimitDelete_Raw(project.OperationLimits.Delete.Rate)
+	createFields.BurstLimitDelete = dbx.Project_BurstLimitDelete_Raw(project.OperationLimits.Delete.Burst)
 	createFields.MaxBuckets = dbx.Project_MaxBuckets_Raw(project.MaxBuckets)
 
 	createdProject, err := projects.db.Create_Project(ctx,
@@ -138,7 +148,9 @@ func (projects *projects) Update(ctx context.Context, project *console.Project)
 		Name:        dbx.Project_Name(project.Name),
 		Description: dbx.Project_Description(project.Description),
 		RateLimit:   dbx.Project_RateLimit_Raw(project.RateLimit),
+		BurstLimit:  dbx.Project_BurstLimit_Raw(project.BurstLimit),
 	}
+	setOperationLimits(&updateFields, project.OperationLimits)
 	if project.StorageLimit != nil {
 		updateFields.UsageLimit = dbx.Project_UsageLimit(project.StorageLimit.Int64())
 	}
@@ -171,6 +183,71 @@ func (projects *projects) UpdateRateLimit(ctx context.Context, id uuid.UUID, new
 	return err
 }
 
+// UpdateBurstLimit is a method for updating projects burst limit, nil removes the project specific limit.
+func (projects *projects) UpdateBurstLimit(ctx context.Context, id uuid.UUID, newLimit *int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = projects.db.Update_Project_By_Id(ctx,
+		dbx.Project_Id(id[:]),
+		dbx.Project_Update_Fields{
+			BurstLimit: dbx.Project_BurstLimit_Raw(newLimit),
+		})
+
+	return err
+}
+
+// UpdateOperationLimits is a method for updating projects per operation rate limits.
+func (projects *projects) UpdateOperationLimits(ctx context.Context, id uuid.UUID, limits console.OperationLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var updateFields dbx.Project_Update_Fields
+	setOperationLimits(&updateFields, limits)
+
+	_, err = projects.db.Update_Project_By_Id(ctx,
+		dbx.Project_Id(id[:]),
+		updateFields)
+
+	return err
+}
+
+// UpdateUsageLimit is a method for updating projects storage usage limit.
+func (projects *projects) UpdateUsageLimit(ctx context.Context, id uuid.UUID, newLimit memory.Size) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = projects.db.Update_Project_By_Id(ctx,
+		dbx.Project_Id(id[:]),
+		dbx.Project_Update_Fields{
+			UsageLimit: dbx.Project_UsageLimit(newLimit.Int64()),
+		})
+
+	return err
+}
+
+// UpdateBandwidthLimit is a method for updating projects bandwidth limit.
+func (projects *projects) UpdateBandwidthLimit(ctx context.Context, id uuid.UUID, newLimit memory.Size) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = projects.db.Update_Project_By_Id(ctx,
+		dbx.Project_Id(id[:]),
+		dbx.Project_Update_Fields{
+			BandwidthLimit: dbx.Project_BandwidthLimit(newLimit.Int64()),
+		})
+
+	return err
+}
+
+// setOperationLimits sets all per operation rate limit columns, nil limits are stored as NULL.
+func setOperationLimits(updateFields *dbx.Project_Update_Fields, limits console.OperationLimits) {
+	updateFields.RateLimitList = dbx.Project_RateLimitList_Raw(limits.List.Rate)
+	updateFields.BurstLimitList = dbx.Project_BurstLimitList_Raw(limits.List.Burst)
+	updateFields.RateLimitUpload = dbx.Project_RateLimitUpload_Raw(limits.Upload.Rate)
+	updateFields.BurstLimitUpload = dbx.Project_BurstLimitUpload_Raw(limits.Upload.Burst)
+	updateFields.RateLimitDownload = dbx.Project_RateLimitDownload_Raw(limits.Download.Rate)
+	updateFields.BurstLimitDownload = dbx.Project_BurstLimitDownload_Raw(limits.Download.Burst)
+	updateFields.RateLimitDelete = dbx.Project_RateLimitDelete_Raw(limits.Delete.Rate)
+	updateFields.BurstLimitDelete = dbx.Project_BurstLimitDelete_Raw(limits.Delete.Burst)
+}
+
 // UpdateBucketLimit is a method for updating projects bucket limit.
 func (projects *projects) UpdateBucketLimit(ctx context.Context, id uuid.UUID, newLimit int) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -290,6 +367,71 @@ func (projects *projects) ListByOwnerID(ctx context.Context, ownerID uuid.UUID,
 	return page, rows.Err()
 }
 
+// Search is a method for querying the projects matching all of the given criteria,
+// newest first.
+func (projects *projects) Search(ctx context.Context, search console.ProjectSearch) (_ []console.Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var conditions []string
+	var args []interface{}
+	if search.Name != "" {
+		conditions = append(conditions, "lower(name) LIKE ?")
+		args = append(args, containsPattern(strings.ToLower(search.Name)))
+	}
+	if search.OwnerID != nil {
+		conditions = append(conditions, "owner_id = ?")
+		args = append(args, *search.OwnerID)
+	}
+	if search.PartnerID != nil {
+		conditions = append(conditions, "partner_id = ?")
+		args = append(args, *search.PartnerID)
+	}
+	if search.CreatedAfter != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, *search.CreatedAfter)
+	}
+	if search.CreatedBefore != nil {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, *search.CreatedBefore)
+	}
+
+	query := `
+		SELECT id, name, description, usage_limit, bandwidth_limit, rate_limit, burst_limit, max_buckets, partner_id, owner_id, created_at
+		FROM projects
+	`
+	if len(conditions) > 0 {
+		query += "WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += `
+		ORDER BY created_at DESC, id
+		LIMIT ? OFFSET ?
+	`
+	args = append(args, search.Limit, search.Offset)
+
+	rows, err := projects.sdb.QueryContext(ctx, projects.sdb.Rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var found []console.Project
+	for rows.Next() {
+		var row dbx.Project
+		err = rows.Scan(&row.Id, &row.Name, &row.Description, &row.UsageLimit, &row.BandwidthLimit, &row.RateLimit, &row.BurstLimit, &row.MaxBuckets, &row.PartnerId, &row.OwnerId, &row.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		project, err := projectFromDBX(ctx, &row)
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, *project)
+	}
+
+	return found, rows.Err()
+}
+
 // projectFromDBX is used for creating Project entity from autogenerated dbx.Project struct.
 func projectFromDBX(ctx context.Context, project *dbx.Project) (_ *console.Project, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -323,10 +465,17 @@ func projectFromDBX(ctx context.Context, project *dbx.Project) (_ *console.Proje
 		PartnerID:      partnerID,
 		OwnerID:        ownerID,
 		RateLimit:      project.RateLimit,
+		BurstLimit:     project.BurstLimit,
 		MaxBuckets:     project.MaxBuckets,
 		CreatedAt:      project.CreatedAt,
 		StorageLimit:   (*memory.Size)(project.UsageLimit),
 		BandwidthLimit: (*memory.Size)(project.BandwidthLimit),
+		OperationLimits: console.OperationLimits{
+			List:     console.OperationLimit{Rate: project.RateLimitList, Burst: project.BurstLimitList},
+			Upload:   console.OperationLimit{Rate: project.RateLimitUpload, Burst: project.BurstLimitUpload},
+			Download: console.OperationLimit{Rate: project.RateLimitDownload, Burst: project.BurstLimitDownload},
+			Delete:   console.OperationLimit{Rate: project.RateLimitDelete, Burst: project.BurstLimitDelete},
+		},
 	}, nil
 }
 
@@ -20,7 +20,8 @@ var _ console.Users = (*users)(nil)
 
 // implementation of Users interface repository using spacemonkeygo/dbx orm.
 type users struct {
-	db dbx.Methods
+	db  dbx.Methods
+	sdb *satelliteDB
 }
 
 // Get is a method for querying user from the database by id.
@@ -140,6 +141,79 @@ func (users *users) GetProjectLimit(ctx context.Context, id uuid.UUID) (limit in
 	return row.ProjectLimit, nil
 }
 
+// Search is a method for querying the users matching all of the given criteria,
+// newest first.
+func (users *users) Search(ctx context.Context, search console.UserSearch) (_ []console.User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var conditions []string
+	var args []interface{}
+	if search.Email != "" {
+		conditions = append(conditions, "normalized_email LIKE ?")
+		args = append(args, containsPattern(normalizeEmail(search.Email)))
+	}
+	if search.Name != "" {
+		conditions = append(conditions, "lower(full_name) LIKE ?")
+		args = append(args, containsPattern(strings.ToLower(search.Name)))
+	}
+	if search.PartnerID != nil {
+		conditions = append(conditions, "partner_id = ?")
+		args = append(args, *search.PartnerID)
+	}
+	if search.CreatedAfter != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, *search.CreatedAfter)
+	}
+	if search.CreatedBefore != nil {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, *search.CreatedBefore)
+	}
+	if search.PaidTier != nil {
+		conditions = append(conditions, "paid_tier = ?")
+		args = append(args, *search.PaidTier)
+	}
+	if search.Status != nil {
+		conditions = append(conditions, "status = ?")
+		args = append(args, int(*search.Status))
+	}
+
+	query := `
+		SELECT id, email, full_name, short_name, status, partner_id, created_at, project_limit, paid_tier
+		FROM users
+	`
+	if len(conditions) > 0 {
+		query += "WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += `
+		ORDER BY created_at DESC, id
+		LIMIT ? OFFSET ?
+	`
+	args = append(args, search.Limit, search.Offset)
+
+	rows, err := users.sdb.QueryContext(ctx, users.sdb.Rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var found []console.User
+	for rows.Next() {
+		var row dbx.User
+		err = rows.Scan(&row.Id, &row.Email, &row.FullName, &row.ShortName, &row.Status, &row.PartnerId, &row.CreatedAt, &row.ProjectLimit, &row.PaidTier)
+		if err != nil {
+			return nil, err
+		}
+
+		user, err := userFromDBX(ctx, &row)
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, *user)
+	}
+
+	return found, rows.Err()
+}
+
 // toUpdateUser creates dbx.User_Update_Fields with only non-empty fields as updatable.
 func toUpdateUser(user *console.User) (*dbx.User_Update_Fields, error) {
 	update := dbx.User_Update_Fields{
@@ -243,3 +317,9 @@ func userFromDBX(ctx context.Context, user *dbx.User) (_ *console.User, err erro
 func normalizeEmail(email string) string {
 	return strings.ToUpper(email)
 }
+
+// containsPattern returns a LIKE pattern matching the values containing s.
+func containsPattern(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+	return "%" + s + "%"
+}
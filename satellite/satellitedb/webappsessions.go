@@ -0,0 +1,166 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that webappSessions implements console.WebappSessions.
+var _ console.WebappSessions = (*webappSessions)(nil)
+
+// webappSessions is an implementation of console.WebappSessions.
+type webappSessions struct {
+	db *satelliteDB
+}
+
+// Insert is a method for inserting a session into the database.
+func (sessions *webappSessions) Insert(ctx context.Context, session console.WebappSession) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = sessions.db.CreateNoReturn_WebappSession(ctx,
+		dbx.WebappSession_Id(session.ID[:]),
+		dbx.WebappSession_UserId(session.UserID[:]),
+		dbx.WebappSession_IpAddress(session.IPAddress),
+		dbx.WebappSession_UserAgent(session.UserAgent),
+		dbx.WebappSession_CreatedAt(session.CreatedAt),
+		dbx.WebappSession_LastActiveAt(session.LastActiveAt),
+		dbx.WebappSession_ExpiresAt(session.ExpiresAt),
+	)
+	return Error.Wrap(err)
+}
+
+// Get is a method for querying a session by its id.
+func (sessions *webappSessions) Get(ctx context.Context, id uuid.UUID) (_ *console.WebappSession, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxSession, err := sessions.db.Get_WebappSession_By_Id(ctx, dbx.WebappSession_Id(id[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return webappSessionFromDBX(dbxSession)
+}
+
+// GetAllByUserID is a method for querying all sessions of a user, most recently active first.
+func (sessions *webappSessions) GetAllByUserID(ctx context.Context, userID uuid.UUID) (_ []console.WebappSession, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxSessions, err := sessions.db.All_WebappSession_By_UserId_OrderBy_Desc_LastActiveAt(ctx, dbx.WebappSession_UserId(userID[:]))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var result []console.WebappSession
+	for _, dbxSession := range dbxSessions {
+		session, err := webappSessionFromDBX(dbxSession)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *session)
+	}
+
+	return result, nil
+}
+
+// UpdateLastActive is a method for updating the time of the last activity of a session.
+func (sessions *webappSessions) UpdateLastActive(ctx context.Context, id uuid.UUID, lastActiveAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = sessions.db.UpdateNoReturn_WebappSession_By_Id(ctx,
+		dbx.WebappSession_Id(id[:]),
+		dbx.WebappSession_Update_Fields{
+			LastActiveAt: dbx.WebappSession_LastActiveAt(lastActiveAt),
+		},
+	)
+	return Error.Wrap(err)
+}
+
+// Delete is a method for deleting a session of a user, it returns sql.ErrNoRows
+// when the user has no such session.
+func (sessions *webappSessions) Delete(ctx context.Context, userID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := sessions.db.Delete_WebappSession_By_Id_And_UserId(ctx,
+		dbx.WebappSession_Id(id[:]),
+		dbx.WebappSession_UserId(userID[:]),
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !deleted {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// DeleteAllByUserID is a method for deleting all sessions of a user.
+func (sessions *webappSessions) DeleteAllByUserID(ctx context.Context, userID uuid.UUID) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err = sessions.db.Delete_WebappSession_By_UserId(ctx, dbx.WebappSession_UserId(userID[:]))
+	return deleted, Error.Wrap(err)
+}
+
+// DeleteExpiredBefore is a method for deleting sessions that expired before the given time.
+func (sessions *webappSessions) DeleteExpiredBefore(ctx context.Context, before time.Time, batchSize int) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if batchSize <= 0 {
+		return 0, nil
+	}
+
+	for {
+		result, err := sessions.db.ExecContext(ctx, sessions.db.Rebind(`
+			DELETE FROM webapp_sessions
+			WHERE id IN (
+				SELECT id FROM webapp_sessions
+				WHERE expires_at < ?
+				LIMIT ?
+			)
+		`), before, batchSize)
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+		deleted += affected
+
+		if affected < int64(batchSize) {
+			return deleted, nil
+		}
+	}
+}
+
+// webappSessionFromDBX converts the dbx session into a console session.
+func webappSessionFromDBX(dbxSession *dbx.WebappSession) (*console.WebappSession, error) {
+	id, err := uuid.FromBytes(dbxSession.Id)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	userID, err := uuid.FromBytes(dbxSession.UserId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &console.WebappSession{
+		ID:           id,
+		UserID:       userID,
+		IPAddress:    dbxSession.IpAddress,
+		UserAgent:    dbxSession.UserAgent,
+		CreatedAt:    dbxSession.CreatedAt,
+		LastActiveAt: dbxSession.LastActiveAt,
+		ExpiresAt:    dbxSession.ExpiresAt,
+	}, nil
+}
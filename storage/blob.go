@@ -112,6 +112,9 @@ type Blobs interface {
 	// error, WalkNamespace will stop iterating and return the error immediately. The ctx
 	// parameter is intended to allow canceling iteration early.
 	WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(BlobInfo) error) error
+	// WalkNamespaceWithPrefix executes walkFunc like WalkNamespace, but only for the blobs
+	// whose key starts with the given byte.
+	WalkNamespaceWithPrefix(ctx context.Context, namespace []byte, keyPrefix byte, walkFunc func(BlobInfo) error) error
 	// CreateVerificationFile creates a file to be used for storage directory verification.
 	CreateVerificationFile(id storj.NodeID) error
 	// VerifyStorageDir verifies that the storage directory is correct by checking for the existence and validity
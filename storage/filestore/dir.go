@@ -685,6 +685,26 @@ func (dir *Dir) WalkNamespace(ctx context.Context, namespace []byte, walkFunc fu
 	return dir.walkNamespaceInPath(ctx, namespace, dir.blobsdir(), walkFunc)
 }
 
+// WalkNamespaceWithPrefix executes walkFunc for each locally stored blob, stored with storage
+// format V1 or greater, in the given namespace, whose key starts with keyPrefix.
+func (dir *Dir) WalkNamespaceWithPrefix(ctx context.Context, namespace []byte, keyPrefix byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	nsDir := filepath.Join(dir.blobsdir(), pathEncoding.EncodeToString(namespace))
+	// the key prefix directories are named after the first two characters of the encoded
+	// key, which hold its first 10 bits, so the first byte of a key selects 4 of them.
+	for low := 0; low < 4; low++ {
+		encoded := pathEncoding.EncodeToString([]byte{keyPrefix, byte(low << 6)})
+		err := walkNamespaceWithPrefix(ctx, dir.log, namespace, nsDir, encoded[:2], walkFunc)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
 func (dir *Dir) walkNamespaceInPath(ctx context.Context, namespace []byte, path string, walkFunc func(storage.BlobInfo) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	namespaceDir := pathEncoding.EncodeToString(namespace)
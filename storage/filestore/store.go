@@ -284,6 +284,12 @@ func (store *blobStore) WalkNamespace(ctx context.Context, namespace []byte, wal
 	return store.dir.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkNamespaceWithPrefix executes walkFunc for each locally stored blob in the given namespace,
+// whose key starts with keyPrefix.
+func (store *blobStore) WalkNamespaceWithPrefix(ctx context.Context, namespace []byte, keyPrefix byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	return store.dir.WalkNamespaceWithPrefix(ctx, namespace, keyPrefix, walkFunc)
+}
+
 // TestCreateV0 creates a new V0 blob that can be written. This is ONLY appropriate in test situations.
 func (store *blobStore) TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error) {
 	defer mon.Task()(&ctx)(&err)
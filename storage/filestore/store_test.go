@@ -533,6 +533,51 @@ func TestStoreTraversals(t *testing.T) {
 	assert.Equal(t, 2, iterations)
 }
 
+func TestStoreWalkNamespaceWithPrefix(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), filestore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(namespaceSize)
+
+	// store blobs with every possible first byte of the key
+	stored := make(map[string]bool)
+	for prefix := 0; prefix < 256; prefix++ {
+		key := testrand.Bytes(keySize)
+		key[0] = byte(prefix)
+		stored[string(key)] = true
+
+		blobWriter, err := store.Create(ctx, storage.BlobRef{Namespace: namespace, Key: key}, 0)
+		require.NoError(t, err)
+		require.NoError(t, blobWriter.Commit(ctx))
+	}
+
+	walked := make(map[string]bool)
+	for prefix := 0; prefix < 256; prefix++ {
+		var count int
+		err := store.WalkNamespaceWithPrefix(ctx, namespace, byte(prefix), func(info storage.BlobInfo) error {
+			key := info.BlobRef().Key
+			require.Equal(t, byte(prefix), key[0])
+			walked[string(key)] = true
+			count++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	}
+	require.Equal(t, stored, walked)
+
+	// test WalkNamespaceWithPrefix on a nonexistent namespace also
+	err = store.WalkNamespaceWithPrefix(ctx, testrand.Bytes(namespaceSize), 0, func(_ storage.BlobInfo) error {
+		t.Fatal("this should not have been called")
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestEmptyTrash(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
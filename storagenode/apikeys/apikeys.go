@@ -12,8 +12,12 @@ import (
 	"storj.io/storj/private/multinodeauth"
 )
 
-// ErrNoAPIKey represents no api key error.
-var ErrNoAPIKey = errs.Class("no api key")
+var (
+	// ErrNoAPIKey represents no api key error.
+	ErrNoAPIKey = errs.Class("no api key")
+	// ErrNotAdmin is returned when a read-only api key is used for an admin operation.
+	ErrNotAdmin = errs.Class("api key is not admin")
+)
 
 // DB is interface for working with api keys.
 //
@@ -25,6 +29,9 @@ type DB interface {
 	// Check checks if api key exists in db by secret.
 	Check(ctx context.Context, secret multinodeauth.Secret) error
 
+	// GetClass returns class of the api key by secret.
+	GetClass(ctx context.Context, secret multinodeauth.Secret) (Class, error)
+
 	// Revoke removes api key from db.
 	Revoke(ctx context.Context, secret multinodeauth.Secret) error
 }
@@ -34,5 +41,28 @@ type APIKey struct {
 	// APIKeys is PK of the table and keeps unique value sno api key.
 	Secret multinodeauth.Secret
 
+	Class     Class     `json:"class"`
 	CreatedAt time.Time `json:"createdAt"`
 }
+
+// Class defines which operations an api key is allowed to perform.
+type Class int
+
+const (
+	// ClassReadOnly api keys can only read node's data.
+	ClassReadOnly Class = 0
+	// ClassAdmin api keys can also change node's configuration and control its services.
+	ClassAdmin Class = 1
+)
+
+// String returns string representation of the class.
+func (class Class) String() string {
+	switch class {
+	case ClassReadOnly:
+		return "read-only"
+	case ClassAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
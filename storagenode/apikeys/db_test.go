@@ -23,6 +23,8 @@ func TestAPIKeysDB(t *testing.T) {
 		assert.NoError(t, err)
 		secret2, err := multinodeauth.NewSecret()
 		assert.NoError(t, err)
+		secret3, err := multinodeauth.NewSecret()
+		assert.NoError(t, err)
 
 		t.Run("Store", func(t *testing.T) {
 			err := apiKeys.Store(ctx, apikeys.APIKey{
@@ -30,6 +32,13 @@ func TestAPIKeysDB(t *testing.T) {
 				CreatedAt: time.Now().UTC(),
 			})
 			assert.NoError(t, err)
+
+			err = apiKeys.Store(ctx, apikeys.APIKey{
+				Secret:    secret3,
+				Class:     apikeys.ClassAdmin,
+				CreatedAt: time.Now().UTC(),
+			})
+			assert.NoError(t, err)
 		})
 
 		t.Run("Check", func(t *testing.T) {
@@ -40,6 +49,19 @@ func TestAPIKeysDB(t *testing.T) {
 			assert.Error(t, err)
 		})
 
+		t.Run("GetClass", func(t *testing.T) {
+			class, err := apiKeys.GetClass(ctx, secret)
+			assert.NoError(t, err)
+			assert.Equal(t, apikeys.ClassReadOnly, class)
+
+			class, err = apiKeys.GetClass(ctx, secret3)
+			assert.NoError(t, err)
+			assert.Equal(t, apikeys.ClassAdmin, class)
+
+			_, err = apiKeys.GetClass(ctx, secret2)
+			assert.True(t, apikeys.ErrNoAPIKey.Has(err))
+		})
+
 		t.Run("Revoke", func(t *testing.T) {
 			err = apiKeys.Revoke(ctx, secret)
 			assert.NoError(t, err)
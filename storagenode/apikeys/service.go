@@ -32,15 +32,29 @@ func NewService(db DB) *Service {
 	return &Service{store: db}
 }
 
-// Issue generates new api key and stores it into db.
+// Issue generates new read-only api key and stores it into db.
 func (service *Service) Issue(ctx context.Context) (apiKey APIKey, err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	return service.issue(ctx, ClassReadOnly)
+}
+
+// IssueAdmin generates new admin api key and stores it into db.
+func (service *Service) IssueAdmin(ctx context.Context) (apiKey APIKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.issue(ctx, ClassAdmin)
+}
+
+// issue generates new api key of the given class and stores it into db.
+func (service *Service) issue(ctx context.Context, class Class) (apiKey APIKey, err error) {
 	secret, err := multinodeauth.NewSecret()
 	if err != nil {
 		return APIKey{}, ErrService.Wrap(err)
 	}
 
 	apiKey.Secret = secret
+	apiKey.Class = class
 	apiKey.CreatedAt = time.Now().UTC()
 
 	err = service.store.Store(ctx, apiKey)
@@ -58,6 +72,21 @@ func (service *Service) Check(ctx context.Context, secret multinodeauth.Secret)
 	return service.store.Check(ctx, secret)
 }
 
+// CheckAdmin returns error if api key does not exists or is not an admin api key.
+func (service *Service) CheckAdmin(ctx context.Context, secret multinodeauth.Secret) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	class, err := service.store.GetClass(ctx, secret)
+	if err != nil {
+		return err
+	}
+	if class != ClassAdmin {
+		return ErrNotAdmin.New("%s", class)
+	}
+
+	return nil
+}
+
 // Remove revokes apikey, deletes it from db.
 func (service *Service) Remove(ctx context.Context, secret multinodeauth.Secret) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,209 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bandwidth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/memory"
+	"storj.io/common/sync2"
+)
+
+// LimiterConfig defines the bandwidth rate schedules and the monthly egress budget.
+type LimiterConfig struct {
+	Schedules           Schedules     `help:"comma separated time of day bandwidth rate limits <from>-<to>=<ingress rate>/<egress rate> in the local time, e.g. 08:00-23:00=2MB/5MB limits the rates to 2MB/s and 5MB/s during the day, 0 is unlimited" default:""`
+	MonthlyEgress       memory.Size   `help:"monthly egress budget, once it's spent the node rejects new uploads and downloads, but still serves audits and repairs, 0 disables the budget" default:"0B"`
+	EgressCheckInterval time.Duration `help:"how often the egress of the current month is checked against the budget" default:"1m" hidden:"true"`
+}
+
+// Limits contains the active bandwidth limits.
+type Limits struct {
+	// IngressRate and EgressRate are in bytes per second, zero means unlimited.
+	IngressRate       int64 `json:"ingressRate"`
+	EgressRate        int64 `json:"egressRate"`
+	MonthlyEgress     int64 `json:"monthlyEgress"`
+	EgressUsed        int64 `json:"egressUsed"`
+	EgressBudgetSpent bool  `json:"egressBudgetSpent"`
+}
+
+// Limiter limits the ingress and egress rate by the active schedule and tracks the monthly egress budget.
+//
+// architecture: Service
+type Limiter struct {
+	log    *zap.Logger
+	db     DB
+	config LimiterConfig
+	Loop   *sync2.Cycle
+
+	nowFn func() time.Time
+
+	mu         sync.Mutex
+	active     Schedule
+	ingress    *rate.Limiter
+	egress     *rate.Limiter
+	egressUsed int64
+}
+
+// NewLimiter creates a new bandwidth limiter.
+func NewLimiter(log *zap.Logger, db DB, config LimiterConfig) *Limiter {
+	return &Limiter{
+		log:     log,
+		db:      db,
+		config:  config,
+		Loop:    sync2.NewCycle(config.EgressCheckInterval),
+		nowFn:   time.Now,
+		ingress: rate.NewLimiter(rate.Inf, 0),
+		egress:  rate.NewLimiter(rate.Inf, 0),
+	}
+}
+
+// SetNow allows tests to have the limiter act as if the current time is whatever they want.
+func (limiter *Limiter) SetNow(nowFn func() time.Time) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	limiter.nowFn = nowFn
+}
+
+// Run periodically checks the egress of the current month against the budget.
+func (limiter *Limiter) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limiter.config.MonthlyEgress <= 0 {
+		return nil
+	}
+
+	return limiter.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := limiter.CheckEgress(ctx); err != nil {
+			limiter.log.Error("Could not check egress budget", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the limiter.
+func (limiter *Limiter) Close() error {
+	limiter.Loop.Close()
+	return nil
+}
+
+// CheckEgress updates the egress used in the current month.
+func (limiter *Limiter) CheckEgress(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limiter.mu.Lock()
+	now := limiter.nowFn()
+	limiter.mu.Unlock()
+
+	usage, err := limiter.db.EgressSummary(ctx, beginningOfMonth(now), now)
+	if err != nil {
+		return err
+	}
+	used := usage.Get + usage.GetAudit + usage.GetRepair
+
+	limiter.mu.Lock()
+	wasSpent := limiter.egressBudgetSpent()
+	limiter.egressUsed = used
+	isSpent := limiter.egressBudgetSpent()
+	limiter.mu.Unlock()
+
+	if isSpent && !wasSpent {
+		limiter.log.Warn("Monthly egress budget is spent, new uploads and downloads are rejected", zap.Int64("used", used), zap.Int64("budget", limiter.config.MonthlyEgress.Int64()))
+	}
+	if !isSpent && wasSpent {
+		limiter.log.Info("Monthly egress budget is available again", zap.Int64("used", used), zap.Int64("budget", limiter.config.MonthlyEgress.Int64()))
+	}
+	return nil
+}
+
+// EgressBudgetSpent returns whether the monthly egress budget is spent.
+func (limiter *Limiter) EgressBudgetSpent() bool {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	return limiter.egressBudgetSpent()
+}
+
+func (limiter *Limiter) egressBudgetSpent() bool {
+	return limiter.config.MonthlyEgress > 0 && limiter.egressUsed >= limiter.config.MonthlyEgress.Int64()
+}
+
+// Limits returns the active bandwidth limits.
+func (limiter *Limiter) Limits() Limits {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	limiter.update()
+
+	return Limits{
+		IngressRate:       limiter.active.Ingress.Int64(),
+		EgressRate:        limiter.active.Egress.Int64(),
+		MonthlyEgress:     limiter.config.MonthlyEgress.Int64(),
+		EgressUsed:        limiter.egressUsed,
+		EgressBudgetSpent: limiter.egressBudgetSpent(),
+	}
+}
+
+// WaitIngress blocks until the ingress rate allows to receive n bytes.
+func (limiter *Limiter) WaitIngress(ctx context.Context, n int) error {
+	return limiter.waitN(ctx, limiter.ingress, n)
+}
+
+// WaitEgress blocks until the egress rate allows to send n bytes.
+func (limiter *Limiter) WaitEgress(ctx context.Context, n int) error {
+	return limiter.waitN(ctx, limiter.egress, n)
+}
+
+// update applies the schedule active at the current time, it must be called with mu held.
+func (limiter *Limiter) update() {
+	active, _ := limiter.config.Schedules.Active(limiter.nowFn())
+	if active.Ingress == limiter.active.Ingress && active.Egress == limiter.active.Egress {
+		return
+	}
+	limiter.active = active
+
+	setRate(limiter.ingress, active.Ingress.Int64())
+	setRate(limiter.egress, active.Egress.Int64())
+}
+
+// setRate changes the rate of the limiter, allowing bursts of one second.
+// It must be called with mu held, so that waiters see the limit and the burst together.
+func setRate(limiter *rate.Limiter, bytesPerSecond int64) {
+	if bytesPerSecond <= 0 {
+		limiter.SetLimit(rate.Inf)
+		return
+	}
+	limiter.SetLimit(rate.Limit(bytesPerSecond))
+	limiter.SetBurst(int(bytesPerSecond))
+}
+
+// waitN waits for n bytes in steps of at most the burst of the rate limiter.
+func (limiter *Limiter) waitN(ctx context.Context, rateLimiter *rate.Limiter, n int) error {
+	for n > 0 {
+		limiter.mu.Lock()
+		limiter.update()
+		limit, burst := rateLimiter.Limit(), rateLimiter.Burst()
+		limiter.mu.Unlock()
+
+		if limit == rate.Inf || burst <= 0 {
+			return nil
+		}
+
+		step := n
+		if step > burst {
+			step = burst
+		}
+		if err := rateLimiter.WaitN(ctx, step); err != nil {
+			// the schedule changed meanwhile and lowered the burst, try again with the new one.
+			if step > rateLimiter.Burst() && ctx.Err() == nil {
+				continue
+			}
+			return err
+		}
+		n -= step
+	}
+	return nil
+}
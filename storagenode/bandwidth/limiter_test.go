@@ -0,0 +1,96 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bandwidth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestLimiterRates(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var config bandwidth.LimiterConfig
+	require.NoError(t, config.Schedules.Set("00:00-24:00=1KB/0"))
+
+	limiter := bandwidth.NewLimiter(zaptest.NewLogger(t), nil, config)
+	require.Equal(t, bandwidth.Limits{IngressRate: 1000}, limiter.Limits())
+
+	// the egress is unlimited
+	require.NoError(t, limiter.WaitEgress(ctx, int(memory.GB)))
+
+	// the ingress can't receive 5KB within 100ms
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	require.Error(t, limiter.WaitIngress(timeoutCtx, 5000))
+}
+
+func TestLimiterEgressBudget(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		satelliteID := testrand.NodeID()
+
+		limiter := bandwidth.NewLimiter(zaptest.NewLogger(t), db.Bandwidth(), bandwidth.LimiterConfig{
+			MonthlyEgress: 10 * memory.KB,
+		})
+
+		require.NoError(t, db.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_PUT, 20000, time.Now()))
+		require.NoError(t, db.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_GET, 6000, time.Now()))
+		require.NoError(t, limiter.CheckEgress(ctx))
+		require.False(t, limiter.EgressBudgetSpent())
+
+		require.NoError(t, db.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_GET_AUDIT, 2000, time.Now()))
+		require.NoError(t, db.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_GET_REPAIR, 2000, time.Now()))
+		require.NoError(t, limiter.CheckEgress(ctx))
+		require.True(t, limiter.EgressBudgetSpent())
+
+		require.Equal(t, bandwidth.Limits{
+			MonthlyEgress:     10000,
+			EgressUsed:        10000,
+			EgressBudgetSpent: true,
+		}, limiter.Limits())
+
+		// the budget is available again in the next month.
+		nextMonth := time.Now().AddDate(0, 1, 0)
+		limiter.SetNow(func() time.Time { return nextMonth })
+		require.NoError(t, limiter.CheckEgress(ctx))
+		require.False(t, limiter.EgressBudgetSpent())
+	})
+}
+
+func TestLimiterScheduleChange(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var config bandwidth.LimiterConfig
+	require.NoError(t, config.Schedules.Set("00:00-12:00=1KB/1KB"))
+
+	limiter := bandwidth.NewLimiter(zaptest.NewLogger(t), nil, config)
+
+	afternoon := time.Date(2021, 8, 1, 15, 0, 0, 0, time.Local)
+	limiter.SetNow(func() time.Time { return afternoon })
+	require.NoError(t, limiter.WaitEgress(ctx, int(memory.GB)))
+
+	// switching from unlimited to limited and back doesn't block the waiters.
+	morning := time.Date(2021, 8, 1, 9, 0, 0, 0, time.Local)
+	limiter.SetNow(func() time.Time { return morning })
+	require.NoError(t, limiter.WaitEgress(ctx, 500))
+	require.Equal(t, int64(1000), limiter.Limits().EgressRate)
+
+	limiter.SetNow(func() time.Time { return afternoon })
+	require.NoError(t, limiter.WaitEgress(ctx, int(memory.GB)))
+	require.Equal(t, int64(0), limiter.Limits().EgressRate)
+}
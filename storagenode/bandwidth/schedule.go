@@ -0,0 +1,171 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bandwidth
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+)
+
+// ErrSchedule is an error class for bandwidth schedule related errors.
+var ErrSchedule = errs.Class("bandwidth schedule")
+
+// Schedule limits the ingress and egress rate during a time of the day.
+type Schedule struct {
+	// From and To are the durations since the midnight of the local time of the node,
+	// To before From means the schedule ends the next day.
+	From time.Duration
+	To   time.Duration
+	// Ingress and Egress are the rates in bytes per second, zero means unlimited.
+	Ingress memory.Size
+	Egress  memory.Size
+}
+
+// Contains returns whether the time of the day of t is within the schedule.
+func (schedule Schedule) Contains(t time.Time) bool {
+	hour, minute, second := t.Clock()
+	sinceMidnight := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second
+
+	if schedule.From <= schedule.To {
+		return schedule.From <= sinceMidnight && sinceMidnight < schedule.To
+	}
+	return schedule.From <= sinceMidnight || sinceMidnight < schedule.To
+}
+
+// String returns a string representation of the schedule.
+func (schedule Schedule) String() string {
+	return fmt.Sprintf("%s-%s=%s/%s",
+		formatTimeOfDay(schedule.From), formatTimeOfDay(schedule.To),
+		formatRate(schedule.Ingress), formatRate(schedule.Egress))
+}
+
+// Schedules is a list of bandwidth schedules that implements pflag.Value.
+//
+// Every entry has the form <from>-<to>=<ingress rate>/<egress rate>, e.g. 08:00-23:00=2MB/5MB,
+// where a zero rate is unlimited. The first schedule containing the current time is used.
+type Schedules struct {
+	List []Schedule
+}
+
+// String returns the string representation of the config.
+func (schedules *Schedules) String() string {
+	s := make([]string, 0, len(schedules.List))
+	for _, schedule := range schedules.List {
+		s = append(s, schedule.String())
+	}
+	return strings.Join(s, ",")
+}
+
+// Set implements pflag.Value by parsing a comma separated list of schedules.
+func (schedules *Schedules) Set(value string) error {
+	var entries []string
+	if value != "" {
+		entries = strings.Split(value, ",")
+	}
+
+	var list []Schedule
+	for _, entry := range entries {
+		schedule, err := parseSchedule(strings.TrimSpace(entry))
+		if err != nil {
+			return ErrSchedule.New("invalid schedule %q: %v", entry, errs.Unwrap(err))
+		}
+		list = append(list, schedule)
+	}
+
+	schedules.List = list
+	return nil
+}
+
+// Type returns the type of the pflag.Value.
+func (schedules Schedules) Type() string {
+	return "bandwidth-schedules"
+}
+
+// Active returns the first schedule containing the time t.
+func (schedules Schedules) Active(t time.Time) (Schedule, bool) {
+	for _, schedule := range schedules.List {
+		if schedule.Contains(t) {
+			return schedule, true
+		}
+	}
+	return Schedule{}, false
+}
+
+// parseSchedule parses the schedule from the form <from>-<to>=<ingress rate>/<egress rate>.
+func parseSchedule(entry string) (schedule Schedule, err error) {
+	parts := strings.SplitN(entry, "=", 2)
+	if len(parts) != 2 {
+		return Schedule{}, ErrSchedule.New("expected <from>-<to>=<ingress rate>/<egress rate>")
+	}
+
+	times := strings.SplitN(parts[0], "-", 2)
+	rates := strings.SplitN(parts[1], "/", 2)
+	if len(times) != 2 || len(rates) != 2 {
+		return Schedule{}, ErrSchedule.New("expected <from>-<to>=<ingress rate>/<egress rate>")
+	}
+
+	if schedule.From, err = parseTimeOfDay(times[0]); err != nil {
+		return Schedule{}, err
+	}
+	if schedule.To, err = parseTimeOfDay(times[1]); err != nil {
+		return Schedule{}, err
+	}
+	if schedule.From == schedule.To {
+		return Schedule{}, ErrSchedule.New("schedule must not be empty")
+	}
+
+	if schedule.Ingress, err = parseRate(rates[0]); err != nil {
+		return Schedule{}, err
+	}
+	if schedule.Egress, err = parseRate(rates[1]); err != nil {
+		return Schedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// parseTimeOfDay parses the time of the day in the form HH:MM.
+func parseTimeOfDay(value string) (time.Duration, error) {
+	if value == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, ErrSchedule.New("invalid time of day %q", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// formatTimeOfDay formats the duration since midnight in the form HH:MM.
+func formatTimeOfDay(sinceMidnight time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(sinceMidnight/time.Hour), int(sinceMidnight%time.Hour/time.Minute))
+}
+
+// parseRate parses the rate in bytes per second.
+func parseRate(value string) (memory.Size, error) {
+	// memory.Size.Set doesn't handle values without any digits.
+	if strings.IndexFunc(value, unicode.IsDigit) < 0 {
+		return 0, ErrSchedule.New("invalid rate %q", value)
+	}
+
+	var rate memory.Size
+	if err := rate.Set(value); err != nil {
+		return 0, ErrSchedule.New("invalid rate %q: %v", value, err)
+	}
+	if rate < 0 {
+		return 0, ErrSchedule.New("rate must not be negative")
+	}
+	return rate, nil
+}
+
+// formatRate formats the rate, so it can be parsed back.
+func formatRate(rate memory.Size) string {
+	return fmt.Sprintf("%dB", rate.Int64())
+}
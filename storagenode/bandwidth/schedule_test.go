@@ -0,0 +1,81 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bandwidth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/storj/storagenode/bandwidth"
+)
+
+func TestSchedulesConfig(t *testing.T) {
+	var schedules bandwidth.Schedules
+	assert.Equal(t, "bandwidth-schedules", schedules.Type())
+	assert.Equal(t, "", schedules.String())
+
+	// Assert that comma separated schedules can be set
+	require.NoError(t, schedules.Set("08:00-23:00=2MB/5MB, 23:00-08:00=0/10MB"))
+	if assert.Len(t, schedules.List, 2) {
+		assert.Equal(t, bandwidth.Schedule{
+			From: 8 * time.Hour, To: 23 * time.Hour,
+			Ingress: 2 * memory.MB, Egress: 5 * memory.MB,
+		}, schedules.List[0])
+		assert.Equal(t, bandwidth.Schedule{
+			From: 23 * time.Hour, To: 8 * time.Hour,
+			Ingress: 0, Egress: 10 * memory.MB,
+		}, schedules.List[1])
+	}
+
+	// Assert that the string representation can be set again
+	var reparsed bandwidth.Schedules
+	require.NoError(t, reparsed.Set(schedules.String()))
+	assert.Equal(t, schedules, reparsed)
+
+	// Assert that a failure to set does not modify the current schedules
+	for _, invalid := range []string{
+		"08:00-23:00",
+		"08:00=2MB/5MB",
+		"08:00-23:00=2MB",
+		"8-23=2MB/5MB",
+		"08:00-25:00=2MB/5MB",
+		"08:00-08:00=2MB/5MB",
+		"08:00-23:00=fast/5MB",
+		"08:00-23:00=-2MB/5MB",
+	} {
+		require.Error(t, schedules.Set(invalid), invalid)
+	}
+	assert.Len(t, schedules.List, 2)
+}
+
+func TestSchedulesActive(t *testing.T) {
+	var schedules bandwidth.Schedules
+	require.NoError(t, schedules.Set("08:00-23:00=2MB/5MB,22:00-24:00=1MB/1MB,23:30-01:00=3MB/3MB"))
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2021, 8, 1, hour, minute, 0, 0, time.Local)
+	}
+
+	for _, tt := range []struct {
+		at     time.Time
+		active bool
+		egress memory.Size
+	}{
+		{at(7, 59), false, 0},
+		{at(8, 0), true, 5 * memory.MB},
+		{at(22, 30), true, 5 * memory.MB},
+		{at(23, 0), true, 1 * memory.MB},
+		{at(23, 45), true, 1 * memory.MB},
+		{at(0, 30), true, 3 * memory.MB},
+		{at(1, 0), false, 0},
+	} {
+		schedule, ok := schedules.Active(tt.at)
+		assert.Equal(t, tt.active, ok, tt.at)
+		assert.Equal(t, tt.egress, schedule.Egress, tt.at)
+	}
+}
@@ -19,6 +19,8 @@ var mon = monkit.Package()
 // Config defines parameters for storage node Collector.
 type Config struct {
 	Interval time.Duration `help:"how frequently bandwidth usage rollups are calculated" default:"1h0m0s"`
+
+	LimiterConfig
 }
 
 // Service implements the bandwidth usage rollup service.
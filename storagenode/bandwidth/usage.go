@@ -126,7 +126,11 @@ func TotalMonthlySummary(ctx context.Context, db DB) (*Usage, error) {
 }
 
 func getBeginningOfMonth() time.Time {
-	t := time.Now()
+	return beginningOfMonth(time.Now())
+}
+
+// beginningOfMonth returns the beginning of the month of t in its location.
+func beginningOfMonth(t time.Time) time.Time {
 	y, m, _ := t.Date()
-	return time.Date(y, m, 1, 0, 0, 0, 0, time.Now().Location())
+	return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
 }
@@ -3,8 +3,11 @@
 
 package console
 
+import "storj.io/storj/storagenode/bandwidth"
+
 // BandwidthInfo stores all info about storage node bandwidth usage.
 type BandwidthInfo struct {
-	Used      int64 `json:"used"`
-	Available int64 `json:"available"`
+	Used      int64            `json:"used"`
+	Available int64            `json:"available"`
+	Limits    bandwidth.Limits `json:"limits"`
 }
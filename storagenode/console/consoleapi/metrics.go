@@ -0,0 +1,52 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/metrics"
+)
+
+// ErrMetricsAPI - console metrics api error type.
+var ErrMetricsAPI = errs.Class("consoleapi metrics")
+
+// Metrics is an api controller that exposes the node metrics in OpenMetrics format.
+type Metrics struct {
+	service *metrics.Service
+
+	log *zap.Logger
+}
+
+// NewMetrics is a constructor for metrics controller.
+func NewMetrics(log *zap.Logger, service *metrics.Service) *Metrics {
+	return &Metrics{
+		log:     log,
+		service: service,
+	}
+}
+
+// Metrics handles the scrapes of the node metrics.
+func (controller *Metrics) Metrics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	families, err := controller.service.Collect(ctx)
+	if err != nil {
+		controller.log.Error("failed to collect metrics", zap.Error(ErrMetricsAPI.Wrap(err)))
+		http.Error(w, ErrMetricsAPI.Wrap(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(contentType, metrics.ContentType)
+
+	if err = metrics.WriteFamilies(w, families); err != nil {
+		controller.log.Error("failed to write metrics response", zap.Error(ErrMetricsAPI.Wrap(err)))
+		return
+	}
+}
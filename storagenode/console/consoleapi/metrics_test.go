@@ -0,0 +1,67 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/metrics"
+)
+
+func TestMetricsApi(t *testing.T) {
+	testplanet.Run(t,
+		testplanet.Config{
+			SatelliteCount:   1,
+			StorageNodeCount: 2,
+			Reconfigure: testplanet.Reconfigure{
+				StorageNode: func(index int, config *storagenode.Config) {
+					config.Metrics.Enabled = index == 0
+				},
+			},
+		},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			satellite := planet.Satellites[0]
+
+			t.Run("enabled", func(t *testing.T) {
+				sno := planet.StorageNodes[0]
+				url := fmt.Sprintf("http://%s/metrics", sno.Console.Listener.Addr())
+
+				res, err := httpGet(ctx, url)
+				require.NoError(t, err)
+				defer ctx.Check(res.Body.Close)
+
+				require.Equal(t, http.StatusOK, res.StatusCode)
+				require.Equal(t, metrics.ContentType, res.Header.Get("Content-Type"))
+
+				body, err := ioutil.ReadAll(res.Body)
+				require.NoError(t, err)
+
+				require.Contains(t, string(body), "# TYPE storagenode_disk_used_bytes gauge\n")
+				require.Contains(t, string(body), fmt.Sprintf("storagenode_disk_used_bytes{satellite=%q} ", satellite.ID().String()))
+				require.Contains(t, string(body), "storagenode_uploads_total{result=\"success\"} ")
+				require.True(t, strings.HasSuffix(string(body), "# EOF\n"))
+			})
+
+			t.Run("disabled", func(t *testing.T) {
+				sno := planet.StorageNodes[1]
+				url := fmt.Sprintf("http://%s/metrics", sno.Console.Listener.Addr())
+
+				res, err := httpGet(ctx, url)
+				require.NoError(t, err)
+				defer ctx.Check(res.Body.Close)
+
+				require.NotEqual(t, metrics.ContentType, res.Header.Get("Content-Type"))
+			})
+		},
+	)
+}
@@ -19,6 +19,7 @@ import (
 	"storj.io/common/errs2"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleapi"
+	"storj.io/storj/storagenode/metrics"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/payouts"
 )
@@ -44,19 +45,22 @@ type Server struct {
 	service       *console.Service
 	notifications *notifications.Service
 	payout        *payouts.Service
+	metrics       *metrics.Service
 	listener      net.Listener
 
 	server http.Server
 }
 
 // NewServer creates new instance of storagenode console web server.
-func NewServer(logger *zap.Logger, assets http.FileSystem, notifications *notifications.Service, service *console.Service, payout *payouts.Service, listener net.Listener) *Server {
+// The /metrics endpoint is served only when metrics is not nil.
+func NewServer(logger *zap.Logger, assets http.FileSystem, notifications *notifications.Service, service *console.Service, payout *payouts.Service, metrics *metrics.Service, listener net.Listener) *Server {
 	server := Server{
 		log:           logger,
 		service:       service,
 		listener:      listener,
 		notifications: notifications,
 		payout:        payout,
+		metrics:       metrics,
 	}
 
 	router := mux.NewRouter()
@@ -86,6 +90,11 @@ func NewServer(logger *zap.Logger, assets http.FileSystem, notifications *notifi
 	payoutRouter.HandleFunc("/periods", payoutController.HeldAmountPeriods).Methods(http.MethodGet)
 	payoutRouter.HandleFunc("/payout-history/{period}", payoutController.PayoutHistory).Methods(http.MethodGet)
 
+	if server.metrics != nil {
+		metricsController := consoleapi.NewMetrics(server.log, server.metrics)
+		router.HandleFunc("/metrics", metricsController.Metrics).Methods(http.MethodGet)
+	}
+
 	if assets != nil {
 		fs := http.FileServer(assets)
 		router.PathPrefix("/static/").Handler(server.cacheMiddleware(http.StripPrefix("/static", fs)))
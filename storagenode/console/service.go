@@ -44,6 +44,7 @@ type Service struct {
 	trust          *trust.Pool
 	usageCache     *pieces.BlobsUsageCache
 	bandwidthDB    bandwidth.DB
+	limiter        *bandwidth.Limiter
 	reputationDB   reputation.DB
 	storageUsageDB storageusage.DB
 	pricingDB      pricing.DB
@@ -67,7 +68,7 @@ type Service struct {
 func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Store, version *checker.Service,
 	allocatedDiskSpace memory.Size, walletAddress string, versionInfo version.Info, trust *trust.Pool,
 	reputationDB reputation.DB, storageUsageDB storageusage.DB, pricingDB pricing.DB, satelliteDB satellites.DB,
-	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service, usageCache *pieces.BlobsUsageCache, walletFeatures operator.WalletFeatures) (*Service, error) {
+	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service, usageCache *pieces.BlobsUsageCache, walletFeatures operator.WalletFeatures, limiter *bandwidth.Limiter) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -80,6 +81,10 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 		return nil, errs.New("bandwidth can't be nil")
 	}
 
+	if limiter == nil {
+		return nil, errs.New("bandwidth limiter can't be nil")
+	}
+
 	if pieceStore == nil {
 		return nil, errs.New("pieceStore can't be nil")
 	}
@@ -105,6 +110,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 		trust:              trust,
 		usageCache:         usageCache,
 		bandwidthDB:        bandwidth,
+		limiter:            limiter,
 		reputationDB:       reputationDB,
 		storageUsageDB:     storageUsageDB,
 		pricingDB:          pricingDB,
@@ -222,7 +228,8 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 	}
 
 	data.Bandwidth = BandwidthInfo{
-		Used: bandwidthUsage,
+		Used:   bandwidthUsage,
+		Limits: s.limiter.Limits(),
 	}
 
 	return data, nil
@@ -5,6 +5,7 @@ package contact
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -39,6 +41,19 @@ type Config struct {
 	Interval time.Duration `help:"how frequently the node contact chore should run" releaseDefault:"1h" devDefault:"30s"`
 }
 
+// CheckInResult is the result of a check-in with a satellite. The satellite
+// pings the external address of the node back during the check-in, so the
+// result shows whether the satellite is able to reach the node.
+type CheckInResult struct {
+	SatelliteID storj.NodeID
+	CheckedAt   time.Time
+	// Error is set when the check-in failed before the satellite pinged the node back.
+	Error string
+	// PingNodeSuccess is set when the satellite was able to ping the node back.
+	PingNodeSuccess  bool
+	PingErrorMessage string
+}
+
 // NodeInfo contains information necessary for introducing storagenode to satellite.
 type NodeInfo struct {
 	ID       storj.NodeID
@@ -55,19 +70,30 @@ type Service struct {
 
 	mu   sync.Mutex
 	self NodeInfo
+	// satelliteCapacities overrides the capacity reported to satellites with an upload policy.
+	satelliteCapacities map[storj.NodeID]pb.NodeCapacity
+
+	// offline contains the satellites the operator was notified about failing check-ins.
+	offline map[storj.NodeID]bool
+	// checkIns contains the result of the last check-in with each satellite.
+	checkIns map[storj.NodeID]CheckInResult
 
-	trust *trust.Pool
+	trust         *trust.Pool
+	notifications *notifications.Service
 
 	initialized sync2.Fence
 }
 
 // NewService creates a new contact service.
-func NewService(log *zap.Logger, dialer rpc.Dialer, self NodeInfo, trust *trust.Pool) *Service {
+func NewService(log *zap.Logger, dialer rpc.Dialer, self NodeInfo, trust *trust.Pool, notifications *notifications.Service) *Service {
 	return &Service{
-		log:    log,
-		dialer: dialer,
-		trust:  trust,
-		self:   self,
+		log:           log,
+		dialer:        dialer,
+		trust:         trust,
+		notifications: notifications,
+		self:          self,
+		offline:       make(map[storj.NodeID]bool),
+		checkIns:      make(map[storj.NodeID]CheckInResult),
 	}
 }
 
@@ -95,6 +121,7 @@ func (service *Service) pingSatellite(ctx context.Context, satellite storj.NodeI
 		err := service.pingSatelliteOnce(ctx, satellite)
 		attempts++
 		if err == nil {
+			service.setOnline(satellite)
 			return nil
 		}
 		service.log.Error("ping satellite failed ", zap.Stringer("Satellite ID", satellite), zap.Int("attempts", attempts), zap.Error(err))
@@ -107,12 +134,54 @@ func (service *Service) pingSatellite(ctx context.Context, satellite storj.NodeI
 		interval *= 2
 		if interval >= maxInterval {
 			service.log.Info("retries timed out for this cycle", zap.Stringer("Satellite ID", satellite))
+			service.notifyOffline(ctx, satellite, err)
 			return nil
 		}
 	}
 
 }
 
+// setOnline marks that the node checked in with the satellite.
+func (service *Service) setOnline(satellite storj.NodeID) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	delete(service.offline, satellite)
+}
+
+// notifyOffline notifies the operator once that the node fails to check in with the
+// satellite, the notification is sent again after a successful check-in.
+func (service *Service) notifyOffline(ctx context.Context, satellite storj.NodeID, checkInErr error) {
+	if service.notifications == nil {
+		return
+	}
+
+	service.mu.Lock()
+	notified := service.offline[satellite]
+	service.offline[satellite] = true
+	service.mu.Unlock()
+
+	if notified {
+		return
+	}
+
+	_, err := service.notifications.Receive(ctx, notifications.NewNotification{
+		SenderID: satellite,
+		Type:     notifications.TypeOffline,
+		Title:    "Your Node failed to contact a satellite",
+		Message:  "Your Node can't check in with the satellite " + satellite.String() + ", the satellite considers it offline until it's reachable again: " + checkInErr.Error(),
+	})
+	if err != nil {
+		service.log.Error("failed to create offline notification", zap.Stringer("Satellite ID", satellite), zap.Error(err))
+	}
+}
+
+// CheckIn checks in with the satellite once. The satellite pings the node back,
+// so an error means that either the satellite or the node isn't reachable.
+func (service *Service) CheckIn(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx, id)(&err)
+	return service.pingSatelliteOnce(ctx, id)
+}
+
 func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID) (err error) {
 	defer mon.Task()(&ctx, id)(&err)
 
@@ -123,17 +192,19 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 
 	conn, err := service.dialer.DialNodeURL(ctx, nodeurl)
 	if err != nil {
+		service.setCheckIn(id, nil, err)
 		return errPingSatellite.Wrap(err)
 	}
 	defer func() { err = errs.Combine(err, conn.Close()) }()
 
-	self := service.Local()
+	self := service.LocalFor(id)
 	resp, err := pb.NewDRPCNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
 		Address:  self.Address,
 		Version:  &self.Version,
 		Capacity: &self.Capacity,
 		Operator: &self.Operator,
 	})
+	service.setCheckIn(id, resp, err)
 	if err != nil {
 		return errPingSatellite.Wrap(err)
 	}
@@ -146,6 +217,39 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	return nil
 }
 
+// setCheckIn stores the result of the check-in with the satellite.
+func (service *Service) setCheckIn(satellite storj.NodeID, resp *pb.CheckInResponse, checkInErr error) {
+	result := CheckInResult{
+		SatelliteID:      satellite,
+		CheckedAt:        time.Now(),
+		PingNodeSuccess:  resp.GetPingNodeSuccess(),
+		PingErrorMessage: resp.GetPingErrorMessage(),
+	}
+	if checkInErr != nil {
+		result.Error = checkInErr.Error()
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.checkIns[satellite] = result
+}
+
+// CheckIns returns the result of the last check-in with each satellite, which
+// the node checked in with since it started.
+func (service *Service) CheckIns() []CheckInResult {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	results := make([]CheckInResult, 0, len(service.checkIns))
+	for _, result := range service.checkIns {
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, k int) bool {
+		return results[i].SatelliteID.Less(results[k].SatelliteID)
+	})
+	return results
+}
+
 // Local returns the storagenode info.
 func (service *Service) Local() NodeInfo {
 	service.mu.Lock()
@@ -153,6 +257,24 @@ func (service *Service) Local() NodeInfo {
 	return service.self
 }
 
+// LocalFor returns the storagenode info reported to the satellite.
+func (service *Service) LocalFor(satelliteID storj.NodeID) NodeInfo {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	self := service.self
+	if capacity, ok := service.satelliteCapacities[satelliteID]; ok {
+		self.Capacity = capacity
+	}
+	return self
+}
+
+// UpdateSatelliteCapacities replaces the capacities reported to the satellites with an upload policy.
+func (service *Service) UpdateSatelliteCapacities(capacities map[storj.NodeID]pb.NodeCapacity) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.satelliteCapacities = capacities
+}
+
 // UpdateSelf updates the local node with the capacity.
 func (service *Service) UpdateSelf(capacity *pb.NodeCapacity) {
 	service.mu.Lock()
@@ -0,0 +1,81 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diagnostics
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pkcrypto"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// sampleWalkFactor limits the pieces walked for a sample to about a multiple of the sample size,
+// so that sampling doesn't take hours on nodes with millions of pieces.
+const sampleWalkFactor = 100
+
+// samplePieces returns a random sample of at most n pieces of the satellite.
+//
+// The pieces are walked by random piece ID prefixes. Piece IDs are random, so every prefix
+// holds a random subset of the pieces and the sample isn't biased toward the pieces walked
+// first. V0 pieces aren't sampled.
+func samplePieces(ctx context.Context, store *pieces.Store, satellite storj.NodeID, n int) (_ []storj.PieceID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var sample []storj.PieceID
+	var walked int
+	for _, prefix := range rand.Perm(256) {
+		if walked >= n*sampleWalkFactor {
+			break
+		}
+		err := store.WalkSatellitePiecesWithPrefix(ctx, satellite, byte(prefix), func(access pieces.StoredPieceAccess) error {
+			// reservoir sampling keeps every walked piece in the sample with the same probability.
+			if len(sample) < n {
+				sample = append(sample, access.PieceID())
+			} else if k := rand.Intn(walked + 1); k < n {
+				sample[k] = access.PieceID()
+			}
+			walked++
+			return nil
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+	return sample, nil
+}
+
+// verifyPiece reads the whole piece and compares its hash with the hash signed by the uplink.
+func verifyPiece(ctx context.Context, store *pieces.Store, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := store.Reader(ctx, satellite, pieceID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	pieceHash, _, err := store.GetHashAndLimit(ctx, satellite, pieceID, reader)
+	if err != nil {
+		return Error.New("unable to read the piece header: %v", err)
+	}
+
+	hash := pkcrypto.NewHash()
+	size, err := io.Copy(hash, reader)
+	if err != nil {
+		return Error.New("unable to read the piece: %v", err)
+	}
+	if size != reader.Size() {
+		return Error.New("read %d bytes of %d", size, reader.Size())
+	}
+	if !bytes.Equal(hash.Sum(nil), pieceHash.Hash) {
+		return Error.New("hash mismatch, the piece is corrupted")
+	}
+	return nil
+}
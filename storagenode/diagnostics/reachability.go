@@ -0,0 +1,81 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diagnostics
+
+import (
+	"bytes"
+	"crypto/tls"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/drpc/drpcmigrate"
+)
+
+// handshakeTimeout limits the time of a handshake, so that a stalled connection doesn't block the others.
+const handshakeTimeout = 10 * time.Second
+
+// handshakeServer answers the TLS handshakes with the identity of the node, while the node isn't running.
+type handshakeServer struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	wg        sync.WaitGroup
+}
+
+// serveHandshakes listens on the address and answers the TLS handshakes of the connections.
+func serveHandshakes(address string, tlsOptions *tlsopts.Options) (*handshakeServer, error) {
+	if tlsOptions == nil {
+		return nil, Error.New("tls options not set")
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	server := &handshakeServer{
+		listener:  listener,
+		tlsConfig: tlsOptions.ServerTLSConfig(),
+	}
+	server.wg.Add(1)
+	go func() {
+		defer server.wg.Done()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			server.handshake(conn)
+		}
+	}()
+	return server, nil
+}
+
+// handshake answers the TLS handshake of the connection and closes it.
+func (server *handshakeServer) handshake(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return
+	}
+
+	// the connections of the node start with the drpc header, like the ones of the node server.
+	header := make([]byte, len(drpcmigrate.DRPCHeader))
+	if _, err := io.ReadFull(conn, header); err != nil || !bytes.Equal(header, []byte(drpcmigrate.DRPCHeader)) {
+		return
+	}
+
+	_ = tls.Server(conn, server.tlsConfig).Handshake()
+}
+
+// Close stops listening.
+func (server *handshakeServer) Close() error {
+	err := server.listener.Close()
+	server.wg.Wait()
+	return errs.Wrap(err)
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diagnostics
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/identity"
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/internalpb"
+)
+
+type runningNode struct {
+	checkIns []*internalpb.SatelliteCheckIn
+}
+
+func (node runningNode) Dashboard(ctx context.Context, in *internalpb.DashboardRequest) (*internalpb.DashboardResponse, error) {
+	return &internalpb.DashboardResponse{CheckIns: node.checkIns}, nil
+}
+
+func TestCheckReachability(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	node := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	other := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+	satelliteID := testrand.NodeID()
+
+	newService := func(address string, inspector Inspector) *Service {
+		return &Service{
+			log:       zaptest.NewLogger(t),
+			identity:  node,
+			addresses: Addresses{Server: address, External: address},
+			dialer:    rpc.NewDefaultDialer(tlsOptions(t, node)),
+			inspector: inspector,
+		}
+	}
+	pingedBack := runningNode{checkIns: []*internalpb.SatelliteCheckIn{{
+		SatelliteId:     satelliteID,
+		CheckedAt:       time.Now(),
+		PingNodeSuccess: true,
+	}}}
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		check := newService("", nil).checkReachability(ctx)
+		require.Equal(t, StatusSkipped, check.Status, check.Details)
+	})
+
+	t.Run("NodeNotRunning", func(t *testing.T) {
+		check := newService(freeAddress(t), nil).checkReachability(ctx)
+		require.Equal(t, StatusSkipped, check.Status, check.Details)
+		require.Contains(t, check.Details[1], "answers with the identity of the node")
+	})
+
+	t.Run("PingedBack", func(t *testing.T) {
+		server, err := serveHandshakes("127.0.0.1:0", tlsOptions(t, node))
+		require.NoError(t, err)
+		defer ctx.Check(server.Close)
+
+		check := newService(server.listener.Addr().String(), pingedBack).checkReachability(ctx)
+		require.Equal(t, StatusOK, check.Status, check.Details)
+	})
+
+	t.Run("NoCheckIns", func(t *testing.T) {
+		check := newService(freeAddress(t), runningNode{}).checkReachability(ctx)
+		require.Equal(t, StatusWarning, check.Status, check.Details)
+	})
+
+	t.Run("CheckInFailed", func(t *testing.T) {
+		check := newService(freeAddress(t), runningNode{checkIns: []*internalpb.SatelliteCheckIn{{
+			SatelliteId: satelliteID,
+			CheckedAt:   time.Now(),
+			Error:       "connection refused",
+		}}}).checkReachability(ctx)
+		require.Equal(t, StatusWarning, check.Status, check.Details)
+	})
+
+	t.Run("PingBackFailed", func(t *testing.T) {
+		check := newService(freeAddress(t), runningNode{checkIns: []*internalpb.SatelliteCheckIn{{
+			SatelliteId:      satelliteID,
+			CheckedAt:        time.Now(),
+			PingErrorMessage: "failed to dial storage node",
+		}}}).checkReachability(ctx)
+		require.Equal(t, StatusFailed, check.Status, check.Details)
+	})
+
+	// the external address dialed from the network of the node doesn't change the status.
+	t.Run("OtherNode", func(t *testing.T) {
+		server, err := serveHandshakes("127.0.0.1:0", tlsOptions(t, other))
+		require.NoError(t, err)
+		defer ctx.Check(server.Close)
+
+		check := newService(server.listener.Addr().String(), pingedBack).checkReachability(ctx)
+		require.Equal(t, StatusOK, check.Status, check.Details)
+		require.Contains(t, check.Details[1], "doesn't answer with the identity of the node")
+	})
+
+	t.Run("LocallyUnreachable", func(t *testing.T) {
+		check := newService(freeAddress(t), pingedBack).checkReachability(ctx)
+		require.Equal(t, StatusOK, check.Status, check.Details)
+		require.Contains(t, check.Details[1], "doesn't answer with the identity of the node")
+	})
+}
+
+func tlsOptions(t *testing.T, ident *identity.FullIdentity) *tlsopts.Options {
+	options, err := tlsopts.NewOptions(ident, tlsopts.Config{PeerIDVersions: "latest"}, nil)
+	require.NoError(t, err)
+	return options
+}
+
+// freeAddress returns a local address, which nothing listens on.
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return address
+}
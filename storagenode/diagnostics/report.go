@@ -0,0 +1,177 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diagnostics
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// Status is the outcome of a check.
+type Status string
+
+const (
+	// StatusOK means that the check didn't find any problems.
+	StatusOK Status = "ok"
+	// StatusWarning means that the check found a problem, which doesn't prevent the node from working.
+	StatusWarning Status = "warning"
+	// StatusFailed means that the check found a problem, which needs to be fixed.
+	StatusFailed Status = "failed"
+	// StatusSkipped means that the check couldn't be run.
+	StatusSkipped Status = "skipped"
+)
+
+// severity orders the statuses, so that the worst status of the checks can be reported.
+func (status Status) severity() int {
+	switch status {
+	case StatusFailed:
+		return 3
+	case StatusWarning:
+		return 2
+	case StatusSkipped:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Check is the outcome of a single check of the node.
+type Check struct {
+	Name    string   `json:"name"`
+	Status  Status   `json:"status"`
+	Details []string `json:"details,omitempty"`
+}
+
+// add appends the detail to the check and raises the status of the check.
+func (check *Check) add(status Status, format string, args ...interface{}) {
+	if status.severity() > check.Status.severity() {
+		check.Status = status
+	}
+	check.Details = append(check.Details, fmt.Sprintf(format, args...))
+}
+
+// Report contains the outcome of all checks of the node.
+type Report struct {
+	NodeID    storj.NodeID `json:"nodeID"`
+	Version   string       `json:"version"`
+	CreatedAt time.Time    `json:"createdAt"`
+	Checks    []Check      `json:"checks"`
+}
+
+// Status returns the worst status of the checks.
+func (report *Report) Status() Status {
+	status := StatusOK
+	for _, check := range report.Checks {
+		if check.Status.severity() > status.severity() {
+			status = check.Status
+		}
+	}
+	return status
+}
+
+// Redact replaces the sensitive values in the details of the checks.
+func (report *Report) Redact(redactor *Redactor) {
+	for i := range report.Checks {
+		for k, detail := range report.Checks[i].Details {
+			report.Checks[i].Details[k] = redactor.Redact(detail)
+		}
+	}
+}
+
+// WriteText writes a human readable form of the report.
+func (report *Report) WriteText(w io.Writer) error {
+	var group errs.Group
+	write := func(format string, args ...interface{}) {
+		_, err := fmt.Fprintf(w, format, args...)
+		group.Add(err)
+	}
+
+	write("Node ID:  %s\n", report.NodeID)
+	write("Version:  %s\n", report.Version)
+	write("Created:  %s\n", report.CreatedAt.Format(time.RFC3339))
+	write("Status:   %s\n", report.Status())
+
+	for _, check := range report.Checks {
+		write("\n[%s] %s\n", strings.ToUpper(string(check.Status)), check.Name)
+		for _, detail := range check.Details {
+			write("    %s\n", detail)
+		}
+	}
+
+	return group.Err()
+}
+
+// WriteBundle writes the report as a zip archive, which can be attached to a support ticket.
+func (report *Report) WriteBundle(w io.Writer) (err error) {
+	archive := zip.NewWriter(w)
+	defer func() { err = errs.Combine(err, archive.Close()) }()
+
+	create := func(name string) (io.Writer, error) {
+		return archive.CreateHeader(&zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: report.CreatedAt,
+		})
+	}
+
+	text, err := create("report.txt")
+	if err != nil {
+		return err
+	}
+	if err := report.WriteText(text); err != nil {
+		return err
+	}
+
+	data, err := create("report.json")
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(data)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// Redactor replaces sensitive values, e.g. the wallet or the external address, with placeholders.
+type Redactor struct {
+	replacer *strings.Replacer
+}
+
+// NewRedactor creates a redactor, which replaces every value of the map with its placeholder.
+// Empty values are ignored.
+func NewRedactor(placeholders map[string]string) *Redactor {
+	values := make([]string, 0, len(placeholders))
+	for value := range placeholders {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	// replace longer values first, so that a value containing another value,
+	// e.g. the storage directory inside of the config directory, is replaced as a whole.
+	sort.Slice(values, func(i, k int) bool {
+		if len(values[i]) != len(values[k]) {
+			return len(values[i]) > len(values[k])
+		}
+		return values[i] < values[k]
+	})
+
+	oldnew := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		oldnew = append(oldnew, value, placeholders[value])
+	}
+	return &Redactor{replacer: strings.NewReplacer(oldnew...)}
+}
+
+// Redact replaces the sensitive values in s.
+func (redactor *Redactor) Redact(s string) string {
+	return redactor.replacer.Replace(s)
+}
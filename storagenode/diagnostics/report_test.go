@@ -0,0 +1,100 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diagnostics_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/diagnostics"
+)
+
+func TestReportStatus(t *testing.T) {
+	report := diagnostics.Report{}
+	assert.Equal(t, diagnostics.StatusOK, report.Status())
+
+	report.Checks = append(report.Checks, diagnostics.Check{Name: "a", Status: diagnostics.StatusOK})
+	report.Checks = append(report.Checks, diagnostics.Check{Name: "b", Status: diagnostics.StatusSkipped})
+	assert.Equal(t, diagnostics.StatusSkipped, report.Status())
+
+	report.Checks = append(report.Checks, diagnostics.Check{Name: "c", Status: diagnostics.StatusWarning})
+	assert.Equal(t, diagnostics.StatusWarning, report.Status())
+
+	report.Checks = append(report.Checks, diagnostics.Check{Name: "d", Status: diagnostics.StatusFailed})
+	report.Checks = append(report.Checks, diagnostics.Check{Name: "e", Status: diagnostics.StatusWarning})
+	assert.Equal(t, diagnostics.StatusFailed, report.Status())
+}
+
+func TestReportRedact(t *testing.T) {
+	report := diagnostics.Report{
+		Checks: []diagnostics.Check{{
+			Name:   "storage directory",
+			Status: diagnostics.StatusFailed,
+			Details: []string{
+				"open /home/operator/storj/storage/storage-dir-verification: permission denied",
+				"node at node.example.test:28967 of operator@example.test isn't reachable",
+			},
+		}},
+	}
+
+	report.Redact(diagnostics.NewRedactor(map[string]string{
+		"/home/operator/storj":         "<config-dir>",
+		"/home/operator/storj/storage": "<storage-dir>",
+		"node.example.test":            "<external-host>",
+		"operator@example.test":        "<email>",
+		"":                             "<empty>",
+	}))
+
+	assert.Equal(t, []string{
+		"open <storage-dir>/storage-dir-verification: permission denied",
+		"node at <external-host>:28967 of <email> isn't reachable",
+	}, report.Checks[0].Details)
+}
+
+func TestReportBundle(t *testing.T) {
+	report := diagnostics.Report{
+		NodeID:    testrand.NodeID(),
+		Version:   "v1.2.3",
+		CreatedAt: time.Date(2021, 8, 1, 12, 0, 0, 0, time.UTC),
+		Checks: []diagnostics.Check{
+			{Name: "identity", Status: diagnostics.StatusOK, Details: []string{"difficulty 36"}},
+			{Name: "clock", Status: diagnostics.StatusWarning, Details: []string{"clock is off by 11m0s"}},
+		},
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, report.WriteBundle(&buffer))
+
+	archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		files[file.Name], err = ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	}
+	require.Len(t, files, 2)
+
+	var decoded diagnostics.Report
+	require.NoError(t, json.Unmarshal(files["report.json"], &decoded))
+	assert.Equal(t, report, decoded)
+
+	text := string(files["report.txt"])
+	assert.True(t, strings.HasPrefix(text, "Node ID:  "+report.NodeID.String()+"\n"), text)
+	assert.Contains(t, text, "Status:   warning\n")
+	assert.Contains(t, text, "[OK] identity\n    difficulty 36\n")
+	assert.Contains(t, text, "[WARNING] clock\n    clock is off by 11m0s\n")
+}
@@ -0,0 +1,432 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package diagnostics implements the self-checks of the storage node.
+package diagnostics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/memory"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/private/version"
+	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/preflight"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the diagnostics service.
+	Error = errs.Class("diagnostics")
+)
+
+// contactWindow is the time after which the node is considered offline, when it
+// wasn't pinged by a satellite.
+const contactWindow = 2 * time.Hour
+
+// Config contains configuration of the self-checks.
+type Config struct {
+	PieceSamples int `help:"number of pieces per satellite, which are read and verified" default:"10"`
+}
+
+// DB contains the database checks of the node.
+type DB interface {
+	// CheckVersion checks that the version of the migration matches the state of the databases.
+	CheckVersion(ctx context.Context) error
+	// CheckSchema compares the schemas of the databases with the expected schema.
+	CheckSchema(ctx context.Context) error
+}
+
+// Addresses contains the addresses of the node.
+type Addresses struct {
+	// Server is the address the node listens on.
+	Server string
+	// External is the address the satellites dial.
+	External string
+}
+
+// Inspector returns the runtime state of the running node.
+type Inspector interface {
+	// Dashboard returns the dashboard data of the node.
+	Dashboard(ctx context.Context, in *internalpb.DashboardRequest) (*internalpb.DashboardResponse, error)
+}
+
+// Service runs the self-checks of the node.
+//
+// architecture: Service
+type Service struct {
+	log     *zap.Logger
+	config  Config
+	version version.Info
+
+	identity  *identity.FullIdentity
+	addresses Addresses
+	db        DB
+	store     *pieces.Store
+	monitor   *monitor.Service
+	localTime *preflight.LocalTime
+	dialer    rpc.Dialer
+	inspector Inspector
+	trust     *trust.Pool
+}
+
+// NewService creates a new diagnostics service. The inspector is nil when the node isn't running.
+func NewService(log *zap.Logger, config Config, versionInfo version.Info, identity *identity.FullIdentity, addresses Addresses, db DB, store *pieces.Store, monitor *monitor.Service, localTime *preflight.LocalTime, dialer rpc.Dialer, inspector Inspector, trust *trust.Pool) *Service {
+	return &Service{
+		log:       log,
+		config:    config,
+		version:   versionInfo,
+		identity:  identity,
+		addresses: addresses,
+		db:        db,
+		store:     store,
+		monitor:   monitor,
+		localTime: localTime,
+		dialer:    dialer,
+		inspector: inspector,
+		trust:     trust,
+	}
+}
+
+// Run runs all self-checks and returns the report.
+func (service *Service) Run(ctx context.Context) (_ Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report := Report{
+		NodeID:    service.identity.ID,
+		Version:   service.version.Version.String(),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	for _, run := range []func(context.Context) Check{
+		service.checkIdentity,
+		service.checkStorageDir,
+		service.checkDatabases,
+		service.checkDiskSpace,
+		service.checkClock,
+		service.checkReachability,
+		service.checkLastContact,
+		service.checkPieces,
+	} {
+		if err := ctx.Err(); err != nil {
+			return Report{}, err
+		}
+		check := run(ctx)
+		service.log.Debug("Check done", zap.String("Check", check.Name), zap.String("Status", string(check.Status)))
+		report.Checks = append(report.Checks, check)
+	}
+
+	return report, nil
+}
+
+// checkIdentity verifies the node ID, the private key and the signature chain of the identity.
+func (service *Service) checkIdentity(ctx context.Context) Check {
+	check := Check{Name: "identity", Status: StatusOK}
+	ident := service.identity
+
+	nodeID, err := identity.NodeIDFromCert(ident.CA)
+	switch {
+	case err != nil:
+		check.add(StatusFailed, "unable to get the node ID from the CA certificate: %v", err)
+	case nodeID != ident.ID:
+		check.add(StatusFailed, "node ID %s doesn't match the CA certificate of %s", ident.ID, nodeID)
+	default:
+		check.add(StatusOK, "node ID %s", ident.ID)
+	}
+
+	if difficulty, err := ident.ID.Difficulty(); err == nil {
+		check.add(StatusOK, "difficulty %d", difficulty)
+	}
+
+	publicKey, err := pkcrypto.PublicKeyFromPrivate(ident.Key)
+	switch {
+	case err != nil:
+		check.add(StatusFailed, "invalid private key: %v", err)
+	case !pkcrypto.PublicKeyEqual(publicKey, ident.Leaf.PublicKey):
+		check.add(StatusFailed, "private key doesn't match the leaf certificate")
+	}
+
+	// the validity period of the certificates isn't checked, because identities don't expire.
+	chain := ident.Chain()
+	for i := 0; i+1 < len(chain); i++ {
+		if err := chain[i].CheckSignatureFrom(chain[i+1]); err != nil {
+			check.add(StatusFailed, "certificate %d of the chain isn't signed by certificate %d: %v", i, i+1, err)
+		}
+	}
+
+	if len(ident.RestChain) == 0 {
+		check.add(StatusWarning, "identity isn't signed by a certificate authority, satellites reject unsigned identities")
+	} else {
+		check.add(StatusOK, "identity is signed by a certificate authority")
+	}
+
+	return check
+}
+
+// checkStorageDir verifies that the storage directory belongs to the node and is writable.
+func (service *Service) checkStorageDir(ctx context.Context) Check {
+	check := Check{Name: "storage directory", Status: StatusOK}
+
+	if err := service.store.VerifyStorageDir(service.identity.ID); err != nil {
+		check.add(StatusFailed, "storage directory verification failed: %v", err)
+	} else {
+		check.add(StatusOK, "storage directory belongs to the node")
+	}
+
+	if err := service.store.CheckWritability(); err != nil {
+		check.add(StatusFailed, "storage directory isn't writable: %v", err)
+	} else {
+		check.add(StatusOK, "storage directory is writable")
+	}
+
+	return check
+}
+
+// checkDatabases compares the databases with the expected schema.
+func (service *Service) checkDatabases(ctx context.Context) Check {
+	check := Check{Name: "databases", Status: StatusOK}
+
+	if err := service.db.CheckVersion(ctx); err != nil {
+		check.add(StatusFailed, "migration version doesn't match: %v", err)
+	}
+	if err := service.db.CheckSchema(ctx); err != nil {
+		check.add(StatusFailed, "%v", err)
+	}
+	if check.Status == StatusOK {
+		check.add(StatusOK, "databases match the expected schema")
+	}
+
+	return check
+}
+
+// checkDiskSpace reports the free disk space and the disk space allocated for the node.
+func (service *Service) checkDiskSpace(ctx context.Context) Check {
+	check := Check{Name: "disk space", Status: StatusOK}
+
+	space, err := service.monitor.DiskSpace(ctx)
+	if err != nil {
+		check.add(StatusFailed, "unable to get the disk space: %v", err)
+		return check
+	}
+
+	check.add(StatusOK, "allocated %s, used by pieces %s, used by trash %s, free on disk %s, available for uploads %s",
+		memory.Size(space.Allocated), memory.Size(space.UsedForPieces), memory.Size(space.UsedForTrash),
+		memory.Size(space.Free), memory.Size(space.Available))
+
+	if minimum := service.monitor.Config.MinimumDiskSpace; space.Allocated < minimum.Int64() {
+		check.add(StatusFailed, "allocated disk space is less than the required minimum %s", minimum)
+	}
+	if space.Overused > 0 {
+		check.add(StatusWarning, "pieces and trash use %s more than allocated", memory.Size(space.Overused))
+	}
+	if total := space.UsedForPieces + space.UsedForTrash + space.Free; total < space.Allocated {
+		check.add(StatusWarning, "disk can hold only %s, which is less than allocated", memory.Size(total))
+	}
+
+	for _, policy := range service.trust.GetPolicies() {
+		if policy.NoUploads {
+			check.add(StatusOK, "satellite %s: uploads are rejected by the node operator", policy.SatelliteID)
+		}
+		if policy.AllocatedDiskSpace > 0 {
+			check.add(StatusOK, "satellite %s: allocated %s", policy.SatelliteID, policy.AllocatedDiskSpace)
+		}
+	}
+
+	return check
+}
+
+// checkClock compares the local system clock with the clock of the trusted satellites.
+func (service *Service) checkClock(ctx context.Context) Check {
+	check := Check{Name: "clock", Status: StatusOK}
+
+	skews := service.localTime.ClockSkews(ctx)
+	if len(skews) == 0 {
+		check.add(StatusSkipped, "no trusted satellites")
+		return check
+	}
+
+	for _, skew := range skews {
+		switch {
+		case skew.Err == nil:
+			check.add(StatusOK, "satellite %s: clock is off by %s", skew.SatelliteID, skew.Skew.Round(time.Millisecond))
+		case preflight.ErrClockOutOfSyncMinor.Has(skew.Err):
+			check.add(StatusWarning, "satellite %s: clock is off by %s", skew.SatelliteID, skew.Skew.Round(time.Second))
+		case preflight.ErrClockOutOfSyncMajor.Has(skew.Err):
+			check.add(StatusFailed, "satellite %s: clock is out of sync by %s", skew.SatelliteID, skew.Skew.Round(time.Second))
+		default:
+			check.add(StatusFailed, "satellite %s: unable to get the satellite time: %v", skew.SatelliteID, skew.Err)
+		}
+	}
+
+	return check
+}
+
+// checkReachability reports whether the satellites are able to reach the node. The satellites
+// ping the external address of the node back on every check-in and the running node keeps the
+// result of its last check-in with each satellite. The check doesn't check in itself, because a
+// check-in reports the capacity, which depends on the runtime state of the node.
+//
+// The external address is also dialed from the network of the node, which is only reported as
+// supplementary information: it fails behind routers without NAT loopback and succeeds when the
+// port is only reachable locally.
+func (service *Service) checkReachability(ctx context.Context) Check {
+	check := Check{Name: "reachability", Status: StatusOK}
+
+	address := service.addresses.External
+	if address == "" {
+		check.add(StatusSkipped, "external address isn't configured")
+		return check
+	}
+
+	service.checkPingBacks(ctx, &check)
+	service.dialExternalAddress(ctx, &check, address)
+
+	return check
+}
+
+// checkPingBacks adds the ping back results of the last check-ins of the running node.
+func (service *Service) checkPingBacks(ctx context.Context, check *Check) {
+	if service.inspector == nil {
+		check.add(StatusSkipped, "node isn't running, the satellites ping the node back when it checks in")
+		return
+	}
+
+	dashboard, err := service.inspector.Dashboard(ctx, &internalpb.DashboardRequest{})
+	if err != nil {
+		check.add(StatusSkipped, "unable to get the state of the running node: %v", err)
+		return
+	}
+
+	if len(dashboard.CheckIns) == 0 {
+		check.add(StatusWarning, "node didn't check in with a satellite since it started %s ago", dashboard.Uptime)
+		return
+	}
+
+	for _, checkIn := range dashboard.CheckIns {
+		sinceCheckIn := time.Since(checkIn.CheckedAt).Round(time.Second)
+		switch {
+		case checkIn.Error != "":
+			check.add(StatusWarning, "satellite %s: check-in %s ago failed before the satellite pinged the node back: %s", checkIn.SatelliteId, sinceCheckIn, checkIn.Error)
+		case !checkIn.PingNodeSuccess:
+			check.add(StatusFailed, "satellite %s: unable to ping the node back at the check-in %s ago: %s", checkIn.SatelliteId, sinceCheckIn, checkIn.PingErrorMessage)
+		case checkIn.PingErrorMessage != "":
+			check.add(StatusWarning, "satellite %s: pinged the node back at the check-in %s ago with an error: %s", checkIn.SatelliteId, sinceCheckIn, checkIn.PingErrorMessage)
+		default:
+			check.add(StatusOK, "satellite %s: pinged the node back at the check-in %s ago", checkIn.SatelliteId, sinceCheckIn)
+		}
+		if sinceCheckIn >= contactWindow {
+			check.add(StatusWarning, "satellite %s: node didn't check in for %s", checkIn.SatelliteId, sinceCheckIn.Round(time.Minute))
+		}
+	}
+}
+
+// dialExternalAddress dials the external address of the node and adds whether it answers with
+// the identity of the node. When the node isn't running, it serves the TLS handshakes on the
+// address the node listens on itself. The outcome doesn't change the status of the check.
+func (service *Service) dialExternalAddress(ctx context.Context, check *Check, address string) {
+	if service.inspector == nil {
+		server, err := serveHandshakes(service.addresses.Server, service.dialer.TLSOptions)
+		if err != nil {
+			check.add(StatusOK, "unable to listen on %s for dialing the external address: %v", service.addresses.Server, err)
+			return
+		}
+		defer func() {
+			if err := server.Close(); err != nil {
+				service.log.Debug("Failed to close the handshake server.", zap.Error(err))
+			}
+		}()
+	}
+
+	conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{ID: service.identity.ID, Address: address})
+	if err != nil {
+		check.add(StatusOK, "external address %s doesn't answer with the identity of the node when dialed from the network of the node, which fails behind routers without NAT loopback too: %v", address, err)
+		return
+	}
+	if err := conn.Close(); err != nil {
+		service.log.Debug("Failed to close the connection.", zap.Error(err))
+	}
+
+	check.add(StatusOK, "external address %s answers with the identity of the node when dialed from the network of the node", address)
+}
+
+// checkLastContact checks when the running node was last pinged by a satellite. The satellites
+// consider the node offline, when they weren't able to contact it for a while.
+func (service *Service) checkLastContact(ctx context.Context) Check {
+	check := Check{Name: "last contact", Status: StatusOK}
+
+	if service.inspector == nil {
+		check.add(StatusSkipped, "node isn't running")
+		return check
+	}
+
+	dashboard, err := service.inspector.Dashboard(ctx, &internalpb.DashboardRequest{})
+	if err != nil {
+		check.add(StatusSkipped, "unable to get the state of the running node: %v", err)
+		return check
+	}
+
+	switch sincePinged := time.Since(dashboard.LastPinged); {
+	case dashboard.LastPinged.IsZero():
+		check.add(StatusWarning, "node wasn't pinged by a satellite since it started %s ago", dashboard.Uptime)
+	case sincePinged >= contactWindow:
+		check.add(StatusFailed, "node wasn't pinged by a satellite for %s", sincePinged.Round(time.Minute))
+	default:
+		check.add(StatusOK, "node was last pinged by a satellite %s ago", sincePinged.Round(time.Second))
+	}
+
+	return check
+}
+
+// checkPieces reads a sample of the pieces of every satellite and verifies their hashes.
+func (service *Service) checkPieces(ctx context.Context) Check {
+	check := Check{Name: "pieces", Status: StatusOK}
+
+	if service.config.PieceSamples <= 0 {
+		check.add(StatusSkipped, "piece sampling is disabled")
+		return check
+	}
+
+	satellites, err := service.store.StoringSatellites(ctx)
+	if err != nil {
+		check.add(StatusFailed, "unable to get the satellites storing pieces: %v", err)
+		return check
+	}
+	if len(satellites) == 0 {
+		check.add(StatusSkipped, "no pieces are stored")
+		return check
+	}
+	sort.Sort(storj.NodeIDList(satellites))
+
+	for _, satellite := range satellites {
+		sample, err := samplePieces(ctx, service.store, satellite, service.config.PieceSamples)
+		if err != nil {
+			check.add(StatusFailed, "satellite %s: unable to list pieces: %v", satellite, err)
+			continue
+		}
+
+		var verified int
+		for _, pieceID := range sample {
+			if err := verifyPiece(ctx, service.store, satellite, pieceID); err != nil {
+				check.add(StatusFailed, "satellite %s: piece %s: %v", satellite, pieceID, err)
+				continue
+			}
+			verified++
+		}
+		check.add(StatusOK, "satellite %s: %d of %d sampled pieces are intact", satellite, verified, len(sample))
+	}
+
+	return check
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diagnostics_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/private/version"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode/diagnostics"
+	"storj.io/storj/storagenode/pieces"
+)
+
+func TestServiceRun(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(1, 1, 1, 1),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		service := diagnostics.NewService(zaptest.NewLogger(t),
+			diagnostics.Config{PieceSamples: 10},
+			version.Info{},
+			node.Identity,
+			diagnostics.Addresses{
+				Server:   node.Addr(),
+				External: node.Addr(),
+			},
+			node.DB.(diagnostics.DB),
+			node.Storage2.Store,
+			node.Storage2.Monitor,
+			node.Preflight.LocalTime,
+			node.Dialer,
+			node.Storage2.Inspector,
+			node.Storage2.Trust,
+		)
+
+		report, err := service.Run(ctx)
+		require.NoError(t, err)
+		require.Equal(t, node.ID(), report.NodeID)
+
+		checks := make(map[string]diagnostics.Check)
+		for _, check := range report.Checks {
+			checks[check.Name] = check
+		}
+		for _, name := range []string{"identity", "storage directory", "databases", "clock", "reachability", "last contact", "pieces"} {
+			require.Contains(t, checks, name)
+			require.Equal(t, diagnostics.StatusOK, checks[name].Status, checks[name].Details)
+		}
+		require.Contains(t, checks["pieces"].Details, "satellite "+satellite.ID().String()+": 1 of 1 sampled pieces are intact")
+
+		// corrupt the content of the stored piece
+		var pieceID storj.PieceID
+		err = node.Storage2.Store.WalkSatellitePieces(ctx, satellite.ID(), func(access pieces.StoredPieceAccess) error {
+			pieceID = access.PieceID()
+			return nil
+		})
+		require.NoError(t, err)
+		require.False(t, pieceID.IsZero())
+
+		blobRef := storage.BlobRef{Namespace: satellite.ID().Bytes(), Key: pieceID.Bytes()}
+		reader, err := node.Storage2.BlobsCache.Open(ctx, blobRef)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		require.NoError(t, node.Storage2.BlobsCache.Delete(ctx, blobRef))
+
+		data[len(data)-1]++
+		writer, err := node.Storage2.BlobsCache.Create(ctx, blobRef, int64(len(data)))
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+
+		report, err = service.Run(ctx)
+		require.NoError(t, err)
+		require.Equal(t, diagnostics.StatusFailed, report.Status())
+		for _, check := range report.Checks {
+			if check.Name == "pieces" {
+				require.Equal(t, diagnostics.StatusFailed, check.Status)
+			}
+		}
+	})
+}
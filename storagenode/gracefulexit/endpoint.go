@@ -13,6 +13,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
 	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
 	"storj.io/storj/storagenode/internalpb"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/satellites"
@@ -96,30 +97,43 @@ func (e *Endpoint) GetNonExitingSatellites(ctx context.Context, req *internalpb.
 func (e *Endpoint) InitiateGracefulExit(ctx context.Context, req *internalpb.InitiateGracefulExitRequest) (*internalpb.ExitProgress, error) {
 	e.log.Debug("initialize graceful exit: start", zap.Stringer("Satellite ID", req.NodeId))
 
-	nodeurl, err := e.trust.GetNodeURL(ctx, req.NodeId)
+	address, _, err := e.Initiate(ctx, req.NodeId)
 	if err != nil {
-		e.log.Debug("initialize graceful exit: retrieve satellite address", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
+	return &internalpb.ExitProgress{
+		DomainName:      address,
+		NodeId:          req.NodeId,
+		PercentComplete: float32(0),
+	}, nil
+}
+
+// Initiate marks the satellite as gracefully exiting in the storagenode's database.
+// It returns the address of the satellite and the size of the pieces to transfer.
+func (e *Endpoint) Initiate(ctx context.Context, satelliteID storj.NodeID) (address string, bytesToTransfer int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeurl, err := e.trust.GetNodeURL(ctx, satelliteID)
+	if err != nil {
+		e.log.Debug("initialize graceful exit: retrieve satellite address", zap.Error(err))
+		return "", 0, Error.Wrap(err)
+	}
+
 	// get space usage by satellites
-	_, piecesContentSize, err := e.usageCache.SpaceUsedBySatellite(ctx, req.NodeId)
+	_, piecesContentSize, err := e.usageCache.SpaceUsedBySatellite(ctx, satelliteID)
 	if err != nil {
-		e.log.Debug("initialize graceful exit: retrieve space used", zap.Stringer("Satellite ID", req.NodeId), zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		e.log.Debug("initialize graceful exit: retrieve space used", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+		return "", 0, Error.Wrap(err)
 	}
 
-	err = e.satellites.InitiateGracefulExit(ctx, req.NodeId, time.Now().UTC(), piecesContentSize)
+	err = e.satellites.InitiateGracefulExit(ctx, satelliteID, time.Now().UTC(), piecesContentSize)
 	if err != nil {
-		e.log.Debug("initialize graceful exit: save info into satellites table", zap.Stringer("Satellite ID", req.NodeId), zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		e.log.Debug("initialize graceful exit: save info into satellites table", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+		return "", 0, Error.Wrap(err)
 	}
 
-	return &internalpb.ExitProgress{
-		DomainName:      nodeurl.Address,
-		NodeId:          req.NodeId,
-		PercentComplete: float32(0),
-	}, nil
+	return nodeurl.Address, piecesContentSize, nil
 }
 
 // GetExitProgress returns graceful exit progress on each satellite that a storagde node has started exiting.
@@ -116,6 +116,17 @@ func (inspector *Endpoint) getDashboardData(ctx context.Context) (_ *internalpb.
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
+	var checkIns []*internalpb.SatelliteCheckIn
+	for _, checkIn := range inspector.contact.CheckIns() {
+		checkIns = append(checkIns, &internalpb.SatelliteCheckIn{
+			SatelliteId:      checkIn.SatelliteID,
+			CheckedAt:        checkIn.CheckedAt,
+			Error:            checkIn.Error,
+			PingNodeSuccess:  checkIn.PingNodeSuccess,
+			PingErrorMessage: checkIn.PingErrorMessage,
+		})
+	}
+
 	lastPingedAt := inspector.pingStats.WhenLastPinged()
 	self := inspector.contact.Local()
 	return &internalpb.DashboardResponse{
@@ -126,5 +137,6 @@ func (inspector *Endpoint) getDashboardData(ctx context.Context) (_ *internalpb.
 		DashboardAddress: inspector.dashboardAddress.String(),
 		Uptime:           time.Since(inspector.startTime).String(),
 		Stats:            statsSummary,
+		CheckIns:         checkIns,
 	}, nil
 }
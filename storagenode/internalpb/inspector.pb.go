@@ -174,6 +174,7 @@ type DashboardResponse struct {
 	LastQueried          time.Time            `protobuf:"bytes,10,opt,name=last_queried,json=lastQueried,proto3,stdtime" json:"last_queried"`
 	LastPingFromId       *NodeID              `protobuf:"bytes,11,opt,name=last_ping_from_id,json=lastPingFromId,proto3,customtype=NodeID" json:"last_ping_from_id,omitempty"`
 	LastPingFromAddress  string               `protobuf:"bytes,12,opt,name=last_ping_from_address,json=lastPingFromAddress,proto3" json:"last_ping_from_address,omitempty"`
+	CheckIns             []*SatelliteCheckIn  `protobuf:"bytes,13,rep,name=check_ins,json=checkIns,proto3" json:"check_ins,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -274,53 +275,133 @@ func (m *DashboardResponse) GetLastPingFromAddress() string {
 	return ""
 }
 
+func (m *DashboardResponse) GetCheckIns() []*SatelliteCheckIn {
+	if m != nil {
+		return m.CheckIns
+	}
+	return nil
+}
+
+type SatelliteCheckIn struct {
+	SatelliteId NodeID    `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	CheckedAt   time.Time `protobuf:"bytes,2,opt,name=checked_at,json=checkedAt,proto3,stdtime" json:"checked_at"`
+	// error is set when the check-in failed before the satellite pinged the node back.
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	PingNodeSuccess      bool     `protobuf:"varint,4,opt,name=ping_node_success,json=pingNodeSuccess,proto3" json:"ping_node_success,omitempty"`
+	PingErrorMessage     string   `protobuf:"bytes,5,opt,name=ping_error_message,json=pingErrorMessage,proto3" json:"ping_error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SatelliteCheckIn) Reset()         { *m = SatelliteCheckIn{} }
+func (m *SatelliteCheckIn) String() string { return proto.CompactTextString(m) }
+func (*SatelliteCheckIn) ProtoMessage()    {}
+func (*SatelliteCheckIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{4}
+}
+func (m *SatelliteCheckIn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteCheckIn.Unmarshal(m, b)
+}
+func (m *SatelliteCheckIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteCheckIn.Marshal(b, m, deterministic)
+}
+func (m *SatelliteCheckIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteCheckIn.Merge(m, src)
+}
+func (m *SatelliteCheckIn) XXX_Size() int {
+	return xxx_messageInfo_SatelliteCheckIn.Size(m)
+}
+func (m *SatelliteCheckIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteCheckIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteCheckIn proto.InternalMessageInfo
+
+func (m *SatelliteCheckIn) GetCheckedAt() time.Time {
+	if m != nil {
+		return m.CheckedAt
+	}
+	return time.Time{}
+}
+
+func (m *SatelliteCheckIn) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SatelliteCheckIn) GetPingNodeSuccess() bool {
+	if m != nil {
+		return m.PingNodeSuccess
+	}
+	return false
+}
+
+func (m *SatelliteCheckIn) GetPingErrorMessage() string {
+	if m != nil {
+		return m.PingErrorMessage
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StatsRequest)(nil), "storagenode.inspector.StatsRequest")
 	proto.RegisterType((*StatSummaryResponse)(nil), "storagenode.inspector.StatSummaryResponse")
 	proto.RegisterType((*DashboardRequest)(nil), "storagenode.inspector.DashboardRequest")
 	proto.RegisterType((*DashboardResponse)(nil), "storagenode.inspector.DashboardResponse")
+	proto.RegisterType((*SatelliteCheckIn)(nil), "storagenode.inspector.SatelliteCheckIn")
 }
 
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 598 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x97, 0x6d, 0xed, 0xd6, 0xb7, 0xa5, 0x7f, 0x5c, 0x31, 0x45, 0x95, 0x50, 0x47, 0xd1,
-	0xd4, 0x01, 0x52, 0x22, 0x6d, 0xe2, 0x0e, 0x65, 0x03, 0xf5, 0x82, 0x46, 0xca, 0x69, 0x97, 0xe2,
-	0xd4, 0xef, 0x32, 0xa3, 0x36, 0xce, 0x6c, 0x87, 0x3f, 0xdf, 0x82, 0x8f, 0xc5, 0x9d, 0x1b, 0x87,
-	0xf1, 0x19, 0x38, 0x72, 0x43, 0x76, 0xea, 0xac, 0xaa, 0x06, 0x68, 0xb7, 0xf8, 0x79, 0x7f, 0xaf,
-	0xed, 0x3c, 0xef, 0x63, 0x68, 0xf1, 0x54, 0x65, 0x38, 0xd3, 0x42, 0x06, 0x99, 0x14, 0x5a, 0x90,
-	0xfb, 0x4a, 0x0b, 0x49, 0x13, 0x4c, 0x05, 0xc3, 0xa0, 0x2c, 0xf6, 0x20, 0x11, 0x89, 0x28, 0x90,
-	0x5e, 0x3f, 0x11, 0x22, 0x99, 0x63, 0x68, 0x57, 0x71, 0x7e, 0x11, 0x6a, 0xbe, 0x40, 0xa5, 0xe9,
-	0x22, 0x2b, 0x80, 0x41, 0x13, 0x1a, 0x13, 0x4d, 0xb5, 0x8a, 0xf0, 0x2a, 0x47, 0xa5, 0x07, 0xbf,
-	0x3d, 0xe8, 0x1a, 0x61, 0x92, 0x2f, 0x16, 0x54, 0x7e, 0x89, 0x50, 0x65, 0x22, 0x55, 0x48, 0x1e,
-	0x00, 0xe4, 0x0a, 0xd9, 0x54, 0x65, 0x74, 0x86, 0xbe, 0xb7, 0xef, 0x1d, 0x6e, 0x45, 0x35, 0xa3,
-	0x4c, 0x8c, 0x40, 0x86, 0xd0, 0xa2, 0x1f, 0x29, 0x9f, 0xd3, 0x78, 0x8e, 0x4b, 0x66, 0xd3, 0x32,
-	0xcd, 0x52, 0x2e, 0xc0, 0x87, 0xd0, 0xb0, 0xfb, 0xf0, 0x34, 0x91, 0xa8, 0x94, 0xbf, 0x65, 0xa9,
-	0xba, 0xd1, 0xc6, 0x85, 0x44, 0xfa, 0x60, 0x97, 0x53, 0x2c, 0x88, 0x6d, 0x4b, 0xd8, 0xd3, 0x4f,
-	0x0b, 0xe0, 0x00, 0x9a, 0x16, 0x88, 0x69, 0xca, 0x3e, 0x71, 0xa6, 0x2f, 0xfd, 0x8a, 0x65, 0xee,
-	0x19, 0x75, 0xe4, 0x44, 0x12, 0x42, 0xf7, 0xe6, 0x4e, 0x37, 0x6c, 0xd5, 0xb2, 0xa4, 0x2c, 0x95,
-	0x0d, 0x03, 0x02, 0xed, 0x13, 0xaa, 0x2e, 0x63, 0x41, 0x25, 0x73, 0x7e, 0xfc, 0xda, 0x86, 0xce,
-	0x8a, 0xb8, 0x74, 0x63, 0x08, 0x3b, 0xc6, 0xf4, 0x29, 0x67, 0xd6, 0x8a, 0xc6, 0xa8, 0xf9, 0xed,
-	0xba, 0xbf, 0xf1, 0xe3, 0xba, 0x5f, 0x7d, 0x23, 0x18, 0x8e, 0x4f, 0xa2, 0xaa, 0x29, 0x8f, 0x19,
-	0x79, 0x0c, 0x6d, 0x0b, 0xce, 0x44, 0x9a, 0xe2, 0x4c, 0x73, 0x91, 0xaa, 0xa5, 0x31, 0x2d, 0xa3,
-	0xbf, 0xbc, 0x91, 0x49, 0x08, 0x9d, 0x58, 0x08, 0xad, 0xb4, 0xa4, 0xd9, 0x94, 0x32, 0x56, 0xda,
-	0x53, 0x1b, 0x6d, 0xfa, 0x5e, 0xd4, 0x2e, 0x8b, 0x2f, 0x8a, 0x9a, 0xd9, 0x9b, 0xa7, 0x1a, 0x65,
-	0x4a, 0xe7, 0x25, 0x6f, 0xcc, 0xaa, 0x45, 0x2d, 0xa7, 0xaf, 0xa0, 0xf8, 0x79, 0x0d, 0xad, 0x14,
-	0xa8, 0xd3, 0x1d, 0xfa, 0x14, 0x3a, 0xcc, 0xfd, 0x6f, 0xc9, 0x56, 0x2d, 0xdb, 0x2e, 0x0b, 0x0e,
-	0x7e, 0x0e, 0x15, 0x65, 0xd2, 0xe3, 0xef, 0xec, 0x7b, 0x87, 0xf5, 0xa3, 0x27, 0xc1, 0xad, 0x89,
-	0x0c, 0x6e, 0x09, 0x54, 0x54, 0x34, 0x92, 0x3d, 0xa8, 0xe6, 0x99, 0x09, 0xa5, 0xbf, 0x6b, 0xcf,
-	0x58, 0xae, 0xc8, 0x29, 0xd4, 0xe7, 0x54, 0xe9, 0x69, 0xc6, 0xd3, 0x04, 0x99, 0x5f, 0xb3, 0xfb,
-	0xf7, 0x82, 0x22, 0xce, 0x81, 0x8b, 0x73, 0xf0, 0xce, 0xc5, 0x79, 0xb4, 0x6b, 0x26, 0xf0, 0xf5,
-	0x67, 0xdf, 0x8b, 0xc0, 0x34, 0x9e, 0xd9, 0x3e, 0xf2, 0x1a, 0x1a, 0x76, 0x9b, 0xab, 0x1c, 0x25,
-	0x47, 0xe6, 0xc3, 0x1d, 0xf6, 0xb1, 0x17, 0x78, 0x5b, 0x34, 0x92, 0x67, 0xd0, 0x29, 0xef, 0x33,
-	0xbd, 0x90, 0x62, 0x61, 0x66, 0x5f, 0xb7, 0xb3, 0x87, 0x95, 0xb9, 0x37, 0xdd, 0xd9, 0xaf, 0xa4,
-	0x58, 0x8c, 0x19, 0x39, 0x86, 0xbd, 0xb5, 0x36, 0x67, 0x69, 0xc3, 0xfe, 0x6e, 0x77, 0x95, 0x5f,
-	0xba, 0x7a, 0xf4, 0xdd, 0x83, 0xee, 0x19, 0xc7, 0x19, 0x4e, 0xb4, 0x90, 0x38, 0x76, 0x36, 0x92,
-	0x73, 0xa8, 0xd8, 0xb7, 0x4a, 0x1e, 0xfd, 0xc3, 0x67, 0xf7, 0x92, 0x7b, 0x77, 0x18, 0xc6, 0x60,
-	0x83, 0xbc, 0x87, 0x5a, 0x19, 0x73, 0x32, 0xfc, 0x4b, 0xeb, 0xfa, 0xeb, 0xe8, 0x1d, 0xfe, 0x1f,
-	0x74, 0x27, 0x8c, 0x86, 0xe7, 0x07, 0x06, 0xfe, 0x10, 0x70, 0x11, 0xda, 0x8f, 0x70, 0xa5, 0x37,
-	0x74, 0x89, 0xcd, 0xe2, 0xb8, 0x6a, 0xa7, 0x72, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x0f,
-	0xce, 0xb4, 0xf0, 0x04, 0x00, 0x00,
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0x13, 0x3b,
+	0x14, 0xc6, 0x3b, 0xed, 0x4d, 0x9a, 0x9c, 0xa4, 0xf9, 0xe3, 0xdc, 0x5b, 0x8d, 0x22, 0x5d, 0xa5,
+	0x37, 0x57, 0x55, 0x72, 0x7b, 0xd1, 0x44, 0xb4, 0x62, 0x4f, 0xd3, 0x16, 0x94, 0x05, 0xa8, 0x4c,
+	0x58, 0x75, 0x33, 0x38, 0xe3, 0xd3, 0xe9, 0x40, 0x32, 0x9e, 0x8e, 0x1d, 0x28, 0x6f, 0xc1, 0xdb,
+	0xf0, 0x0a, 0xec, 0xd9, 0xb1, 0x28, 0xcf, 0x01, 0x2b, 0x64, 0x3b, 0x9e, 0x86, 0xa8, 0x05, 0x75,
+	0x17, 0x7f, 0xe7, 0x77, 0x6c, 0xe7, 0x3b, 0xdf, 0x18, 0xea, 0x71, 0x22, 0x52, 0x0c, 0x25, 0xcf,
+	0xbc, 0x34, 0xe3, 0x92, 0x93, 0xbf, 0x84, 0xe4, 0x19, 0x8d, 0x30, 0xe1, 0x0c, 0xbd, 0xbc, 0xd8,
+	0x86, 0x88, 0x47, 0xdc, 0x20, 0xed, 0x4e, 0xc4, 0x79, 0x34, 0xc5, 0x81, 0x5e, 0x4d, 0xe6, 0xe7,
+	0x03, 0x19, 0xcf, 0x50, 0x48, 0x3a, 0x4b, 0x0d, 0xd0, 0xad, 0x41, 0x75, 0x2c, 0xa9, 0x14, 0x3e,
+	0x5e, 0xce, 0x51, 0xc8, 0xee, 0x37, 0x07, 0x5a, 0x4a, 0x18, 0xcf, 0x67, 0x33, 0x9a, 0xbd, 0xf7,
+	0x51, 0xa4, 0x3c, 0x11, 0x48, 0xfe, 0x06, 0x98, 0x0b, 0x64, 0x81, 0x48, 0x69, 0x88, 0xae, 0xb3,
+	0xe3, 0xf4, 0x37, 0xfc, 0xb2, 0x52, 0xc6, 0x4a, 0x20, 0x3d, 0xa8, 0xd3, 0xb7, 0x34, 0x9e, 0xd2,
+	0xc9, 0x14, 0x17, 0xcc, 0xba, 0x66, 0x6a, 0xb9, 0x6c, 0xc0, 0x7f, 0xa0, 0xaa, 0xf7, 0x89, 0x93,
+	0x28, 0x43, 0x21, 0xdc, 0x0d, 0x4d, 0x55, 0x94, 0x36, 0x32, 0x12, 0xe9, 0x80, 0x5e, 0x06, 0x68,
+	0x88, 0x3f, 0x34, 0xa1, 0x4f, 0x3f, 0x31, 0xc0, 0x2e, 0xd4, 0x34, 0x30, 0xa1, 0x09, 0x7b, 0x17,
+	0x33, 0x79, 0xe1, 0x16, 0x34, 0xb3, 0xa5, 0xd4, 0xa1, 0x15, 0xc9, 0x00, 0x5a, 0x37, 0x77, 0xba,
+	0x61, 0x8b, 0x9a, 0x25, 0x79, 0x29, 0x6f, 0xe8, 0x12, 0x68, 0x1c, 0x53, 0x71, 0x31, 0xe1, 0x34,
+	0x63, 0xd6, 0x8f, 0x8f, 0x05, 0x68, 0x2e, 0x89, 0x0b, 0x37, 0x7a, 0xb0, 0xa9, 0x4c, 0x0f, 0x62,
+	0xa6, 0xad, 0xa8, 0x0e, 0x6b, 0x9f, 0xae, 0x3b, 0x6b, 0x5f, 0xae, 0x3b, 0xc5, 0xe7, 0x9c, 0xe1,
+	0xe8, 0xd8, 0x2f, 0xaa, 0xf2, 0x88, 0x91, 0xff, 0xa0, 0xa1, 0xc1, 0x90, 0x27, 0x09, 0x86, 0x32,
+	0xe6, 0x89, 0x58, 0x18, 0x53, 0x57, 0xfa, 0xd1, 0x8d, 0x4c, 0x06, 0xd0, 0x9c, 0x70, 0x2e, 0x85,
+	0xcc, 0x68, 0x1a, 0x50, 0xc6, 0x72, 0x7b, 0xca, 0xc3, 0x75, 0xd7, 0xf1, 0x1b, 0x79, 0xf1, 0xd0,
+	0xd4, 0xd4, 0xde, 0x71, 0x22, 0x31, 0x4b, 0xe8, 0x34, 0xe7, 0x95, 0x59, 0x65, 0xbf, 0x6e, 0xf5,
+	0x25, 0x14, 0xaf, 0x56, 0xd0, 0x82, 0x41, 0xf1, 0xea, 0x67, 0xf4, 0x7f, 0x68, 0x32, 0xfb, 0x7f,
+	0x73, 0xb6, 0xa8, 0xd9, 0x46, 0x5e, 0xb0, 0xf0, 0x63, 0x28, 0x08, 0x95, 0x1e, 0x77, 0x73, 0xc7,
+	0xe9, 0x57, 0xf6, 0xf7, 0xbc, 0x5b, 0x13, 0xe9, 0xdd, 0x12, 0x28, 0xdf, 0x34, 0x92, 0x6d, 0x28,
+	0xce, 0x53, 0x15, 0x4a, 0xb7, 0xa4, 0xcf, 0x58, 0xac, 0xc8, 0x09, 0x54, 0xa6, 0x54, 0xc8, 0x20,
+	0x8d, 0x93, 0x08, 0x99, 0x5b, 0xd6, 0xfb, 0xb7, 0x3d, 0x13, 0x67, 0xcf, 0xc6, 0xd9, 0x7b, 0x69,
+	0xe3, 0x3c, 0x2c, 0xa9, 0x09, 0x7c, 0xf8, 0xda, 0x71, 0x7c, 0x50, 0x8d, 0xa7, 0xba, 0x8f, 0x3c,
+	0x85, 0xaa, 0xde, 0xe6, 0x72, 0x8e, 0x59, 0x8c, 0xcc, 0x85, 0x7b, 0xec, 0xa3, 0x2f, 0xf0, 0xc2,
+	0x34, 0x92, 0x47, 0xd0, 0xcc, 0xef, 0x13, 0x9c, 0x67, 0x7c, 0xa6, 0x66, 0x5f, 0xd1, 0xb3, 0x87,
+	0xa5, 0xb9, 0xd7, 0xec, 0xd9, 0x4f, 0x32, 0x3e, 0x1b, 0x31, 0x72, 0x00, 0xdb, 0x2b, 0x6d, 0xd6,
+	0xd2, 0xaa, 0xfe, 0xbb, 0xad, 0x65, 0xde, 0xba, 0x7a, 0x0c, 0xe5, 0xf0, 0x02, 0xc3, 0x37, 0x41,
+	0x9c, 0x08, 0x77, 0x6b, 0x67, 0xa3, 0x5f, 0xd9, 0xef, 0xdd, 0xe5, 0x2c, 0x95, 0x38, 0x9d, 0xc6,
+	0x12, 0x8f, 0x54, 0xc3, 0x28, 0xf1, 0x4b, 0xa1, 0xf9, 0x21, 0xba, 0xdf, 0x1d, 0x68, 0xac, 0x96,
+	0xc9, 0x43, 0xa8, 0x0a, 0xab, 0xdd, 0x9d, 0xde, 0x4a, 0xce, 0x8c, 0x18, 0x39, 0x02, 0xd0, 0x7b,
+	0x22, 0x0b, 0xa8, 0x74, 0xd7, 0xef, 0x61, 0x60, 0x79, 0xd1, 0x77, 0x28, 0xc9, 0x9f, 0x50, 0xc0,
+	0x2c, 0xe3, 0x99, 0x09, 0xb4, 0x6f, 0x16, 0x64, 0x0f, 0x9a, 0xda, 0x18, 0xfd, 0x89, 0x88, 0x79,
+	0x18, 0xda, 0x08, 0x97, 0xfc, 0xba, 0x2a, 0xa8, 0xeb, 0x8c, 0x8d, 0x4c, 0x1e, 0x00, 0xd1, 0xac,
+	0xee, 0x0c, 0x66, 0x28, 0x04, 0x8d, 0x70, 0x11, 0xe2, 0x86, 0xaa, 0x9c, 0xa8, 0xc2, 0x33, 0xa3,
+	0xef, 0x7f, 0x76, 0xa0, 0x75, 0x1a, 0x63, 0x88, 0x63, 0xc9, 0x33, 0x1c, 0x59, 0xbf, 0xc8, 0x19,
+	0x14, 0xf4, 0x73, 0x47, 0xfe, 0xfd, 0x45, 0x54, 0xed, 0x63, 0xd8, 0xbe, 0x47, 0x9e, 0xbb, 0x6b,
+	0xe4, 0x15, 0x94, 0xf3, 0x97, 0x82, 0xdc, 0x35, 0xb0, 0xd5, 0x07, 0xa6, 0xdd, 0xff, 0x3d, 0x68,
+	0x4f, 0x18, 0xf6, 0xce, 0x76, 0x15, 0xfc, 0xda, 0x8b, 0xf9, 0x40, 0xff, 0x18, 0x2c, 0xf5, 0x0e,
+	0xec, 0x47, 0x9f, 0x4e, 0x26, 0x45, 0x3d, 0x97, 0x83, 0x1f, 0x03, 0x00, 0x33, 0x22, 0x80, 0x80,
+	0x33, 0x06, 0x00, 0x00,
 }
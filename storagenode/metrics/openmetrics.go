@@ -0,0 +1,115 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ContentType is the content type of the OpenMetrics text format.
+const ContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// Type is the type of a metric family.
+type Type string
+
+const (
+	// Gauge is a value which can go up and down.
+	Gauge Type = "gauge"
+	// Counter is a value which only goes up, until the node restarts.
+	Counter Type = "counter"
+)
+
+// Label is a name value pair, which identifies a sample of a family.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is a single value of a family.
+type Sample struct {
+	Labels []Label
+	Value  float64
+}
+
+// Family is a set of samples of the same metric.
+//
+// Unit is optional, when it's set the name must end with it, e.g. storagenode_trash_bytes has the unit bytes.
+type Family struct {
+	Name    string
+	Type    Type
+	Unit    string
+	Help    string
+	Samples []Sample
+}
+
+// Add appends a sample to the family.
+func (family *Family) Add(value float64, labels ...Label) {
+	family.Samples = append(family.Samples, Sample{Labels: labels, Value: value})
+}
+
+// WriteFamilies writes the families in the OpenMetrics text format.
+func WriteFamilies(w io.Writer, families []Family) error {
+	b := bufio.NewWriter(w)
+
+	for _, family := range families {
+		_, _ = b.WriteString("# TYPE " + family.Name + " " + string(family.Type) + "\n")
+		if family.Unit != "" {
+			_, _ = b.WriteString("# UNIT " + family.Name + " " + family.Unit + "\n")
+		}
+		if family.Help != "" {
+			_, _ = b.WriteString("# HELP " + family.Name + " " + escape(family.Help, false) + "\n")
+		}
+
+		name := family.Name
+		if family.Type == Counter {
+			name += "_total"
+		}
+
+		for _, sample := range family.Samples {
+			_, _ = b.WriteString(name)
+			if len(sample.Labels) > 0 {
+				_ = b.WriteByte('{')
+				for i, label := range sample.Labels {
+					if i > 0 {
+						_ = b.WriteByte(',')
+					}
+					_, _ = b.WriteString(label.Name + `="` + escape(label.Value, true) + `"`)
+				}
+				_ = b.WriteByte('}')
+			}
+			_, _ = b.WriteString(" " + formatValue(sample.Value) + "\n")
+		}
+	}
+	_, _ = b.WriteString("# EOF\n")
+
+	return b.Flush()
+}
+
+// escape escapes the backslashes and the new lines, and the double quotes of the label values.
+func escape(s string, quotes bool) string {
+	replacements := []string{`\`, `\\`, "\n", `\n`}
+	if quotes {
+		replacements = append(replacements, `"`, `\"`)
+	}
+	return strings.NewReplacer(replacements...).Replace(s)
+}
+
+// formatValue formats the value, integers are formatted without the exponent.
+func formatValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case value == math.Trunc(value) && math.Abs(value) < 1<<53:
+		return strconv.FormatInt(int64(value), 10)
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
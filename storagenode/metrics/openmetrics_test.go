@@ -0,0 +1,54 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/storagenode/metrics"
+)
+
+func TestWriteFamilies(t *testing.T) {
+	trash := metrics.Family{
+		Name: "storagenode_trash_bytes",
+		Type: metrics.Gauge,
+		Unit: "bytes",
+		Help: "Disk space used by the trash.",
+	}
+	trash.Add(1 << 40)
+
+	score := metrics.Family{
+		Name: "storagenode_audit_score",
+		Type: metrics.Gauge,
+	}
+	score.Add(0.95, metrics.Label{Name: "satellite", Value: `a"b\c`})
+	score.Add(math.NaN(), metrics.Label{Name: "satellite", Value: "d\ne"})
+
+	uploads := metrics.Family{
+		Name: "storagenode_uploads",
+		Type: metrics.Counter,
+		Help: "Finished uploads by result.\nSince the start.",
+	}
+	uploads.Add(3, metrics.Label{Name: "result", Value: "success"}, metrics.Label{Name: "action", Value: "put"})
+
+	var buf bytes.Buffer
+	require.NoError(t, metrics.WriteFamilies(&buf, []metrics.Family{trash, score, uploads}))
+
+	require.Equal(t, `# TYPE storagenode_trash_bytes gauge
+# UNIT storagenode_trash_bytes bytes
+# HELP storagenode_trash_bytes Disk space used by the trash.
+storagenode_trash_bytes 1099511627776
+# TYPE storagenode_audit_score gauge
+storagenode_audit_score{satellite="a\"b\\c"} 0.95
+storagenode_audit_score{satellite="d\ne"} NaN
+# TYPE storagenode_uploads counter
+# HELP storagenode_uploads Finished uploads by result.\nSince the start.
+storagenode_uploads_total{result="success",action="put"} 3
+# EOF
+`, buf.String())
+}
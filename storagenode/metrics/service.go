@@ -0,0 +1,278 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/date"
+	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the metrics service.
+	Error = errs.Class("metrics")
+)
+
+// Config contains configuration of the node metrics exporter.
+type Config struct {
+	Enabled bool `help:"expose the node metrics in OpenMetrics format on /metrics of the console server" default:"false"`
+}
+
+// Service collects the node metrics for the OpenMetrics exporter.
+//
+// architecture: Service
+type Service struct {
+	log *zap.Logger
+
+	trust      *trust.Pool
+	monitor    *monitor.Service
+	usageCache *pieces.BlobsUsageCache
+	bandwidth  bandwidth.DB
+	reputation reputation.DB
+	retain     *retain.Service
+	endpoint   *piecestore.Endpoint
+
+	nowFn func() time.Time
+}
+
+// NewService creates new instance of Service.
+func NewService(log *zap.Logger, trust *trust.Pool, monitor *monitor.Service, usageCache *pieces.BlobsUsageCache, bandwidth bandwidth.DB, reputation reputation.DB, retain *retain.Service, endpoint *piecestore.Endpoint) *Service {
+	return &Service{
+		log:        log,
+		trust:      trust,
+		monitor:    monitor,
+		usageCache: usageCache,
+		bandwidth:  bandwidth,
+		reputation: reputation,
+		retain:     retain,
+		endpoint:   endpoint,
+		nowFn:      time.Now,
+	}
+}
+
+// Collect returns the current values of the node metrics.
+func (service *Service) Collect(ctx context.Context) (_ []Family, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var families []Family
+	for _, collect := range []func(context.Context) ([]Family, error){
+		service.disk,
+		service.bandwidthUsage,
+		service.reputationScores,
+	} {
+		collected, err := collect(ctx)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		families = append(families, collected...)
+	}
+
+	families = append(families, service.pieceCounts()...)
+	families = append(families, service.garbageCollection()...)
+	families = append(families, service.transfers()...)
+
+	return families, nil
+}
+
+// disk returns the disk space used by every satellite, the trash and the free disk space.
+func (service *Service) disk(ctx context.Context) (_ []Family, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	diskSpace, err := service.monitor.DiskSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	used := Family{
+		Name: "storagenode_disk_used_bytes", Type: Gauge, Unit: "bytes",
+		Help: "Disk space used by the pieces of the satellite.",
+	}
+	for _, satelliteID := range sortedIDs(service.trust.GetSatellites(ctx)) {
+		piecesTotal, _, err := service.usageCache.SpaceUsedBySatellite(ctx, satelliteID)
+		if err != nil {
+			return nil, err
+		}
+		used.Add(float64(piecesTotal), satelliteLabel(satelliteID))
+	}
+
+	return []Family{
+		used,
+		gauge("storagenode_disk_allocated_bytes", "Disk space allocated for the pieces.", diskSpace.Allocated),
+		gauge("storagenode_disk_available_bytes", "Allocated disk space, which is still available for new pieces.", diskSpace.Available),
+		gauge("storagenode_disk_free_bytes", "Free space of the disk.", diskSpace.Free),
+		gauge("storagenode_disk_overused_bytes", "Disk space used above the allocation.", diskSpace.Overused),
+		gauge("storagenode_trash_bytes", "Disk space used by the trash.", diskSpace.UsedForTrash),
+	}, nil
+}
+
+// bandwidthUsage returns the bandwidth used in the current month by satellite and action.
+func (service *Service) bandwidthUsage(ctx context.Context) (_ []Family, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := service.nowFn().UTC()
+	from, _ := date.MonthBoundary(now)
+
+	usages, err := service.bandwidth.SummaryBySatellite(ctx, from, now)
+	if err != nil {
+		return nil, err
+	}
+
+	used := Family{
+		Name: "storagenode_bandwidth_month_bytes", Type: Gauge, Unit: "bytes",
+		Help: "Bandwidth used in the current month by satellite and action.",
+	}
+	satelliteIDs := make([]storj.NodeID, 0, len(usages))
+	for satelliteID := range usages {
+		satelliteIDs = append(satelliteIDs, satelliteID)
+	}
+	for _, satelliteID := range sortedIDs(satelliteIDs) {
+		usage := usages[satelliteID]
+		for _, action := range []struct {
+			name  string
+			value int64
+		}{
+			{"put", usage.Put},
+			{"get", usage.Get},
+			{"get_audit", usage.GetAudit},
+			{"get_repair", usage.GetRepair},
+			{"put_repair", usage.PutRepair},
+			{"delete", usage.Delete},
+		} {
+			used.Add(float64(action.value), satelliteLabel(satelliteID), Label{Name: "action", Value: action.name})
+		}
+	}
+
+	return []Family{used}, nil
+}
+
+// reputationScores returns the audit, suspension and online scores of every satellite.
+func (service *Service) reputationScores(ctx context.Context) (_ []Family, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats, err := service.reputation.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(stats, func(i, k int) bool {
+		return stats[i].SatelliteID.Less(stats[k].SatelliteID)
+	})
+
+	audit := Family{Name: "storagenode_audit_score", Type: Gauge, Help: "Audit score reported by the satellite."}
+	suspension := Family{Name: "storagenode_suspension_score", Type: Gauge, Help: "Suspension score reported by the satellite."}
+	online := Family{Name: "storagenode_online_score", Type: Gauge, Help: "Online score reported by the satellite."}
+	for _, stat := range stats {
+		label := satelliteLabel(stat.SatelliteID)
+		audit.Add(stat.Audit.Score, label)
+		suspension.Add(stat.Audit.UnknownScore, label)
+		online.Add(stat.OnlineScore, label)
+	}
+
+	return []Family{audit, suspension, online}, nil
+}
+
+// pieceCounts returns the number of pieces of every satellite.
+func (service *Service) pieceCounts() []Family {
+	counts := service.usageCache.PieceCounts()
+
+	family := Family{
+		Name: "storagenode_pieces", Type: Gauge,
+		Help: "Number of pieces of the satellite counted by the last used space recalculation.",
+	}
+	satelliteIDs := make([]storj.NodeID, 0, len(counts))
+	for satelliteID := range counts {
+		satelliteIDs = append(satelliteIDs, satelliteID)
+	}
+	for _, satelliteID := range sortedIDs(satelliteIDs) {
+		family.Add(float64(counts[satelliteID]), satelliteLabel(satelliteID))
+	}
+
+	return []Family{family}
+}
+
+// garbageCollection returns the progress of the running or the last garbage collection of every satellite.
+func (service *Service) garbageCollection() []Family {
+	progress := service.retain.Progress()
+
+	running := Family{Name: "storagenode_gc_running", Type: Gauge, Help: "Whether the garbage collection of the satellite is running."}
+	started := Family{
+		Name: "storagenode_gc_started_seconds", Type: Gauge, Unit: "seconds",
+		Help: "Unix time when the last garbage collection of the satellite started.",
+	}
+	checked := Family{Name: "storagenode_gc_pieces_checked", Type: Gauge, Help: "Pieces checked by the last garbage collection of the satellite."}
+	skipped := Family{Name: "storagenode_gc_pieces_skipped", Type: Gauge, Help: "Pieces skipped by the last garbage collection of the satellite."}
+	deleted := Family{Name: "storagenode_gc_pieces_deleted", Type: Gauge, Help: "Pieces moved to the trash by the last garbage collection of the satellite."}
+
+	satelliteIDs := make([]storj.NodeID, 0, len(progress))
+	for satelliteID := range progress {
+		satelliteIDs = append(satelliteIDs, satelliteID)
+	}
+	for _, satelliteID := range sortedIDs(satelliteIDs) {
+		satelliteProgress := progress[satelliteID]
+		label := satelliteLabel(satelliteID)
+
+		isRunning := 0.0
+		if satelliteProgress.Finished.IsZero() {
+			isRunning = 1
+		}
+		running.Add(isRunning, label)
+		started.Add(float64(satelliteProgress.Started.Unix()), label)
+		checked.Add(float64(satelliteProgress.PiecesChecked), label)
+		skipped.Add(float64(satelliteProgress.PiecesSkipped), label)
+		deleted.Add(float64(satelliteProgress.PiecesDeleted), label)
+	}
+
+	return []Family{running, started, checked, skipped, deleted}
+}
+
+// transfers returns the number of finished uploads and downloads by their result.
+func (service *Service) transfers() []Family {
+	stats := service.endpoint.TransferStats()
+
+	uploads := Family{Name: "storagenode_uploads", Type: Counter, Help: "Finished uploads by result."}
+	uploads.Add(float64(stats.UploadsSucceeded), Label{Name: "result", Value: "success"})
+	uploads.Add(float64(stats.UploadsFailed), Label{Name: "result", Value: "failure"})
+	uploads.Add(float64(stats.UploadsCanceled), Label{Name: "result", Value: "canceled"})
+
+	downloads := Family{Name: "storagenode_downloads", Type: Counter, Help: "Finished downloads by result."}
+	downloads.Add(float64(stats.DownloadsSucceeded), Label{Name: "result", Value: "success"})
+	downloads.Add(float64(stats.DownloadsFailed), Label{Name: "result", Value: "failure"})
+	downloads.Add(float64(stats.DownloadsCanceled), Label{Name: "result", Value: "canceled"})
+
+	return []Family{uploads, downloads}
+}
+
+// gauge returns a family with a single sample in bytes.
+func gauge(name, help string, value int64) Family {
+	family := Family{Name: name, Type: Gauge, Unit: "bytes", Help: help}
+	family.Add(float64(value))
+	return family
+}
+
+func satelliteLabel(satelliteID storj.NodeID) Label {
+	return Label{Name: "satellite", Value: satelliteID.String()}
+}
+
+// sortedIDs sorts the ids, so the samples are always written in the same order.
+func sortedIDs(ids []storj.NodeID) []storj.NodeID {
+	sort.Slice(ids, func(i, k int) bool {
+		return ids[i].Less(ids[k])
+	})
+	return ids
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor
+
+import (
+	"context"
+	"time"
+)
+
+// AllocationDB stores the disk space allocation changed at runtime, so that it's kept when the node restarts.
+//
+// architecture: Database
+type AllocationDB interface {
+	// Get returns the allocation changed at runtime, or nil when it wasn't changed.
+	Get(ctx context.Context) (*Allocation, error)
+	// Set stores the allocation changed at runtime.
+	Set(ctx context.Context, allocation Allocation) error
+	// Delete removes the allocation changed at runtime.
+	Delete(ctx context.Context) error
+}
+
+// Allocation is the disk space allocation changed at runtime.
+type Allocation struct {
+	// Allocated is the disk space allocated for the node.
+	Allocated int64
+	// Configured is storage.allocated-disk-space at the time of the change. The allocation
+	// is dropped when the configuration is changed afterwards.
+	Configured int64
+	UpdatedAt  time.Time
+}
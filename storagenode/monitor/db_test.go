@@ -0,0 +1,52 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestAllocationDB(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		allocations := db.Allocation()
+
+		allocation, err := allocations.Get(ctx)
+		require.NoError(t, err)
+		require.Nil(t, allocation)
+
+		now := time.Now().UTC()
+		require.NoError(t, allocations.Set(ctx, monitor.Allocation{
+			Allocated:  (1 * memory.TB).Int64(),
+			Configured: (2 * memory.TB).Int64(),
+			UpdatedAt:  now,
+		}))
+		require.NoError(t, allocations.Set(ctx, monitor.Allocation{
+			Allocated:  (3 * memory.TB).Int64(),
+			Configured: (2 * memory.TB).Int64(),
+			UpdatedAt:  now,
+		}))
+
+		allocation, err = allocations.Get(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, allocation)
+		require.Equal(t, (3 * memory.TB).Int64(), allocation.Allocated)
+		require.Equal(t, (2 * memory.TB).Int64(), allocation.Configured)
+		require.WithinDuration(t, now, allocation.UpdatedAt, time.Second)
+
+		require.NoError(t, allocations.Delete(ctx))
+
+		allocation, err = allocations.Get(ctx)
+		require.NoError(t, err)
+		require.Nil(t, allocation)
+	})
+}
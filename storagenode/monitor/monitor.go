@@ -5,6 +5,8 @@ package monitor
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -14,10 +16,13 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
 )
 
 var (
@@ -25,6 +30,8 @@ var (
 
 	// Error is the default error class for piecestore monitor errors.
 	Error = errs.Class("piecestore monitor")
+	// ErrInvalidAllocation is returned when the requested disk space allocation can't be used.
+	ErrInvalidAllocation = errs.Class("invalid allocated disk space")
 )
 
 // DiskSpace consolidates monitored disk space statistics.
@@ -45,6 +52,7 @@ type Config struct {
 	MinimumDiskSpace          memory.Size   `help:"how much disk space a node at minimum has to advertise" default:"500GB"`
 	MinimumBandwidth          memory.Size   `help:"how much bandwidth a node at minimum has to advertise (deprecated)" default:"0TB"`
 	NotifyLowDiskCooldown     time.Duration `help:"minimum length of time between capacity reports" default:"10m" hidden:"true"`
+	NotifyDiskSpaceBelow      memory.Size   `help:"notify the operator when the available disk space drops below this size, zero disables the notification" default:"5GB"`
 }
 
 // Service which monitors disk usage.
@@ -54,22 +62,38 @@ type Service struct {
 	log                   *zap.Logger
 	store                 *pieces.Store
 	contact               *contact.Service
+	trust                 *trust.Pool
 	usageDB               bandwidth.DB
-	allocatedDiskSpace    int64
+	limiter               *bandwidth.Limiter
+	notifications         *notifications.Service
+	allocationDB          AllocationDB
+	configuredDiskSpace   int64
 	cooldown              *sync2.Cooldown
 	Loop                  *sync2.Cycle
 	VerifyDirReadableLoop *sync2.Cycle
 	VerifyDirWritableLoop *sync2.Cycle
 	Config                Config
+
+	// allocatedDiskSpace and uploadsPaused can be changed at runtime, only the change
+	// of allocatedDiskSpace is kept when the node restarts.
+	mu                 sync.Mutex
+	allocatedDiskSpace int64
+	uploadsPaused      bool
+	lowDiskNotified    bool
 }
 
 // NewService creates a new storage node monitoring service.
-func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service, usageDB bandwidth.DB, allocatedDiskSpace int64, interval time.Duration, reportCapacity func(context.Context), config Config) *Service {
+func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service, trust *trust.Pool, usageDB bandwidth.DB, limiter *bandwidth.Limiter, notifications *notifications.Service, allocationDB AllocationDB, allocatedDiskSpace int64, interval time.Duration, reportCapacity func(context.Context), config Config) *Service {
 	return &Service{
 		log:                   log,
 		store:                 store,
 		contact:               contact,
+		trust:                 trust,
 		usageDB:               usageDB,
+		limiter:               limiter,
+		notifications:         notifications,
+		allocationDB:          allocationDB,
+		configuredDiskSpace:   allocatedDiskSpace,
 		allocatedDiskSpace:    allocatedDiskSpace,
 		cooldown:              sync2.NewCooldown(config.NotifyLowDiskCooldown),
 		Loop:                  sync2.NewCycle(interval),
@@ -96,33 +120,42 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		return Error.Wrap(err)
 	}
 
+	allocatedDiskSpace, err := service.loadAllocatedDiskSpace(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	// check your hard drive is big enough
 	// first time setup as a piece node server
-	if totalUsed == 0 && freeDiskSpace < service.allocatedDiskSpace {
-		service.allocatedDiskSpace = freeDiskSpace
-		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", service.allocatedDiskSpace))
+	if totalUsed == 0 && freeDiskSpace < allocatedDiskSpace {
+		allocatedDiskSpace = freeDiskSpace
+		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", allocatedDiskSpace))
 	}
 
 	// on restarting the Piece node server, assuming already been working as a node
 	// used above the alloacated space, user changed the allocation space setting
 	// before restarting
-	if totalUsed >= service.allocatedDiskSpace {
-		service.log.Warn("Used more space than allocated. Allocated space is", zap.Int64("bytes", service.allocatedDiskSpace))
+	if totalUsed >= allocatedDiskSpace {
+		service.log.Warn("Used more space than allocated. Allocated space is", zap.Int64("bytes", allocatedDiskSpace))
 	}
 
 	// the available disk space is less than remaining allocated space,
 	// due to change of setting before restarting
-	if freeDiskSpace < service.allocatedDiskSpace-totalUsed {
-		service.allocatedDiskSpace = freeDiskSpace + totalUsed
-		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", service.allocatedDiskSpace))
+	if freeDiskSpace < allocatedDiskSpace-totalUsed {
+		allocatedDiskSpace = freeDiskSpace + totalUsed
+		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", allocatedDiskSpace))
 	}
 
 	// Ensure the disk is at least 500GB in size, which is our current minimum required to be an operator
-	if service.allocatedDiskSpace < service.Config.MinimumDiskSpace.Int64() {
+	if allocatedDiskSpace < service.Config.MinimumDiskSpace.Int64() {
 		service.log.Error("Total disk space is less than required minimum", zap.Int64("bytes", service.Config.MinimumDiskSpace.Int64()))
 		return Error.New("disk space requirement not met")
 	}
 
+	service.mu.Lock()
+	service.allocatedDiskSpace = allocatedDiskSpace
+	service.mu.Unlock()
+
 	group, ctx := errgroup.WithContext(ctx)
 	group.Go(func() error {
 		return service.VerifyDirReadableLoop.Run(ctx, func(ctx context.Context) error {
@@ -144,7 +177,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	})
 	group.Go(func() error {
 		return service.Loop.Run(ctx, func(ctx context.Context) error {
-			err := service.updateNodeInformation(ctx)
+			err := service.UpdateNodeInformation(ctx)
 			if err != nil {
 				service.log.Error("error during updating node information: ", zap.Error(err))
 			}
@@ -152,7 +185,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		})
 	})
 	service.cooldown.Start(ctx, group, func(ctx context.Context) error {
-		err := service.updateNodeInformation(ctx)
+		err := service.UpdateNodeInformation(ctx)
 		if err != nil {
 			service.log.Error("error during updating node information: ", zap.Error(err))
 			return nil
@@ -180,13 +213,119 @@ func (service *Service) Close() (err error) {
 	return nil
 }
 
-func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
+// AllocatedDiskSpace returns the disk space allocated for the node.
+func (service *Service) AllocatedDiskSpace() int64 {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.allocatedDiskSpace
+}
+
+// loadAllocatedDiskSpace returns the disk space allocation changed at runtime, unless
+// storage.allocated-disk-space was changed in the configuration since.
+func (service *Service) loadAllocatedDiskSpace(ctx context.Context) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	allocation, err := service.allocationDB.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if allocation == nil {
+		return service.configuredDiskSpace, nil
+	}
+
+	if allocation.Configured != service.configuredDiskSpace {
+		service.log.Info("Allocated disk space changed in the configuration, the change made at runtime is dropped",
+			zap.Int64("bytes", service.configuredDiskSpace), zap.Int64("dropped bytes", allocation.Allocated))
+		return service.configuredDiskSpace, service.allocationDB.Delete(ctx)
+	}
+
+	service.log.Info("Using allocated disk space changed at runtime", zap.Int64("bytes", allocation.Allocated), zap.Time("changed at", allocation.UpdatedAt))
+	return allocation.Allocated, nil
+}
+
+// SetAllocatedDiskSpace changes the disk space allocated for the node and reports the new capacity to satellites.
+// The change is kept when the node restarts, until storage.allocated-disk-space is changed in the configuration.
+func (service *Service) SetAllocatedDiskSpace(ctx context.Context, allocated int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if allocated < service.Config.MinimumDiskSpace.Int64() {
+		return ErrInvalidAllocation.New("%s is less than required minimum %s", memory.Size(allocated), service.Config.MinimumDiskSpace)
+	}
+
+	storageStatus, err := service.store.StorageStatus(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	totalUsed, err := service.store.SpaceUsedForPiecesAndTrash(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if allocated > storageStatus.DiskFree+totalUsed {
+		return ErrInvalidAllocation.New("%s is more than the disk can hold %s", memory.Size(allocated), memory.Size(storageStatus.DiskFree+totalUsed))
+	}
+
+	err = service.allocationDB.Set(ctx, Allocation{
+		Allocated:  allocated,
+		Configured: service.configuredDiskSpace,
+		UpdatedAt:  time.Now().UTC(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.mu.Lock()
+	service.allocatedDiskSpace = allocated
+	service.mu.Unlock()
+
+	service.log.Info("Allocated disk space changed", zap.Int64("bytes", allocated))
+	service.NotifyLowDisk()
+	return nil
+}
+
+// UploadsPaused returns whether the node rejects new uploads.
+func (service *Service) UploadsPaused() bool {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.uploadsPaused
+}
+
+// SetUploadsPaused pauses or resumes new uploads. While uploads are paused the node reports no free
+// disk space to satellites, so that it isn't selected for new uploads.
+// The change is temporary, uploads are resumed when the node restarts.
+func (service *Service) SetUploadsPaused(paused bool) {
+	service.mu.Lock()
+	service.uploadsPaused = paused
+	service.mu.Unlock()
+
+	service.log.Info("Uploads paused changed", zap.Bool("paused", paused))
+	service.NotifyLowDisk()
+}
+
+// UpdateNodeInformation updates the capacity, which the contact service reports to the satellites.
+func (service *Service) UpdateNodeInformation(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	freeSpace, err := service.AvailableSpace(ctx)
 	if err != nil {
 		return err
 	}
+	service.notifyLowDisk(ctx, freeSpace)
+
+	// the node doesn't accept uploads while they are paused or the egress budget is spent.
+	if service.UploadsPaused() || (service.limiter != nil && service.limiter.EgressBudgetSpent()) {
+		freeSpace = 0
+	}
+
+	capacities := make(map[storj.NodeID]pb.NodeCapacity)
+	for _, policy := range service.trust.GetPolicies() {
+		satelliteFreeSpace, err := service.limitAvailableSpace(ctx, policy, freeSpace)
+		if err != nil {
+			return err
+		}
+		capacities[policy.SatelliteID] = pb.NodeCapacity{FreeDisk: satelliteFreeSpace}
+	}
+
+	service.contact.UpdateSatelliteCapacities(capacities)
 	service.contact.UpdateSelf(&pb.NodeCapacity{
 		FreeDisk: freeSpace,
 	})
@@ -194,6 +333,36 @@ func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 	return nil
 }
 
+// notifyLowDisk notifies the operator once when the available disk space drops below
+// the configured size, the notification is sent again after the disk space recovered.
+func (service *Service) notifyLowDisk(ctx context.Context, available int64) {
+	threshold := service.Config.NotifyDiskSpaceBelow.Int64()
+	if service.notifications == nil || threshold <= 0 {
+		return
+	}
+
+	low := available < threshold
+
+	service.mu.Lock()
+	notify := low && !service.lowDiskNotified
+	service.lowDiskNotified = low
+	service.mu.Unlock()
+
+	if !notify {
+		return
+	}
+
+	_, err := service.notifications.Receive(ctx, notifications.NewNotification{
+		SenderID: service.contact.Local().ID,
+		Type:     notifications.TypeLowDisk,
+		Title:    "Your Node is running out of disk space",
+		Message:  fmt.Sprintf("Only %s of the allocated disk space is available. The Node isn't selected for new uploads once it's full.", memory.Size(available)),
+	})
+	if err != nil {
+		service.log.Error("failed to create low disk notification", zap.Error(err))
+	}
+}
+
 // AvailableSpace returns available disk space for upload.
 func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -203,7 +372,8 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		return 0, err
 	}
 
-	freeSpaceForStorj := service.allocatedDiskSpace - usedSpace
+	allocatedDiskSpace := service.AllocatedDiskSpace()
+	freeSpaceForStorj := allocatedDiskSpace - usedSpace
 
 	diskStatus, err := service.store.StorageStatus(ctx)
 	if err != nil {
@@ -213,13 +383,52 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		freeSpaceForStorj = diskStatus.DiskFree
 	}
 
-	mon.IntVal("allocated_space").Observe(service.allocatedDiskSpace)
+	mon.IntVal("allocated_space").Observe(allocatedDiskSpace)
 	mon.IntVal("used_space").Observe(usedSpace)
 	mon.IntVal("available_space").Observe(freeSpaceForStorj)
 
 	return freeSpaceForStorj, nil
 }
 
+// AvailableSpaceForSatellite returns available disk space for upload of the satellite,
+// limited by the upload policy of the satellite.
+func (service *Service) AvailableSpaceForSatellite(ctx context.Context, satelliteID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	available, err := service.AvailableSpace(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return service.limitAvailableSpace(ctx, service.trust.GetPolicy(satelliteID), available)
+}
+
+// limitAvailableSpace limits the available disk space by the policy of the satellite.
+func (service *Service) limitAvailableSpace(ctx context.Context, policy trust.Policy, available int64) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if policy.NoUploads {
+		return 0, nil
+	}
+	if policy.AllocatedDiskSpace <= 0 {
+		return available, nil
+	}
+
+	usedSpace, _, err := service.store.SpaceUsedBySatellite(ctx, policy.SatelliteID)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	remaining := policy.AllocatedDiskSpace.Int64() - usedSpace
+	if remaining < 0 {
+		remaining = 0
+	}
+	if remaining < available {
+		return remaining, nil
+	}
+	return available, nil
+}
+
 // DiskSpace returns consolidated disk space state info.
 func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -240,7 +449,8 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 
 	overused := int64(0)
 
-	available := service.allocatedDiskSpace - (usedForPieces + usedForTrash)
+	allocatedDiskSpace := service.AllocatedDiskSpace()
+	available := allocatedDiskSpace - (usedForPieces + usedForTrash)
 	if available < 0 {
 		overused = -available
 	}
@@ -249,7 +459,7 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 	}
 
 	return DiskSpace{
-		Allocated:     service.allocatedDiskSpace,
+		Allocated:     allocatedDiskSpace,
 		UsedForPieces: usedForPieces,
 		UsedForTrash:  usedForTrash,
 		Free:          storageStatus.DiskFree,
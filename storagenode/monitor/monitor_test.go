@@ -9,11 +9,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/identity/testidentity"
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/notifications"
 )
 
 func TestMonitor(t *testing.T) {
@@ -43,3 +47,72 @@ func TestMonitor(t *testing.T) {
 		assert.NotZero(t, nodeAssertions, "No storage node were verifed")
 	})
 }
+
+func TestMonitorSatellitePolicies(t *testing.T) {
+	// testplanet creates the satellites from the first pregenerated identities.
+	satellite0 := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	satellite1 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 3, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				err := config.Storage2.Trust.Policies.Set(satellite0.String() + "=no-uploads," + satellite1.String() + "=1MB")
+				require.NoError(t, err)
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		require.Equal(t, satellite0, planet.Satellites[0].ID())
+		require.Equal(t, satellite1, planet.Satellites[1].ID())
+
+		node := planet.StorageNodes[0]
+		node.Storage2.Monitor.Loop.TriggerWait()
+
+		available, err := node.Storage2.Monitor.AvailableSpace(ctx)
+		require.NoError(t, err)
+		require.Greater(t, available, memory.MB.Int64())
+
+		for _, tt := range []struct {
+			satelliteID storj.NodeID
+			freeDisk    int64
+		}{
+			{satellite0, 0},
+			{satellite1, memory.MB.Int64()},
+			{planet.Satellites[2].ID(), available},
+		} {
+			satelliteAvailable, err := node.Storage2.Monitor.AvailableSpaceForSatellite(ctx, tt.satelliteID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.freeDisk, satelliteAvailable)
+			assert.Equal(t, tt.freeDisk, node.Contact.Service.LocalFor(tt.satelliteID).Capacity.FreeDisk)
+		}
+	})
+}
+
+func TestLowDiskNotification(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Storage2.Monitor.NotifyDiskSpaceBelow = 100 * memory.PB
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		node.Storage2.Monitor.Loop.Pause()
+
+		// the operator is notified only once while the disk space stays low.
+		require.NoError(t, node.Storage2.Monitor.UpdateNodeInformation(ctx))
+		require.NoError(t, node.Storage2.Monitor.UpdateNodeInformation(ctx))
+
+		page, err := node.DB.Notifications().List(ctx, notifications.Cursor{Limit: 10, Page: 1})
+		require.NoError(t, err)
+
+		var lowDisk int
+		for _, notification := range page.Notifications {
+			if notification.Type == notifications.TypeLowDisk {
+				lowDisk++
+			}
+		}
+		require.Equal(t, 1, lowDisk)
+	})
+}
@@ -24,3 +24,13 @@ func authenticate(ctx context.Context, apiKeys *apikeys.Service, header *multino
 
 	return nil
 }
+
+// authenticateAdmin checks if request header contains valid admin api key.
+func authenticateAdmin(ctx context.Context, apiKeys *apikeys.Service, header *multinodepb.RequestHeader) error {
+	secret, err := multinodeauth.SecretFromBytes(header.GetApiKey())
+	if err != nil {
+		return err
+	}
+
+	return apiKeys.CheckAdmin(ctx, secret)
+}
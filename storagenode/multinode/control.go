@@ -0,0 +1,271 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/trust"
+)
+
+// ensures that ControlEndpoint implements multinodepb.DRPCControlServer.
+var _ multinodepb.DRPCControlServer = (*ControlEndpoint)(nil)
+
+// ControlEndpoint implements multinode control endpoint, which allows to change
+// node's configuration and to control its services. Every request requires an admin api key.
+//
+// architecture: Endpoint
+type ControlEndpoint struct {
+	multinodepb.DRPCControlUnimplementedServer
+
+	log           *zap.Logger
+	nodeID        storj.NodeID
+	apiKeys       *apikeys.Service
+	monitor       *monitor.Service
+	store         *pieces.Store
+	cache         *pieces.CacheService
+	retain        *retain.Service
+	trust         *trust.Pool
+	satellites    satellites.DB
+	gracefulExit  *gracefulexit.Endpoint
+	notifications *notifications.Service
+}
+
+// NewControlEndpoint creates new multinode control endpoint.
+func NewControlEndpoint(log *zap.Logger, nodeID storj.NodeID, apiKeys *apikeys.Service, monitor *monitor.Service, store *pieces.Store, cache *pieces.CacheService, retain *retain.Service, trust *trust.Pool, satellites satellites.DB, gracefulExit *gracefulexit.Endpoint, notifications *notifications.Service) *ControlEndpoint {
+	return &ControlEndpoint{
+		log:           log,
+		nodeID:        nodeID,
+		apiKeys:       apiKeys,
+		monitor:       monitor,
+		store:         store,
+		cache:         cache,
+		retain:        retain,
+		trust:         trust,
+		satellites:    satellites,
+		gracefulExit:  gracefulExit,
+		notifications: notifications,
+	}
+}
+
+// SetAllocatedDiskSpace changes the disk space allocated for the node, the change is kept when the node restarts.
+func (control *ControlEndpoint) SetAllocatedDiskSpace(ctx context.Context, req *multinodepb.SetAllocatedDiskSpaceRequest) (_ *multinodepb.SetAllocatedDiskSpaceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = control.authenticate(ctx, req.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	if err = control.monitor.SetAllocatedDiskSpace(ctx, req.Allocated); err != nil {
+		if monitor.ErrInvalidAllocation.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+		}
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	control.notify(ctx, "Allocated disk space changed",
+		fmt.Sprintf("Allocated disk space was changed to %s from the multinode dashboard. "+
+			"The change is dropped when storage.allocated-disk-space is changed in the configuration.", memory.Size(req.Allocated)))
+
+	return &multinodepb.SetAllocatedDiskSpaceResponse{
+		Allocated: control.monitor.AllocatedDiskSpace(),
+	}, nil
+}
+
+// InitiateGracefulExit starts graceful exit from the satellite.
+func (control *ControlEndpoint) InitiateGracefulExit(ctx context.Context, req *multinodepb.ControlInitiateGracefulExitRequest) (_ *multinodepb.ControlInitiateGracefulExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = control.authenticate(ctx, req.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	exits, err := control.satellites.ListGracefulExits(ctx)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+	for _, exit := range exits {
+		if exit.SatelliteID == req.SatelliteId {
+			return nil, rpcstatus.Errorf(rpcstatus.FailedPrecondition, "graceful exit from satellite %s is already initiated", req.SatelliteId)
+		}
+	}
+
+	address, bytesToTransfer, err := control.gracefulExit.Initiate(ctx, req.SatelliteId)
+	if err != nil {
+		if trust.Error.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	control.notify(ctx, "Graceful exit initiated",
+		fmt.Sprintf("Graceful exit from satellite %s was initiated from the multinode dashboard.", address))
+
+	return &multinodepb.ControlInitiateGracefulExitResponse{
+		SatelliteAddress: address,
+		BytesToTransfer:  bytesToTransfer,
+	}, nil
+}
+
+// Trash returns the disk space used by the trash.
+func (control *ControlEndpoint) Trash(ctx context.Context, req *multinodepb.TrashRequest) (_ *multinodepb.TrashResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = control.authenticate(ctx, req.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	used, err := control.store.SpaceUsedForTrash(ctx)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	return &multinodepb.TrashResponse{
+		Used: used,
+	}, nil
+}
+
+// EmptyTrash deletes all pieces in the trash of the trusted satellites.
+func (control *ControlEndpoint) EmptyTrash(ctx context.Context, req *multinodepb.EmptyTrashRequest) (_ *multinodepb.EmptyTrashResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = control.authenticate(ctx, req.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	before, err := control.store.SpaceUsedForTrash(ctx)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	var group errs.Group
+	now := time.Now()
+	for _, satelliteID := range control.trust.GetSatellites(ctx) {
+		group.Add(control.store.EmptyTrash(ctx, satelliteID, now))
+	}
+
+	after, usedErr := control.store.SpaceUsedForTrash(ctx)
+	if usedErr != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, errs.Combine(group.Err(), usedErr))
+	}
+
+	freed := before - after
+	if freed < 0 {
+		freed = 0
+	}
+	control.notify(ctx, "Trash emptied",
+		fmt.Sprintf("%s of trash was deleted from the multinode dashboard.", memory.Size(freed)))
+
+	if err = group.Err(); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	return &multinodepb.EmptyTrashResponse{
+		Freed: freed,
+	}, nil
+}
+
+// SetUploadsPaused pauses or resumes new uploads until the node restarts.
+func (control *ControlEndpoint) SetUploadsPaused(ctx context.Context, req *multinodepb.SetUploadsPausedRequest) (_ *multinodepb.SetUploadsPausedResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = control.authenticate(ctx, req.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	if control.monitor.UploadsPaused() != req.Paused {
+		control.monitor.SetUploadsPaused(req.Paused)
+
+		if req.Paused {
+			control.notify(ctx, "Uploads paused", "New uploads were paused from the multinode dashboard. Uploads are resumed when the Node restarts.")
+		} else {
+			control.notify(ctx, "Uploads resumed", "New uploads were resumed from the multinode dashboard.")
+		}
+	}
+
+	return &multinodepb.SetUploadsPausedResponse{
+		Paused: control.monitor.UploadsPaused(),
+	}, nil
+}
+
+// RestartRetain walks the pieces of the satellite again with the last received bloom filter,
+// if the garbage collection with it failed or was interrupted.
+func (control *ControlEndpoint) RestartRetain(ctx context.Context, req *multinodepb.RestartRetainRequest) (_ *multinodepb.RestartRetainResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = control.authenticate(ctx, req.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	queued := control.retain.Restart(req.SatelliteId)
+	if queued {
+		control.notify(ctx, "Garbage collection restarted",
+			fmt.Sprintf("Garbage collection for satellite %s was restarted from the multinode dashboard.", req.SatelliteId))
+	}
+
+	return &multinodepb.RestartRetainResponse{
+		Queued: queued,
+	}, nil
+}
+
+// RestartUsedSpaceWalker recalculates the disk space used by pieces and the trash.
+func (control *ControlEndpoint) RestartUsedSpaceWalker(ctx context.Context, req *multinodepb.RestartUsedSpaceWalkerRequest) (_ *multinodepb.RestartUsedSpaceWalkerResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = control.authenticate(ctx, req.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	triggered := control.cache.TriggerRecalculation()
+	if triggered {
+		control.notify(ctx, "Used space recalculation started",
+			"Recalculation of the used disk space was started from the multinode dashboard.")
+	}
+
+	return &multinodepb.RestartUsedSpaceWalkerResponse{
+		Triggered: triggered,
+	}, nil
+}
+
+// authenticate checks that the request is made with an admin api key.
+func (control *ControlEndpoint) authenticate(ctx context.Context, header *multinodepb.RequestHeader) error {
+	err := authenticateAdmin(ctx, control.apiKeys, header)
+	switch {
+	case err == nil:
+		return nil
+	case apikeys.ErrNotAdmin.Has(err):
+		return rpcstatus.Wrap(rpcstatus.PermissionDenied, err)
+	default:
+		return rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+}
+
+// notify records the change in the node's notifications.
+func (control *ControlEndpoint) notify(ctx context.Context, title, message string) {
+	_, err := control.notifications.Receive(ctx, notifications.NewNotification{
+		SenderID: control.nodeID,
+		Type:     notifications.TypeCustom,
+		Title:    title,
+		Message:  message,
+	})
+	if err != nil {
+		control.log.Error("failed to record notification", zap.String("title", title), zap.Error(err))
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/multinode"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestControlEndpoint(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		nodeID := testrand.NodeID()
+		satelliteID := testrand.NodeID()
+
+		apiKeys := apikeys.NewService(db.APIKeys())
+		notificationsService := notifications.NewService(log, db.Notifications())
+		monitorService := monitor.NewService(log, nil, nil, nil, nil, nil, nil, db.Allocation(), 0, time.Hour, nil, monitor.Config{})
+		retainService := retain.NewService(log, nil, retain.Config{})
+		cacheService := pieces.NewService(log, nil, nil, time.Hour)
+
+		endpoint := multinode.NewControlEndpoint(log, nodeID, apiKeys, monitorService, nil, cacheService, retainService, nil, db.Satellites(), nil, notificationsService)
+
+		readOnly, err := apiKeys.Issue(ctx)
+		require.NoError(t, err)
+		admin, err := apiKeys.IssueAdmin(ctx)
+		require.NoError(t, err)
+
+		adminHeader := &multinodepb.RequestHeader{ApiKey: admin.Secret[:]}
+
+		t.Run("authentication", func(t *testing.T) {
+			_, err := endpoint.SetUploadsPaused(ctx, &multinodepb.SetUploadsPausedRequest{
+				Header: &multinodepb.RequestHeader{ApiKey: testrand.BytesInt(32)},
+				Paused: true,
+			})
+			require.Equal(t, rpcstatus.Unauthenticated, rpcstatus.Code(err))
+
+			_, err = endpoint.SetUploadsPaused(ctx, &multinodepb.SetUploadsPausedRequest{
+				Header: &multinodepb.RequestHeader{ApiKey: readOnly.Secret[:]},
+				Paused: true,
+			})
+			require.Equal(t, rpcstatus.PermissionDenied, rpcstatus.Code(err))
+			require.False(t, monitorService.UploadsPaused())
+		})
+
+		t.Run("uploads paused", func(t *testing.T) {
+			response, err := endpoint.SetUploadsPaused(ctx, &multinodepb.SetUploadsPausedRequest{
+				Header: adminHeader,
+				Paused: true,
+			})
+			require.NoError(t, err)
+			require.True(t, response.Paused)
+			require.True(t, monitorService.UploadsPaused())
+
+			// pausing again doesn't record another notification.
+			_, err = endpoint.SetUploadsPaused(ctx, &multinodepb.SetUploadsPausedRequest{
+				Header: adminHeader,
+				Paused: true,
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("restart retain", func(t *testing.T) {
+			response, err := endpoint.RestartRetain(ctx, &multinodepb.RestartRetainRequest{
+				Header:      adminHeader,
+				SatelliteId: satelliteID,
+			})
+			require.NoError(t, err)
+			require.False(t, response.Queued)
+
+			require.True(t, retainService.Queue(retain.Request{SatelliteID: satelliteID, CreatedBefore: time.Now()}))
+
+			response, err = endpoint.RestartRetain(ctx, &multinodepb.RestartRetainRequest{
+				Header:      adminHeader,
+				SatelliteId: satelliteID,
+			})
+			require.NoError(t, err)
+			require.True(t, response.Queued)
+		})
+
+		t.Run("restart used space walker", func(t *testing.T) {
+			response, err := endpoint.RestartUsedSpaceWalker(ctx, &multinodepb.RestartUsedSpaceWalkerRequest{
+				Header: adminHeader,
+			})
+			require.NoError(t, err)
+			require.True(t, response.Triggered)
+
+			// the recalculation is still pending.
+			response, err = endpoint.RestartUsedSpaceWalker(ctx, &multinodepb.RestartUsedSpaceWalkerRequest{
+				Header: adminHeader,
+			})
+			require.NoError(t, err)
+			require.False(t, response.Triggered)
+		})
+
+		t.Run("graceful exit of untrusted satellite", func(t *testing.T) {
+			require.NoError(t, db.Satellites().InitiateGracefulExit(ctx, satelliteID, time.Now().UTC(), 0))
+
+			_, err := endpoint.InitiateGracefulExit(ctx, &multinodepb.ControlInitiateGracefulExitRequest{
+				Header:      adminHeader,
+				SatelliteId: satelliteID,
+			})
+			require.Equal(t, rpcstatus.FailedPrecondition, rpcstatus.Code(err))
+		})
+
+		page, err := notificationsService.List(ctx, notifications.Cursor{Limit: 10, Page: 1})
+		require.NoError(t, err)
+		require.Len(t, page.Notifications, 3)
+		for _, notification := range page.Notifications {
+			require.Equal(t, nodeID, notification.SenderID)
+		}
+	})
+}
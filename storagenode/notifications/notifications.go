@@ -5,12 +5,18 @@ package notifications
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 )
 
+// Error is the default error class for notifications package.
+var Error = errs.Class("notifications")
+
 // DB tells how application works with notifications database.
 //
 // architecture: Database
@@ -35,8 +41,39 @@ const (
 	TypeDisqualification Type = 2
 	// TypeSuspension is a notification type which describes node's suspension status.
 	TypeSuspension Type = 3
+	// TypeLowDisk is a notification type which describes that node is running out of disk space.
+	TypeLowDisk Type = 4
+	// TypeOffline is a notification type which describes that node failed to contact a satellite.
+	TypeOffline Type = 5
 )
 
+var typeNames = map[Type]string{
+	TypeCustom:            "custom",
+	TypeAuditCheckFailure: "audit-check-failure",
+	TypeDisqualification:  "disqualification",
+	TypeSuspension:        "suspension",
+	TypeLowDisk:           "low-disk",
+	TypeOffline:           "offline",
+}
+
+// String returns the name of the notification type.
+func (t Type) String() string {
+	if name, ok := typeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("type(%d)", int(t))
+}
+
+// ParseType parses the name of the notification type.
+func ParseType(name string) (Type, error) {
+	for t, typeName := range typeNames {
+		if typeName == name {
+			return t, nil
+		}
+	}
+	return 0, Error.New("unknown notification type %q", name)
+}
+
 // NewNotification holds notification entity info which is being received from satellite or local client.
 type NewNotification struct {
 	SenderID storj.NodeID
@@ -30,18 +30,40 @@ const (
 	TimesNotifiedLast TimesNotified = 3
 )
 
+// queueSize is the number of notifications waiting for the delivery to the sinks.
+const queueSize = 100
+
 // Service is the notification service between storage nodes and satellites.
 // architecture: Service
 type Service struct {
-	log *zap.Logger
-	db  DB
+	log    *zap.Logger
+	db     DB
+	routes []*Route
+	queue  chan Notification
 }
 
 // NewService creates a new notification service.
-func NewService(log *zap.Logger, db DB) *Service {
+// Received notifications are also delivered to the sinks of the routes, while Run is running.
+func NewService(log *zap.Logger, db DB, routes ...*Route) *Service {
 	return &Service{
-		log: log,
-		db:  db,
+		log:    log,
+		db:     db,
+		routes: routes,
+		queue:  make(chan Notification, queueSize),
+	}
+}
+
+// Run delivers the received notifications to the sinks.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-service.queue:
+			service.deliver(ctx, notification)
+		}
 	}
 }
 
@@ -52,9 +74,35 @@ func (service *Service) Receive(ctx context.Context, newNotification NewNotifica
 		return Notification{}, err
 	}
 
+	if len(service.routes) > 0 {
+		select {
+		case service.queue <- notification:
+		default:
+			service.log.Warn("notification delivery queue is full, notification is not delivered to the sinks",
+				zap.Stringer("id", notification.ID), zap.String("title", notification.Title))
+		}
+	}
+
 	return notification, nil
 }
 
+// deliver sends the notification to the sinks which accept its type.
+func (service *Service) deliver(ctx context.Context, notification Notification) {
+	for _, route := range service.routes {
+		if !route.Accepts(notification.Type) {
+			continue
+		}
+
+		err := route.Deliver(ctx, notification)
+		switch {
+		case ErrRateLimited.Has(err):
+			service.log.Warn("notification delivery rate limit exceeded", zap.String("sink", route.Name()), zap.Stringer("id", notification.ID))
+		case err != nil:
+			service.log.Error("failed to deliver notification", zap.String("sink", route.Name()), zap.Stringer("id", notification.ID), zap.Error(err))
+		}
+	}
+}
+
 // Read - change notification status to Read by ID.
 func (service *Service) Read(ctx context.Context, notificationID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
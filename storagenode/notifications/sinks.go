@@ -0,0 +1,223 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/notify"
+)
+
+var (
+	// ErrSink is the error class for failing notification sinks.
+	ErrSink = errs.Class("notifications sink")
+
+	// ErrRateLimited is returned when the rate limit of the sink doesn't allow to deliver a notification.
+	ErrRateLimited = errs.Class("notifications sink rate limited")
+)
+
+// Config contains configuration of the sinks the notifications are delivered to.
+type Config struct {
+	SMTP    SMTPConfig
+	Webhook WebhookConfig
+	Command CommandConfig
+}
+
+// RouteConfig defines which notifications are delivered to the sink and how often.
+type RouteConfig struct {
+	Types     string `help:"comma separated notification types delivered to the sink (custom, audit-check-failure, disqualification, suspension, low-disk, offline), empty delivers all types" default:""`
+	RateLimit int    `help:"maximum number of notifications delivered to the sink per hour, zero disables the limit" default:"10"`
+}
+
+// SMTPConfig contains configuration of the e-mail sink.
+type SMTPConfig struct {
+	notify.SMTPConfig
+	RouteConfig
+}
+
+// WebhookConfig contains configuration of the webhook sink.
+type WebhookConfig struct {
+	notify.WebhookConfig
+	RouteConfig
+}
+
+// CommandConfig contains configuration of the command sink.
+type CommandConfig struct {
+	notify.CommandConfig
+	RouteConfig
+}
+
+// Sink delivers notifications outside of the node.
+type Sink interface {
+	// Name returns the name of the sink used in the logs.
+	Name() string
+	// Send delivers the notification.
+	Send(ctx context.Context, notification Notification) error
+}
+
+// Route delivers the notifications of the selected types to a sink, limiting how often they are delivered.
+type Route struct {
+	sink    Sink
+	types   map[Type]bool
+	limiter *rate.Limiter
+}
+
+// NewRoute creates a new route to the sink.
+func NewRoute(sink Sink, config RouteConfig) (*Route, error) {
+	route := &Route{
+		sink:    sink,
+		limiter: rate.NewLimiter(rate.Inf, 1),
+	}
+
+	if config.Types != "" {
+		route.types = make(map[Type]bool)
+		for _, name := range strings.Split(config.Types, ",") {
+			t, err := ParseType(strings.TrimSpace(name))
+			if err != nil {
+				return nil, ErrSink.New("%s: %v", sink.Name(), err)
+			}
+			route.types[t] = true
+		}
+	}
+
+	if config.RateLimit > 0 {
+		route.limiter = rate.NewLimiter(rate.Every(time.Hour/time.Duration(config.RateLimit)), config.RateLimit)
+	}
+
+	return route, nil
+}
+
+// NewRoutes creates routes to the sinks enabled in the config.
+func NewRoutes(nodeID storj.NodeID, config Config) (routes []*Route, err error) {
+	add := func(sink Sink, config RouteConfig) error {
+		route, err := NewRoute(sink, config)
+		if err != nil {
+			return err
+		}
+		routes = append(routes, route)
+		return nil
+	}
+
+	if config.SMTP.ServerAddress != "" {
+		notifier, err := notify.NewSMTPNotifier(config.SMTP.SMTPConfig)
+		if err != nil {
+			return nil, ErrSink.Wrap(err)
+		}
+		if err := add(NewSink(nodeID, notifier), config.SMTP.RouteConfig); err != nil {
+			return nil, err
+		}
+	}
+	if config.Webhook.URL != "" {
+		notifier := notify.NewWebhookNotifier(config.Webhook.WebhookConfig)
+		if err := add(NewSink(nodeID, notifier), config.Webhook.RouteConfig); err != nil {
+			return nil, err
+		}
+	}
+	if config.Command.Path != "" {
+		notifier := notify.NewCommandNotifier(config.Command.CommandConfig)
+		if err := add(NewSink(nodeID, notifier), config.Command.RouteConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	return routes, nil
+}
+
+// Name returns the name of the sink.
+func (route *Route) Name() string { return route.sink.Name() }
+
+// Accepts returns whether the notifications of the type are delivered to the sink.
+func (route *Route) Accepts(t Type) bool {
+	return route.types == nil || route.types[t]
+}
+
+// Deliver sends the notification to the sink, unless the rate limit is exceeded.
+func (route *Route) Deliver(ctx context.Context, notification Notification) error {
+	if !route.limiter.Allow() {
+		return ErrRateLimited.New("%s: notification %q dropped", route.Name(), notification.Title)
+	}
+	return route.sink.Send(ctx, notification)
+}
+
+// Test sends a test notification to the sink regardless of the types and the rate limit.
+func (route *Route) Test(ctx context.Context) error {
+	id, err := uuid.New()
+	if err != nil {
+		return ErrSink.Wrap(err)
+	}
+
+	return route.sink.Send(ctx, Notification{
+		ID:        id,
+		Type:      TypeCustom,
+		Title:     "Test notification",
+		Message:   "The storage node notifications are delivered to " + route.Name() + ".",
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+// NewSink creates a sink delivering the notifications of the node with the notifier.
+//
+// The Payload is posted by webhooks and passed to commands as json on the
+// standard input, while the main fields of the notification are passed to
+// commands as NOTIFICATION_* environment variables.
+func NewSink(nodeID storj.NodeID, notifier notify.Notifier) Sink {
+	return &notifierSink{
+		nodeID:   nodeID,
+		notifier: notifier,
+	}
+}
+
+// Payload is the json body delivered by the webhook and command sinks.
+type Payload struct {
+	NodeID       storj.NodeID `json:"nodeId"`
+	TypeName     string       `json:"typeName"`
+	Notification Notification `json:"notification"`
+}
+
+// notifierSink delivers the notifications as notify messages.
+type notifierSink struct {
+	nodeID   storj.NodeID
+	notifier notify.Notifier
+}
+
+// Name implements Sink.
+func (sink *notifierSink) Name() string { return sink.notifier.Name() }
+
+// Send implements Sink.
+func (sink *notifierSink) Send(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fields := []notify.Field{
+		{Label: "Node", Env: "NOTIFICATION_NODE_ID", Value: sink.nodeID.String()},
+	}
+	if !notification.SenderID.IsZero() {
+		fields = append(fields, notify.Field{Label: "Satellite", Value: notification.SenderID.String()})
+	}
+	fields = append(fields,
+		notify.Field{Env: "NOTIFICATION_SENDER_ID", Value: notification.SenderID.String()},
+		notify.Field{Label: "Type", Env: "NOTIFICATION_TYPE", Value: notification.Type.String()},
+		notify.Field{Env: "NOTIFICATION_TITLE", Value: notification.Title},
+		notify.Field{Env: "NOTIFICATION_MESSAGE", Value: notification.Message},
+		notify.Field{Label: "Created at", Value: notification.CreatedAt.Format(time.RFC3339)},
+	)
+
+	return sink.notifier.Notify(ctx, notify.Message{
+		Subject: fmt.Sprintf("[storagenode %s] %s", sink.nodeID.String(), notification.Title),
+		Fields:  fields,
+		Body:    notification.Message,
+		Payload: Payload{
+			NodeID:       sink.nodeID,
+			TypeName:     notification.Type.String(),
+			Notification: notification,
+		},
+	})
+}
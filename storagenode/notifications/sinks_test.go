@@ -0,0 +1,198 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/notify"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func testNotification() notifications.Notification {
+	return notifications.Notification{
+		ID:        testrand.UUID(),
+		SenderID:  testrand.NodeID(),
+		Type:      notifications.TypeSuspension,
+		Title:     "Your Node is suspended",
+		Message:   "Your Node is suspended since 2021-08-01.",
+		CreatedAt: time.Date(2021, 8, 1, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+// recordingSink records the notifications sent to it.
+type recordingSink struct {
+	sent chan notifications.Notification
+}
+
+func (sink *recordingSink) Name() string { return "recording" }
+
+func (sink *recordingSink) Send(ctx context.Context, notification notifications.Notification) error {
+	sink.sent <- notification
+	return nil
+}
+
+func TestParseType(t *testing.T) {
+	for _, typ := range []notifications.Type{
+		notifications.TypeCustom,
+		notifications.TypeAuditCheckFailure,
+		notifications.TypeDisqualification,
+		notifications.TypeSuspension,
+		notifications.TypeLowDisk,
+		notifications.TypeOffline,
+	} {
+		parsed, err := notifications.ParseType(typ.String())
+		require.NoError(t, err)
+		require.Equal(t, typ, parsed)
+	}
+
+	_, err := notifications.ParseType("unknown")
+	require.Error(t, err)
+}
+
+func TestRoute(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	sink := &recordingSink{sent: make(chan notifications.Notification, 10)}
+
+	_, err := notifications.NewRoute(sink, notifications.RouteConfig{Types: "suspension,unknown"})
+	require.Error(t, err)
+
+	route, err := notifications.NewRoute(sink, notifications.RouteConfig{Types: "suspension, disqualification", RateLimit: 2})
+	require.NoError(t, err)
+
+	require.True(t, route.Accepts(notifications.TypeSuspension))
+	require.True(t, route.Accepts(notifications.TypeDisqualification))
+	require.False(t, route.Accepts(notifications.TypeCustom))
+
+	notification := testNotification()
+	require.NoError(t, route.Deliver(ctx, notification))
+	require.NoError(t, route.Deliver(ctx, notification))
+	require.True(t, notifications.ErrRateLimited.Has(route.Deliver(ctx, notification)))
+	require.Len(t, sink.sent, 2)
+
+	// test notifications ignore the rate limit and the types.
+	require.NoError(t, route.Test(ctx))
+	require.Len(t, sink.sent, 3)
+
+	unfiltered, err := notifications.NewRoute(sink, notifications.RouteConfig{})
+	require.NoError(t, err)
+	require.True(t, unfiltered.Accepts(notifications.TypeCustom))
+}
+
+func TestServiceDelivery(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		sink := &recordingSink{sent: make(chan notifications.Notification, 10)}
+		route, err := notifications.NewRoute(sink, notifications.RouteConfig{Types: "suspension"})
+		require.NoError(t, err)
+
+		service := notifications.NewService(zaptest.NewLogger(t), db.Notifications(), route)
+
+		runCtx, cancel := context.WithCancel(ctx)
+		ctx.Go(func() error { return service.Run(runCtx) })
+		defer cancel()
+
+		_, err = service.Receive(ctx, notifications.NewNotification{
+			SenderID: testrand.NodeID(),
+			Type:     notifications.TypeCustom,
+			Title:    "custom",
+		})
+		require.NoError(t, err)
+
+		suspension, err := service.Receive(ctx, notifications.NewNotification{
+			SenderID: testrand.NodeID(),
+			Type:     notifications.TypeSuspension,
+			Title:    "suspension",
+		})
+		require.NoError(t, err)
+
+		select {
+		case sent := <-sink.sent:
+			require.Equal(t, suspension, sent)
+		case <-time.After(10 * time.Second):
+			t.Fatal("notification was not delivered")
+		}
+		require.Len(t, sink.sent, 0)
+	})
+}
+
+func TestWebhookSink(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	received := make(chan notifications.Payload, 1)
+	status := int32(http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload notifications.Payload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err == nil {
+			received <- payload
+		}
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer server.Close()
+
+	nodeID := testrand.NodeID()
+	sink := notifications.NewSink(nodeID, notify.NewWebhookNotifier(notify.WebhookConfig{URL: server.URL, Timeout: time.Second}))
+
+	notification := testNotification()
+	require.NoError(t, sink.Send(ctx, notification))
+	require.Equal(t, notifications.Payload{
+		NodeID:       nodeID,
+		TypeName:     "suspension",
+		Notification: notification,
+	}, <-received)
+
+	atomic.StoreInt32(&status, http.StatusInternalServerError)
+	require.Error(t, sink.Send(ctx, notification))
+}
+
+func TestCommandSink(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a shell")
+	}
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	output := ctx.File("output")
+	script := filepath.Join(ctx.Dir("bin"), "notify.sh")
+	require.NoError(t, ioutil.WriteFile(script, []byte("#!/bin/sh\necho \"$NOTIFICATION_TYPE $NOTIFICATION_TITLE\" > "+output+"\ncat >> "+output+"\n"), 0700))
+
+	nodeID := testrand.NodeID()
+	sink := notifications.NewSink(nodeID, notify.NewCommandNotifier(notify.CommandConfig{Path: script, Timeout: 10 * time.Second}))
+
+	notification := testNotification()
+	require.NoError(t, sink.Send(ctx, notification))
+
+	data, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+
+	expected, err := json.Marshal(notifications.Payload{
+		NodeID:       nodeID,
+		TypeName:     "suspension",
+		Notification: notification,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "suspension Your Node is suspended\n"+string(expected), string(data))
+
+	failing := notifications.NewSink(nodeID, notify.NewCommandNotifier(notify.CommandConfig{Path: filepath.Join(ctx.Dir("bin"), "missing")}))
+	require.Error(t, failing.Send(ctx, notification))
+}
@@ -39,9 +39,11 @@ import (
 	"storj.io/storj/storagenode/console/consoleassets"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/diagnostics"
 	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/metrics"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/multinode"
 	"storj.io/storj/storagenode/nodestats"
@@ -92,6 +94,7 @@ type DB interface {
 	Payout() payouts.DB
 	Pricing() pricing.DB
 	APIKeys() apikeys.DB
+	Allocation() monitor.AllocationDB
 
 	Preflight(ctx context.Context) error
 }
@@ -122,6 +125,12 @@ type Config struct {
 
 	Console consoleserver.Config
 
+	Metrics metrics.Config
+
+	Notifications notifications.Config
+
+	Diagnostics diagnostics.Config
+
 	Version checker.Config
 
 	Bandwidth bandwidth.Config
@@ -243,6 +252,8 @@ type Peer struct {
 		Inspector     *inspector.Endpoint
 		Monitor       *monitor.Service
 		Orders        *orders.Service
+
+		BandwidthLimiter *bandwidth.Limiter
 	}
 
 	Collector *collector.Service
@@ -257,6 +268,7 @@ type Peer struct {
 		Listener net.Listener
 		Service  *console.Service
 		Endpoint *consoleserver.Server
+		Metrics  *metrics.Service
 	}
 
 	PieceTransfer struct {
@@ -288,6 +300,7 @@ type Peer struct {
 		Bandwidth *multinode.BandwidthEndpoint
 		Node      *multinode.NodeEndpoint
 		Payout    *multinode.PayoutEndpoint
+		Control   *multinode.ControlEndpoint
 	}
 }
 
@@ -303,7 +316,16 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 	}
 
 	{ // setup notification service.
-		peer.Notifications.Service = notifications.NewService(peer.Log, peer.DB.Notifications())
+		routes, err := notifications.NewRoutes(peer.Identity.ID, config.Notifications)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Notifications.Service = notifications.NewService(peer.Log, peer.DB.Notifications(), routes...)
+		peer.Services.Add(lifecycle.Item{
+			Name: "notifications:service",
+			Run:  peer.Notifications.Service.Run,
+		})
 	}
 
 	{ // setup debug
@@ -410,7 +432,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Version: *pbVersion,
 		}
 		peer.Contact.PingStats = new(contact.PingStats)
-		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust)
+		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust, peer.Notifications.Service)
 
 		peer.Contact.Chore = contact.NewChore(peer.Log.Named("contact:chore"), config.Contact.Interval, peer.Contact.Service)
 		peer.Services.Add(lifecycle.Item{
@@ -470,11 +492,26 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Piecestore Cache", peer.Storage2.CacheService.Loop))
 
+		peer.Storage2.BandwidthLimiter = bandwidth.NewLimiter(
+			peer.Log.Named("bandwidth:limiter"),
+			peer.DB.Bandwidth(),
+			config.Bandwidth.LimiterConfig,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bandwidth:limiter",
+			Run:   peer.Storage2.BandwidthLimiter.Run,
+			Close: peer.Storage2.BandwidthLimiter.Close,
+		})
+
 		peer.Storage2.Monitor = monitor.NewService(
 			log.Named("piecestore:monitor"),
 			peer.Storage2.Store,
 			peer.Contact.Service,
+			peer.Storage2.Trust,
 			peer.DB.Bandwidth(),
+			peer.Storage2.BandwidthLimiter,
+			peer.Notifications.Service,
+			peer.DB.Allocation(),
 			config.Storage.AllocatedDiskSpace.Int64(),
 			// TODO: use config.Storage.Monitor.Interval, but for some reason is not set
 			config.Storage.KBucketRefreshInterval,
@@ -522,6 +559,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Storage2.PieceDeleter,
 			peer.OrdersStore,
 			peer.DB.Bandwidth(),
+			peer.Storage2.BandwidthLimiter,
 			peer.UsedSerials,
 			config.Storage2,
 		)
@@ -653,6 +691,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Estimation.Service,
 			peer.Storage2.BlobsCache,
 			config.Operator.WalletFeatures,
+			peer.Storage2.BandwidthLimiter,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -669,12 +708,26 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			assets = http.Dir(config.Console.StaticDir)
 		}
 
+		if config.Metrics.Enabled {
+			peer.Console.Metrics = metrics.NewService(
+				peer.Log.Named("console:metrics"),
+				peer.Storage2.Trust,
+				peer.Storage2.Monitor,
+				peer.Storage2.BlobsCache,
+				peer.DB.Bandwidth(),
+				peer.DB.Reputation(),
+				peer.Storage2.RetainService,
+				peer.Storage2.Endpoint,
+			)
+		}
+
 		peer.Console.Endpoint = consoleserver.NewServer(
 			peer.Log.Named("console:endpoint"),
 			assets,
 			peer.Notifications.Service,
 			peer.Console.Service,
 			peer.Payout.Service,
+			peer.Console.Metrics,
 			peer.Console.Listener,
 		)
 		peer.Services.Add(lifecycle.Item{
@@ -813,6 +866,20 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Payout.Service,
 		)
 
+		peer.Multinode.Control = multinode.NewControlEndpoint(
+			peer.Log.Named("multinode:control-endpoint"),
+			peer.Identity.ID,
+			apiKeys,
+			peer.Storage2.Monitor,
+			peer.Storage2.Store,
+			peer.Storage2.CacheService,
+			peer.Storage2.RetainService,
+			peer.Storage2.Trust,
+			peer.DB.Satellites(),
+			peer.GracefulExit.Endpoint,
+			peer.Notifications.Service,
+		)
+
 		if err = multinodepb.DRPCRegisterStorage(peer.Server.DRPC(), peer.Multinode.Storage); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -828,6 +895,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err = multinodepb.DRPCRegisterPayouts(peer.Server.DRPC(), peer.Multinode.Payout); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err = multinodepb.DRPCRegisterControl(peer.Server.DRPC(), peer.Multinode.Control); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	return peer, nil
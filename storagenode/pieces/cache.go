@@ -11,7 +11,9 @@ import (
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
+	"storj.io/common/errs2"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/storage"
@@ -26,6 +28,8 @@ type CacheService struct {
 	store      *Store
 	Loop       *sync2.Cycle
 
+	recalculate chan struct{}
+
 	// InitFence is released once the cache's Run method returns or when it has
 	// completed its first loop. This is useful for testing.
 	InitFence sync2.Fence
@@ -39,6 +43,8 @@ func NewService(log *zap.Logger, usageCache *BlobsUsageCache, pieces *Store, int
 		usageCache: usageCache,
 		store:      pieces,
 		Loop:       sync2.NewCycle(interval),
+
+		recalculate: make(chan struct{}, 1),
 	}
 }
 
@@ -48,10 +54,57 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	defer service.InitFence.Release()
 
+	// recalculate the cache once
+	if err = service.Recalculate(ctx); err != nil {
+		return err
+	}
+
+	if err = service.store.spaceUsedDB.Init(ctx); err != nil {
+		service.log.Error("error during init space usage db: ", zap.Error(err))
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var group errgroup.Group
+	group.Go(func() error {
+		defer cancel()
+		return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
+			defer mon.Task()(&ctx)(&err)
+
+			// on a loop sync the cache values to the db so that we have the them saved
+			// in the case that the storagenode restarts
+			if err := service.PersistCacheTotals(ctx); err != nil {
+				service.log.Error("error persisting cache totals to the database: ", zap.Error(err))
+			}
+			service.InitFence.Release()
+			return err
+		})
+	})
+	group.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-service.recalculate:
+				if err := service.Recalculate(ctx); err != nil && !errs2.IsCanceled(err) {
+					service.log.Error("error recalculating used space: ", zap.Error(err))
+				}
+			}
+		}
+	})
+
+	return group.Wait()
+}
+
+// Recalculate walks over all pieces and the trash and updates the space used cache.
+func (service *CacheService) Recalculate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	totalsAtStart := service.usageCache.copyCacheTotals()
 
-	// recalculate the cache once
-	piecesTotal, piecesContentSize, totalsBySatellite, err := service.store.SpaceUsedTotalAndBySatellite(ctx)
+	piecesTotal, piecesContentSize, totalsBySatellite, pieceCounts, err := service.store.spaceUsedAndPieceCounts(ctx)
 	if err != nil {
 		service.log.Error("error getting current used space: ", zap.Error(err))
 		return err
@@ -71,23 +124,20 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 		totalsBySatellite,
 		totalsAtStart.spaceUsedBySatellite,
 	)
+	service.usageCache.setPieceCounts(pieceCounts)
 
-	if err = service.store.spaceUsedDB.Init(ctx); err != nil {
-		service.log.Error("error during init space usage db: ", zap.Error(err))
-		return err
-	}
-
-	return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
-		defer mon.Task()(&ctx)(&err)
+	return nil
+}
 
-		// on a loop sync the cache values to the db so that we have the them saved
-		// in the case that the storagenode restarts
-		if err := service.PersistCacheTotals(ctx); err != nil {
-			service.log.Error("error persisting cache totals to the database: ", zap.Error(err))
-		}
-		service.InitFence.Release()
-		return err
-	})
+// TriggerRecalculation requests a new walk over all pieces to recalculate the space used cache.
+// false is returned if a recalculation is already pending.
+func (service *CacheService) TriggerRecalculation() bool {
+	select {
+	case service.recalculate <- struct{}{}:
+		return true
+	default:
+		return false
+	}
 }
 
 // PersistCacheTotals saves the current totals of the space used cache to the database
@@ -159,6 +209,7 @@ type BlobsUsageCache struct {
 	piecesContentSize    int64
 	trashTotal           int64
 	spaceUsedBySatellite map[storj.NodeID]SatelliteUsage
+	pieceCounts          map[storj.NodeID]int64
 }
 
 // NewBlobsUsageCache creates a new disk blob store with a space used cache.
@@ -200,6 +251,25 @@ func (blobs *BlobsUsageCache) SpaceUsedBySatellite(ctx context.Context, satellit
 	return values.Total, values.ContentSize, nil
 }
 
+// PieceCounts returns the number of pieces of every satellite counted by the last
+// recalculation of the used space. It's empty until the first recalculation finishes.
+func (blobs *BlobsUsageCache) PieceCounts() map[storj.NodeID]int64 {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+
+	counts := make(map[storj.NodeID]int64, len(blobs.pieceCounts))
+	for satelliteID, count := range blobs.pieceCounts {
+		counts[satelliteID] = count
+	}
+	return counts
+}
+
+func (blobs *BlobsUsageCache) setPieceCounts(counts map[storj.NodeID]int64) {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	blobs.pieceCounts = counts
+}
+
 // SpaceUsedForPieces returns the current total used space for all pieces.
 func (blobs *BlobsUsageCache) SpaceUsedForPieces(ctx context.Context) (int64, int64, error) {
 	blobs.mu.Lock()
@@ -198,8 +198,9 @@ func TestCachServiceRun(t *testing.T) {
 		// Prior to initializing the cache service (which should walk the files),
 		// write a single file so something exists to be counted
 		expBlobSize := memory.KB
+		satelliteID := testrand.NodeID()
 		w, err := blobstore.Create(ctx, storage.BlobRef{
-			Namespace: testrand.NodeID().Bytes(),
+			Namespace: satelliteID.Bytes(),
 			Key:       testrand.PieceID().Bytes(),
 		}, -1)
 		require.NoError(t, err)
@@ -239,6 +240,7 @@ func TestCachServiceRun(t *testing.T) {
 		assert.Equal(t, int64(0), piecesTotal)
 		assert.Equal(t, int64(0), piecesContentSize)
 		assert.Equal(t, int64(0), trashTotal)
+		assert.Empty(t, cache.PieceCounts())
 
 		// Run the cache service, which will walk all the pieces
 		var eg errgroup.Group
@@ -258,6 +260,7 @@ func TestCachServiceRun(t *testing.T) {
 		assert.Equal(t, int64(expBlobSize), piecesTotal)
 		assert.Equal(t, int64(expBlobSize-pieces.V1PieceHeaderReservedArea), piecesContentSize)
 		assert.True(t, trashTotal >= int64(expTrashSize))
+		assert.Equal(t, int64(1), cache.PieceCounts()[satelliteID])
 
 		require.NoError(t, cacheService.Close())
 		require.NoError(t, eg.Wait())
@@ -514,6 +514,26 @@ func (store *Store) WalkSatellitePieces(ctx context.Context, satellite storj.Nod
 	return err
 }
 
+// WalkSatellitePiecesWithPrefix executes walkFunc for each locally stored piece in the namespace
+// of the given satellite, whose piece ID starts with pieceIDPrefix. If walkFunc returns a non-nil
+// error, WalkSatellitePiecesWithPrefix will stop iterating and return the error immediately.
+//
+// Note that unlike WalkSatellitePieces this method doesn't include V0 pieces.
+func (store *Store) WalkSatellitePiecesWithPrefix(ctx context.Context, satellite storj.NodeID, pieceIDPrefix byte, walkFunc func(StoredPieceAccess) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.WalkNamespaceWithPrefix(ctx, satellite.Bytes(), pieceIDPrefix, func(blobInfo storage.BlobInfo) error {
+		if blobInfo.StorageFormatVersion() < filestore.FormatV1 {
+			return nil
+		}
+		pieceAccess, err := newStoredPieceAccess(store, blobInfo)
+		if err != nil {
+			// this is not a real piece blob, see WalkSatellitePieces.
+			return nil //nolint: nilerr // we ignore other files
+		}
+		return walkFunc(pieceAccess)
+	})
+}
+
 // GetExpired gets piece IDs that are expired and were created before the given time.
 func (store *Store) GetExpired(ctx context.Context, expiredAt time.Time, limit int64) (_ []ExpiredInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -561,7 +581,7 @@ func (store *Store) SpaceUsedForPieces(ctx context.Context) (piecesTotal int64,
 	if cache, ok := store.blobs.(*BlobsUsageCache); ok {
 		return cache.SpaceUsedForPieces(ctx)
 	}
-	satellites, err := store.getAllStoringSatellites(ctx)
+	satellites, err := store.StoringSatellites(ctx)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -599,7 +619,10 @@ func (store *Store) SpaceUsedForPiecesAndTrash(ctx context.Context) (int64, erro
 	return piecesTotal + trashTotal, nil
 }
 
-func (store *Store) getAllStoringSatellites(ctx context.Context) ([]storj.NodeID, error) {
+// StoringSatellites returns the satellites, which might have pieces stored on the node.
+func (store *Store) StoringSatellites(ctx context.Context) (_ []storj.NodeID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	namespaces, err := store.blobs.ListNamespaces(ctx)
 	if err != nil {
 		return nil, err
@@ -647,17 +670,28 @@ func (store *Store) SpaceUsedBySatellite(ctx context.Context, satelliteID storj.
 func (store *Store) SpaceUsedTotalAndBySatellite(ctx context.Context) (piecesTotal, piecesContentSize int64, totalBySatellite map[storj.NodeID]SatelliteUsage, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	satelliteIDs, err := store.getAllStoringSatellites(ctx)
+	piecesTotal, piecesContentSize, totalBySatellite, _, err = store.spaceUsedAndPieceCounts(ctx)
+	return piecesTotal, piecesContentSize, totalBySatellite, err
+}
+
+// spaceUsedAndPieceCounts adds up the space used by and for all satellites for blob storage
+// and counts the pieces of every satellite.
+func (store *Store) spaceUsedAndPieceCounts(ctx context.Context) (piecesTotal, piecesContentSize int64, totalBySatellite map[storj.NodeID]SatelliteUsage, pieceCounts map[storj.NodeID]int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satelliteIDs, err := store.StoringSatellites(ctx)
 	if err != nil {
-		return 0, 0, nil, Error.New("failed to enumerate satellites: %w", err)
+		return 0, 0, nil, nil, Error.New("failed to enumerate satellites: %w", err)
 	}
 
 	totalBySatellite = map[storj.NodeID]SatelliteUsage{}
+	pieceCounts = map[storj.NodeID]int64{}
 	var group errs.Group
 
 	for _, satelliteID := range satelliteIDs {
 		var satPiecesTotal int64
 		var satPiecesContentSize int64
+		var satPieceCount int64
 
 		err := store.WalkSatellitePieces(ctx, satelliteID, func(access StoredPieceAccess) error {
 			pieceTotal, pieceContentSize, err := access.Size(ctx)
@@ -666,6 +700,7 @@ func (store *Store) SpaceUsedTotalAndBySatellite(ctx context.Context) (piecesTot
 			}
 			satPiecesTotal += pieceTotal
 			satPiecesContentSize += pieceContentSize
+			satPieceCount++
 			return nil
 		})
 		if err != nil {
@@ -678,8 +713,9 @@ func (store *Store) SpaceUsedTotalAndBySatellite(ctx context.Context) (piecesTot
 			Total:       satPiecesTotal,
 			ContentSize: satPiecesContentSize,
 		}
+		pieceCounts[satelliteID] = satPieceCount
 	}
-	return piecesTotal, piecesContentSize, totalBySatellite, group.Err()
+	return piecesTotal, piecesContentSize, totalBySatellite, pieceCounts, group.Err()
 }
 
 // GetV0PieceInfo fetches the Info record from the V0 piece info database. Obviously,
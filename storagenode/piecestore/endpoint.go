@@ -84,6 +84,9 @@ type pingStatsSource interface {
 type Endpoint struct {
 	pb.DRPCContactUnimplementedServer
 
+	// transfers is accessed atomically, so it's the first field to be 64-bit aligned on 32-bit platforms.
+	transfers TransferStats
+
 	log    *zap.Logger
 	config Config
 
@@ -96,6 +99,7 @@ type Endpoint struct {
 	store        *pieces.Store
 	ordersStore  *orders.FileStore
 	usage        bandwidth.DB
+	limiter      *bandwidth.Limiter
 	usedSerials  *usedserials.Table
 	pieceDeleter *pieces.Deleter
 
@@ -103,7 +107,7 @@ type Endpoint struct {
 }
 
 // NewEndpoint creates a new piecestore endpoint.
-func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, store *pieces.Store, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, usedSerials *usedserials.Table, config Config) (*Endpoint, error) {
+func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, store *pieces.Store, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, limiter *bandwidth.Limiter, usedSerials *usedserials.Table, config Config) (*Endpoint, error) {
 	return &Endpoint{
 		log:    log,
 		config: config,
@@ -117,6 +121,7 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, moni
 		store:        store,
 		ordersStore:  ordersStore,
 		usage:        usage,
+		limiter:      limiter,
 		usedSerials:  usedSerials,
 		pieceDeleter: pieceDeleter,
 
@@ -126,6 +131,29 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, moni
 
 var monLiveRequests = mon.TaskNamed("live-request")
 
+// TransferStats contains the number of finished uploads and downloads by their result
+// since the start of the node.
+type TransferStats struct {
+	UploadsSucceeded   int64
+	UploadsFailed      int64
+	UploadsCanceled    int64
+	DownloadsSucceeded int64
+	DownloadsFailed    int64
+	DownloadsCanceled  int64
+}
+
+// TransferStats returns the number of finished uploads and downloads by their result.
+func (endpoint *Endpoint) TransferStats() TransferStats {
+	return TransferStats{
+		UploadsSucceeded:   atomic.LoadInt64(&endpoint.transfers.UploadsSucceeded),
+		UploadsFailed:      atomic.LoadInt64(&endpoint.transfers.UploadsFailed),
+		UploadsCanceled:    atomic.LoadInt64(&endpoint.transfers.UploadsCanceled),
+		DownloadsSucceeded: atomic.LoadInt64(&endpoint.transfers.DownloadsSucceeded),
+		DownloadsFailed:    atomic.LoadInt64(&endpoint.transfers.DownloadsFailed),
+		DownloadsCanceled:  atomic.LoadInt64(&endpoint.transfers.DownloadsCanceled),
+	}
+}
+
 // Delete handles deleting a piece on piece store requested by uplink.
 //
 // Deprecated: use DeletePieces instead.
@@ -234,7 +262,20 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 		return err
 	}
 
-	availableSpace, err := endpoint.monitor.AvailableSpace(ctx)
+	if endpoint.monitor.UploadsPaused() {
+		return rpcstatus.Error(rpcstatus.Unavailable, "uploads are paused by the node operator")
+	}
+
+	if endpoint.trust.GetPolicy(limit.SatelliteId).NoUploads {
+		return rpcstatus.Error(rpcstatus.Unavailable, "uploads of the satellite are rejected by the node operator")
+	}
+
+	if limit.Action == pb.PieceAction_PUT && endpoint.limiter.EgressBudgetSpent() {
+		endpoint.monitor.NotifyLowDisk()
+		return rpcstatus.Error(rpcstatus.Unavailable, "monthly egress budget of the node is spent")
+	}
+
+	availableSpace, err := endpoint.monitor.AvailableSpaceForSatellite(ctx, limit.SatelliteId)
 	if err != nil {
 		return rpcstatus.Wrap(rpcstatus.Internal, err)
 	}
@@ -268,18 +309,21 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 		uploadDuration := dt.Nanoseconds()
 
 		if err != nil && !errs2.IsCanceled(err) {
+			atomic.AddInt64(&endpoint.transfers.UploadsFailed, 1)
 			mon.Meter("upload_failure_byte_meter").Mark64(uploadSize)
 			mon.IntVal("upload_failure_size_bytes").Observe(uploadSize)
 			mon.IntVal("upload_failure_duration_ns").Observe(uploadDuration)
 			mon.FloatVal("upload_failure_rate_bytes_per_sec").Observe(uploadRate)
 			endpoint.log.Error("upload failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Error(err), zap.Int64("Size", uploadSize))
 		} else if errs2.IsCanceled(err) && !committed {
+			atomic.AddInt64(&endpoint.transfers.UploadsCanceled, 1)
 			mon.Meter("upload_cancel_byte_meter").Mark64(uploadSize)
 			mon.IntVal("upload_cancel_size_bytes").Observe(uploadSize)
 			mon.IntVal("upload_cancel_duration_ns").Observe(uploadDuration)
 			mon.FloatVal("upload_cancel_rate_bytes_per_sec").Observe(uploadRate)
 			endpoint.log.Info("upload canceled", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Int64("Size", uploadSize))
 		} else {
+			atomic.AddInt64(&endpoint.transfers.UploadsSucceeded, 1)
 			mon.Meter("upload_success_byte_meter").Mark64(uploadSize)
 			mon.IntVal("upload_success_size_bytes").Observe(uploadSize)
 			mon.IntVal("upload_success_duration_ns").Observe(uploadDuration)
@@ -375,6 +419,9 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 			if availableSpace < 0 {
 				return rpcstatus.Error(rpcstatus.Internal, "out of space")
 			}
+			if err := endpoint.limiter.WaitIngress(ctx, len(message.Chunk.Data)); err != nil {
+				return rpcstatus.Wrap(rpcstatus.Canceled, err)
+			}
 			if _, err := pieceWriter.Write(message.Chunk.Data); err != nil {
 				return rpcstatus.Wrap(rpcstatus.Internal, err)
 			}
@@ -493,6 +540,10 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 		return err
 	}
 
+	if limit.Action == pb.PieceAction_GET && endpoint.limiter.EgressBudgetSpent() {
+		return rpcstatus.Error(rpcstatus.Unavailable, "monthly egress budget of the node is spent")
+	}
+
 	var pieceReader *pieces.Reader
 	defer func() {
 		endTime := time.Now().UTC()
@@ -507,18 +558,21 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 		}
 		downloadDuration := dt.Nanoseconds()
 		if errs2.IsCanceled(err) {
+			atomic.AddInt64(&endpoint.transfers.DownloadsCanceled, 1)
 			mon.Meter("download_cancel_byte_meter").Mark64(downloadSize)
 			mon.IntVal("download_cancel_size_bytes").Observe(downloadSize)
 			mon.IntVal("download_cancel_duration_ns").Observe(downloadDuration)
 			mon.FloatVal("download_cancel_rate_bytes_per_sec").Observe(downloadRate)
 			endpoint.log.Info("download canceled", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action))
 		} else if err != nil {
+			atomic.AddInt64(&endpoint.transfers.DownloadsFailed, 1)
 			mon.Meter("download_failure_byte_meter").Mark64(downloadSize)
 			mon.IntVal("download_failure_size_bytes").Observe(downloadSize)
 			mon.IntVal("download_failure_duration_ns").Observe(downloadDuration)
 			mon.FloatVal("download_failure_rate_bytes_per_sec").Observe(downloadRate)
 			endpoint.log.Error("download failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Error(err))
 		} else {
+			atomic.AddInt64(&endpoint.transfers.DownloadsSucceeded, 1)
 			mon.Meter("download_success_byte_meter").Mark64(downloadSize)
 			mon.IntVal("download_success_size_bytes").Observe(downloadSize)
 			mon.IntVal("download_success_duration_ns").Observe(downloadDuration)
@@ -590,6 +644,13 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 				return nil //nolint: nilerr // We don't need to return an error when client cancels.
 			}
 
+			// audits and repairs aren't limited, so they don't time out.
+			if limit.Action != pb.PieceAction_GET_AUDIT && limit.Action != pb.PieceAction_GET_REPAIR {
+				if err := endpoint.limiter.WaitEgress(ctx, int(chunkSize)); err != nil {
+					return rpcstatus.Wrap(rpcstatus.Canceled, err)
+				}
+			}
+
 			chunkData := make([]byte, chunkSize)
 			_, err = pieceReader.Seek(currentOffset, io.SeekStart)
 			if err != nil {
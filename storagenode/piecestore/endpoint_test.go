@@ -18,6 +18,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/errs2"
+	"storj.io/common/identity/testidentity"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/pkcrypto"
@@ -296,6 +297,76 @@ func TestUploadOverAvailable(t *testing.T) {
 	})
 }
 
+func TestUploadSatellitePolicies(t *testing.T) {
+	// testplanet creates the satellites from the first pregenerated identities.
+	satellite0 := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	satellite1 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 2, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				err := config.Storage2.Trust.Policies.Set(satellite0.String() + "=no-uploads," + satellite1.String() + "=1MB")
+				require.NoError(t, err)
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		require.Equal(t, satellite0, planet.Satellites[0].ID())
+		require.Equal(t, satellite1, planet.Satellites[1].ID())
+
+		client, err := planet.Uplinks[0].DialPiecestore(ctx, planet.StorageNodes[0])
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		for _, tt := range []struct {
+			satellite     int
+			contentLength memory.Size
+			err           string
+		}{
+			{ // should reject uploads of the satellite without uploads
+				satellite:     0,
+				contentLength: 1 * memory.KiB,
+				err:           "uploads of the satellite are rejected by the node operator",
+			},
+			{ // should reject uploads over the disk space allocated for the satellite
+				satellite:     1,
+				contentLength: 2 * memory.MB,
+				err:           "not enough available disk space, have: 1000000, need: 2000000",
+			},
+			{ // should accept uploads within the disk space allocated for the satellite
+				satellite:     1,
+				contentLength: 50 * memory.KiB,
+			},
+		} {
+			satellite := planet.Satellites[tt.satellite]
+			data := testrand.Bytes(tt.contentLength)
+
+			orderLimit, piecePrivateKey := GenerateOrderLimit(
+				t,
+				satellite.ID(),
+				planet.StorageNodes[0].ID(),
+				testrand.PieceID(),
+				pb.PieceAction_PUT,
+				testrand.SerialNumber(),
+				24*time.Hour,
+				24*time.Hour,
+				int64(len(data)),
+			)
+			signer := signing.SignerFromFullIdentity(satellite.Identity)
+			orderLimit, err = signing.SignOrderLimit(ctx, signer, orderLimit)
+			require.NoError(t, err)
+
+			_, err = client.UploadReader(ctx, orderLimit, piecePrivateKey, bytes.NewReader(data))
+			if tt.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		}
+	})
+}
+
 func TestDownload(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
@@ -444,6 +515,102 @@ func TestDownloadGetRepair(t *testing.T) {
 	})
 }
 
+func TestEgressBudgetSpent(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Bandwidth.MonthlyEgress = memory.KiB
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		pieceID := storj.PieceID{1}
+		expectedData, _, _ := uploadPiece(t, ctx, pieceID, node, planet.Uplinks[0], satellite)
+
+		// spend the egress budget
+		err := node.DB.Bandwidth().Add(ctx, satellite.ID(), pb.PieceAction_GET, memory.KiB.Int64(), time.Now())
+		require.NoError(t, err)
+		require.NoError(t, node.Storage2.BandwidthLimiter.CheckEgress(ctx))
+		require.True(t, node.Storage2.BandwidthLimiter.EgressBudgetSpent())
+
+		// the node reports no free disk space to the satellites
+		require.NoError(t, node.Storage2.Monitor.UpdateNodeInformation(ctx))
+		require.Zero(t, node.Contact.Service.LocalFor(satellite.ID()).Capacity.FreeDisk)
+
+		client, err := planet.Uplinks[0].DialPiecestore(ctx, node)
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+		signer := signing.SignerFromFullIdentity(satellite.Identity)
+
+		// should reject uploads
+		data := testrand.Bytes(memory.KiB)
+		orderLimit, piecePrivateKey := GenerateOrderLimit(
+			t,
+			satellite.ID(),
+			node.ID(),
+			testrand.PieceID(),
+			pb.PieceAction_PUT,
+			testrand.SerialNumber(),
+			24*time.Hour,
+			24*time.Hour,
+			int64(len(data)),
+		)
+		orderLimit, err = signing.SignOrderLimit(ctx, signer, orderLimit)
+		require.NoError(t, err)
+
+		_, err = client.UploadReader(ctx, orderLimit, piecePrivateKey, bytes.NewReader(data))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "monthly egress budget of the node is spent")
+
+		for _, tt := range []struct {
+			action pb.PieceAction
+			err    string
+		}{
+			{ // should reject downloads
+				action: pb.PieceAction_GET,
+				err:    "monthly egress budget of the node is spent",
+			},
+			{ // should still serve audits
+				action: pb.PieceAction_GET_AUDIT,
+			},
+			{ // should still serve repairs
+				action: pb.PieceAction_GET_REPAIR,
+			},
+		} {
+			orderLimit, piecePrivateKey := GenerateOrderLimit(
+				t,
+				satellite.ID(),
+				node.ID(),
+				pieceID,
+				tt.action,
+				testrand.SerialNumber(),
+				24*time.Hour,
+				24*time.Hour,
+				int64(len(expectedData)),
+			)
+			orderLimit, err = signing.SignOrderLimit(ctx, signer, orderLimit)
+			require.NoError(t, err)
+
+			downloader, err := client.Download(ctx, orderLimit, piecePrivateKey, 0, int64(len(expectedData)))
+			require.NoError(t, err)
+
+			buffer := make([]byte, len(expectedData))
+			n, readErr := downloader.Read(buffer)
+			err = errs.Combine(readErr, downloader.Close())
+			if tt.err != "" {
+				require.Error(t, err, tt.action)
+				require.Contains(t, err.Error(), tt.err)
+			} else {
+				require.NoError(t, err, tt.action)
+				require.Equal(t, expectedData, buffer[:n])
+			}
+		}
+	})
+}
+
 func TestDelete(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
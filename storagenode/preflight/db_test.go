@@ -19,6 +19,9 @@ func TestPreflightSchema(t *testing.T) {
 	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
 		err := db.Preflight(ctx)
 		require.NoError(t, err)
+
+		err = db.(*storagenodedb.DB).CheckSchema(ctx)
+		require.NoError(t, err)
 	})
 
 	// adding something to the schema should cause a preflight error
@@ -36,6 +39,11 @@ func TestPreflightSchema(t *testing.T) {
 		err = db.Preflight(ctx)
 		require.Error(t, err)
 		require.True(t, storagenodedb.ErrPreflight.Has(err))
+
+		// expect the same error from the schema check
+		err = db.(*storagenodedb.DB).CheckSchema(ctx)
+		require.Error(t, err)
+		require.True(t, storagenodedb.ErrPreflight.Has(err))
 	})
 
 	// removing something from the schema should cause a preflight error
@@ -100,6 +100,47 @@ func (localTime *LocalTime) Check(ctx context.Context) (err error) {
 	return nil
 }
 
+// ClockSkew is the difference of the satellite's system clock to the local system clock.
+type ClockSkew struct {
+	SatelliteID storj.NodeID
+	Skew        time.Duration
+	// Err is the error of getting the satellite's system clock or
+	// ErrClockOutOfSyncMinor and ErrClockOutOfSyncMajor, when the clock is off.
+	Err error
+}
+
+// ClockSkews compares local system clock with all trusted satellites' system clock,
+// regardless of whether the check is enabled.
+func (localTime *LocalTime) ClockSkews(ctx context.Context) (skews []ClockSkew) {
+	defer mon.Task()(&ctx)(nil)
+
+	satellites := localTime.trust.GetSatellites(ctx)
+	skews = make([]ClockSkew, len(satellites))
+
+	var group errgroup.Group
+	for i, satellite := range satellites {
+		i := i
+		satellite := satellite
+		group.Go(func() error {
+			skews[i].SatelliteID = satellite
+
+			currentLocalTime := time.Now().UTC()
+			satelliteTime, err := localTime.getSatelliteTime(ctx, satellite)
+			if err != nil {
+				skews[i].Err = err
+				return nil
+			}
+
+			skews[i].Skew = satelliteTime.GetTimestamp().Sub(currentLocalTime)
+			skews[i].Err = localTime.checkSatelliteTime(ctx, satelliteTime.GetTimestamp(), currentLocalTime)
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	return skews
+}
+
 func (localTime *LocalTime) getSatelliteTime(ctx context.Context, satelliteID storj.NodeID) (_ *pb.GetTimeResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -40,6 +40,19 @@ type Request struct {
 	Filter        *bloomfilter.Filter
 }
 
+// Progress contains the progress of the garbage collection of a satellite.
+type Progress struct {
+	CreatedBefore time.Time
+	Started       time.Time
+	// Finished is zero while the garbage collection is running.
+	Finished time.Time
+
+	PiecesChecked  int64
+	PiecesSkipped  int64
+	PiecesToDelete int64
+	PiecesDeleted  int64
+}
+
 // Status is a type defining the enabled/disabled status of retain requests.
 type Status uint32
 
@@ -94,7 +107,12 @@ type Service struct {
 	cond    sync.Cond
 	queued  map[storj.NodeID]Request
 	working map[storj.NodeID]struct{}
-	group   errgroup.Group
+	// unfinished contains the last request of every satellite until it's processed successfully.
+	unfinished map[storj.NodeID]Request
+	group      errgroup.Group
+
+	progressMu sync.Mutex
+	progress   map[storj.NodeID]Progress
 
 	closedOnce sync.Once
 	closed     chan struct{}
@@ -109,10 +127,13 @@ func NewService(log *zap.Logger, store *pieces.Store, config Config) *Service {
 		log:    log,
 		config: config,
 
-		cond:    *sync.NewCond(&sync.Mutex{}),
-		queued:  make(map[storj.NodeID]Request),
-		working: make(map[storj.NodeID]struct{}),
-		closed:  make(chan struct{}),
+		cond:       *sync.NewCond(&sync.Mutex{}),
+		queued:     make(map[storj.NodeID]Request),
+		working:    make(map[storj.NodeID]struct{}),
+		unfinished: make(map[storj.NodeID]Request),
+		closed:     make(chan struct{}),
+
+		progress: make(map[storj.NodeID]Progress),
 
 		store: store,
 	}
@@ -132,11 +153,46 @@ func (s *Service) Queue(req Request) bool {
 	}
 
 	s.queued[req.SatelliteID] = req
+	s.unfinished[req.SatelliteID] = req
 	s.cond.Broadcast()
 
 	return true
 }
 
+// Restart queues the last retain request received from the satellite again,
+// so that the pieces are walked once more with the same bloom filter.
+// Requests are kept only until they are processed successfully, so only
+// a failed or interrupted garbage collection can be restarted.
+// false is returned if there is no such request for the satellite or the request is discarded.
+func (s *Service) Restart(satelliteID storj.NodeID) bool {
+	s.cond.L.Lock()
+	req, ok := s.unfinished[satelliteID]
+	s.cond.L.Unlock()
+
+	if !ok {
+		return false
+	}
+	return s.Queue(req)
+}
+
+// Progress returns the progress of the running or the last garbage collection of every satellite.
+func (s *Service) Progress() map[storj.NodeID]Progress {
+	s.progressMu.Lock()
+	defer s.progressMu.Unlock()
+
+	progress := make(map[storj.NodeID]Progress, len(s.progress))
+	for satelliteID, satelliteProgress := range s.progress {
+		progress[satelliteID] = satelliteProgress
+	}
+	return progress
+}
+
+func (s *Service) setProgress(satelliteID storj.NodeID, progress Progress) {
+	s.progressMu.Lock()
+	defer s.progressMu.Unlock()
+	s.progress[satelliteID] = progress
+}
+
 // Run listens for queued retain requests and processes them as they come in.
 func (s *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -227,7 +283,7 @@ func (s *Service) Run(ctx context.Context) (err error) {
 				// Mark the request as finished. Relock to maintain that
 				// at the top of the for loop the lock is held.
 				s.cond.L.Lock()
-				s.finish(request)
+				s.finish(request, err == nil)
 				s.cond.Broadcast()
 			}
 		})
@@ -264,8 +320,13 @@ func (s *Service) next() (Request, bool) {
 }
 
 // finish marks the request as finished, requires mutex to be held.
-func (s *Service) finish(request Request) {
+// A successfully processed request can't be restarted anymore, unless a newer one was queued meanwhile.
+func (s *Service) finish(request Request, succeeded bool) {
 	delete(s.working, request.SatelliteID)
+
+	if succeeded && s.unfinished[request.SatelliteID].Filter == request.Filter {
+		delete(s.unfinished, request.SatelliteID)
+	}
 }
 
 // Close causes any pending Run to exit and waits for any retain requests to
@@ -361,6 +422,23 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 	// subtract some time to leave room for clock difference between the satellite and storage node
 	createdBefore := req.CreatedBefore.Add(-s.config.MaxTimeSkew)
 	started := time.Now().UTC()
+
+	progress := func() Progress {
+		return Progress{
+			CreatedBefore:  createdBefore,
+			Started:        started,
+			PiecesChecked:  piecesCount,
+			PiecesSkipped:  piecesSkipped,
+			PiecesToDelete: piecesToDeleteCount,
+			PiecesDeleted:  int64(numDeleted),
+		}
+	}
+	s.setProgress(satelliteID, progress())
+	defer func() {
+		finished := progress()
+		finished.Finished = time.Now().UTC()
+		s.setProgress(satelliteID, finished)
+	}()
 	filterHashCount, _ := req.Filter.Parameters()
 	mon.IntVal("garbage_collection_created_before").Observe(createdBefore.Unix())
 	mon.IntVal("garbage_collection_filter_hash_count").Observe(int64(filterHashCount))
@@ -375,6 +453,7 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 	err = s.store.WalkSatellitePieces(ctx, satelliteID, func(access pieces.StoredPieceAccess) (err error) {
 		defer mon.Task()(&ctx)(&err)
 		piecesCount++
+		defer func() { s.setProgress(satelliteID, progress()) }()
 
 		// We call Gosched() when done because the GC process is expected to be long and we want to keep it at low priority,
 		// so other goroutines can continue serving requests.
@@ -187,6 +187,16 @@ func TestRetainPieces(t *testing.T) {
 			require.NotContains(t, satellite0Pieces, id, "piece should have been deleted")
 		}
 
+		progress := retainEnabled.Progress()
+		require.Len(t, progress, 1)
+		require.EqualValues(t, numPieces, progress[satellite0.ID].PiecesChecked)
+		require.EqualValues(t, numOldPieces, progress[satellite0.ID].PiecesToDelete)
+		require.EqualValues(t, numOldPieces, progress[satellite0.ID].PiecesDeleted)
+		require.False(t, progress[satellite0.ID].Finished.IsZero())
+
+		require.Empty(t, retainDisabled.Progress())
+		require.EqualValues(t, numOldPieces, retainDebug.Progress()[satellite0.ID].PiecesToDelete)
+
 		// shut down retain services
 		cancel()
 		err = group.Wait()
@@ -210,3 +220,27 @@ func generateTestIDs(n int) []storj.PieceID {
 	}
 	return ids
 }
+
+func TestRestart(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	retainService := retain.NewService(zaptest.NewLogger(t), nil, retain.Config{
+		Status:      retain.Disabled,
+		Concurrency: 1,
+	})
+	defer ctx.Check(retainService.Close)
+
+	satelliteID := testrand.NodeID()
+	require.False(t, retainService.Restart(satelliteID))
+
+	// the request can be restarted until it's processed.
+	require.True(t, retainService.Queue(retain.Request{SatelliteID: satelliteID, CreatedBefore: time.Now()}))
+	require.True(t, retainService.Restart(satelliteID))
+
+	ctx.Go(func() error { return retainService.Run(ctx) })
+	retainService.TestWaitUntilEmpty()
+
+	// the successfully processed request isn't kept.
+	require.False(t, retainService.Restart(satelliteID))
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/storagenode/monitor"
+)
+
+// ensures that allocationDB implements monitor.AllocationDB interface.
+var _ monitor.AllocationDB = (*allocationDB)(nil)
+
+// ErrAllocation represents errors from the allocation database.
+var ErrAllocation = errs.Class("allocation")
+
+// AllocationDBName represents the database name.
+const AllocationDBName = "allocation"
+
+// allocationDB works with the disk space allocation changed at runtime.
+// The table holds at most one row, it's always stored with the same id.
+//
+// architecture: Database
+type allocationDB struct {
+	dbContainerImpl
+}
+
+// Get returns the allocation changed at runtime, or nil when it wasn't changed.
+func (db *allocationDB) Get(ctx context.Context) (_ *monitor.Allocation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var allocation monitor.Allocation
+
+	row := db.QueryRowContext(ctx,
+		`SELECT allocated, configured, updated_at FROM allocated_disk_space WHERE id = 0`,
+	)
+
+	err = row.Scan(&allocation.Allocated, &allocation.Configured, &allocation.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, ErrAllocation.Wrap(err)
+	}
+
+	return &allocation, nil
+}
+
+// Set stores the allocation changed at runtime.
+func (db *allocationDB) Set(ctx context.Context, allocation monitor.Allocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `INSERT OR REPLACE INTO allocated_disk_space (
+			id,
+			allocated,
+			configured,
+			updated_at
+		) VALUES(0,?,?,?)`
+
+	_, err = db.ExecContext(ctx, query,
+		allocation.Allocated,
+		allocation.Configured,
+		allocation.UpdatedAt,
+	)
+
+	return ErrAllocation.Wrap(err)
+}
+
+// Delete removes the allocation changed at runtime.
+func (db *allocationDB) Delete(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `DELETE FROM allocated_disk_space`)
+
+	return ErrAllocation.Wrap(err)
+}
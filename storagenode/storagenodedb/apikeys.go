@@ -34,11 +34,13 @@ func (db *apiKeysDB) Store(ctx context.Context, apiKey apikeys.APIKey) (err erro
 
 	query := `INSERT INTO secret (
 			token,
+			class,
 			created_at
-		) VALUES(?,?)`
+		) VALUES(?,?,?)`
 
 	_, err = db.ExecContext(ctx, query,
 		apiKey.Secret[:],
+		int(apiKey.Class),
 		apiKey.CreatedAt,
 	)
 
@@ -71,6 +73,28 @@ func (db *apiKeysDB) Check(ctx context.Context, secret multinodeauth.Secret) (er
 	return nil
 }
 
+// GetClass returns class of the api key by secret.
+func (db *apiKeysDB) GetClass(ctx context.Context, secret multinodeauth.Secret) (_ apikeys.Class, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var class int
+
+	rowStub := db.QueryRowContext(ctx,
+		`SELECT class FROM secret WHERE token = ?`,
+		secret[:],
+	)
+
+	err = rowStub.Scan(&class)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, apikeys.ErrNoAPIKey.Wrap(err)
+		}
+		return 0, ErrAPIKeysDB.Wrap(err)
+	}
+
+	return apikeys.Class(class), nil
+}
+
 // Revoke removes api key from db.
 func (db *apiKeysDB) Revoke(ctx context.Context, secret multinodeauth.Secret) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -27,6 +28,7 @@ import (
 	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode/apikeys"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/payouts"
@@ -108,6 +110,7 @@ type DB struct {
 	payoutDB          *payoutDB
 	pricingDB         *pricingDB
 	apiKeysDB         *apiKeysDB
+	allocationDB      *allocationDB
 
 	SQLDBs map[string]DBContainer
 }
@@ -135,6 +138,7 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 	payoutDB := &payoutDB{}
 	pricingDB := &pricingDB{}
 	apiKeysDB := &apiKeysDB{}
+	allocationDB := &allocationDB{}
 
 	db := &DB{
 		log:    log,
@@ -158,6 +162,7 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 		payoutDB:          payoutDB,
 		pricingDB:         pricingDB,
 		apiKeysDB:         apiKeysDB,
+		allocationDB:      allocationDB,
 
 		SQLDBs: map[string]DBContainer{
 			DeprecatedInfoDBName:  deprecatedInfoDB,
@@ -174,6 +179,7 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 			HeldAmountDBName:      payoutDB,
 			PricingDBName:         pricingDB,
 			APIKeysDBName:         apiKeysDB,
+			AllocationDBName:      allocationDB,
 		},
 	}
 
@@ -203,6 +209,7 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 	payoutDB := &payoutDB{}
 	pricingDB := &pricingDB{}
 	apiKeysDB := &apiKeysDB{}
+	allocationDB := &allocationDB{}
 
 	db := &DB{
 		log:    log,
@@ -226,6 +233,7 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 		payoutDB:          payoutDB,
 		pricingDB:         pricingDB,
 		apiKeysDB:         apiKeysDB,
+		allocationDB:      allocationDB,
 
 		SQLDBs: map[string]DBContainer{
 			DeprecatedInfoDBName:  deprecatedInfoDB,
@@ -242,6 +250,7 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 			HeldAmountDBName:      payoutDB,
 			PricingDBName:         pricingDB,
 			APIKeysDBName:         apiKeysDB,
+			AllocationDBName:      allocationDB,
 		},
 	}
 
@@ -275,6 +284,7 @@ func (db *DB) openDatabases(ctx context.Context) error {
 		HeldAmountDBName,
 		PricingDBName,
 		APIKeysDBName,
+		AllocationDBName,
 	}
 
 	for _, dbName := range dbs {
@@ -356,60 +366,33 @@ func (db *DB) Preflight(ctx context.Context) (err error) {
 	return nil
 }
 
-func (db *DB) preflight(ctx context.Context, dbName string, dbContainer DBContainer) error {
-	nextDB := dbContainer.GetDB()
-	// Preflight stage 1: test schema correctness
-	schema, err := sqliteutil.QuerySchema(ctx, nextDB)
-	if err != nil {
-		return ErrPreflight.New("database %q: schema check failed: %v", dbName, err)
+// CheckSchema compares the schemas of the databases with the expected schema, without modifying the databases.
+func (db *DB) CheckSchema(ctx context.Context) error {
+	dbNames := make([]string, 0, len(db.SQLDBs))
+	for dbName := range db.SQLDBs {
+		dbNames = append(dbNames, dbName)
 	}
-	// we don't care about changes in versions table
-	schema.DropTable("versions")
-	// if there was a previous pre-flight failure, test_table might still be in the schema
-	schema.DropTable("test_table")
+	sort.Strings(dbNames)
 
-	// If tables and indexes of the schema are empty, set to nil
-	// to help with comparison to the snapshot.
-	if len(schema.Tables) == 0 {
-		schema.Tables = nil
-	}
-	if len(schema.Indexes) == 0 {
-		schema.Indexes = nil
-	}
-
-	// get expected schema
-	expectedSchema := Schema()[dbName]
-
-	// find extra indexes
-	var extraIdxs []*dbschema.Index
-	for _, idx := range schema.Indexes {
-		if _, exists := expectedSchema.FindIndex(idx.Name); exists {
-			continue
-		}
-
-		extraIdxs = append(extraIdxs, idx)
-	}
-	// drop index from schema if it is not unique to not fail preflight
-	for _, idx := range extraIdxs {
-		if !idx.Unique {
-			schema.DropIndex(idx.Name)
-		}
-	}
-	// warn that schema contains unexpected indexes
-	if len(extraIdxs) > 0 {
-		db.log.Warn(fmt.Sprintf("database %q: schema contains unexpected indices %v", dbName, extraIdxs))
+	var group errs.Group
+	for _, dbName := range dbNames {
+		group.Add(db.checkSchema(ctx, dbName, db.SQLDBs[dbName]))
 	}
+	return group.Err()
+}
 
-	// expect expected schema to match actual schema
-	if diff := cmp.Diff(expectedSchema, schema); diff != "" {
-		return ErrPreflight.New("database %q: expected schema does not match actual: %s", dbName, diff)
+func (db *DB) preflight(ctx context.Context, dbName string, dbContainer DBContainer) error {
+	nextDB := dbContainer.GetDB()
+	// Preflight stage 1: test schema correctness
+	if err := db.checkSchema(ctx, dbName, dbContainer); err != nil {
+		return err
 	}
 
 	// Preflight stage 2: test basic read/write access
 	// for each database, create a new table, insert a row into that table, retrieve and validate that row, and drop the table.
 
 	// drop test table in case the last preflight check failed before table could be dropped
-	_, err = nextDB.ExecContext(ctx, "DROP TABLE IF EXISTS test_table")
+	_, err := nextDB.ExecContext(ctx, "DROP TABLE IF EXISTS test_table")
 	if err != nil {
 		return ErrPreflight.New("database %q: failed drop if test_table: %w", dbName, err)
 	}
@@ -454,6 +437,56 @@ func (db *DB) preflight(ctx context.Context, dbName string, dbContainer DBContai
 	return nil
 }
 
+// checkSchema compares the schema of the database with the expected schema.
+func (db *DB) checkSchema(ctx context.Context, dbName string, dbContainer DBContainer) error {
+	schema, err := sqliteutil.QuerySchema(ctx, dbContainer.GetDB())
+	if err != nil {
+		return ErrPreflight.New("database %q: schema check failed: %v", dbName, err)
+	}
+	// we don't care about changes in versions table
+	schema.DropTable("versions")
+	// if there was a previous pre-flight failure, test_table might still be in the schema
+	schema.DropTable("test_table")
+
+	// If tables and indexes of the schema are empty, set to nil
+	// to help with comparison to the snapshot.
+	if len(schema.Tables) == 0 {
+		schema.Tables = nil
+	}
+	if len(schema.Indexes) == 0 {
+		schema.Indexes = nil
+	}
+
+	// get expected schema
+	expectedSchema := Schema()[dbName]
+
+	// find extra indexes
+	var extraIdxs []*dbschema.Index
+	for _, idx := range schema.Indexes {
+		if _, exists := expectedSchema.FindIndex(idx.Name); exists {
+			continue
+		}
+
+		extraIdxs = append(extraIdxs, idx)
+	}
+	// drop index from schema if it is not unique to not fail preflight
+	for _, idx := range extraIdxs {
+		if !idx.Unique {
+			schema.DropIndex(idx.Name)
+		}
+	}
+	// warn that schema contains unexpected indexes
+	if len(extraIdxs) > 0 {
+		db.log.Warn(fmt.Sprintf("database %q: schema contains unexpected indices %v", dbName, extraIdxs))
+	}
+
+	// expect expected schema to match actual schema
+	if diff := cmp.Diff(expectedSchema, schema); diff != "" {
+		return ErrPreflight.New("database %q: expected schema does not match actual: %s", dbName, diff)
+	}
+	return nil
+}
+
 // Close closes any resources.
 func (db *DB) Close() error {
 	return db.closeDatabases()
@@ -548,6 +581,11 @@ func (db *DB) APIKeys() apikeys.DB {
 	return db.apiKeysDB
 }
 
+// Allocation returns instance of the Allocation database.
+func (db *DB) Allocation() monitor.AllocationDB {
+	return db.allocationDB
+}
+
 // RawDatabases are required for testing purposes.
 func (db *DB) RawDatabases() map[string]DBContainer {
 	return db.SQLDBs
@@ -1996,6 +2034,35 @@ func (db *DB) Migration(ctx context.Context) *migrate.Migration {
 					 UPDATE satellites SET address = 'satellite.stefan-benten.de:7777' WHERE node_id = X'004ae89e970e703df42ba4ab1416a3b30b7e1d8e14aa0e558f7ee26800000000'`,
 				},
 			},
+			{
+				DB:          &db.apiKeysDB.DB,
+				Description: "Add class to secret table",
+				Version:     54,
+				Action: migrate.SQL{
+					`ALTER TABLE secret ADD COLUMN class INTEGER NOT NULL DEFAULT 0`,
+				},
+			},
+			{
+				DB:          &db.allocationDB.DB,
+				Description: "Create allocated_disk_space table",
+				Version:     55,
+				CreateDB: func(ctx context.Context, log *zap.Logger) error {
+					if err := db.openDatabase(ctx, AllocationDBName); err != nil {
+						return ErrDatabase.Wrap(err)
+					}
+
+					return nil
+				},
+				Action: migrate.SQL{
+					`CREATE TABLE allocated_disk_space (
+						id INTEGER NOT NULL,
+						allocated INTEGER NOT NULL,
+						configured INTEGER NOT NULL,
+						updated_at TIMESTAMP NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+				},
+			},
 		},
 	}
 }
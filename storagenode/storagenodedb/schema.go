@@ -8,6 +8,36 @@ import "storj.io/private/dbutil/dbschema"
 
 func Schema() map[string]*dbschema.Schema {
 	return map[string]*dbschema.Schema{
+		"allocation": &dbschema.Schema{
+			Tables: []*dbschema.Table{
+				&dbschema.Table{
+					Name:       "allocated_disk_space",
+					PrimaryKey: []string{"id"},
+					Columns: []*dbschema.Column{
+						&dbschema.Column{
+							Name:       "allocated",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "configured",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "id",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						&dbschema.Column{
+							Name:       "updated_at",
+							Type:       "TIMESTAMP",
+							IsNullable: false,
+						},
+					},
+				},
+			},
+		},
 		"bandwidth": &dbschema.Schema{
 			Tables: []*dbschema.Table{
 				&dbschema.Table{
@@ -692,6 +722,11 @@ func Schema() map[string]*dbschema.Schema {
 					Name:       "secret",
 					PrimaryKey: []string{"token"},
 					Columns: []*dbschema.Column{
+						&dbschema.Column{
+							Name:       "class",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
 						&dbschema.Column{
 							Name:       "created_at",
 							Type:       "timestamp with time zone",
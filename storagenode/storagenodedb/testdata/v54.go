@@ -0,0 +1,38 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import "storj.io/storj/storagenode/storagenodedb"
+
+var v54 = MultiDBState{
+	Version: 54,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v53.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v53.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:      v53.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v53.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v53.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v53.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v53.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v53.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:      v53.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName:  v53.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:   v53.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.HeldAmountDBName:      v53.DBStates[storagenodedb.HeldAmountDBName],
+		storagenodedb.PricingDBName:         v53.DBStates[storagenodedb.PricingDBName],
+		storagenodedb.APIKeysDBName: &DBState{
+			SQL: `
+				-- table to hold storagenode secret token
+				CREATE TABLE secret (
+					token bytea NOT NULL,
+					created_at timestamp with time zone NOT NULL,
+					class INTEGER NOT NULL DEFAULT 0,
+					PRIMARY KEY ( token )
+				);`,
+			NewData: `
+				INSERT INTO secret (token, created_at, class) VALUES
+					(X'6a7ae0ab2f9b1c6a2aa3d1f1bd2c8e5d4e2a1b0c9d8e7f6a5b4c3d2e1f0a9b8c', '2021-08-01 10:00:00+00:00', 1);`,
+		},
+	},
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import "storj.io/storj/storagenode/storagenodedb"
+
+var v55 = MultiDBState{
+	Version: 55,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v54.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v54.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:      v54.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v54.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v54.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v54.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v54.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v54.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:      v54.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName:  v54.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:   v54.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.HeldAmountDBName:      v54.DBStates[storagenodedb.HeldAmountDBName],
+		storagenodedb.PricingDBName:         v54.DBStates[storagenodedb.PricingDBName],
+		storagenodedb.APIKeysDBName: &DBState{
+			SQL: `
+				-- table to hold storagenode secret token
+				CREATE TABLE secret (
+					token bytea NOT NULL,
+					created_at timestamp with time zone NOT NULL,
+					class INTEGER NOT NULL DEFAULT 0,
+					PRIMARY KEY ( token )
+				);
+				INSERT INTO secret (token, created_at, class) VALUES
+					(X'6a7ae0ab2f9b1c6a2aa3d1f1bd2c8e5d4e2a1b0c9d8e7f6a5b4c3d2e1f0a9b8c', '2021-08-01 10:00:00+00:00', 1);`,
+		},
+		storagenodedb.AllocationDBName: &DBState{
+			SQL: `
+				-- table to hold the disk space allocation changed at runtime
+				CREATE TABLE allocated_disk_space (
+					id INTEGER NOT NULL,
+					allocated INTEGER NOT NULL,
+					configured INTEGER NOT NULL,
+					updated_at TIMESTAMP NOT NULL,
+					PRIMARY KEY ( id )
+				);`,
+			NewData: `
+				INSERT INTO allocated_disk_space (id, allocated, configured, updated_at) VALUES
+					(0, 1000000000000, 2000000000000, '2021-08-01 10:00:00+00:00');`,
+		},
+	},
+}
@@ -14,6 +14,7 @@ import (
 type Config struct {
 	Sources         Sources       `help:"list of trust sources" devDefault:"" releaseDefault:"https://www.storj.io/dcs-satellites"`
 	Exclusions      Exclusions    `help:"list of trust exclusions" devDefault:"" releaseDefault:""`
+	Policies        Policies      `help:"list of per satellite upload policies, <satellite id>=<disk space> limits the disk space used by the satellite, <satellite id>=no-uploads rejects its new uploads" devDefault:"" releaseDefault:""`
 	RefreshInterval time.Duration `help:"how often the trust pool should be refreshed" default:"6h"`
 	CachePath       string        `help:"file path where trust lists should be cached" default:"${CONFDIR}/trust-cache.json"`
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/memory"
 	"storj.io/common/testrand"
 	"storj.io/storj/storagenode/trust"
 )
@@ -61,3 +62,35 @@ func TestExclusionsConfig(t *testing.T) {
 		assert.Equal(t, exclusion2, exclusions.Rules[2].String())
 	}
 }
+
+func TestPoliciesConfig(t *testing.T) {
+	satellite0 := testrand.NodeID()
+	satellite1 := testrand.NodeID()
+
+	var policies trust.Policies
+	assert.Equal(t, "trust-policies", policies.Type())
+	assert.Equal(t, "", policies.String())
+
+	// Assert that comma separated policies can be set and the entries of a satellite are merged
+	require.NoError(t, policies.Set(fmt.Sprintf("%s=1TB,%s=no-uploads,%s=2.0 GB", satellite0, satellite1, satellite1)))
+	assert.Equal(t, memory.TB, policies.Get(satellite0).AllocatedDiskSpace)
+	assert.False(t, policies.Get(satellite0).NoUploads)
+	assert.Equal(t, 2*memory.GB, policies.Get(satellite1).AllocatedDiskSpace)
+	assert.True(t, policies.Get(satellite1).NoUploads)
+
+	// Assert that the string representation can be set again
+	var reparsed trust.Policies
+	require.NoError(t, reparsed.Set(policies.String()))
+	assert.Equal(t, policies, reparsed)
+
+	// Assert that a failure to set does not modify the current policies
+	require.Error(t, policies.Set(satellite0.String()))
+	require.Error(t, policies.Set(satellite0.String()+"=everything"))
+	require.Error(t, policies.Set(satellite0.String()+"=0B"))
+	require.Error(t, policies.Set("unknown=1TB"))
+	assert.Len(t, policies.List, 2)
+
+	// Assert that satellites without a policy aren't restricted
+	other := testrand.NodeID()
+	assert.Equal(t, trust.Policy{SatelliteID: other}, policies.Get(other))
+}
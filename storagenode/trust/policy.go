@@ -0,0 +1,139 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+)
+
+var (
+	// ErrPolicy is an error class for satellite policy related errors.
+	ErrPolicy = errs.Class("policy")
+)
+
+// noUploads is the policy value, which rejects new uploads of the satellite.
+const noUploads = "no-uploads"
+
+// Policy restricts the uploads of a satellite.
+type Policy struct {
+	SatelliteID storj.NodeID
+	// AllocatedDiskSpace is the disk space the pieces of the satellite may use,
+	// zero means the satellite may use all the disk space allocated for the node.
+	AllocatedDiskSpace memory.Size
+	// NoUploads rejects new uploads of the satellite.
+	NoUploads bool
+}
+
+// String returns a string representation of the policy.
+func (policy Policy) String() string {
+	var values []string
+	if policy.AllocatedDiskSpace > 0 {
+		values = append(values, policy.SatelliteID.String()+"="+policy.AllocatedDiskSpace.String())
+	}
+	if policy.NoUploads {
+		values = append(values, policy.SatelliteID.String()+"="+noUploads)
+	}
+	return strings.Join(values, ",")
+}
+
+// Policies is a list of satellite policies that implements pflag.Value.
+//
+// Every entry has the form <satellite id>=<disk space> or <satellite id>=no-uploads,
+// the entries of the same satellite are merged.
+type Policies struct {
+	List []Policy
+}
+
+// String returns the string representation of the config.
+func (policies *Policies) String() string {
+	s := make([]string, 0, len(policies.List))
+	for _, policy := range policies.List {
+		s = append(s, policy.String())
+	}
+	return strings.Join(s, ",")
+}
+
+// Set implements pflag.Value by parsing a comma separated list of policies.
+func (policies *Policies) Set(value string) error {
+	var entries []string
+	if value != "" {
+		entries = strings.Split(value, ",")
+	}
+
+	bySatellite := make(map[storj.NodeID]Policy)
+	for _, entry := range entries {
+		if err := parsePolicyEntry(bySatellite, strings.TrimSpace(entry)); err != nil {
+			return Error.New("invalid policy %q: %w", entry, errs.Unwrap(err))
+		}
+	}
+
+	list := make([]Policy, 0, len(bySatellite))
+	for _, policy := range bySatellite {
+		list = append(list, policy)
+	}
+	sort.Slice(list, func(i, k int) bool {
+		return list[i].SatelliteID.Less(list[k].SatelliteID)
+	})
+
+	policies.List = list
+	return nil
+}
+
+// Type returns the type of the pflag.Value.
+func (policies Policies) Type() string {
+	return "trust-policies"
+}
+
+// Get returns the policy of the satellite, satellites without a policy have no restrictions.
+func (policies Policies) Get(satelliteID storj.NodeID) Policy {
+	for _, policy := range policies.List {
+		if policy.SatelliteID == satelliteID {
+			return policy
+		}
+	}
+	return Policy{SatelliteID: satelliteID}
+}
+
+// parsePolicyEntry parses the entry and merges it into the policy of its satellite.
+func parsePolicyEntry(bySatellite map[storj.NodeID]Policy, entry string) error {
+	parts := strings.SplitN(entry, "=", 2)
+	if len(parts) != 2 {
+		return ErrPolicy.New("expected <satellite id>=<disk space> or <satellite id>=%s", noUploads)
+	}
+
+	satelliteID, err := storj.NodeIDFromString(parts[0])
+	if err != nil {
+		return ErrPolicy.New("invalid satellite id: %v", err)
+	}
+
+	policy := bySatellite[satelliteID]
+	policy.SatelliteID = satelliteID
+
+	if parts[1] == noUploads {
+		policy.NoUploads = true
+	} else {
+		var size memory.Size
+		// memory.Size.Set doesn't handle values without any digits.
+		if strings.IndexFunc(parts[1], unicode.IsDigit) < 0 {
+			return ErrPolicy.New("invalid disk space %q", parts[1])
+		}
+		if err := size.Set(parts[1]); err != nil {
+			return ErrPolicy.New("invalid disk space: %v", err)
+		}
+		if size <= 0 {
+			return ErrPolicy.New("disk space must be positive")
+		}
+		policy.AllocatedDiskSpace = size
+	}
+
+	bySatellite[satelliteID] = policy
+	return nil
+}
@@ -65,6 +65,7 @@ type Pool struct {
 	log             *zap.Logger
 	resolver        IdentityResolver
 	refreshInterval time.Duration
+	policies        Policies
 
 	listMu sync.Mutex
 	list   *List
@@ -100,6 +101,7 @@ func NewPool(log *zap.Logger, resolver IdentityResolver, config Config, satellit
 		log:             log,
 		resolver:        resolver,
 		refreshInterval: config.RefreshInterval,
+		policies:        config.Policies,
 		list:            list,
 		satellitesDB:    satellitesDB,
 		satellites:      make(map[storj.NodeID]*satelliteInfoCache),
@@ -170,6 +172,16 @@ func (pool *Pool) GetSatellites(ctx context.Context) (satellites []storj.NodeID)
 	return satellites
 }
 
+// GetPolicy returns the upload policy of the satellite.
+func (pool *Pool) GetPolicy(id storj.NodeID) Policy {
+	return pool.policies.Get(id)
+}
+
+// GetPolicies returns the satellites with an upload policy.
+func (pool *Pool) GetPolicies() []Policy {
+	return pool.policies.List
+}
+
 // GetNodeURL returns the node url of a satellite in the trusted list.
 func (pool *Pool) GetNodeURL(ctx context.Context, id storj.NodeID) (_ storj.NodeURL, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,78 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+import { AccountsClient } from '@/api/accounts';
+
+/**
+ * Role defines what an account is allowed to do.
+ */
+export enum Role {
+    Viewer = 'viewer',
+    Admin = 'admin',
+}
+
+/**
+ * Account is an operator account of the Multinode Dashboard.
+ */
+export class Account {
+    public constructor(
+        public id: string = '',
+        public username: string = '',
+        public role: Role = Role.Viewer,
+        public totpEnabled: boolean = false,
+        public createdAt: Date = new Date(),
+    ) {}
+
+    /**
+     * indicates if the account is allowed to manage nodes and accounts.
+     */
+    public get isAdmin(): boolean {
+        return this.role === Role.Admin;
+    }
+}
+
+/**
+ * Credentials holds the login form data.
+ */
+export class Credentials {
+    public constructor(
+        public username: string = '',
+        public password: string = '',
+        public totpCode: string = '',
+    ) {}
+}
+
+/**
+ * exposes accounts and sessions related functionality.
+ */
+export class Accounts {
+    private readonly accounts: AccountsClient;
+
+    public constructor(accounts: AccountsClient) {
+        this.accounts = accounts;
+    }
+
+    /**
+     * starts a new session with the credentials.
+     *
+     * @throws {@link TOTPRequiredError}
+     * Thrown if the account requires a TOTP code and none was provided.
+     */
+    public async login(credentials: Credentials): Promise<void> {
+        await this.accounts.login(credentials);
+    }
+
+    /**
+     * ends the current session.
+     */
+    public async logout(): Promise<void> {
+        await this.accounts.logout();
+    }
+
+    /**
+     * returns the logged in account.
+     */
+    public async account(): Promise<Account> {
+        return await this.accounts.account();
+    }
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+import { Account, Credentials } from '@/accounts';
+import { APIClient } from '@/api/index';
+
+/**
+ * client for accounts controller of MND api.
+ */
+export class AccountsClient extends APIClient {
+    private readonly ROOT_PATH: string = '/api/v0/auth';
+
+    /**
+     * starts a new session, the session cookie is set by the server.
+     *
+     * @param credentials - username, password and the optional TOTP code.
+     *
+     * @throws {@link TOTPRequiredError}
+     * Thrown if the account requires a TOTP code and none was provided.
+     *
+     * @throws {@link UnauthorizedError}
+     * Thrown if the credentials are invalid.
+     *
+     * @throws {@link InternalError}
+     * Thrown if something goes wrong on server side.
+     */
+    public async login(credentials: Credentials): Promise<void> {
+        const path = `${this.ROOT_PATH}/login`;
+        const response = await this.http.post(path, JSON.stringify(credentials));
+
+        if (!response.ok) {
+            await this.handleError(response);
+        }
+    }
+
+    /**
+     * ends the current session.
+     *
+     * @throws {@link InternalError}
+     * Thrown if something goes wrong on server side.
+     */
+    public async logout(): Promise<void> {
+        const path = `${this.ROOT_PATH}/logout`;
+        const response = await this.http.post(path, null);
+
+        if (!response.ok) {
+            await this.handleError(response);
+        }
+    }
+
+    /**
+     * returns the logged in account.
+     *
+     * @throws {@link UnauthorizedError}
+     * Thrown if the auth cookie is missing or invalid.
+     *
+     * @throws {@link InternalError}
+     * Thrown if something goes wrong on server side.
+     */
+    public async account(): Promise<Account> {
+        const path = `${this.ROOT_PATH}/account`;
+        const response = await this.http.get(path);
+
+        if (!response.ok) {
+            await this.handleError(response);
+        }
+
+        const account = await response.json();
+
+        return new Account(
+            account.id,
+            account.username,
+            account.role,
+            account.totpEnabled,
+            new Date(account.createdAt),
+        );
+    }
+}
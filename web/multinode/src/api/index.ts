@@ -12,6 +12,15 @@ export class UnauthorizedError extends Error {
     }
 }
 
+/**
+ * TOTPRequiredError is a custom error type for logging in to an account with TOTP enabled without a code.
+ */
+export class TOTPRequiredError extends UnauthorizedError {
+    public constructor(message = 'totp code required') {
+        super(message);
+    }
+}
+
 /**
  * BadRequestError is a custom error type for performing bad request.
  */
@@ -21,6 +30,15 @@ export class BadRequestError extends Error {
     }
 }
 
+/**
+ * TooManyRequestsError is a custom error type for exceeding the rate limit, e.g. of the login attempts.
+ */
+export class TooManyRequestsError extends Error {
+    public constructor(message = 'too many requests, try again later') {
+        super(message);
+    }
+}
+
 /**
  * InternalError is a custom error type for internal server error.
  */
@@ -52,10 +70,20 @@ export class APIClient {
      * @private
      */
     protected async handleError(response: Response): Promise<void> {
+        // the rate limiter responds with plain text.
+        if (response.status === 429) {
+            throw new TooManyRequestsError();
+        }
+
         const body = await response.json();
 
         switch (response.status) {
-        case 401: throw new UnauthorizedError(body.error);
+        case 401:
+            if (body.error && body.error.includes('totp code required')) {
+                throw new TOTPRequiredError(body.error);
+            }
+
+            throw new UnauthorizedError(body.error);
         case 400: throw new BadRequestError(body.error);
         case 500:
         default:
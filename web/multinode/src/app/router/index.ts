@@ -8,6 +8,7 @@ import { store } from '@/app/store';
 import AddFirstNode from '@/app/views/AddFirstNode.vue';
 import BandwidthPage from '@/app/views/bandwidth/BandwidthPage.vue';
 import Dashboard from '@/app/views/Dashboard.vue';
+import LoginPage from '@/app/views/LoginPage.vue';
 import MyNodes from '@/app/views/myNodes/MyNodes.vue';
 import PayoutsByNode from '@/app/views/payouts/PayoutsByNode.vue';
 import PayoutsPage from '@/app/views/payouts/PayoutsPage.vue';
@@ -92,6 +93,7 @@ export class Route {
 export class Config {
     public static Root: Route = new Route('/', 'Root', Dashboard, { requiresAuth: true });
     public static Welcome: Route = new Route('/welcome', 'Welcome', WelcomeScreen);
+    public static Login: Route = new Route('/login', 'Login', LoginPage);
     // nodes.
     public static AddFirstNode: Route = new Route('/add-first-node', 'AddFirstNode', AddFirstNode);
     public static MyNodes: Route = new Route('/my-nodes', 'MyNodes', MyNodes);
@@ -122,15 +124,51 @@ export class Config {
         ]),
         Config.Welcome,
         Config.AddFirstNode,
+        Config.Login,
     ];
 }
 
 export const router = new Router(Config);
 
+/**
+ * List of allowed routes without a logged in account.
+ */
+const publicRoutesNames = [Config.Login.name];
+
 /**
  * List of allowed routes without any node added.
  */
-const allowedRoutesNames = [Config.AddFirstNode.name, Config.Welcome.name];
+const allowedRoutesNames = [Config.AddFirstNode.name, Config.Welcome.name, Config.Login.name];
+
+/**
+ * Checks if there is a logged in account and redirects to the Login screen if not.
+ */
+router.beforeEach(async(to, _from, next) => {
+    if (to.matched.some(record => publicRoutesNames.includes(<string>record.name)) || store.state.accounts.account) {
+        next();
+
+        return;
+    }
+
+    try {
+        await store.dispatch('accounts/fetch');
+    } catch (error) {
+        next({ name: Config.Login.name, query: { redirect: to.fullPath } });
+
+        return;
+    }
+
+    next();
+});
+
+/**
+ * Redirects to the Login screen, e.g. after the session expired.
+ */
+export async function redirectToLogin(): Promise<void> {
+    store.commit('accounts/setAccount', null);
+
+    await router.push({ name: Config.Login.name, query: { redirect: router.currentRoute.fullPath } });
+}
 
 /**
  * Checks if redirect to some of internal routes and no nodes added so far.
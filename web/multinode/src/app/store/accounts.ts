@@ -0,0 +1,84 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+import { ActionContext, ActionTree, GetterTree, Module, MutationTree } from 'vuex';
+
+import { Account, Accounts, Credentials } from '@/accounts';
+import { RootState } from '@/app/store/index';
+
+/**
+ * AccountsState is a representation of the logged in account.
+ */
+export class AccountsState {
+    public account: Account | null = null;
+}
+
+/**
+ * AccountsModule is a part of a global store that encapsulates all accounts and sessions related logic.
+ */
+export class AccountsModule implements Module<AccountsState, RootState> {
+    public readonly namespaced: boolean;
+    public readonly state: AccountsState;
+    public readonly getters?: GetterTree<AccountsState, RootState>;
+    public readonly actions: ActionTree<AccountsState, RootState>;
+    public readonly mutations: MutationTree<AccountsState>;
+
+    private readonly accounts: Accounts;
+
+    public constructor(accounts: Accounts) {
+        this.accounts = accounts;
+
+        this.namespaced = true;
+        this.state = new AccountsState();
+
+        this.mutations = {
+            setAccount: this.setAccount,
+        };
+
+        this.actions = {
+            login: this.login.bind(this),
+            logout: this.logout.bind(this),
+            fetch: this.fetch.bind(this),
+        };
+    }
+
+    /**
+     * setAccount mutation will set the logged in account.
+     * @param state - state of the module.
+     * @param account - logged in account or null after logout.
+     */
+    public setAccount(state: AccountsState, account: Account | null): void {
+        state.account = account;
+    }
+
+    /**
+     * login action starts a new session and loads the logged in account.
+     * @param ctx - context of the Vuex action.
+     * @param credentials - username, password and the optional TOTP code.
+     */
+    public async login(ctx: ActionContext<AccountsState, RootState>, credentials: Credentials): Promise<void> {
+        await this.accounts.login(credentials);
+
+        await this.fetch(ctx);
+    }
+
+    /**
+     * logout action ends the session.
+     * @param ctx - context of the Vuex action.
+     */
+    public async logout(ctx: ActionContext<AccountsState, RootState>): Promise<void> {
+        await this.accounts.logout();
+
+        ctx.commit('setAccount', null);
+    }
+
+    /**
+     * fetch action loads the logged in account.
+     * @param ctx - context of the Vuex action.
+     */
+    public async fetch(ctx: ActionContext<AccountsState, RootState>): Promise<void> {
+        const account = await this.accounts.account();
+
+        ctx.commit('setAccount', account);
+    }
+}
@@ -4,11 +4,14 @@
 import Vue from 'vue';
 import Vuex, { ModuleTree, Store, StoreOptions } from 'vuex';
 
+import { Accounts } from '@/accounts';
+import { AccountsClient } from '@/api/accounts';
 import { BandwidthClient } from '@/api/bandwidth';
 import { NodesClient } from '@/api/nodes';
 import { Operators as OperatorsClient } from '@/api/operators';
 import { PayoutsClient } from '@/api/payouts';
 import { StorageClient } from '@/api/storage';
+import { AccountsModule, AccountsState } from '@/app/store/accounts';
 import { BandwidthModule, BandwidthState } from '@/app/store/bandwidth';
 import { NodesModule, NodesState } from '@/app/store/nodes';
 import { OperatorsModule, OperatorsState } from '@/app/store/operators';
@@ -26,6 +29,7 @@ Vue.use(Vuex);
  * RootState is a representation of global state.
  */
 export class RootState {
+    accounts: AccountsState;
     nodes: NodesState;
     payouts: PayoutsState;
     operators: OperatorsState;
@@ -42,6 +46,7 @@ export class MultinodeStoreOptions implements StoreOptions<RootState> {
     public readonly modules: ModuleTree<RootState>;
 
     public constructor(
+        accounts: AccountsModule,
         nodes: NodesModule,
         payouts: PayoutsModule,
         operators: OperatorsModule,
@@ -51,6 +56,7 @@ export class MultinodeStoreOptions implements StoreOptions<RootState> {
         this.strict = true;
 
         this.state = {
+            accounts: accounts.state,
             nodes: nodes.state,
             payouts: payouts.state,
             bandwidth: bandwidth.state,
@@ -59,6 +65,7 @@ export class MultinodeStoreOptions implements StoreOptions<RootState> {
         };
 
         this.modules = {
+            accounts,
             nodes,
             payouts,
             bandwidth,
@@ -69,6 +76,8 @@ export class MultinodeStoreOptions implements StoreOptions<RootState> {
 }
 
 // Services
+const accountsClient: AccountsClient = new AccountsClient();
+const accountsService: Accounts = new Accounts(accountsClient);
 const nodesClient: NodesClient = new NodesClient();
 const nodesService: Nodes = new Nodes(nodesClient);
 const payoutsClient: PayoutsClient = new PayoutsClient();
@@ -81,6 +90,7 @@ const storageClient: StorageClient = new StorageClient();
 const storageService: StorageService = new StorageService(storageClient);
 
 // Modules
+const accountsModule: AccountsModule = new AccountsModule(accountsService);
 const nodesModule: NodesModule = new NodesModule(nodesService);
 const payoutsModule: PayoutsModule = new PayoutsModule(payoutsService);
 const bandwidthModule: BandwidthModule = new BandwidthModule(bandwidthService);
@@ -89,5 +99,5 @@ const storageModule: StorageModule = new StorageModule(storageService);
 
 // Store
 export const store: Store<RootState> = new Vuex.Store<RootState>(
-    new MultinodeStoreOptions(nodesModule, payoutsModule, operatorsModule, bandwidthModule, storageModule),
+    new MultinodeStoreOptions(accountsModule, nodesModule, payoutsModule, operatorsModule, bandwidthModule, storageModule),
 );
@@ -3,11 +3,14 @@
 
 import Vuex from 'vuex';
 
+import { Accounts } from '@/accounts';
+import { AccountsClient } from '@/api/accounts';
 import { BandwidthClient } from '@/api/bandwidth';
 import { NodesClient } from '@/api/nodes';
 import { Operators as OperatorsClient } from '@/api/operators';
 import { PayoutsClient } from '@/api/payouts';
 import { StorageClient } from '@/api/storage';
+import { AccountsModule } from '@/app/store/accounts';
 import { BandwidthModule } from '@/app/store/bandwidth';
 import { NodesModule } from '@/app/store/nodes';
 import { OperatorsModule } from '@/app/store/operators';
@@ -24,6 +27,12 @@ const Vue = createLocalVue();
 
 Vue.use(Vuex);
 
+const accountsClient: AccountsClient = new AccountsClient();
+
+export const accountsService: Accounts = new Accounts(accountsClient);
+
+const accountsModule: AccountsModule = new AccountsModule(accountsService);
+
 const nodesClient: NodesClient = new NodesClient();
 
 export const nodesService: Nodes = new Nodes(nodesClient);
@@ -55,6 +64,7 @@ export const storageService = new StorageService(storageClient);
 const storageModule: StorageModule = new StorageModule(storageService);
 
 const store = new Vuex.Store({ modules: {
+    accounts: accountsModule,
     payouts: payoutsModule,
     nodes: nodesModule,
     operators: operatorsModule,
@@ -0,0 +1,65 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+import Vuex from 'vuex';
+
+import { Account, Credentials, Role } from '@/accounts';
+import { TOTPRequiredError } from '@/api';
+import { RootState } from '@/app/store';
+import { createLocalVue } from '@vue/test-utils';
+
+import store, { accountsService } from '../mock/store';
+
+const state = store.state as RootState;
+
+const account = new Account('id', 'admin', Role.Admin, false, new Date());
+
+describe('mutations', () => {
+    beforeEach(() => {
+        createLocalVue().use(Vuex);
+    });
+
+    it('sets account', () => {
+        store.commit('accounts/setAccount', account);
+
+        expect(state.accounts.account).toBe(account);
+        expect(state.accounts.account && state.accounts.account.isAdmin).toBe(true);
+    });
+});
+
+describe('actions', () => {
+    beforeEach(() => {
+        jest.resetAllMocks();
+        store.commit('accounts/setAccount', null);
+    });
+
+    it('does not set account on failed login', async() => {
+        jest.spyOn(accountsService, 'login').mockImplementation(() => { throw new TOTPRequiredError(); });
+
+        try {
+            await store.dispatch('accounts/login', new Credentials('admin', 'password'));
+            expect(true).toBe(false);
+        } catch (error) {
+            expect(error instanceof TOTPRequiredError).toBe(true);
+            expect(state.accounts.account).toBe(null);
+        }
+    });
+
+    it('sets account on login', async() => {
+        jest.spyOn(accountsService, 'login').mockReturnValue(Promise.resolve());
+        jest.spyOn(accountsService, 'account').mockReturnValue(Promise.resolve(account));
+
+        await store.dispatch('accounts/login', new Credentials('admin', 'password'));
+
+        expect(state.accounts.account).toBe(account);
+    });
+
+    it('removes account on logout', async() => {
+        store.commit('accounts/setAccount', account);
+        jest.spyOn(accountsService, 'logout').mockReturnValue(Promise.resolve());
+
+        await store.dispatch('accounts/logout');
+
+        expect(state.accounts.account).toBe(null);
+    });
+});
@@ -76,6 +76,7 @@ export class UINotification {
             this.icon = NotificationIcon.SOFTWARE_UPDATE;
             break;
         case NotificationTypes.Suspension:
+        case NotificationTypes.Offline:
             this.icon = NotificationIcon.SUSPENDED;
             break;
         default: